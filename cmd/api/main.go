@@ -78,6 +78,11 @@ func gracefulShutdown(fiberServer *server.FiberServer, scheduler *task.Scheduler
 		)
 	}
 
+	// Stop enriching records, the scheduled tasks pick up what is left
+	if fiberServer.Enrichment != nil {
+		fiberServer.Enrichment.Close()
+	}
+
 	// Stop the task scheduler
 	if scheduler != nil {
 		scheduler.Stop()
@@ -31,11 +31,17 @@ func main() {
 	switch command {
 	case "sync":
 		syncSchema()
+	case "migrate":
+		migrateServiceTables()
 	case "import-moments":
 		if len(os.Args) < 3 {
 			dbToolsLogger.Fatal("please specify CSV file path")
 		}
 		importMoments(os.Args[2])
+	case "repair-vectors":
+		repairVectors(len(os.Args) > 2 && os.Args[2] == "--dry-run")
+	case "migrate-pgvector":
+		migratePgvector()
 	default:
 		dbToolsLogger.Errorw("unknown command", "command", command)
 		printUsage()
@@ -46,12 +52,18 @@ func main() {
 func printUsage() {
 	dbToolsLogger.Infow("db-tools usage information",
 		"commands", map[string]string{
-			"sync":           "sync database schema from existing database",
-			"import-moments": "import data from CSV file to moment table",
+			"sync":             "sync database schema from existing database",
+			"migrate":          "create or update the tables owned by this service",
+			"import-moments":   "import data from CSV file to moment table",
+			"repair-vectors":   "re-embed vectors stored as placeholders while the embedding service was down",
+			"migrate-pgvector": "convert the vector columns to pgvector and add the text search columns used by the postgres search backend",
 		},
 		"examples", []string{
 			"go run ./cmd/db-tools sync",
+			"go run ./cmd/db-tools migrate",
 			"go run ./cmd/db-tools import-moments <csv_file_path>",
+			"go run ./cmd/db-tools repair-vectors [--dry-run]",
+			"go run ./cmd/db-tools migrate-pgvector",
 		},
 	)
 }
@@ -66,6 +78,16 @@ func syncSchema() {
 	dbToolsLogger.Info("database schema synced successfully")
 }
 
+func migrateServiceTables() {
+	dbToolsLogger.Info("migrating service tables")
+
+	if err := tools.MigrateServiceTables(); err != nil {
+		dbToolsLogger.Fatalw("failed to migrate service tables", "error", err)
+	}
+
+	dbToolsLogger.Info("service tables migrated successfully")
+}
+
 func importMoments(csvPath string) {
 	dbToolsLogger.Infow("importing data from CSV to moment table", "csv_path", csvPath)
 
@@ -78,3 +100,33 @@ func importMoments(csvPath string) {
 
 	dbToolsLogger.Info("data imported successfully")
 }
+
+func repairVectors(dryRun bool) {
+	dbToolsLogger.Infow("repairing placeholder vectors", "dry_run", dryRun)
+
+	report, err := tools.RepairPlaceholderVectors(dryRun)
+	if err != nil {
+		dbToolsLogger.Fatalw("failed to repair vectors",
+			"error", err,
+			"found", report.Found,
+			"repaired", report.Repaired,
+			"cleared", report.Cleared,
+		)
+	}
+
+	dbToolsLogger.Infow("placeholder vectors repaired, reindex to refresh search",
+		"found", report.Found,
+		"repaired", report.Repaired,
+		"cleared", report.Cleared,
+	)
+}
+
+func migratePgvector() {
+	dbToolsLogger.Info("migrating vector columns to pgvector")
+
+	if err := tools.MigratePgvector(); err != nil {
+		dbToolsLogger.Fatalw("failed to migrate vector columns", "error", err)
+	}
+
+	dbToolsLogger.Info("vector columns migrated successfully")
+}
@@ -10,6 +10,8 @@ import (
 
 func main() {
 	err := entc.Generate("../../internal/ent/schema", &gen.Config{
+		// QueryContext/ExecContext on the client, for the Postgres search backend
+		Features: []gen.Feature{gen.FeatureExecQuery},
 		Hooks: []gen.Hook{
 			func(next gen.Generator) gen.Generator {
 				return gen.GenerateFunc(func(g *gen.Graph) error {
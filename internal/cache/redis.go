@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"api.us4ever/internal/config"
+	"api.us4ever/internal/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	redisLogger *logger.Logger
+)
+
+func init() {
+	var err error
+	redisLogger, err = logger.New("redis")
+	if err != nil {
+		panic("failed to initialize redis logger: " + err.Error())
+	}
+}
+
+// NewRedisClient creates a Redis client from the configuration and verifies the connection
+func NewRedisClient(cfg config.RedisConfig) (*redis.Client, error) {
+	// Validate configuration
+	if cfg.Host == "" {
+		return nil, fmt.Errorf("redis host is required")
+	}
+	port := cfg.Port
+	if port == 0 {
+		port = 6379
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     net.JoinHostPort(cfg.Host, strconv.Itoa(port)),
+		Password: cfg.Password,
+		DB:       cfg.DB,
+	})
+
+	// Test the connection with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	redisLogger.Info("Redis client created and connection verified successfully")
+	return client, nil
+}
@@ -0,0 +1,232 @@
+// Package chunk splits long notes into passages small enough to be retrieved
+// and quoted on their own, at paragraph and sentence boundaries, for both
+// Chinese and Latin text.
+package chunk
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultMaxRunes is the default upper bound of a passage
+	DefaultMaxRunes = 500
+	// DefaultOverlapRunes is the default amount of text repeated at the start
+	// of a passage from the end of the previous one
+	DefaultOverlapRunes = 80
+)
+
+// Passage is one piece of a text. Start and End are rune offsets into the
+// original text; with overlap, consecutive passages share a range.
+type Passage struct {
+	Text  string
+	Start int
+	End   int
+	// Heading is the path of Markdown headings above the passage, set by SplitMarkdown
+	Heading string
+}
+
+// Options bound the passages returned by Split.
+type Options struct {
+	MaxRunes     int
+	OverlapRunes int
+}
+
+func (o Options) normalize() Options {
+	if o.MaxRunes <= 0 {
+		o.MaxRunes = DefaultMaxRunes
+	}
+	if o.OverlapRunes < 0 || o.OverlapRunes >= o.MaxRunes {
+		o.OverlapRunes = 0
+	}
+	return o
+}
+
+// unit is a paragraph, a sentence or, for a run-on sentence, a slice of at
+// most MaxRunes runes, with its rune offsets.
+type unit struct {
+	text       string
+	start, end int
+	// paragraph marks the first unit of a paragraph
+	paragraph bool
+}
+
+// Split cuts text into passages of at most opts.MaxRunes runes. Paragraphs are
+// kept whole when they fit; longer ones are cut between sentences, and only a
+// sentence longer than MaxRunes is cut mid-sentence. A text that fits in one
+// passage is returned as is. Blank text yields no passage.
+func Split(text string, opts Options) []Passage {
+	opts = opts.normalize()
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	runes := []rune(text)
+	if len(runes) <= opts.MaxRunes {
+		u := trimUnit(runes, 0, len(runes), true)
+		return []Passage{{Text: u.text, Start: u.start, End: u.end}}
+	}
+
+	units := splitUnits(runes, opts.MaxRunes)
+	var (
+		passages []Passage
+		current  []unit
+		size     int
+	)
+	flush := func() {
+		if len(current) == 0 {
+			return
+		}
+		passages = append(passages, join(current))
+		// 保留末尾不超过 OverlapRunes 的句子作为下一段的开头
+		var keep []unit
+		kept := 0
+		for i := len(current) - 1; i >= 0; i-- {
+			n := current[i].end - current[i].start
+			if kept+n > opts.OverlapRunes {
+				break
+			}
+			keep = append([]unit{current[i]}, keep...)
+			kept += n
+		}
+		current, size = keep, kept
+	}
+
+	for _, u := range units {
+		n := u.end - u.start
+		if size+n > opts.MaxRunes {
+			flush()
+			// 重叠部分和新句子放不下时丢弃重叠
+			if size+n > opts.MaxRunes {
+				current, size = nil, 0
+			}
+		}
+		current = append(current, u)
+		size += n
+	}
+	// 只剩重叠部分时它已经包含在上一段里
+	if len(current) > 0 && (len(passages) == 0 || current[len(current)-1].end > passages[len(passages)-1].End) {
+		flush()
+	}
+	return passages
+}
+
+// join concatenates units into a passage, separating paragraphs with a blank line.
+func join(units []unit) Passage {
+	var b strings.Builder
+	for i, u := range units {
+		if i > 0 {
+			if u.paragraph {
+				b.WriteString("\n\n")
+			} else if needsSpace(units[i-1].text, u.text) {
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteString(u.text)
+	}
+	return Passage{Text: b.String(), Start: units[0].start, End: units[len(units)-1].end}
+}
+
+// needsSpace reports whether two sentences of Latin text were separated by a space.
+func needsSpace(prev, next string) bool {
+	last, _ := utf8.DecodeLastRuneInString(prev)
+	first, _ := utf8.DecodeRuneInString(next)
+	return last < utf8.RuneSelf && first < utf8.RuneSelf
+}
+
+// splitUnits cuts text into paragraphs, paragraphs longer than maxRunes into
+// sentences and sentences longer than maxRunes into slices of maxRunes runes.
+func splitUnits(text []rune, maxRunes int) []unit {
+	var units []unit
+	for _, p := range paragraphs(text) {
+		if p.end-p.start <= maxRunes {
+			p.paragraph = true
+			units = append(units, p)
+			continue
+		}
+		for i, s := range sentences(text, p.start, p.end) {
+			for start := s.start; start < s.end; start += maxRunes {
+				end := min(start+maxRunes, s.end)
+				units = append(units, trimUnit(text, start, end, i == 0 && start == s.start))
+			}
+		}
+	}
+	return units
+}
+
+// paragraphs returns the non-blank ranges of text separated by blank lines.
+func paragraphs(text []rune) []unit {
+	var out []unit
+	start := 0
+	for i := 0; i <= len(text); i++ {
+		if i < len(text) && !(text[i] == '\n' && blankLineFollows(text, i)) {
+			continue
+		}
+		if u := trimUnit(text, start, i, true); u.text != "" {
+			out = append(out, u)
+		}
+		start = i + 1
+	}
+	return out
+}
+
+// blankLineFollows reports whether the newline at i is followed by a line
+// holding only white space.
+func blankLineFollows(text []rune, i int) bool {
+	for j := i + 1; j < len(text); j++ {
+		switch text[j] {
+		case '\n':
+			return true
+		case ' ', '\t', '\r':
+			continue
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// sentences cuts text[start:end] after sentence-ending punctuation, Chinese
+// or Latin, and after line breaks.
+func sentences(text []rune, start, end int) []unit {
+	var out []unit
+	from := start
+	for i := start; i < end; i++ {
+		if !sentenceEnd(text, i, end) {
+			continue
+		}
+		if u := trimUnit(text, from, i+1, false); u.text != "" {
+			out = append(out, u)
+		}
+		from = i + 1
+	}
+	if u := trimUnit(text, from, end, false); u.text != "" {
+		out = append(out, u)
+	}
+	return out
+}
+
+func sentenceEnd(text []rune, i, end int) bool {
+	switch text[i] {
+	case '。', '！', '？', '；', '\n':
+		return true
+	case '.', '!', '?', ';':
+		// 英文标点后需要空白，避免切开 3.14 或 example.com
+		return i+1 == end || text[i+1] == ' ' || text[i+1] == '\n'
+	}
+	return false
+}
+
+// trimUnit returns text[start:end] without surrounding white space.
+func trimUnit(text []rune, start, end int, paragraph bool) unit {
+	for start < end && isSpace(text[start]) {
+		start++
+	}
+	for end > start && isSpace(text[end-1]) {
+		end--
+	}
+	return unit{text: string(text[start:end]), start: start, end: end, paragraph: paragraph}
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == '　'
+}
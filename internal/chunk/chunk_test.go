@@ -0,0 +1,83 @@
+package chunk
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSplit_ShortText(t *testing.T) {
+	passages := Split("  一条短笔记。\n", Options{})
+	if len(passages) != 1 || passages[0].Text != "一条短笔记。" {
+		t.Fatalf("expected the text as one passage, got %+v", passages)
+	}
+	if passages[0].Start != 2 || passages[0].End != 8 {
+		t.Errorf("expected rune offsets 2-8, got %d-%d", passages[0].Start, passages[0].End)
+	}
+	if Split(" \n\t", Options{}) != nil {
+		t.Error("expected no passage for blank text")
+	}
+}
+
+func TestSplit_Paragraphs(t *testing.T) {
+	text := strings.Repeat("甲", 30) + "\n\n" + strings.Repeat("乙", 30) + "\n \n" + strings.Repeat("丙", 30)
+	passages := Split(text, Options{MaxRunes: 70})
+	if len(passages) != 2 {
+		t.Fatalf("expected 2 passages, got %d: %+v", len(passages), passages)
+	}
+	if passages[0].Text != strings.Repeat("甲", 30)+"\n\n"+strings.Repeat("乙", 30) {
+		t.Errorf("expected the first two paragraphs together, got %q", passages[0].Text)
+	}
+	runes := []rune(text)
+	for _, p := range passages {
+		if got := string(runes[p.Start:p.End]); !strings.HasPrefix(got, string([]rune(p.Text)[:5])) {
+			t.Errorf("offsets %d-%d do not point at %q", p.Start, p.End, p.Text)
+		}
+	}
+}
+
+func TestSplit_SentencesAndOverlap(t *testing.T) {
+	var b strings.Builder
+	for i := range 20 {
+		b.WriteString(strings.Repeat(string(rune('a'+i)), 18))
+		b.WriteString(". ")
+	}
+	text := b.String()
+
+	passages := Split(text, Options{MaxRunes: 60, OverlapRunes: 20})
+	if len(passages) < 2 {
+		t.Fatalf("expected the paragraph to be split, got %+v", passages)
+	}
+	for i, p := range passages {
+		if n := utf8.RuneCountInString(p.Text); n > 60 {
+			t.Errorf("passage %d has %d runes", i, n)
+		}
+		if !strings.HasSuffix(p.Text, ".") {
+			t.Errorf("passage %d does not end at a sentence: %q", i, p.Text)
+		}
+		// 每段以上一段的最后一句开头
+		if i > 0 && p.Start >= passages[i-1].End {
+			t.Errorf("passage %d does not overlap the previous one", i)
+		}
+	}
+	if last := passages[len(passages)-1]; last.End != len([]rune(strings.TrimSpace(text))) {
+		t.Errorf("expected the last passage to reach the end, got %d", last.End)
+	}
+}
+
+func TestSplit_RunOnSentence(t *testing.T) {
+	text := strings.Repeat("长", 250)
+	passages := Split(text, Options{MaxRunes: 100})
+	if len(passages) != 3 || utf8.RuneCountInString(passages[2].Text) != 50 {
+		t.Errorf("expected a run-on sentence to be cut every 100 runes, got %d passages", len(passages))
+	}
+}
+
+func TestSplit_KeepsDecimals(t *testing.T) {
+	text := strings.Repeat("x", 50) + " pi is 3.14 and e is 2.71. " + strings.Repeat("y", 50) + "."
+	for _, p := range Split(text, Options{MaxRunes: 80}) {
+		if strings.HasSuffix(p.Text, "3.") || strings.HasPrefix(p.Text, "14") {
+			t.Errorf("expected 3.14 not to be split, got %q", p.Text)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package chunk
+
+import (
+	"strings"
+)
+
+// section is the body of a Markdown section with the path of headings above it.
+// start and end are rune offsets of the body, without its heading line.
+type section struct {
+	path       []string
+	start, end int
+}
+
+// SplitMarkdown cuts a Markdown text into passages that never span two
+// sections of unrelated headings. Consecutive short sections are packed
+// together up to opts.MaxRunes, keeping their heading lines inline; longer
+// sections are cut with Split. Every passage carries the heading path it falls
+// under, e.g. "安装 > Linux". Headings inside fenced code blocks are ignored.
+func SplitMarkdown(text string, opts Options) []Passage {
+	opts = opts.normalize()
+	runes := []rune(text)
+
+	var (
+		passages []Passage
+		group    *section
+	)
+	flush := func() {
+		if group == nil {
+			return
+		}
+		heading := strings.Join(group.path, " > ")
+		for _, p := range Split(string(runes[group.start:group.end]), opts) {
+			p.Start += group.start
+			p.End += group.start
+			p.Heading = heading
+			passages = append(passages, p)
+		}
+		group = nil
+	}
+
+	for _, s := range sections(runes) {
+		if group != nil && len([]rune(strings.TrimSpace(string(runes[group.start:s.end])))) <= opts.MaxRunes {
+			// 合并后仍放得下：后一节的标题行留在正文中，标题取两者的共同上级
+			group.end = s.end
+			group.path = commonPrefix(group.path, s.path)
+			continue
+		}
+		flush()
+		group = &section{path: s.path, start: s.start, end: s.end}
+	}
+	flush()
+	return passages
+}
+
+// sections splits text at ATX headings ("# ..." to "###### ...") outside
+// fenced code blocks. Sections without body text are left out.
+func sections(text []rune) []section {
+	var (
+		out   []section
+		stack []string // heading path, one entry per level in use
+		cur   = section{}
+		fence string
+	)
+	closeSection := func(end int) {
+		cur.end = end
+		if strings.TrimSpace(string(text[cur.start:cur.end])) != "" {
+			out = append(out, cur)
+		}
+	}
+
+	for lineStart := 0; lineStart < len(text); {
+		lineEnd := lineStart
+		for lineEnd < len(text) && text[lineEnd] != '\n' {
+			lineEnd++
+		}
+		next := min(lineEnd+1, len(text))
+		line := strings.TrimSpace(string(text[lineStart:lineEnd]))
+
+		if marker := fenceMarker(line); marker != "" && (fence == "" || strings.HasPrefix(marker, fence)) {
+			if fence == "" {
+				fence = marker
+			} else {
+				fence = ""
+			}
+		} else if level, title := heading(line); fence == "" && level > 0 {
+			closeSection(lineStart)
+			if len(stack) >= level {
+				stack = stack[:level-1]
+			}
+			// 跳级的标题（# 之后直接 ###）不补空的中间层
+			stack = append(stack, title)
+			cur = section{path: append([]string(nil), stack...), start: next}
+		}
+		lineStart = next
+	}
+	closeSection(len(text))
+	return out
+}
+
+// heading returns the level and text of an ATX heading line, or 0.
+func heading(line string) (int, string) {
+	level := 0
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || (level < len(line) && line[level] != ' ' && line[level] != '\t') {
+		return 0, ""
+	}
+	// 去掉可选的结尾 #
+	title := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(line[level:]), "#"))
+	if title == "" {
+		return 0, ""
+	}
+	return level, title
+}
+
+// fenceMarker returns the ``` or ~~~ run opening or closing a fenced code block.
+func fenceMarker(line string) string {
+	for _, c := range []string{"`", "~"} {
+		n := 0
+		for n < len(line) && line[n:n+1] == c {
+			n++
+		}
+		if n >= 3 {
+			return line[:n]
+		}
+	}
+	return ""
+}
+
+func commonPrefix(a, b []string) []string {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return a[:n:n]
+}
+
+// EmbeddingText returns the text to embed for a passage: the heading path
+// followed by the passage, so a passage keeps the context of its section.
+func (p Passage) EmbeddingText() string {
+	if p.Heading == "" {
+		return p.Text
+	}
+	return p.Heading + "\n\n" + p.Text
+}
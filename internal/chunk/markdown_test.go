@@ -0,0 +1,62 @@
+package chunk
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSplitMarkdown_PacksShortSections(t *testing.T) {
+	text := "# 安装\n\n## Linux\n\n用 apt 安装。\n\n## macOS\n\n用 brew 安装。\n"
+	got := SplitMarkdown(text, Options{MaxRunes: 200})
+	if len(got) != 1 {
+		t.Fatalf("got %d passages, want 1: %+v", len(got), got)
+	}
+	p := got[0]
+	if p.Heading != "安装" {
+		t.Errorf("heading = %q, want the common parent %q", p.Heading, "安装")
+	}
+	if !strings.Contains(p.Text, "## macOS") || !strings.HasPrefix(p.Text, "用 apt 安装。") {
+		t.Errorf("unexpected text %q", p.Text)
+	}
+	if got := string([]rune(text)[p.Start:p.End]); got != p.Text {
+		t.Errorf("offsets point at %q, want %q", got, p.Text)
+	}
+}
+
+func TestSplitMarkdown_KeepsLongSectionsApart(t *testing.T) {
+	linux := strings.Repeat("在 Linux 上安装。", 10)
+	mac := strings.Repeat("在 macOS 上安装。", 10)
+	text := "# 安装\n## Linux\n" + linux + "\n## macOS\n" + mac + "\n"
+
+	got := SplitMarkdown(text, Options{MaxRunes: 150})
+	if len(got) != 2 {
+		t.Fatalf("got %d passages, want 2: %+v", len(got), got)
+	}
+	if got[0].Heading != "安装 > Linux" || got[0].Text != linux {
+		t.Errorf("first passage = %q under %q", got[0].Text, got[0].Heading)
+	}
+	if got[1].Heading != "安装 > macOS" || got[1].Text != mac {
+		t.Errorf("second passage = %q under %q", got[1].Text, got[1].Heading)
+	}
+	if want := "安装 > macOS\n\n" + mac; got[1].EmbeddingText() != want {
+		t.Errorf("EmbeddingText = %q", got[1].EmbeddingText())
+	}
+}
+
+func TestSplitMarkdown_IgnoresHeadingsInCodeAndHashtags(t *testing.T) {
+	body := strings.Repeat("正文内容。", 30)
+	text := "#标签 不是标题\n" + body + "\n```sh\n# 注释不是标题\necho hi\n```\n"
+	got := SplitMarkdown(text, Options{MaxRunes: 500})
+	if len(got) != 1 {
+		t.Fatalf("got %d passages, want 1: %+v", len(got), got)
+	}
+	if got[0].Heading != "" || !strings.Contains(got[0].Text, "# 注释不是标题") {
+		t.Errorf("unexpected passage %+v", got[0])
+	}
+}
+
+func TestSplitMarkdown_Empty(t *testing.T) {
+	if got := SplitMarkdown("# 只有标题\n\n## 还是标题\n", Options{}); len(got) != 0 {
+		t.Errorf("got %+v, want no passage", got)
+	}
+}
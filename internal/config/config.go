@@ -25,11 +25,19 @@ type AppConfig struct {
 	OCR       OCRConfig       `json:"ocr,omitempty"`
 	Telegram  TelegramConfig  `json:"telegram,omitempty"`
 	Embedding EmbeddingConfig `json:"embedding,omitempty"`
+	Search    SearchConfig    `json:"search,omitempty"`
 	// 添加其他配置项...
 }
 
+// EmbeddingConfig 向量模型配置
 type EmbeddingConfig struct {
+	// Provider 选择后端：service（默认，自建服务）、openai（兼容 /v1/embeddings）或 ollama
+	Provider string `json:"provider,omitempty"`
 	Endpoint string `json:"endpoint"`
+	Model    string `json:"model,omitempty"`
+	// Dimensions 模型输出的向量维度，同时决定索引 mapping 中 dense_vector 的 dims，默认 1024
+	Dimensions int    `json:"dimensions,omitempty"`
+	ApiKey     string `json:"api_key,omitempty"`
 }
 
 type TelegramConfig struct {
@@ -74,6 +82,33 @@ type ESConfig struct {
 	Addresses []string `json:"addresses"`
 	Username  string   `json:"username,omitempty"`
 	Password  string   `json:"password,omitempty"`
+	// Indices 按索引名（keeps、moments ...）覆盖索引定义中的分片与副本数
+	Indices map[string]ESIndexConfig `json:"indices,omitempty"`
+	// RetainIndices 每个别名保留的索引代数（含当前），用于回滚，默认 3
+	RetainIndices int `json:"retain_indices,omitempty"`
+	// MinDocRatio 新索引的文档数低于当前索引的该比例时拒绝切换别名，默认 0.9，负数关闭检查
+	MinDocRatio float64 `json:"min_doc_ratio,omitempty"`
+	// MaxFailureRatio 重索引中写入失败的文档占比不超过该值时仍切换别名，默认 0.01，负数表示不允许失败
+	MaxFailureRatio float64 `json:"max_failure_ratio,omitempty"`
+	// RepairInconsistencies 定时一致性检查时修复缺失、残留和过期的文档，默认只报告
+	RepairInconsistencies bool `json:"repair_inconsistencies,omitempty"`
+}
+
+// SearchConfig 搜索后端配置
+type SearchConfig struct {
+	// Backend 选择搜索后端：elasticsearch、postgres 或 bleve（内嵌索引，适合单机部署和本地开发）；
+	// 为空时配置了 ES 地址则用 elasticsearch，否则用 postgres
+	Backend string `json:"backend,omitempty"`
+	// BlevePath bleve 索引的存放目录，默认 data/search
+	BlevePath string `json:"bleve_path,omitempty"`
+	// TextSearchConfig postgres 全文检索使用的 text search configuration，默认 simple
+	TextSearchConfig string `json:"text_search_config,omitempty"`
+}
+
+// ESIndexConfig 单个索引的设置，未填写的字段使用索引定义中的默认值
+type ESIndexConfig struct {
+	Shards   *int `json:"shards,omitempty"`
+	Replicas *int `json:"replicas,omitempty"`
 }
 
 var (
@@ -7,6 +7,12 @@ import (
 
 	"api.us4ever/internal/config"
 	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/image"
+	"api.us4ever/internal/ent/keep"
+	"api.us4ever/internal/ent/mindmap"
+	"api.us4ever/internal/ent/moment"
+	"api.us4ever/internal/ent/todo"
+	"api.us4ever/internal/ent/video"
 
 	_ "github.com/lib/pq"
 )
@@ -19,11 +25,23 @@ type Service interface {
 	// Client returns the ent client
 	Client() *ent.Client
 
-	// GetAllKeeps retrieves all Keep entities from the database.
-	GetAllKeeps(ctx context.Context) ([]*ent.Keep, error)
+	// KeepPages calls fn with every Keep, one page of at most pageSize rows at a time.
+	KeepPages(ctx context.Context, pageSize int, fn func(page []*ent.Keep, progress Progress) error) error
 
-	// GetAllMoments retrieves all Moment entities from the database.
-	GetAllMoments(ctx context.Context) ([]*ent.Moment, error)
+	// MomentPages calls fn with every Moment, with images eager loaded, one page at a time.
+	MomentPages(ctx context.Context, pageSize int, fn func(page []*ent.Moment, progress Progress) error) error
+
+	// MindmapPages calls fn with every Mindmap, one page at a time.
+	MindmapPages(ctx context.Context, pageSize int, fn func(page []*ent.Mindmap, progress Progress) error) error
+
+	// TodoPages calls fn with every Todo, one page at a time.
+	TodoPages(ctx context.Context, pageSize int, fn func(page []*ent.Todo, progress Progress) error) error
+
+	// ImagePages calls fn with every Image, one page at a time.
+	ImagePages(ctx context.Context, pageSize int, fn func(page []*ent.Image, progress Progress) error) error
+
+	// VideoPages calls fn with every Video, one page at a time.
+	VideoPages(ctx context.Context, pageSize int, fn func(page []*ent.Video, progress Progress) error) error
 
 	// Close closes the database connection
 	Close() error
@@ -47,6 +65,11 @@ func New() (Service, error) {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	// 注册搜索同步钩子
+	hookCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	registerSearchHooks(hookCtx, client)
+
 	return &Database{
 		client: client,
 	}, nil
@@ -77,26 +100,164 @@ func (db *Database) Client() *ent.Client {
 	return db.client
 }
 
-// GetAllKeeps retrieves all Keep entities.
-func (db *Database) GetAllKeeps(ctx context.Context) ([]*ent.Keep, error) {
-	keeps, err := db.client.Keep.Query().All(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed getting all keeps: %w", err)
-	}
-	return keeps, nil
+// Progress tells a page callback how far the iteration has got.
+type Progress struct {
+	// Done is the number of rows handed out so far, the current page included
+	Done int
+	// Total is the row count taken when the iteration started; rows added
+	// meanwhile can make Done exceed it
+	Total int
+}
+
+// KeepPages iterates over all keeps ordered by ID. Pages are fetched by keyset
+// so memory stays bounded by pageSize however large the table grows. The
+// first error returned by fn stops the iteration and is returned.
+func (db *Database) KeepPages(ctx context.Context, pageSize int, fn func(page []*ent.Keep, progress Progress) error) error {
+	return pagesByID(ctx, "keeps", pageSize,
+		db.client.Keep.Query().Count,
+		func(lastID string) ([]*ent.Keep, error) {
+			return db.client.Keep.Query().
+				Where(keep.IDGT(lastID)).
+				Order(ent.Asc(keep.FieldID)).
+				Limit(pageSize).
+				All(ctx)
+		},
+		func(k *ent.Keep) string { return k.ID },
+		fn,
+	)
+}
+
+// MomentPages iterates over all moments ordered by ID, like KeepPages.
+func (db *Database) MomentPages(ctx context.Context, pageSize int, fn func(page []*ent.Moment, progress Progress) error) error {
+	return pagesByID(ctx, "moments", pageSize,
+		db.client.Moment.Query().Count,
+		func(lastID string) ([]*ent.Moment, error) {
+			return db.client.Moment.Query().
+				Where(moment.IDGT(lastID)).
+				Order(ent.Asc(moment.FieldID)).
+				Limit(pageSize).
+				WithMomentImages(func(q *ent.MomentImageQuery) {
+					q.WithImage() // Eager load images
+				}).
+				All(ctx)
+		},
+		func(m *ent.Moment) string { return m.ID },
+		fn,
+	)
 }
 
-// GetAllMoments retrieves all Moment entities.
-func (db *Database) GetAllMoments(ctx context.Context) ([]*ent.Moment, error) {
-	moments, err := db.client.Moment.Query().
-		WithMomentImages(func(q *ent.MomentImageQuery) {
-			q.WithImage() // Eager load images
-		}).
-		All(ctx)
+// MindmapPages iterates over all mindmaps ordered by ID, like KeepPages.
+func (db *Database) MindmapPages(ctx context.Context, pageSize int, fn func(page []*ent.Mindmap, progress Progress) error) error {
+	return pagesByID(ctx, "mindmaps", pageSize,
+		db.client.Mindmap.Query().Count,
+		func(lastID string) ([]*ent.Mindmap, error) {
+			return db.client.Mindmap.Query().
+				Where(mindmap.IDGT(lastID)).
+				Order(ent.Asc(mindmap.FieldID)).
+				Limit(pageSize).
+				All(ctx)
+		},
+		func(m *ent.Mindmap) string { return m.ID },
+		fn,
+	)
+}
+
+// TodoPages iterates over all todos ordered by ID, like KeepPages.
+func (db *Database) TodoPages(ctx context.Context, pageSize int, fn func(page []*ent.Todo, progress Progress) error) error {
+	return pagesByID(ctx, "todos", pageSize,
+		db.client.Todo.Query().Count,
+		func(lastID string) ([]*ent.Todo, error) {
+			return db.client.Todo.Query().
+				Where(todo.IDGT(lastID)).
+				Order(ent.Asc(todo.FieldID)).
+				Limit(pageSize).
+				All(ctx)
+		},
+		func(t *ent.Todo) string { return t.ID },
+		fn,
+	)
+}
+
+// ImagePages iterates over all images ordered by ID, like KeepPages. The
+// thumbnail bytes are not loaded.
+func (db *Database) ImagePages(ctx context.Context, pageSize int, fn func(page []*ent.Image, progress Progress) error) error {
+	return pagesByID(ctx, "images", pageSize,
+		db.client.Image.Query().Count,
+		func(lastID string) ([]*ent.Image, error) {
+			return db.client.Image.Query().
+				Where(image.IDGT(lastID)).
+				Order(ent.Asc(image.FieldID)).
+				Limit(pageSize).
+				Select(ImageSearchFields...).
+				All(ctx)
+		},
+		func(i *ent.Image) string { return i.ID },
+		fn,
+	)
+}
+
+// VideoPages iterates over all videos ordered by ID, like KeepPages.
+func (db *Database) VideoPages(ctx context.Context, pageSize int, fn func(page []*ent.Video, progress Progress) error) error {
+	return pagesByID(ctx, "videos", pageSize,
+		db.client.Video.Query().Count,
+		func(lastID string) ([]*ent.Video, error) {
+			return db.client.Video.Query().
+				Where(video.IDGT(lastID)).
+				Order(ent.Asc(video.FieldID)).
+				Limit(pageSize).
+				All(ctx)
+		},
+		func(v *ent.Video) string { return v.ID },
+		fn,
+	)
+}
+
+// ImageSearchFields are the image columns the search index needs; the
+// thumbnail bytes are left out.
+var ImageSearchFields = []string{
+	image.FieldID,
+	image.FieldName,
+	image.FieldType,
+	image.FieldWidth,
+	image.FieldHeight,
+	image.FieldExif,
+	image.FieldIsPublic,
+	image.FieldDescription,
+	image.FieldTags,
+	image.FieldCreatedAt,
+	image.FieldUpdatedAt,
+	image.FieldUploadedBy,
+	image.FieldCategory,
+	image.FieldDescriptionVector,
+}
+
+// pagesByID implements the keyset iteration shared by the *Pages methods.
+// next fetches the page after lastID; name is used in error messages.
+func pagesByID[T any](ctx context.Context, name string, pageSize int, count func(context.Context) (int, error), next func(lastID string) ([]T, error), id func(T) string, fn func(page []T, progress Progress) error) error {
+	total, err := count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed getting all moments: %w", err)
+		return fmt.Errorf("failed counting %s: %w", name, err)
+	}
+
+	progress := Progress{Total: total}
+	lastID := ""
+	for {
+		page, err := next(lastID)
+		if err != nil {
+			return fmt.Errorf("failed getting %s after %q: %w", name, lastID, err)
+		}
+		if len(page) == 0 {
+			return nil
+		}
+		lastID = id(page[len(page)-1])
+		progress.Done += len(page)
+		if err := fn(page, progress); err != nil {
+			return err
+		}
+		if len(page) < pageSize {
+			return nil
+		}
 	}
-	return moments, nil
 }
 
 // Close closes the database connection
@@ -0,0 +1,241 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/hook"
+	"api.us4ever/internal/ent/keepchunk"
+	"api.us4ever/internal/ent/momentimage"
+	"api.us4ever/internal/ent/searchoutbox"
+	"api.us4ever/internal/logger"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+var (
+	dbLogger *logger.Logger
+)
+
+func init() {
+	var err error
+	dbLogger, err = logger.New("database")
+	if err != nil {
+		panic("failed to initialize database logger: " + err.Error())
+	}
+}
+
+// Entity types recorded in the search outbox, matching the Type of the es
+// index definitions
+const (
+	OutboxEntityKeep    = "keep"
+	OutboxEntityMoment  = "moment"
+	OutboxEntityMindmap = "mindmap"
+	OutboxEntityTodo    = "todo"
+	OutboxEntityImage   = "image"
+	OutboxEntityVideo   = "video"
+)
+
+// registerSearchHooks makes every change of an indexed entity, including image
+// changes that alter a moment's document, enqueue a search outbox row. The
+// hooks are skipped when the outbox table has not been created yet, so an
+// un-migrated database keeps working as before.
+func registerSearchHooks(ctx context.Context, client *ent.Client) {
+	if _, err := client.SearchOutbox.Query().Exist(ctx); err != nil {
+		dbLogger.Warn("search outbox unavailable, incremental search sync disabled; run `db-tools migrate`",
+			zap.Error(err),
+		)
+		return
+	}
+
+	client.Keep.Use(entityHook(OutboxEntityKeep, func(k *ent.Keep) string { return k.ID }))
+	client.Moment.Use(entityHook(OutboxEntityMoment, func(mo *ent.Moment) string { return mo.ID }))
+	client.Mindmap.Use(entityHook(OutboxEntityMindmap, func(mm *ent.Mindmap) string { return mm.ID }))
+	client.Todo.Use(entityHook(OutboxEntityTodo, func(t *ent.Todo) string { return t.ID }))
+	client.Image.Use(entityHook(OutboxEntityImage, func(i *ent.Image) string { return i.ID }))
+	client.Video.Use(entityHook(OutboxEntityVideo, func(v *ent.Video) string { return v.ID }))
+
+	// 图片关联变化会影响 moment 文档中的 images 字段
+	client.MomentImage.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.MomentImageFunc(func(ctx context.Context, m *ent.MomentImageMutation) (ent.Value, error) {
+			var momentIDs []string
+			if !m.Op().Is(ent.OpCreate) {
+				ids, err := m.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+				momentIDs, err = m.Client().MomentImage.Query().
+					Where(momentimage.IDIn(ids...), momentimage.MomentIdNotNil()).
+					Select(momentimage.FieldMomentId).
+					Strings(ctx)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if id, ok := m.MomentId(); ok {
+				momentIDs = append(momentIDs, id)
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			return v, enqueue(ctx, m.Client(), isTx(m.Tx), OutboxEntityMoment, searchoutbox.OpUpsert, momentIDs)
+		})
+	})
+
+	// keep 的分块及其向量以 nested 文档的形式索引在 keep 中
+	client.KeepChunk.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.KeepChunkFunc(func(ctx context.Context, m *ent.KeepChunkMutation) (ent.Value, error) {
+			var keepIDs []string
+			if !m.Op().Is(ent.OpCreate) {
+				ids, err := m.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+				keepIDs, err = m.Client().KeepChunk.Query().
+					Where(keepchunk.IDIn(ids...)).
+					Select(keepchunk.FieldKeepId).
+					Strings(ctx)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if id, ok := m.KeepId(); ok {
+				keepIDs = append(keepIDs, id)
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			return v, enqueue(ctx, m.Client(), isTx(m.Tx), OutboxEntityKeep, searchoutbox.OpUpsert, keepIDs)
+		})
+	})
+
+	// OCR 写入的图片描述也会被索引到 moment 中
+	client.Image.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.ImageFunc(func(ctx context.Context, m *ent.ImageMutation) (ent.Value, error) {
+			_, descriptionChanged := m.Description()
+			if m.Op().Is(ent.OpCreate) || !(descriptionChanged || m.Op().Is(ent.OpDelete|ent.OpDeleteOne)) {
+				return next.Mutate(ctx, m)
+			}
+			ids, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			momentIDs, err := m.Client().MomentImage.Query().
+				Where(momentimage.ImageIdIn(ids...), momentimage.MomentIdNotNil()).
+				Select(momentimage.FieldMomentId).
+				Strings(ctx)
+			if err != nil {
+				return nil, err
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			return v, enqueue(ctx, m.Client(), isTx(m.Tx), OutboxEntityMoment, searchoutbox.OpUpsert, momentIDs)
+		})
+	})
+}
+
+// entityMutation is implemented by the generated mutation of every entity
+// with a string ID.
+type entityMutation interface {
+	ent.Mutation
+	ID() (string, bool)
+	IDs(ctx context.Context) ([]string, error)
+	Client() *ent.Client
+	Tx() (*ent.Tx, error)
+}
+
+// entityHook enqueues an outbox row for every entity a mutation creates,
+// updates or deletes. id extracts the ID of a created entity of type T.
+func entityHook[T any](entityType string, id func(*T) string) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, mutation ent.Mutation) (ent.Value, error) {
+			m, ok := mutation.(entityMutation)
+			if !ok {
+				return next.Mutate(ctx, mutation)
+			}
+			ids, err := mutatedIDs(ctx, m.Op(), m.ID, m.IDs)
+			if err != nil {
+				return nil, err
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			if m.Op().Is(ent.OpCreate) {
+				ids = idFromValue(v, id)
+			}
+			return v, enqueue(ctx, m.Client(), isTx(m.Tx), entityType, outboxOp(m.Op()), ids)
+		})
+	}
+}
+
+// mutatedIDs returns the IDs an update or delete is about to touch. It has to
+// run before the mutation, since the rows of a delete are gone afterwards.
+func mutatedIDs(ctx context.Context, op ent.Op, id func() (string, bool), ids func(context.Context) ([]string, error)) ([]string, error) {
+	if op.Is(ent.OpCreate) {
+		return nil, nil
+	}
+	if one, ok := id(); ok {
+		return []string{one}, nil
+	}
+	return ids(ctx)
+}
+
+// idFromValue extracts the ID of a created entity.
+func idFromValue[T any](v ent.Value, id func(*T) string) []string {
+	if e, ok := v.(*T); ok && e != nil {
+		return []string{id(e)}
+	}
+	return nil
+}
+
+func isTx(tx func() (*ent.Tx, error)) bool {
+	_, err := tx()
+	return err == nil
+}
+
+func outboxOp(op ent.Op) searchoutbox.Op {
+	if op.Is(ent.OpDelete | ent.OpDeleteOne) {
+		return searchoutbox.OpDelete
+	}
+	return searchoutbox.OpUpsert
+}
+
+// enqueue records one outbox row per entity. Inside a transaction a failure is
+// returned so the change rolls back with it; outside one the change is already
+// committed, so the failure is only logged and left to the next full reindex.
+func enqueue(ctx context.Context, client *ent.Client, inTx bool, entityType string, op searchoutbox.Op, ids []string) error {
+	ids = lo.Uniq(lo.Compact(ids))
+	if len(ids) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	builders := make([]*ent.SearchOutboxCreate, 0, len(ids))
+	for _, id := range ids {
+		builders = append(builders, client.SearchOutbox.Create().
+			SetEntityType(entityType).
+			SetEntityId(id).
+			SetOp(op).
+			SetAvailableAt(now),
+		)
+	}
+	if err := client.SearchOutbox.CreateBulk(builders...).Exec(ctx); err != nil {
+		err = fmt.Errorf("failed to enqueue search sync for %s: %w", entityType, err)
+		if inTx {
+			return err
+		}
+		dbLogger.Error("search outbox write failed",
+			zap.String("entity_type", entityType),
+			zap.Strings("ids", ids),
+			zap.Error(err),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,312 @@
+// Package dedup finds near-duplicate keeps and moments, as created by
+// repeated imports, and merges them into one canonical record.
+//
+// Records of the same owner are compared by the SimHash of their text, which
+// takes a few popcounts per pair. A pair is a duplicate when the texts are
+// nearly identical, or when they are a little further apart and their stored
+// content vectors agree; vectors are only compared for those close pairs.
+package dedup
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"api.us4ever/internal/logger"
+)
+
+var dedupLogger *logger.Logger
+
+func init() {
+	var err error
+	dedupLogger, err = logger.New("dedup")
+	if err != nil {
+		panic("failed to initialize dedup logger: " + err.Error())
+	}
+}
+
+// Kinds of records checked for duplicates, named like their search index
+const (
+	KindKeeps   = "keeps"
+	KindMoments = "moments"
+)
+
+// Kinds lists the kinds of records checked for duplicates.
+func Kinds() []string {
+	return []string{KindKeeps, KindMoments}
+}
+
+// IsKind reports whether kind is checked for duplicates.
+func IsKind(kind string) bool {
+	return slices.Contains(Kinds(), kind)
+}
+
+const (
+	// DefaultMaxDistance is the SimHash distance up to which texts are duplicates on their own
+	DefaultMaxDistance = 3
+	// DefaultMaxVectorDistance is the SimHash distance up to which texts are
+	// duplicates when their vectors agree. A one-character edit of a short
+	// moment already flips around 10 bits; unrelated texts are 32 bits apart
+	// on average.
+	DefaultMaxVectorDistance = 12
+	// DefaultMinSimilarity is the cosine similarity from which content vectors agree
+	DefaultMinSimilarity = 0.95
+
+	// previewRunes bounds the text shown for each member of a group
+	previewRunes = 120
+)
+
+// Options tunes what counts as a duplicate. Zero values use the defaults.
+type Options struct {
+	MaxDistance       int
+	MaxVectorDistance int
+	MinSimilarity     float64
+}
+
+func (o Options) normalize() Options {
+	if o.MaxDistance <= 0 {
+		o.MaxDistance = DefaultMaxDistance
+	}
+	if o.MaxVectorDistance <= 0 {
+		o.MaxVectorDistance = DefaultMaxVectorDistance
+	}
+	o.MaxDistance = min(o.MaxDistance, o.MaxVectorDistance)
+	if o.MinSimilarity <= 0 || o.MinSimilarity > 1 {
+		o.MinSimilarity = DefaultMinSimilarity
+	}
+	return o
+}
+
+// Item is a record checked for duplicates.
+type Item struct {
+	ID      string
+	OwnerID string
+	Text    string
+	// Vector is the stored content vector, nil when there is none
+	Vector    []float32
+	CreatedAt time.Time
+}
+
+// Member is a record of a duplicate group.
+type Member struct {
+	ID        string    `json:"id"`
+	Preview   string    `json:"preview"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Distance is the SimHash distance to the canonical record
+	Distance int `json:"distance"`
+	// Similarity is the cosine similarity of the content vectors with the
+	// canonical record, omitted when either has no vector
+	Similarity *float64 `json:"similarity,omitempty"`
+}
+
+// Group is a set of near-duplicate records of one owner. The canonical record
+// is the oldest one, listed first; the others are the ones to merge into it.
+type Group struct {
+	CanonicalID string   `json:"canonicalId"`
+	OwnerID     string   `json:"ownerId"`
+	Members     []Member `json:"members"`
+}
+
+// Find groups the near-duplicates among items. Groups are ordered by size,
+// largest first.
+func Find(items []Item, opts Options) []Group {
+	opts = opts.normalize()
+
+	type entry struct {
+		item Item
+		hash uint64
+		vec  vector
+	}
+	entries := make([]entry, 0, len(items))
+	for _, item := range items {
+		if len(normalize(item.Text)) == 0 {
+			continue
+		}
+		entries = append(entries, entry{item: item, hash: SimHash(item.Text), vec: newVector(item.Vector)})
+	}
+
+	// 只比较同一所有者的记录
+	owners := make(map[string][]int)
+	for i, e := range entries {
+		owners[e.item.OwnerID] = append(owners[e.item.OwnerID], i)
+	}
+
+	parent := make([]int, len(entries))
+	for i := range parent {
+		parent[i] = i
+	}
+	var root func(int) int
+	root = func(i int) int {
+		if parent[i] != i {
+			parent[i] = root(parent[i])
+		}
+		return parent[i]
+	}
+
+	for _, idx := range owners {
+		for x, i := range idx {
+			for _, j := range idx[x+1:] {
+				a, b := entries[i], entries[j]
+				if duplicates(Distance(a.hash, b.hash), a.vec, b.vec, opts) {
+					parent[root(i)] = root(j)
+				}
+			}
+		}
+	}
+
+	members := make(map[int][]int)
+	for i := range entries {
+		r := root(i)
+		members[r] = append(members[r], i)
+	}
+
+	var groups []Group
+	for _, idx := range members {
+		if len(idx) < 2 {
+			continue
+		}
+		slices.SortFunc(idx, func(x, y int) int {
+			a, b := entries[x].item, entries[y].item
+			return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+		})
+		canonical := entries[idx[0]]
+		group := Group{CanonicalID: canonical.item.ID, OwnerID: canonical.item.OwnerID}
+		for _, i := range idx {
+			e := entries[i]
+			m := Member{
+				ID:        e.item.ID,
+				Preview:   preview(e.item.Text),
+				CreatedAt: e.item.CreatedAt,
+				Distance:  Distance(canonical.hash, e.hash),
+			}
+			if s, ok := canonical.vec.cosine(e.vec); ok {
+				m.Similarity = &s
+			}
+			group.Members = append(group.Members, m)
+		}
+		groups = append(groups, group)
+	}
+	slices.SortFunc(groups, func(a, b Group) int {
+		return cmp.Or(
+			cmp.Compare(len(b.Members), len(a.Members)),
+			a.Members[0].CreatedAt.Compare(b.Members[0].CreatedAt),
+			cmp.Compare(a.CanonicalID, b.CanonicalID),
+		)
+	})
+	return groups
+}
+
+// duplicates decides whether two records at SimHash distance d, with
+// vectors a and b, are duplicates.
+func duplicates(d int, a, b vector, opts Options) bool {
+	if d <= opts.MaxDistance {
+		return true
+	}
+	if d > opts.MaxVectorDistance {
+		return false
+	}
+	s, ok := a.cosine(b)
+	return ok && s >= opts.MinSimilarity
+}
+
+// vector is a content vector with its precomputed norm.
+type vector struct {
+	values []float32
+	norm   float64
+}
+
+func newVector(values []float32) vector {
+	var sum float64
+	for _, x := range values {
+		sum += float64(x) * float64(x)
+	}
+	return vector{values: values, norm: math.Sqrt(sum)}
+}
+
+// cosine returns the cosine similarity of v and w. It is not defined when
+// either is missing or zero, or when their dimensions differ, which means
+// they were embedded by different models.
+func (v vector) cosine(w vector) (float64, bool) {
+	if v.norm == 0 || w.norm == 0 || len(v.values) != len(w.values) {
+		return 0, false
+	}
+	var dot float64
+	for i := range v.values {
+		dot += float64(v.values[i]) * float64(w.values[i])
+	}
+	return dot / (v.norm * w.norm), true
+}
+
+func preview(text string) string {
+	if utf8.RuneCountInString(text) <= previewRunes {
+		return text
+	}
+	return string([]rune(text)[:previewRunes]) + "…"
+}
+
+// Report is the outcome of a duplicate scan of one kind of record.
+type Report struct {
+	Kind       string    `json:"kind"`
+	CheckedAt  time.Time `json:"checkedAt"`
+	DurationMs int64     `json:"durationMs"`
+	Scanned    int       `json:"scanned"`
+	// Duplicates is the number of records that would be merged away
+	Duplicates int     `json:"duplicates"`
+	Groups     []Group `json:"groups"`
+}
+
+// Reports keeps the latest duplicate report of every kind.
+type Reports struct {
+	mu      sync.RWMutex
+	reports map[string]Report
+}
+
+// NewReports returns an empty report store.
+func NewReports() *Reports {
+	return &Reports{reports: make(map[string]Report)}
+}
+
+// Record stores report as the latest one of its kind.
+func (r *Reports) Record(report Report) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reports[report.Kind] = report
+}
+
+// Latest returns the latest report of kind.
+func (r *Reports) Latest(kind string) (Report, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	report, ok := r.reports[kind]
+	return report, ok
+}
+
+// Forget drops merged records from the latest report of kind, so the groups
+// they were in are no longer listed until the next scan finds them again.
+func (r *Reports) Forget(kind string, ids []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	report, ok := r.reports[kind]
+	if !ok {
+		return
+	}
+	var groups []Group
+	duplicates := 0
+	for _, g := range report.Groups {
+		members := slices.DeleteFunc(slices.Clone(g.Members), func(m Member) bool {
+			return slices.Contains(ids, m.ID)
+		})
+		if len(members) < 2 || members[0].ID != g.CanonicalID {
+			continue
+		}
+		g.Members = members
+		groups = append(groups, g)
+		duplicates += len(members) - 1
+	}
+	report.Groups = groups
+	report.Duplicates = duplicates
+	r.reports[kind] = report
+}
@@ -0,0 +1,103 @@
+package dedup
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSimHash(t *testing.T) {
+	text := "今天去公园散步，看到湖边的柳树发芽了，春天真的来了。Spring is here!"
+	if SimHash(text) != SimHash("  今天去公园散步 看到湖边的柳树发芽了 春天真的来了 spring IS here ") {
+		t.Error("expected formatting to be ignored")
+	}
+	if d := Distance(SimHash(text), SimHash(text+"好开心")); d > DefaultMaxVectorDistance {
+		t.Errorf("expected a small edit to stay close, got distance %d", d)
+	}
+	if d := Distance(SimHash(text), SimHash("Go 的 context 包用于在 goroutine 之间传递取消信号和截止时间")); d <= DefaultMaxVectorDistance {
+		t.Errorf("expected unrelated texts to be far apart, got distance %d", d)
+	}
+	if SimHash("，。！ ") != 0 {
+		t.Error("expected 0 for text without letters or digits")
+	}
+}
+
+func TestFind(t *testing.T) {
+	base := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	text := strings.Repeat("从 Telegram 同步的一条动态，内容完全相同。", 3)
+	items := []Item{
+		{ID: "m2", OwnerID: "u1", Text: text, CreatedAt: base.Add(time.Hour)},
+		{ID: "m1", OwnerID: "u1", Text: text, CreatedAt: base},
+		{ID: "m3", OwnerID: "u1", Text: strings.ToUpper(text) + "  ", CreatedAt: base.Add(2 * time.Hour)},
+		// 其他用户的相同内容不算重复
+		{ID: "m4", OwnerID: "u2", Text: text, CreatedAt: base},
+		{ID: "m5", OwnerID: "u1", Text: "完全不同的另一条记录，讲的是周末去爬山。", CreatedAt: base},
+		{ID: "m6", OwnerID: "u1", Text: "", CreatedAt: base},
+		{ID: "m7", OwnerID: "u1", Text: "", CreatedAt: base},
+	}
+	groups := Find(items, Options{})
+	if len(groups) != 1 {
+		t.Fatalf("expected 1 group, got %+v", groups)
+	}
+	g := groups[0]
+	if g.CanonicalID != "m1" || g.OwnerID != "u1" {
+		t.Errorf("expected the oldest record as canonical, got %+v", g)
+	}
+	var ids []string
+	for _, m := range g.Members {
+		ids = append(ids, m.ID)
+	}
+	if strings.Join(ids, ",") != "m1,m2,m3" {
+		t.Errorf("unexpected members %v", ids)
+	}
+}
+
+func TestFindConfirmsWithVectors(t *testing.T) {
+	a := "周末和朋友去西湖边骑车，天气很好，拍了很多照片，晚上在河坊街吃了小吃。"
+	b := "周末和朋友去西湖边骑车，天气很好，拍了不少照片，晚上在河坊街吃了小吃。"
+	d := Distance(SimHash(a), SimHash(b))
+	if d <= DefaultMaxDistance || d > DefaultMaxVectorDistance {
+		t.Fatalf("expected the texts to need vectors to confirm, got distance %d", d)
+	}
+
+	close1, close2, far := []float32{1, 0.1, 0}, []float32{1, 0.12, 0.01}, []float32{0, 1, 0}
+	items := []Item{{ID: "a", Text: a, Vector: close1}, {ID: "b", Text: b, Vector: close2}}
+	if groups := Find(items, Options{}); len(groups) != 1 || groups[0].Members[1].Similarity == nil {
+		t.Errorf("expected agreeing vectors to confirm the pair, got %+v", groups)
+	}
+	items[1].Vector = far
+	if groups := Find(items, Options{}); len(groups) != 0 {
+		t.Errorf("expected disagreeing vectors to reject the pair, got %+v", groups)
+	}
+	items[1].Vector = nil
+	if groups := Find(items, Options{}); len(groups) != 0 {
+		t.Errorf("expected no group without a vector to confirm, got %+v", groups)
+	}
+}
+
+func TestReportsForget(t *testing.T) {
+	reports := NewReports()
+	reports.Record(Report{Kind: KindMoments, Duplicates: 3, Groups: []Group{
+		{CanonicalID: "a", Members: []Member{{ID: "a"}, {ID: "b"}, {ID: "c"}}},
+		{CanonicalID: "d", Members: []Member{{ID: "d"}, {ID: "e"}}},
+	}})
+	reports.Forget(KindMoments, []string{"b", "e"})
+
+	report, ok := reports.Latest(KindMoments)
+	if !ok || len(report.Groups) != 1 || report.Duplicates != 1 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	if members := report.Groups[0].Members; len(members) != 2 || members[1].ID != "c" {
+		t.Errorf("unexpected members %+v", members)
+	}
+}
+
+func TestEncodeTags(t *testing.T) {
+	got := string(encodeTags([]string{"go", "notes"}, nil, []string{"notes", "import"}))
+	if got != `["go","notes","import"]` {
+		t.Errorf("unexpected tags %s", got)
+	}
+	if got := string(encodeTags()); got != `[]` {
+		t.Errorf("expected an empty array, got %s", got)
+	}
+}
@@ -0,0 +1,312 @@
+package dedup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/keep"
+	"api.us4ever/internal/ent/keepchunk"
+	"api.us4ever/internal/ent/moment"
+	"api.us4ever/internal/ent/momentimage"
+	"api.us4ever/internal/ent/momentvideo"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrNotFound is returned when a record to merge does not exist.
+	ErrNotFound = errors.New("record not found")
+	// ErrOwnerMismatch is returned when the records to merge have different owners.
+	ErrOwnerMismatch = errors.New("records belong to different owners")
+)
+
+// MergeResult describes a merge.
+type MergeResult struct {
+	CanonicalID string   `json:"canonicalId"`
+	Merged      []string `json:"merged"`
+	// ImagesMoved and VideosMoved count the links re-pointed to the canonical
+	// moment; links to media it already had are dropped
+	ImagesMoved int `json:"imagesMoved"`
+	VideosMoved int `json:"videosMoved"`
+}
+
+// Merge folds the duplicates into the canonical record of kind and deletes
+// them, in one transaction. The canonical record keeps its own text and gains
+// the tags, likes and views of the duplicates; a moment also takes over their
+// images and videos, appended after its own. The search outbox hooks update
+// the index accordingly.
+func Merge(ctx context.Context, client *ent.Client, kind, canonicalID string, duplicateIDs []string) (MergeResult, error) {
+	result := MergeResult{CanonicalID: canonicalID, Merged: []string{}}
+	duplicateIDs = slices.Compact(slices.Sorted(slices.Values(duplicateIDs)))
+	if len(duplicateIDs) == 0 {
+		return result, fmt.Errorf("no duplicates to merge")
+	}
+	if slices.Contains(duplicateIDs, canonicalID) {
+		return result, fmt.Errorf("canonical record %s is also listed as a duplicate", canonicalID)
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	switch kind {
+	case KindKeeps:
+		err = mergeKeeps(ctx, tx, canonicalID, duplicateIDs)
+	case KindMoments:
+		err = mergeMoments(ctx, tx, canonicalID, duplicateIDs, &result)
+	default:
+		err = fmt.Errorf("unknown kind %q", kind)
+	}
+	if err != nil {
+		return result, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return result, fmt.Errorf("failed to commit merge: %w", err)
+	}
+
+	result.Merged = duplicateIDs
+	dedupLogger.Info("merged duplicates",
+		zap.String("kind", kind),
+		zap.String("canonicalId", canonicalID),
+		zap.Strings("merged", duplicateIDs),
+		zap.Int("imagesMoved", result.ImagesMoved),
+		zap.Int("videosMoved", result.VideosMoved),
+	)
+	return result, nil
+}
+
+func mergeKeeps(ctx context.Context, tx *ent.Tx, canonicalID string, duplicateIDs []string) error {
+	canonical, err := tx.Keep.Get(ctx, canonicalID)
+	if err != nil {
+		return notFound(err, "keep", canonicalID)
+	}
+	duplicates, err := tx.Keep.Query().Where(keep.IDIn(duplicateIDs...)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load duplicate keeps: %w", err)
+	}
+	if err := checkFound("keep", duplicateIDs, len(duplicates), func(i int) string { return duplicates[i].ID }); err != nil {
+		return err
+	}
+
+	tags := [][]string{decodeTags(canonical.Tags)}
+	var likes, views int32
+	for _, d := range duplicates {
+		if d.OwnerId != canonical.OwnerId {
+			return fmt.Errorf("keep %s: %w", d.ID, ErrOwnerMismatch)
+		}
+		tags = append(tags, decodeTags(d.Tags))
+		likes += d.Likes
+		views += d.Views
+	}
+
+	err = tx.Keep.UpdateOneID(canonicalID).
+		SetTags(encodeTags(tags...)).
+		AddLikes(likes).
+		AddViews(views).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update keep %s: %w", canonicalID, err)
+	}
+	if _, err := tx.KeepChunk.Delete().Where(keepchunk.KeepIdIn(duplicateIDs...)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete chunks of duplicate keeps: %w", err)
+	}
+	if _, err := tx.Keep.Delete().Where(keep.IDIn(duplicateIDs...)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete duplicate keeps: %w", err)
+	}
+	return nil
+}
+
+func mergeMoments(ctx context.Context, tx *ent.Tx, canonicalID string, duplicateIDs []string, result *MergeResult) error {
+	canonical, err := tx.Moment.Get(ctx, canonicalID)
+	if err != nil {
+		return notFound(err, "moment", canonicalID)
+	}
+	duplicates, err := tx.Moment.Query().Where(moment.IDIn(duplicateIDs...)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load duplicate moments: %w", err)
+	}
+	if err := checkFound("moment", duplicateIDs, len(duplicates), func(i int) string { return duplicates[i].ID }); err != nil {
+		return err
+	}
+
+	tags := [][]string{decodeTags(canonical.Tags)}
+	var likes, views int32
+	for _, d := range duplicates {
+		if d.OwnerId != canonical.OwnerId {
+			return fmt.Errorf("moment %s: %w", d.ID, ErrOwnerMismatch)
+		}
+		tags = append(tags, decodeTags(d.Tags))
+		likes += d.Likes
+		views += d.Views
+	}
+
+	if result.ImagesMoved, err = moveMomentImages(ctx, tx, canonicalID, duplicateIDs); err != nil {
+		return err
+	}
+	if result.VideosMoved, err = moveMomentVideos(ctx, tx, canonicalID, duplicateIDs); err != nil {
+		return err
+	}
+
+	err = tx.Moment.UpdateOneID(canonicalID).
+		SetTags(encodeTags(tags...)).
+		AddLikes(likes).
+		AddViews(views).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update moment %s: %w", canonicalID, err)
+	}
+	if _, err := tx.Moment.Delete().Where(moment.IDIn(duplicateIDs...)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete duplicate moments: %w", err)
+	}
+	return nil
+}
+
+// moveMomentImages re-points the image links of the duplicates to the
+// canonical moment, after its own images and in the order of the duplicates.
+// Links to images the canonical moment already shows are deleted.
+func moveMomentImages(ctx context.Context, tx *ent.Tx, canonicalID string, duplicateIDs []string) (int, error) {
+	own, err := tx.MomentImage.Query().Where(momentimage.MomentId(canonicalID)).All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load images of moment %s: %w", canonicalID, err)
+	}
+	seen := make(map[string]bool, len(own))
+	var sort int32
+	for _, link := range own {
+		seen[link.ImageId] = true
+		sort = max(sort, link.Sort+1)
+	}
+
+	links, err := tx.MomentImage.Query().
+		Where(momentimage.MomentIdIn(duplicateIDs...)).
+		Order(ent.Asc(momentimage.FieldCreatedAt), ent.Asc(momentimage.FieldSort), ent.Asc(momentimage.FieldID)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load images of duplicate moments: %w", err)
+	}
+	moved := 0
+	for _, link := range links {
+		if seen[link.ImageId] {
+			if err := tx.MomentImage.DeleteOneID(link.ID).Exec(ctx); err != nil {
+				return moved, fmt.Errorf("failed to delete image link %d: %w", link.ID, err)
+			}
+			continue
+		}
+		seen[link.ImageId] = true
+		err := tx.MomentImage.UpdateOneID(link.ID).
+			SetMomentId(canonicalID).
+			SetSort(sort).
+			SetUpdatedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return moved, fmt.Errorf("failed to move image link %d: %w", link.ID, err)
+		}
+		sort++
+		moved++
+	}
+	return moved, nil
+}
+
+// moveMomentVideos is moveMomentImages for the video links.
+func moveMomentVideos(ctx context.Context, tx *ent.Tx, canonicalID string, duplicateIDs []string) (int, error) {
+	own, err := tx.MomentVideo.Query().Where(momentvideo.MomentId(canonicalID)).All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load videos of moment %s: %w", canonicalID, err)
+	}
+	seen := make(map[string]bool, len(own))
+	var sort int32
+	for _, link := range own {
+		seen[link.VideoId] = true
+		sort = max(sort, link.Sort+1)
+	}
+
+	links, err := tx.MomentVideo.Query().
+		Where(momentvideo.MomentIdIn(duplicateIDs...)).
+		Order(ent.Asc(momentvideo.FieldCreatedAt), ent.Asc(momentvideo.FieldSort), ent.Asc(momentvideo.FieldID)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load videos of duplicate moments: %w", err)
+	}
+	moved := 0
+	for _, link := range links {
+		if seen[link.VideoId] {
+			if err := tx.MomentVideo.DeleteOneID(link.ID).Exec(ctx); err != nil {
+				return moved, fmt.Errorf("failed to delete video link %d: %w", link.ID, err)
+			}
+			continue
+		}
+		seen[link.VideoId] = true
+		err := tx.MomentVideo.UpdateOneID(link.ID).
+			SetMomentId(canonicalID).
+			SetSort(sort).
+			SetUpdatedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return moved, fmt.Errorf("failed to move video link %d: %w", link.ID, err)
+		}
+		sort++
+		moved++
+	}
+	return moved, nil
+}
+
+// checkFound returns ErrNotFound naming the first of ids missing from the
+// found records.
+func checkFound(entity string, ids []string, found int, id func(int) string) error {
+	if found == len(ids) {
+		return nil
+	}
+	present := make(map[string]bool, found)
+	for i := range found {
+		present[id(i)] = true
+	}
+	for _, want := range ids {
+		if !present[want] {
+			return fmt.Errorf("%s %s: %w", entity, want, ErrNotFound)
+		}
+	}
+	return nil
+}
+
+func notFound(err error, entity, id string) error {
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("%s %s: %w", entity, id, ErrNotFound)
+	}
+	return fmt.Errorf("failed to load %s %s: %w", entity, id, err)
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+	}
+	return err
+}
+
+// decodeTags reads a JSON tags column; anything but an array of strings is
+// treated as no tags.
+func decodeTags(raw json.RawMessage) []string {
+	var tags []string
+	if len(raw) == 0 || json.Unmarshal(raw, &tags) != nil {
+		return nil
+	}
+	return tags
+}
+
+// encodeTags returns the union of lists of tags, in first-seen order.
+func encodeTags(lists ...[]string) json.RawMessage {
+	tags := []string{}
+	for _, list := range lists {
+		for _, tag := range list {
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	raw, _ := json.Marshal(tags)
+	return raw
+}
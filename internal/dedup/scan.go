@@ -0,0 +1,75 @@
+package dedup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/embedding"
+	"api.us4ever/internal/ent"
+	"go.uber.org/zap"
+)
+
+// scanPageSize is the number of records loaded per page during a scan
+const scanPageSize = 500
+
+// Scan loads every record of kind and reports its near-duplicates.
+func Scan(ctx context.Context, db database.Service, kind string, opts Options) (Report, error) {
+	start := time.Now()
+	report := Report{Kind: kind, CheckedAt: start, Groups: []Group{}}
+
+	var items []Item
+	var err error
+	switch kind {
+	case KindKeeps:
+		err = db.KeepPages(ctx, scanPageSize, func(page []*ent.Keep, _ database.Progress) error {
+			for _, k := range page {
+				items = append(items, Item{
+					ID:        k.ID,
+					OwnerID:   k.OwnerId,
+					Text:      k.Title + "\n" + k.Content,
+					Vector:    embedding.DecodeStored(k.ContentVector),
+					CreatedAt: k.CreatedAt,
+				})
+			}
+			return nil
+		})
+	case KindMoments:
+		err = db.MomentPages(ctx, scanPageSize, func(page []*ent.Moment, _ database.Progress) error {
+			for _, m := range page {
+				items = append(items, Item{
+					ID:        m.ID,
+					OwnerID:   m.OwnerId,
+					Text:      m.Content,
+					Vector:    embedding.DecodeStored(m.ContentVector),
+					CreatedAt: m.CreatedAt,
+				})
+			}
+			return nil
+		})
+	default:
+		return report, fmt.Errorf("unknown kind %q", kind)
+	}
+	if err != nil {
+		return report, fmt.Errorf("failed to load %s: %w", kind, err)
+	}
+
+	if groups := Find(items, opts); groups != nil {
+		report.Groups = groups
+	}
+	report.Scanned = len(items)
+	for _, g := range report.Groups {
+		report.Duplicates += len(g.Members) - 1
+	}
+	report.DurationMs = time.Since(start).Milliseconds()
+
+	dedupLogger.Info("duplicate scan completed",
+		zap.String("kind", kind),
+		zap.Int("scanned", report.Scanned),
+		zap.Int("groups", len(report.Groups)),
+		zap.Int("duplicates", report.Duplicates),
+		zap.Int64("durationMs", report.DurationMs),
+	)
+	return report, nil
+}
@@ -0,0 +1,68 @@
+package dedup
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"strings"
+	"unicode"
+)
+
+// shingleSize is the number of runes per text feature. Character shingles
+// work for Chinese, which has no spaces to split words on, as well as for
+// Latin text.
+const shingleSize = 3
+
+// SimHash returns the 64-bit SimHash of text. Case, spaces and punctuation
+// are ignored, so texts that only differ in formatting hash the same, and
+// texts differing in a few characters hash a few bits apart. It returns 0
+// for text without letters or digits.
+func SimHash(text string) uint64 {
+	runes := normalize(text)
+	if len(runes) == 0 {
+		return 0
+	}
+
+	var weights [64]int
+	add := func(feature []rune) {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(string(feature)))
+		sum := h.Sum64()
+		for i := range weights {
+			if sum&(1<<i) != 0 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+	if len(runes) <= shingleSize {
+		add(runes)
+	}
+	for i := 0; i+shingleSize <= len(runes); i++ {
+		add(runes[i : i+shingleSize])
+	}
+
+	var hash uint64
+	for i, w := range weights {
+		if w > 0 {
+			hash |= 1 << i
+		}
+	}
+	return hash
+}
+
+// Distance returns the number of bits two SimHashes differ in.
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// normalize keeps the lower-cased letters and digits of text.
+func normalize(text string) []rune {
+	runes := make([]rune, 0, len(text))
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			runes = append(runes, r)
+		}
+	}
+	return runes
+}
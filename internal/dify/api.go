@@ -2,6 +2,7 @@ package dify
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -41,6 +42,10 @@ const (
 	ActionTitle   ActionType = "title"
 	ActionContent ActionType = "content"
 	ActionExpand  ActionType = "expand"
+	// ActionAnswer 根据 Content 中给出的笔记片段回答问题，并用 [n] 标注引用
+	ActionAnswer ActionType = "answer"
+	// ActionTag 为 Content 中给出的一组主题相近的笔记起一个简短的标签
+	ActionTag ActionType = "tag"
 )
 
 // WorkflowInput 定义 inputs 字段的结构
@@ -119,6 +124,17 @@ type WorkflowResult struct {
 
 // CallWorkflow 调用 Dify Workflow API
 func CallWorkflow(req *WorkflowRequest) (*WorkflowResult, error) {
+	return callWorkflow(context.Background(), req, nil)
+}
+
+// StreamWorkflow 以流式模式调用 Dify Workflow API，每收到一段文本就调用 onText。
+// onText 返回错误时停止读取并返回该错误；ctx 取消时请求随之中断。
+func StreamWorkflow(ctx context.Context, req *WorkflowRequest, onText func(text string) error) (*WorkflowResult, error) {
+	req.ResponseMode = ResponseModeStreaming
+	return callWorkflow(ctx, req, onText)
+}
+
+func callWorkflow(ctx context.Context, req *WorkflowRequest, onText func(text string) error) (*WorkflowResult, error) {
 	req.SetDefaults()
 	// 从配置中获取 endpoint 和 apiKey
 	appConfig := config.GetAppConfig()
@@ -135,7 +151,7 @@ func CallWorkflow(req *WorkflowRequest) (*WorkflowResult, error) {
 		return nil, fmt.Errorf("序列化请求数据失败: %v", err)
 	}
 
-	httpReq, err := http.NewRequest("POST", appConfig.Dify.Endpoint, bytes.NewBuffer(jsonData))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", appConfig.Dify.Endpoint, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return nil, fmt.Errorf("创建HTTP请求失败: %v", err)
 	}
@@ -162,46 +178,9 @@ func CallWorkflow(req *WorkflowRequest) (*WorkflowResult, error) {
 		return nil, fmt.Errorf("API返回错误状态码: %d", resp.StatusCode)
 	}
 
-	result := &WorkflowResult{}
-
 	switch req.ResponseMode {
 	case ResponseModeStreaming:
-		// 处理 SSE 流式响应
-		reader := bufio.NewReader(resp.Body)
-		for {
-			line, err := reader.ReadString('\n')
-			if err != nil {
-				if err == io.EOF {
-					break
-				}
-				return nil, fmt.Errorf("读取SSE响应失败: %v", err)
-			}
-
-			line = strings.TrimSpace(line)
-
-			// 跳过空行
-			if line == "" {
-				continue
-			}
-
-			// 解析 SSE 数据行
-			if strings.HasPrefix(line, "data: ") {
-				jsonPart := strings.TrimPrefix(line, "data: ")
-				var streamResp WorkflowStreamResponse
-				if err := json.Unmarshal([]byte(jsonPart), &streamResp); err != nil {
-					return nil, fmt.Errorf("解析SSE数据失败: %v", err)
-				}
-
-				// 只处理 text_chunk 事件
-				if streamResp.Event == "text_chunk" {
-					result.Message += streamResp.Data.Text
-				}
-
-				if streamResp.Event == "workflow_finished" {
-					result.Status = streamResp.Data.Status
-				}
-			}
-		}
+		return readWorkflowStream(resp.Body, onText)
 
 	case ResponseModeBlocking:
 		// 处理阻塞式响应
@@ -209,12 +188,56 @@ func CallWorkflow(req *WorkflowRequest) (*WorkflowResult, error) {
 		if err := json.NewDecoder(resp.Body).Decode(&blockResp); err != nil {
 			return nil, fmt.Errorf("解析API响应失败: %v", err)
 		}
-		result.Message = blockResp.Data.Outputs.Text
-		result.Status = blockResp.Data.Status
+		return &WorkflowResult{
+			Message: blockResp.Data.Outputs.Text,
+			Status:  blockResp.Data.Status,
+		}, nil
 
 	default:
 		return nil, fmt.Errorf("不支持的响应模式: %s", req.ResponseMode)
 	}
+}
 
+// readWorkflowStream 处理 SSE 流式响应，拼接 text_chunk 事件的文本，onText 不为空时逐段回调
+func readWorkflowStream(body io.Reader, onText func(text string) error) (*WorkflowResult, error) {
+	result := &WorkflowResult{}
+	reader := bufio.NewReader(body)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("读取SSE响应失败: %v", err)
+		}
+
+		line = strings.TrimSpace(line)
+
+		// 解析 SSE 数据行，跳过空行和其他字段
+		if strings.HasPrefix(line, "data: ") {
+			jsonPart := strings.TrimPrefix(line, "data: ")
+			var streamResp WorkflowStreamResponse
+			if err := json.Unmarshal([]byte(jsonPart), &streamResp); err != nil {
+				return nil, fmt.Errorf("解析SSE数据失败: %v", err)
+			}
+
+			// 只处理 text_chunk 事件
+			if streamResp.Event == "text_chunk" {
+				result.Message += streamResp.Data.Text
+				if onText != nil {
+					if err := onText(streamResp.Data.Text); err != nil {
+						return result, err
+					}
+				}
+			}
+
+			if streamResp.Event == "workflow_finished" {
+				result.Status = streamResp.Data.Status
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+	}
 	return result, nil
 }
@@ -1,6 +1,8 @@
 package dify
 
 import (
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -31,3 +33,44 @@ func TestCallWorkflow_Integration(t *testing.T) {
 	// 打印响应以便调试
 	t.Logf("API Status: %+v", resp.Status)
 }
+
+func TestReadWorkflowStream(t *testing.T) {
+	stream := "event: ping\n\n" +
+		`data: {"event":"workflow_started","data":{}}` + "\n\n" +
+		`data: {"event":"text_chunk","data":{"text":"你好"}}` + "\n\n" +
+		`data: {"event":"text_chunk","data":{"text":"，世界"}}` + "\n\n" +
+		`data: {"event":"workflow_finished","data":{"status":"succeeded"}}`
+
+	var chunks []string
+	result, err := readWorkflowStream(strings.NewReader(stream), func(text string) error {
+		chunks = append(chunks, text)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("读取流失败: %v", err)
+	}
+	if result.Message != "你好，世界" || result.Status != "succeeded" {
+		t.Errorf("结果不符: %+v", result)
+	}
+	if len(chunks) != 2 {
+		t.Errorf("期望回调 2 次，实际 %d 次", len(chunks))
+	}
+}
+
+func TestReadWorkflowStream_StopsOnCallbackError(t *testing.T) {
+	stream := `data: {"event":"text_chunk","data":{"text":"a"}}` + "\n\n" +
+		`data: {"event":"text_chunk","data":{"text":"b"}}` + "\n\n"
+
+	stop := errors.New("client gone")
+	calls := 0
+	_, err := readWorkflowStream(strings.NewReader(stream), func(string) error {
+		calls++
+		return stop
+	})
+	if !errors.Is(err, stop) {
+		t.Fatalf("期望返回回调错误，实际: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("期望回调 1 次，实际 %d 次", calls)
+	}
+}
@@ -0,0 +1,249 @@
+package embedding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"api.us4ever/internal/config"
+	"api.us4ever/internal/logger"
+	"go.uber.org/zap"
+)
+
+var (
+	embeddingLogger *logger.Logger
+)
+
+func init() {
+	var err error
+	embeddingLogger, err = logger.New("embedding")
+	if err != nil {
+		panic("failed to initialize embedding logger: " + err.Error())
+	}
+}
+
+const (
+	ProviderService = "service"
+	ProviderOpenAI  = "openai"
+	ProviderOllama  = "ollama"
+
+	// DefaultDimensions is the output size of the model the service backend has always served
+	DefaultDimensions = 1024
+
+	// defaultBatchSize caps how many texts go into one request to a batching backend
+	defaultBatchSize = 64
+	// requestTimeout bounds a single HTTP request, a whole batch included
+	requestTimeout = 30 * time.Second
+)
+
+var (
+	// ErrUnavailable marks transient failures: the backend could not be reached,
+	// timed out, or answered 429/5xx. These are retried.
+	ErrUnavailable = errors.New("embedding service unavailable")
+	// ErrInvalidResponse marks failures where the backend answered but the answer
+	// is unusable: a 4xx status, a malformed body or vectors of the wrong size.
+	ErrInvalidResponse = errors.New("invalid embedding response")
+)
+
+// Embedder turns texts into vectors.
+type Embedder interface {
+	// Embed returns one vector per text, in the same order.
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+	// Dimensions is the length of every vector returned by Embed.
+	Dimensions() int
+	// Model identifies the model, so vectors from different models are never mixed.
+	Model() string
+}
+
+// New creates the embedder selected by cfg.Provider.
+func New(cfg config.EmbeddingConfig) (Embedder, error) {
+	if cfg.Endpoint == "" {
+		return nil, fmt.Errorf("embedding endpoint is required")
+	}
+	if cfg.Dimensions < 0 {
+		return nil, fmt.Errorf("embedding dimensions must be positive, got %d", cfg.Dimensions)
+	}
+	if cfg.Dimensions == 0 {
+		cfg.Dimensions = DefaultDimensions
+	}
+	httpClient := &http.Client{Timeout: requestTimeout}
+
+	var e Embedder
+	switch strings.ToLower(cfg.Provider) {
+	case "", ProviderService:
+		e = &serviceEmbedder{cfg: cfg, http: httpClient}
+	case ProviderOpenAI:
+		if cfg.Model == "" {
+			return nil, fmt.Errorf("embedding model is required for provider %s", ProviderOpenAI)
+		}
+		e = &openAIEmbedder{cfg: cfg, http: httpClient}
+	case ProviderOllama:
+		if cfg.Model == "" {
+			return nil, fmt.Errorf("embedding model is required for provider %s", ProviderOllama)
+		}
+		e = &ollamaEmbedder{cfg: cfg, http: httpClient}
+	default:
+		return nil, fmt.Errorf("unsupported embedding provider: %s", cfg.Provider)
+	}
+	return WithRetry(e, DefaultRetryPolicy), nil
+}
+
+var (
+	defaultMu       sync.Mutex
+	defaultCfg      config.EmbeddingConfig
+	defaultEmbedder Embedder
+)
+
+// Default returns the embedder for the current application configuration.
+// It is rebuilt whenever the embedding configuration changes.
+func Default() (Embedder, error) {
+	appConfig := config.GetAppConfig()
+	if appConfig == nil {
+		return nil, fmt.Errorf("application configuration is not available")
+	}
+
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+
+	if defaultEmbedder != nil && defaultCfg == appConfig.Embedding {
+		return defaultEmbedder, nil
+	}
+	e, err := New(appConfig.Embedding)
+	if err != nil {
+		return nil, err
+	}
+	embeddingLogger.Info("embedder initialized",
+		zap.String("provider", appConfig.Embedding.Provider),
+		zap.String("model", e.Model()),
+		zap.Int("dimensions", e.Dimensions()),
+	)
+	defaultCfg = appConfig.Embedding
+	defaultEmbedder = e
+	return e, nil
+}
+
+// Dimensions returns the vector size of the configured model, falling back to
+// DefaultDimensions when the configuration cannot be loaded.
+func Dimensions() int {
+	e, err := Default()
+	if err != nil {
+		return DefaultDimensions
+	}
+	return e.Dimensions()
+}
+
+// EmbedOne embeds a single text with the given embedder.
+func EmbedOne(ctx context.Context, e Embedder, text string) ([]float32, error) {
+	vectors, err := e.Embed(ctx, []string{text})
+	if err != nil {
+		return nil, err
+	}
+	return vectors[0], nil
+}
+
+// postJSON sends body to url and decodes a 2xx response into out.
+func postJSON(ctx context.Context, client *http.Client, url, apiKey string, body, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		// The caller giving up is not the backend's fault
+		if errors.Is(err, context.Canceled) {
+			return err
+		}
+		return fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			embeddingLogger.Error("error closing response body", zap.Error(err))
+		}
+	}(resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		kind := ErrInvalidResponse
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			kind = ErrUnavailable
+		}
+		return fmt.Errorf("%w: status %d: %s", kind, resp.StatusCode, respBody)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	return nil
+}
+
+// checkVectors verifies the backend returned one vector of the expected size per text.
+func checkVectors(vectors [][]float32, texts, dims int) error {
+	if len(vectors) != texts {
+		return fmt.Errorf("%w: expect %d vectors, got %d", ErrInvalidResponse, texts, len(vectors))
+	}
+	for _, v := range vectors {
+		if len(v) != dims {
+			return fmt.Errorf("%w: expect %d dims, got %d", ErrInvalidResponse, dims, len(v))
+		}
+	}
+	return nil
+}
+
+// inBatches calls fn for consecutive slices of at most size texts and concatenates the results.
+func inBatches(texts []string, size int, fn func(batch []string) ([][]float32, error)) ([][]float32, error) {
+	out := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += size {
+		batch := texts[start:min(start+size, len(texts))]
+		vectors, err := fn(batch)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, vectors...)
+	}
+	return out, nil
+}
+
+// IsLegacyPlaceholder reports whether v is the sentinel that was stored when
+// the embedding service was down: every component zero except v[0] = 0.1.
+// Such vectors carry no meaning and must be re-embedded.
+func IsLegacyPlaceholder(v []float32) bool {
+	if len(v) == 0 || v[0] != 0.1 {
+		return false
+	}
+	for _, x := range v[1:] {
+		if x != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// DecodeStored reads a vector stored in a JSON (or pgvector) column. It
+// returns nil for a missing or unreadable vector and for the legacy
+// placeholder.
+func DecodeStored(raw json.RawMessage) []float32 {
+	if len(raw) == 0 {
+		return nil
+	}
+	var vector []float32
+	if err := json.Unmarshal(raw, &vector); err != nil || IsLegacyPlaceholder(vector) {
+		return nil
+	}
+	return vector
+}
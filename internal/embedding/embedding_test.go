@@ -0,0 +1,261 @@
+package embedding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"api.us4ever/internal/config"
+)
+
+func vectorOf(dims int, first float32) []float32 {
+	v := make([]float32, dims)
+	v[0] = first
+	return v
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.EmbeddingConfig
+		wantErr bool
+	}{
+		{name: "default provider", cfg: config.EmbeddingConfig{Endpoint: "http://embed"}},
+		{name: "openai", cfg: config.EmbeddingConfig{Provider: "openai", Endpoint: "http://embed", Model: "m"}},
+		{name: "ollama", cfg: config.EmbeddingConfig{Provider: "Ollama", Endpoint: "http://embed", Model: "m"}},
+		{name: "missing endpoint", cfg: config.EmbeddingConfig{}, wantErr: true},
+		{name: "openai without model", cfg: config.EmbeddingConfig{Provider: "openai", Endpoint: "http://embed"}, wantErr: true},
+		{name: "unknown provider", cfg: config.EmbeddingConfig{Provider: "foo", Endpoint: "http://embed"}, wantErr: true},
+		{name: "negative dimensions", cfg: config.EmbeddingConfig{Endpoint: "http://embed", Dimensions: -1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := New(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && tt.cfg.Dimensions == 0 && e.Dimensions() != DefaultDimensions {
+				t.Errorf("expected default dimensions %d, got %d", DefaultDimensions, e.Dimensions())
+			}
+		})
+	}
+}
+
+func TestServiceEmbedder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req serviceRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		first := float32(len(req.Text))
+		_ = json.NewEncoder(w).Encode(serviceResponse{Vector: vectorOf(4, first)})
+	}))
+	defer srv.Close()
+
+	e, err := New(config.EmbeddingConfig{Endpoint: srv.URL, Dimensions: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	vectors, err := e.Embed(context.Background(), []string{"a", "abc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vectors) != 2 || vectors[0][0] != 1 || vectors[1][0] != 3 {
+		t.Errorf("unexpected vectors: %v", vectors)
+	}
+	if e.Model() != srv.URL {
+		t.Errorf("expected the endpoint to stand in for the model, got %q", e.Model())
+	}
+}
+
+func TestServiceEmbedder_WrongDimensions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(serviceResponse{Vector: vectorOf(3, 1)})
+	}))
+	defer srv.Close()
+
+	e, _ := New(config.EmbeddingConfig{Endpoint: srv.URL, Dimensions: 4})
+	if _, err := e.Embed(context.Background(), []string{"a"}); !errors.Is(err, ErrInvalidResponse) {
+		t.Errorf("expected ErrInvalidResponse, got %v", err)
+	}
+}
+
+func TestOpenAIEmbedder(t *testing.T) {
+	var gotAuth, gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		gotPath = r.URL.Path
+		var req openAIRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		// Answer in reverse order to check that index is honoured
+		var resp openAIResponse
+		for i := len(req.Input) - 1; i >= 0; i-- {
+			resp.Data = append(resp.Data, struct {
+				Index     int       `json:"index"`
+				Embedding []float32 `json:"embedding"`
+			}{Index: i, Embedding: vectorOf(2, float32(i))})
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	e, err := New(config.EmbeddingConfig{Provider: ProviderOpenAI, Endpoint: srv.URL + "/v1", Model: "text-embedding-3-small", Dimensions: 2, ApiKey: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	vectors, err := e.Embed(context.Background(), []string{"a", "b", "c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range vectors {
+		if v[0] != float32(i) {
+			t.Errorf("vector %d out of order: %v", i, v)
+		}
+	}
+	if gotPath != "/v1/embeddings" {
+		t.Errorf("unexpected path %q", gotPath)
+	}
+	if gotAuth != "Bearer secret" {
+		t.Errorf("unexpected Authorization header %q", gotAuth)
+	}
+}
+
+func TestOllamaEmbedder_Batches(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/api/embed" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		var req ollamaRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		var resp ollamaResponse
+		for range req.Input {
+			resp.Embeddings = append(resp.Embeddings, vectorOf(2, 1))
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	e, err := New(config.EmbeddingConfig{Provider: ProviderOllama, Endpoint: srv.URL, Model: "bge-m3", Dimensions: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	texts := make([]string, defaultBatchSize+1)
+	vectors, err := e.Embed(context.Background(), texts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vectors) != len(texts) {
+		t.Errorf("expected %d vectors, got %d", len(texts), len(vectors))
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 batched requests, got %d", requests)
+	}
+}
+
+func TestPostJSON_ErrorStatus(t *testing.T) {
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{status: http.StatusServiceUnavailable, want: ErrUnavailable},
+		{status: http.StatusTooManyRequests, want: ErrUnavailable},
+		{status: http.StatusNotFound, want: ErrInvalidResponse},
+	}
+
+	for _, tt := range tests {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "model not loaded", tt.status)
+		}))
+		e := &ollamaEmbedder{cfg: config.EmbeddingConfig{Endpoint: srv.URL, Model: "bge-m3", Dimensions: 2}, http: srv.Client()}
+		if _, err := e.Embed(context.Background(), []string{"a"}); !errors.Is(err, tt.want) {
+			t.Errorf("status %d: expected %v, got %v", tt.status, tt.want, err)
+		}
+		srv.Close()
+	}
+}
+
+func TestRetry_TransientThenSuccess(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			http.Error(w, "busy", http.StatusTooManyRequests)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(serviceResponse{Vector: vectorOf(2, 1)})
+	}))
+	defer srv.Close()
+
+	inner := &serviceEmbedder{cfg: config.EmbeddingConfig{Endpoint: srv.URL, Dimensions: 2}, http: srv.Client()}
+	e := WithRetry(inner, RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond})
+	if _, err := e.Embed(context.Background(), []string{"a"}); err != nil {
+		t.Fatalf("expected success after retries, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetry_DoesNotRetryInvalidResponse(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "bad input", http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	inner := &serviceEmbedder{cfg: config.EmbeddingConfig{Endpoint: srv.URL, Dimensions: 2}, http: srv.Client()}
+	e := WithRetry(inner, RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond})
+	if _, err := e.Embed(context.Background(), []string{"a"}); !errors.Is(err, ErrInvalidResponse) {
+		t.Fatalf("expected ErrInvalidResponse, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected a single call, got %d", calls)
+	}
+}
+
+func TestUnreachableIsUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := srv.URL
+	srv.Close()
+
+	inner := &serviceEmbedder{cfg: config.EmbeddingConfig{Endpoint: url, Dimensions: 2}, http: &http.Client{}}
+	if _, err := inner.Embed(context.Background(), []string{"a"}); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("expected ErrUnavailable, got %v", err)
+	}
+}
+
+func TestIsLegacyPlaceholder(t *testing.T) {
+	placeholder := make([]float32, 1024)
+	placeholder[0] = 0.1
+
+	real := make([]float32, 1024)
+	real[0], real[1] = 0.1, 0.2
+
+	if !IsLegacyPlaceholder(placeholder) {
+		t.Error("expected the placeholder to be detected")
+	}
+	if IsLegacyPlaceholder(real) {
+		t.Error("expected a real vector not to be flagged")
+	}
+	if IsLegacyPlaceholder(nil) {
+		t.Error("expected an empty vector not to be flagged")
+	}
+}
+
+func TestDecodeStored(t *testing.T) {
+	if v := DecodeStored(json.RawMessage(`[0.5,-0.25]`)); len(v) != 2 || v[1] != -0.25 {
+		t.Errorf("unexpected vector %v", v)
+	}
+	for _, raw := range []string{``, `null`, `"x"`, `[0.1,0,0]`} {
+		if v := DecodeStored(json.RawMessage(raw)); v != nil {
+			t.Errorf("expected nil for %q, got %v", raw, v)
+		}
+	}
+}
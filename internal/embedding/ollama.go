@@ -0,0 +1,55 @@
+package embedding
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"api.us4ever/internal/config"
+)
+
+// ollamaEmbedder uses Ollama's batch endpoint POST /api/embed.
+type ollamaEmbedder struct {
+	cfg  config.EmbeddingConfig
+	http *http.Client
+}
+
+type ollamaRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type ollamaResponse struct {
+	Embeddings [][]float32 `json:"embeddings"`
+}
+
+func (e *ollamaEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	url := ollamaEmbedURL(e.cfg.Endpoint)
+	return inBatches(texts, defaultBatchSize, func(batch []string) ([][]float32, error) {
+		var resp ollamaResponse
+		if err := postJSON(ctx, e.http, url, e.cfg.ApiKey, ollamaRequest{Model: e.cfg.Model, Input: batch}, &resp); err != nil {
+			return nil, err
+		}
+		if err := checkVectors(resp.Embeddings, len(batch), e.cfg.Dimensions); err != nil {
+			return nil, err
+		}
+		return resp.Embeddings, nil
+	})
+}
+
+func (e *ollamaEmbedder) Dimensions() int {
+	return e.cfg.Dimensions
+}
+
+func (e *ollamaEmbedder) Model() string {
+	return e.cfg.Model
+}
+
+// ollamaEmbedURL accepts either the server URL ("http://localhost:11434") or the full endpoint.
+func ollamaEmbedURL(endpoint string) string {
+	endpoint = strings.TrimRight(endpoint, "/")
+	if strings.HasSuffix(endpoint, "/api/embed") {
+		return endpoint
+	}
+	return endpoint + "/api/embed"
+}
@@ -0,0 +1,76 @@
+package embedding
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"api.us4ever/internal/config"
+)
+
+// openAIEmbedder speaks the OpenAI /v1/embeddings API, which most hosted and
+// self-hosted model servers (vLLM, TEI, LM Studio, ...) also implement.
+type openAIEmbedder struct {
+	cfg  config.EmbeddingConfig
+	http *http.Client
+}
+
+type openAIRequest struct {
+	Model          string   `json:"model"`
+	Input          []string `json:"input"`
+	EncodingFormat string   `json:"encoding_format"`
+}
+
+type openAIResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+func (e *openAIEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	url := openAIEmbeddingsURL(e.cfg.Endpoint)
+	return inBatches(texts, defaultBatchSize, func(batch []string) ([][]float32, error) {
+		var resp openAIResponse
+		req := openAIRequest{Model: e.cfg.Model, Input: batch, EncodingFormat: "float"}
+		if err := postJSON(ctx, e.http, url, e.cfg.ApiKey, req, &resp); err != nil {
+			return nil, err
+		}
+
+		// The API does not promise to keep input order, index does
+		vectors := make([][]float32, len(batch))
+		for _, d := range resp.Data {
+			if d.Index < 0 || d.Index >= len(batch) {
+				return nil, fmt.Errorf("%w: index %d out of range", ErrInvalidResponse, d.Index)
+			}
+			vectors[d.Index] = d.Embedding
+		}
+		if err := checkVectors(vectors, len(batch), e.cfg.Dimensions); err != nil {
+			return nil, err
+		}
+		return vectors, nil
+	})
+}
+
+func (e *openAIEmbedder) Dimensions() int {
+	return e.cfg.Dimensions
+}
+
+func (e *openAIEmbedder) Model() string {
+	return e.cfg.Model
+}
+
+// openAIEmbeddingsURL accepts either a base URL ("https://api.openai.com" or
+// ".../v1") or the full embeddings URL.
+func openAIEmbeddingsURL(endpoint string) string {
+	endpoint = strings.TrimRight(endpoint, "/")
+	switch {
+	case strings.HasSuffix(endpoint, "/embeddings"):
+		return endpoint
+	case strings.HasSuffix(endpoint, "/v1"):
+		return endpoint + "/embeddings"
+	default:
+		return endpoint + "/v1/embeddings"
+	}
+}
@@ -0,0 +1,77 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RetryPolicy controls how transient (ErrUnavailable) failures are retried.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultRetryPolicy retries twice, after roughly 200ms and 400ms.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   200 * time.Millisecond,
+	MaxDelay:    2 * time.Second,
+}
+
+// retryingEmbedder retries transient failures with exponential backoff and jitter.
+type retryingEmbedder struct {
+	Embedder
+	policy RetryPolicy
+}
+
+// WithRetry wraps e so that ErrUnavailable failures are retried according to policy.
+// Other errors, and the caller's context ending, are returned immediately.
+func WithRetry(e Embedder, policy RetryPolicy) Embedder {
+	if policy.MaxAttempts <= 1 {
+		return e
+	}
+	return &retryingEmbedder{Embedder: e, policy: policy}
+}
+
+func (r *retryingEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	var err error
+	for attempt := 1; ; attempt++ {
+		var vectors [][]float32
+		vectors, err = r.Embedder.Embed(ctx, texts)
+		if err == nil || !errors.Is(err, ErrUnavailable) || attempt >= r.policy.MaxAttempts {
+			return vectors, err
+		}
+
+		delay := r.policy.backoff(attempt)
+		embeddingLogger.Warn("embedding request failed, retrying",
+			zap.Int("attempt", attempt),
+			zap.Duration("delay", delay),
+			zap.Error(err),
+		)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the delay before the next attempt: BaseDelay doubled per
+// attempt, capped at MaxDelay, with up to 50% jitter subtracted.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay << (attempt - 1)
+	if p.MaxDelay > 0 && (delay > p.MaxDelay || delay <= 0) {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return delay - time.Duration(rand.Int64N(int64(delay)/2+1))
+}
@@ -0,0 +1,50 @@
+package embedding
+
+import (
+	"context"
+	"net/http"
+
+	"api.us4ever/internal/config"
+)
+
+// serviceEmbedder talks to our own embedding service, which takes
+// {"text": ...} and answers {"embedding": [...]}, one text per request.
+type serviceEmbedder struct {
+	cfg  config.EmbeddingConfig
+	http *http.Client
+}
+
+type serviceRequest struct {
+	Text string `json:"text"`
+}
+
+type serviceResponse struct {
+	Vector []float32 `json:"embedding"`
+}
+
+func (e *serviceEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		var resp serviceResponse
+		if err := postJSON(ctx, e.http, e.cfg.Endpoint, e.cfg.ApiKey, serviceRequest{Text: text}, &resp); err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, resp.Vector)
+	}
+	if err := checkVectors(vectors, len(texts), e.cfg.Dimensions); err != nil {
+		return nil, err
+	}
+	return vectors, nil
+}
+
+func (e *serviceEmbedder) Dimensions() int {
+	return e.cfg.Dimensions
+}
+
+// Model falls back to the endpoint, since the service serves exactly one model.
+func (e *serviceEmbedder) Model() string {
+	if e.cfg.Model != "" {
+		return e.cfg.Model
+	}
+	return e.cfg.Endpoint
+}
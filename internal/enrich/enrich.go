@@ -0,0 +1,199 @@
+// Package enrich fills in what a record derives from its content: the
+// generated title and summary of a keep, and the vectors of keeps and moments.
+//
+// Records written through the API are enriched right away by a Queue; the
+// scheduled title/summary and embedding tasks pick up whatever the queue
+// missed: records dropped from a full queue, failed, or still waiting at
+// shutdown.
+package enrich
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"api.us4ever/internal/dify"
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/keep"
+	"api.us4ever/internal/ent/moment"
+	"api.us4ever/internal/es"
+	"api.us4ever/internal/logger"
+)
+
+var enrichLogger *logger.Logger
+
+func init() {
+	var err error
+	enrichLogger, err = logger.New("enrich")
+	if err != nil {
+		panic("failed to initialize enrich logger: " + err.Error())
+	}
+}
+
+// Keep generates the missing title and summary of a keep, then embeds the
+// fields that have no vector yet. A keep deleted meanwhile is skipped.
+func Keep(ctx context.Context, client *ent.Client, id string) error {
+	k, err := client.Keep.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load keep %s: %w", id, err)
+	}
+	if k, err = TitleAndSummary(ctx, client, k); err != nil {
+		return err
+	}
+	return Vectors(ctx, client, k)
+}
+
+// Moment embeds the content of a moment that has no vector yet. A moment
+// deleted meanwhile is skipped.
+func Moment(ctx context.Context, client *ent.Client, id string) error {
+	m, err := client.Moment.Query().
+		Where(moment.ID(id)).
+		Select(moment.FieldContent, moment.FieldContentVector).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load moment %s: %w", id, err)
+	}
+	if m.ContentVector != nil || m.Content == "" {
+		return nil
+	}
+	raw, err := embed(ctx, m.Content)
+	if err != nil {
+		return fmt.Errorf("failed to embed content: %w", err)
+	}
+	err = client.Moment.Update().
+		Where(moment.ID(id), moment.Content(m.Content)).
+		SetContentVector(raw).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update content vector: %w", err)
+	}
+	return nil
+}
+
+// TitleAndSummary generates the title and summary of k when they are empty
+// and returns the keep as stored. A generated value is only written while the
+// field is still empty, so an edit made during generation wins.
+func TitleAndSummary(ctx context.Context, client *ent.Client, k *ent.Keep) (*ent.Keep, error) {
+	if k.Content == "" || (k.Title != "" && k.Summary != "") {
+		return k, nil
+	}
+
+	// 生成 title
+	if k.Title == "" {
+		title, err := generate(dify.ActionTitle, k.Content)
+		if err != nil {
+			return k, fmt.Errorf("failed to generate title: %w", err)
+		}
+		err = client.Keep.Update().
+			Where(keep.ID(k.ID), keep.TitleEQ("")).
+			SetTitle(title).
+			SetUpdatedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return k, fmt.Errorf("failed to update title: %w", err)
+		}
+	}
+
+	// 生成 summary
+	if k.Summary == "" {
+		summary, err := generate(dify.ActionContent, k.Content)
+		if err != nil {
+			return k, fmt.Errorf("failed to generate summary: %w", err)
+		}
+		err = client.Keep.Update().
+			Where(keep.ID(k.ID), keep.SummaryEQ("")).
+			SetSummary(summary).
+			SetUpdatedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return k, fmt.Errorf("failed to update summary: %w", err)
+		}
+	}
+
+	stored, err := client.Keep.Get(ctx, k.ID)
+	if err != nil {
+		return k, fmt.Errorf("failed to reload keep %s: %w", k.ID, err)
+	}
+	return stored, nil
+}
+
+// Vectors embeds the title, summary and content of k that have text but no
+// vector. A vector is only written while its text is unchanged, so an edit
+// made during embedding is embedded again on the next run.
+func Vectors(ctx context.Context, client *ent.Client, k *ent.Keep) error {
+	if k.TitleVector == nil && k.Title != "" {
+		raw, err := embed(ctx, k.Title)
+		if err != nil {
+			return fmt.Errorf("failed to embed title: %w", err)
+		}
+		err = client.Keep.Update().
+			Where(keep.ID(k.ID), keep.Title(k.Title)).
+			SetTitleVector(raw).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update title vector: %w", err)
+		}
+	}
+	if k.SummaryVector == nil && k.Summary != "" {
+		raw, err := embed(ctx, k.Summary)
+		if err != nil {
+			return fmt.Errorf("failed to embed summary: %w", err)
+		}
+		err = client.Keep.Update().
+			Where(keep.ID(k.ID), keep.Summary(k.Summary)).
+			SetSummaryVector(raw).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update summary vector: %w", err)
+		}
+	}
+	if k.ContentVector == nil && k.Content != "" {
+		raw, err := embed(ctx, k.Content)
+		if err != nil {
+			return fmt.Errorf("failed to embed content: %w", err)
+		}
+		err = client.Keep.Update().
+			Where(keep.ID(k.ID), keep.Content(k.Content)).
+			SetContentVector(raw).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update content vector: %w", err)
+		}
+	}
+	// 向量更新会通过 search outbox 增量同步到搜索后端
+	return nil
+}
+
+// generate 使用 Dify 生成标题或摘要
+func generate(action dify.ActionType, content string) (string, error) {
+	req := &dify.WorkflowRequest{
+		Inputs: dify.WorkflowInput{
+			Action:  action,
+			Content: content,
+		},
+		ResponseMode: dify.ResponseModeBlocking,
+	}
+
+	result, err := dify.CallWorkflow(req)
+	if err != nil {
+		return "", err
+	}
+
+	return result.Message, nil
+}
+
+// embed returns the stored JSON form of the vector of text.
+func embed(ctx context.Context, text string) (json.RawMessage, error) {
+	vector, err := es.Embed(ctx, text)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(vector)
+}
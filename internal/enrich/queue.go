@@ -0,0 +1,145 @@
+package enrich
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"api.us4ever/internal/ent"
+	"go.uber.org/zap"
+)
+
+const (
+	// KindKeep queues a keep for Keep
+	KindKeep = "keep"
+	// KindMoment queues a moment for Moment
+	KindMoment = "moment"
+
+	// DefaultQueueSize is the number of records that can wait for enrichment
+	DefaultQueueSize = 256
+	// recordTimeout bounds the enrichment of one record
+	recordTimeout = 2 * time.Minute
+)
+
+type job struct {
+	client *ent.Client
+	kind   string
+	id     string
+}
+
+func (j job) key() string {
+	return j.kind + ":" + j.id
+}
+
+// Queue enriches records one at a time in the background. It only lives in
+// memory: records dropped from a full queue, failed or still waiting at
+// shutdown are left to the scheduled tasks.
+type Queue struct {
+	process func(ctx context.Context, client *ent.Client, kind, id string) error
+	jobs    chan job
+
+	mu      sync.Mutex
+	pending map[string]bool
+	closed  bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewQueue starts a queue enriching keeps with Keep and moments with Moment.
+func NewQueue(size int) *Queue {
+	return newQueue(size, func(ctx context.Context, client *ent.Client, kind, id string) error {
+		switch kind {
+		case KindKeep:
+			return Keep(ctx, client, id)
+		case KindMoment:
+			return Moment(ctx, client, id)
+		default:
+			return fmt.Errorf("unknown kind %q", kind)
+		}
+	})
+}
+
+func newQueue(size int, process func(ctx context.Context, client *ent.Client, kind, id string) error) *Queue {
+	if size <= 0 {
+		size = DefaultQueueSize
+	}
+	// 与请求无关的后台 context，只能通过 Close 取消
+	ctx, cancel := context.WithCancel(context.Background())
+	q := &Queue{
+		process: process,
+		jobs:    make(chan job, size),
+		pending: make(map[string]bool),
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	go q.run()
+	return q
+}
+
+// Enqueue schedules the enrichment of record id of kind. It never blocks and
+// reports false when the record could not be queued; a record already
+// waiting is not queued twice.
+func (q *Queue) Enqueue(client *ent.Client, kind, id string) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return false
+	}
+	j := job{client: client, kind: kind, id: id}
+	if q.pending[j.key()] {
+		return true
+	}
+	select {
+	case q.jobs <- j:
+		q.pending[j.key()] = true
+		return true
+	default:
+		enrichLogger.Warn("enrichment queue is full, leaving record to the scheduled tasks",
+			zap.String("kind", kind),
+			zap.String("id", id),
+		)
+		return false
+	}
+}
+
+// Close stops the queue, cancelling the record being enriched. Records still
+// waiting are left to the scheduled tasks.
+func (q *Queue) Close() {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.jobs)
+	}
+	q.mu.Unlock()
+
+	q.cancel()
+	<-q.done
+}
+
+func (q *Queue) run() {
+	defer close(q.done)
+	for j := range q.jobs {
+		if q.ctx.Err() != nil {
+			continue
+		}
+		// 开始处理前移出 pending，处理期间的修改会重新入队
+		q.mu.Lock()
+		delete(q.pending, j.key())
+		q.mu.Unlock()
+
+		ctx, cancel := context.WithTimeout(q.ctx, recordTimeout)
+		if err := q.process(ctx, j.client, j.kind, j.id); err != nil {
+			enrichLogger.Warn("failed to enrich record, leaving it to the scheduled tasks",
+				zap.String("kind", j.kind),
+				zap.String("id", j.id),
+				zap.Error(err),
+			)
+		}
+		cancel()
+	}
+}
@@ -0,0 +1,97 @@
+package enrich
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"api.us4ever/internal/ent"
+)
+
+func TestQueueEnrichesEachKeepOnce(t *testing.T) {
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var seen []string
+	q := newQueue(4, func(ctx context.Context, _ *ent.Client, _, id string) error {
+		if id == "first" {
+			<-release
+		}
+		mu.Lock()
+		seen = append(seen, id)
+		mu.Unlock()
+		return nil
+	})
+
+	// first 阻塞在处理中，其余的在队列中等待
+	if !q.Enqueue(nil, KindKeep, "first") {
+		t.Fatal("expected first to be queued")
+	}
+	waitFor(t, func() bool {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		return !q.pending[KindKeep+":first"]
+	})
+	for _, id := range []string{"a", "b", "a", "first"} {
+		if !q.Enqueue(nil, KindKeep, id) {
+			t.Fatalf("expected %s to be queued", id)
+		}
+	}
+	close(release)
+	waitFor(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(seen) == 4
+	})
+	q.Close()
+
+	want := []string{"first", "a", "b"}
+	if len(seen) != len(want)+1 || seen[3] != "first" {
+		t.Fatalf("expected %v then first again, got %v", want, seen)
+	}
+	for i, id := range want {
+		if seen[i] != id {
+			t.Fatalf("expected %v then first again, got %v", want, seen)
+		}
+	}
+}
+
+func TestQueueFullAndClosed(t *testing.T) {
+	release := make(chan struct{})
+	q := newQueue(1, func(ctx context.Context, _ *ent.Client, _, id string) error {
+		<-release
+		return errors.New("failed")
+	})
+
+	q.Enqueue(nil, KindKeep, "running")
+	waitFor(t, func() bool {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		return len(q.pending) == 0
+	})
+	if !q.Enqueue(nil, KindKeep, "waiting") {
+		t.Fatal("expected waiting to be queued")
+	}
+	if q.Enqueue(nil, KindKeep, "dropped") {
+		t.Error("expected a full queue to refuse a keep")
+	}
+
+	close(release)
+	q.Close()
+	if q.Enqueue(nil, KindKeep, "late") {
+		t.Error("expected a closed queue to refuse a keep")
+	}
+	q.Close()
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the queue")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
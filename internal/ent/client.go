@@ -15,11 +15,17 @@ import (
 	"api.us4ever/internal/ent/file"
 	"api.us4ever/internal/ent/group"
 	"api.us4ever/internal/ent/image"
+	"api.us4ever/internal/ent/indexdeadletter"
 	"api.us4ever/internal/ent/keep"
+	"api.us4ever/internal/ent/keepchunk"
 	"api.us4ever/internal/ent/mindmap"
 	"api.us4ever/internal/ent/moment"
 	"api.us4ever/internal/ent/momentimage"
 	"api.us4ever/internal/ent/momentvideo"
+	"api.us4ever/internal/ent/searchclick"
+	"api.us4ever/internal/ent/searchlog"
+	"api.us4ever/internal/ent/searchoutbox"
+	"api.us4ever/internal/ent/tagsuggestion"
 	"api.us4ever/internal/ent/todo"
 	"api.us4ever/internal/ent/user"
 	"api.us4ever/internal/ent/video"
@@ -27,6 +33,8 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -42,8 +50,12 @@ type Client struct {
 	Group *GroupClient
 	// Image is the client for interacting with the Image builders.
 	Image *ImageClient
+	// IndexDeadLetter is the client for interacting with the IndexDeadLetter builders.
+	IndexDeadLetter *IndexDeadLetterClient
 	// Keep is the client for interacting with the Keep builders.
 	Keep *KeepClient
+	// KeepChunk is the client for interacting with the KeepChunk builders.
+	KeepChunk *KeepChunkClient
 	// Mindmap is the client for interacting with the Mindmap builders.
 	Mindmap *MindmapClient
 	// Moment is the client for interacting with the Moment builders.
@@ -52,6 +64,14 @@ type Client struct {
 	MomentImage *MomentImageClient
 	// MomentVideo is the client for interacting with the MomentVideo builders.
 	MomentVideo *MomentVideoClient
+	// SearchClick is the client for interacting with the SearchClick builders.
+	SearchClick *SearchClickClient
+	// SearchLog is the client for interacting with the SearchLog builders.
+	SearchLog *SearchLogClient
+	// SearchOutbox is the client for interacting with the SearchOutbox builders.
+	SearchOutbox *SearchOutboxClient
+	// TagSuggestion is the client for interacting with the TagSuggestion builders.
+	TagSuggestion *TagSuggestionClient
 	// Todo is the client for interacting with the Todo builders.
 	Todo *TodoClient
 	// User is the client for interacting with the User builders.
@@ -73,11 +93,17 @@ func (c *Client) init() {
 	c.File = NewFileClient(c.config)
 	c.Group = NewGroupClient(c.config)
 	c.Image = NewImageClient(c.config)
+	c.IndexDeadLetter = NewIndexDeadLetterClient(c.config)
 	c.Keep = NewKeepClient(c.config)
+	c.KeepChunk = NewKeepChunkClient(c.config)
 	c.Mindmap = NewMindmapClient(c.config)
 	c.Moment = NewMomentClient(c.config)
 	c.MomentImage = NewMomentImageClient(c.config)
 	c.MomentVideo = NewMomentVideoClient(c.config)
+	c.SearchClick = NewSearchClickClient(c.config)
+	c.SearchLog = NewSearchLogClient(c.config)
+	c.SearchOutbox = NewSearchOutboxClient(c.config)
+	c.TagSuggestion = NewTagSuggestionClient(c.config)
 	c.Todo = NewTodoClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.Video = NewVideoClient(c.config)
@@ -171,20 +197,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Bucket:      NewBucketClient(cfg),
-		File:        NewFileClient(cfg),
-		Group:       NewGroupClient(cfg),
-		Image:       NewImageClient(cfg),
-		Keep:        NewKeepClient(cfg),
-		Mindmap:     NewMindmapClient(cfg),
-		Moment:      NewMomentClient(cfg),
-		MomentImage: NewMomentImageClient(cfg),
-		MomentVideo: NewMomentVideoClient(cfg),
-		Todo:        NewTodoClient(cfg),
-		User:        NewUserClient(cfg),
-		Video:       NewVideoClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Bucket:          NewBucketClient(cfg),
+		File:            NewFileClient(cfg),
+		Group:           NewGroupClient(cfg),
+		Image:           NewImageClient(cfg),
+		IndexDeadLetter: NewIndexDeadLetterClient(cfg),
+		Keep:            NewKeepClient(cfg),
+		KeepChunk:       NewKeepChunkClient(cfg),
+		Mindmap:         NewMindmapClient(cfg),
+		Moment:          NewMomentClient(cfg),
+		MomentImage:     NewMomentImageClient(cfg),
+		MomentVideo:     NewMomentVideoClient(cfg),
+		SearchClick:     NewSearchClickClient(cfg),
+		SearchLog:       NewSearchLogClient(cfg),
+		SearchOutbox:    NewSearchOutboxClient(cfg),
+		TagSuggestion:   NewTagSuggestionClient(cfg),
+		Todo:            NewTodoClient(cfg),
+		User:            NewUserClient(cfg),
+		Video:           NewVideoClient(cfg),
 	}, nil
 }
 
@@ -202,20 +234,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Bucket:      NewBucketClient(cfg),
-		File:        NewFileClient(cfg),
-		Group:       NewGroupClient(cfg),
-		Image:       NewImageClient(cfg),
-		Keep:        NewKeepClient(cfg),
-		Mindmap:     NewMindmapClient(cfg),
-		Moment:      NewMomentClient(cfg),
-		MomentImage: NewMomentImageClient(cfg),
-		MomentVideo: NewMomentVideoClient(cfg),
-		Todo:        NewTodoClient(cfg),
-		User:        NewUserClient(cfg),
-		Video:       NewVideoClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Bucket:          NewBucketClient(cfg),
+		File:            NewFileClient(cfg),
+		Group:           NewGroupClient(cfg),
+		Image:           NewImageClient(cfg),
+		IndexDeadLetter: NewIndexDeadLetterClient(cfg),
+		Keep:            NewKeepClient(cfg),
+		KeepChunk:       NewKeepChunkClient(cfg),
+		Mindmap:         NewMindmapClient(cfg),
+		Moment:          NewMomentClient(cfg),
+		MomentImage:     NewMomentImageClient(cfg),
+		MomentVideo:     NewMomentVideoClient(cfg),
+		SearchClick:     NewSearchClickClient(cfg),
+		SearchLog:       NewSearchLogClient(cfg),
+		SearchOutbox:    NewSearchOutboxClient(cfg),
+		TagSuggestion:   NewTagSuggestionClient(cfg),
+		Todo:            NewTodoClient(cfg),
+		User:            NewUserClient(cfg),
+		Video:           NewVideoClient(cfg),
 	}, nil
 }
 
@@ -245,8 +283,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Bucket, c.File, c.Group, c.Image, c.Keep, c.Mindmap, c.Moment, c.MomentImage,
-		c.MomentVideo, c.Todo, c.User, c.Video,
+		c.Bucket, c.File, c.Group, c.Image, c.IndexDeadLetter, c.Keep, c.KeepChunk,
+		c.Mindmap, c.Moment, c.MomentImage, c.MomentVideo, c.SearchClick, c.SearchLog,
+		c.SearchOutbox, c.TagSuggestion, c.Todo, c.User, c.Video,
 	} {
 		n.Use(hooks...)
 	}
@@ -256,8 +295,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Bucket, c.File, c.Group, c.Image, c.Keep, c.Mindmap, c.Moment, c.MomentImage,
-		c.MomentVideo, c.Todo, c.User, c.Video,
+		c.Bucket, c.File, c.Group, c.Image, c.IndexDeadLetter, c.Keep, c.KeepChunk,
+		c.Mindmap, c.Moment, c.MomentImage, c.MomentVideo, c.SearchClick, c.SearchLog,
+		c.SearchOutbox, c.TagSuggestion, c.Todo, c.User, c.Video,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -274,8 +314,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Group.mutate(ctx, m)
 	case *ImageMutation:
 		return c.Image.mutate(ctx, m)
+	case *IndexDeadLetterMutation:
+		return c.IndexDeadLetter.mutate(ctx, m)
 	case *KeepMutation:
 		return c.Keep.mutate(ctx, m)
+	case *KeepChunkMutation:
+		return c.KeepChunk.mutate(ctx, m)
 	case *MindmapMutation:
 		return c.Mindmap.mutate(ctx, m)
 	case *MomentMutation:
@@ -284,6 +328,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.MomentImage.mutate(ctx, m)
 	case *MomentVideoMutation:
 		return c.MomentVideo.mutate(ctx, m)
+	case *SearchClickMutation:
+		return c.SearchClick.mutate(ctx, m)
+	case *SearchLogMutation:
+		return c.SearchLog.mutate(ctx, m)
+	case *SearchOutboxMutation:
+		return c.SearchOutbox.mutate(ctx, m)
+	case *TagSuggestionMutation:
+		return c.TagSuggestion.mutate(ctx, m)
 	case *TodoMutation:
 		return c.Todo.mutate(ctx, m)
 	case *UserMutation:
@@ -1099,6 +1151,139 @@ func (c *ImageClient) mutate(ctx context.Context, m *ImageMutation) (Value, erro
 	}
 }
 
+// IndexDeadLetterClient is a client for the IndexDeadLetter schema.
+type IndexDeadLetterClient struct {
+	config
+}
+
+// NewIndexDeadLetterClient returns a client for the IndexDeadLetter from the given config.
+func NewIndexDeadLetterClient(c config) *IndexDeadLetterClient {
+	return &IndexDeadLetterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `indexdeadletter.Hooks(f(g(h())))`.
+func (c *IndexDeadLetterClient) Use(hooks ...Hook) {
+	c.hooks.IndexDeadLetter = append(c.hooks.IndexDeadLetter, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `indexdeadletter.Intercept(f(g(h())))`.
+func (c *IndexDeadLetterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IndexDeadLetter = append(c.inters.IndexDeadLetter, interceptors...)
+}
+
+// Create returns a builder for creating a IndexDeadLetter entity.
+func (c *IndexDeadLetterClient) Create() *IndexDeadLetterCreate {
+	mutation := newIndexDeadLetterMutation(c.config, OpCreate)
+	return &IndexDeadLetterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IndexDeadLetter entities.
+func (c *IndexDeadLetterClient) CreateBulk(builders ...*IndexDeadLetterCreate) *IndexDeadLetterCreateBulk {
+	return &IndexDeadLetterCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IndexDeadLetterClient) MapCreateBulk(slice any, setFunc func(*IndexDeadLetterCreate, int)) *IndexDeadLetterCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IndexDeadLetterCreateBulk{err: fmt.Errorf("calling to IndexDeadLetterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IndexDeadLetterCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IndexDeadLetterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IndexDeadLetter.
+func (c *IndexDeadLetterClient) Update() *IndexDeadLetterUpdate {
+	mutation := newIndexDeadLetterMutation(c.config, OpUpdate)
+	return &IndexDeadLetterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IndexDeadLetterClient) UpdateOne(idl *IndexDeadLetter) *IndexDeadLetterUpdateOne {
+	mutation := newIndexDeadLetterMutation(c.config, OpUpdateOne, withIndexDeadLetter(idl))
+	return &IndexDeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IndexDeadLetterClient) UpdateOneID(id int) *IndexDeadLetterUpdateOne {
+	mutation := newIndexDeadLetterMutation(c.config, OpUpdateOne, withIndexDeadLetterID(id))
+	return &IndexDeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IndexDeadLetter.
+func (c *IndexDeadLetterClient) Delete() *IndexDeadLetterDelete {
+	mutation := newIndexDeadLetterMutation(c.config, OpDelete)
+	return &IndexDeadLetterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IndexDeadLetterClient) DeleteOne(idl *IndexDeadLetter) *IndexDeadLetterDeleteOne {
+	return c.DeleteOneID(idl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IndexDeadLetterClient) DeleteOneID(id int) *IndexDeadLetterDeleteOne {
+	builder := c.Delete().Where(indexdeadletter.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IndexDeadLetterDeleteOne{builder}
+}
+
+// Query returns a query builder for IndexDeadLetter.
+func (c *IndexDeadLetterClient) Query() *IndexDeadLetterQuery {
+	return &IndexDeadLetterQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIndexDeadLetter},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IndexDeadLetter entity by its id.
+func (c *IndexDeadLetterClient) Get(ctx context.Context, id int) (*IndexDeadLetter, error) {
+	return c.Query().Where(indexdeadletter.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IndexDeadLetterClient) GetX(ctx context.Context, id int) *IndexDeadLetter {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IndexDeadLetterClient) Hooks() []Hook {
+	return c.hooks.IndexDeadLetter
+}
+
+// Interceptors returns the client interceptors.
+func (c *IndexDeadLetterClient) Interceptors() []Interceptor {
+	return c.inters.IndexDeadLetter
+}
+
+func (c *IndexDeadLetterClient) mutate(ctx context.Context, m *IndexDeadLetterMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IndexDeadLetterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IndexDeadLetterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IndexDeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IndexDeadLetterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IndexDeadLetter mutation op: %q", m.Op())
+	}
+}
+
 // KeepClient is a client for the Keep schema.
 type KeepClient struct {
 	config
@@ -1248,6 +1433,139 @@ func (c *KeepClient) mutate(ctx context.Context, m *KeepMutation) (Value, error)
 	}
 }
 
+// KeepChunkClient is a client for the KeepChunk schema.
+type KeepChunkClient struct {
+	config
+}
+
+// NewKeepChunkClient returns a client for the KeepChunk from the given config.
+func NewKeepChunkClient(c config) *KeepChunkClient {
+	return &KeepChunkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `keepchunk.Hooks(f(g(h())))`.
+func (c *KeepChunkClient) Use(hooks ...Hook) {
+	c.hooks.KeepChunk = append(c.hooks.KeepChunk, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `keepchunk.Intercept(f(g(h())))`.
+func (c *KeepChunkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KeepChunk = append(c.inters.KeepChunk, interceptors...)
+}
+
+// Create returns a builder for creating a KeepChunk entity.
+func (c *KeepChunkClient) Create() *KeepChunkCreate {
+	mutation := newKeepChunkMutation(c.config, OpCreate)
+	return &KeepChunkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KeepChunk entities.
+func (c *KeepChunkClient) CreateBulk(builders ...*KeepChunkCreate) *KeepChunkCreateBulk {
+	return &KeepChunkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KeepChunkClient) MapCreateBulk(slice any, setFunc func(*KeepChunkCreate, int)) *KeepChunkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KeepChunkCreateBulk{err: fmt.Errorf("calling to KeepChunkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KeepChunkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KeepChunkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KeepChunk.
+func (c *KeepChunkClient) Update() *KeepChunkUpdate {
+	mutation := newKeepChunkMutation(c.config, OpUpdate)
+	return &KeepChunkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KeepChunkClient) UpdateOne(kc *KeepChunk) *KeepChunkUpdateOne {
+	mutation := newKeepChunkMutation(c.config, OpUpdateOne, withKeepChunk(kc))
+	return &KeepChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KeepChunkClient) UpdateOneID(id int) *KeepChunkUpdateOne {
+	mutation := newKeepChunkMutation(c.config, OpUpdateOne, withKeepChunkID(id))
+	return &KeepChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KeepChunk.
+func (c *KeepChunkClient) Delete() *KeepChunkDelete {
+	mutation := newKeepChunkMutation(c.config, OpDelete)
+	return &KeepChunkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KeepChunkClient) DeleteOne(kc *KeepChunk) *KeepChunkDeleteOne {
+	return c.DeleteOneID(kc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KeepChunkClient) DeleteOneID(id int) *KeepChunkDeleteOne {
+	builder := c.Delete().Where(keepchunk.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KeepChunkDeleteOne{builder}
+}
+
+// Query returns a query builder for KeepChunk.
+func (c *KeepChunkClient) Query() *KeepChunkQuery {
+	return &KeepChunkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKeepChunk},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KeepChunk entity by its id.
+func (c *KeepChunkClient) Get(ctx context.Context, id int) (*KeepChunk, error) {
+	return c.Query().Where(keepchunk.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KeepChunkClient) GetX(ctx context.Context, id int) *KeepChunk {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *KeepChunkClient) Hooks() []Hook {
+	return c.hooks.KeepChunk
+}
+
+// Interceptors returns the client interceptors.
+func (c *KeepChunkClient) Interceptors() []Interceptor {
+	return c.inters.KeepChunk
+}
+
+func (c *KeepChunkClient) mutate(ctx context.Context, m *KeepChunkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KeepChunkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KeepChunkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KeepChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KeepChunkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KeepChunk mutation op: %q", m.Op())
+	}
+}
+
 // MindmapClient is a client for the Mindmap schema.
 type MindmapClient struct {
 	config
@@ -1908,6 +2226,538 @@ func (c *MomentVideoClient) mutate(ctx context.Context, m *MomentVideoMutation)
 	}
 }
 
+// SearchClickClient is a client for the SearchClick schema.
+type SearchClickClient struct {
+	config
+}
+
+// NewSearchClickClient returns a client for the SearchClick from the given config.
+func NewSearchClickClient(c config) *SearchClickClient {
+	return &SearchClickClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `searchclick.Hooks(f(g(h())))`.
+func (c *SearchClickClient) Use(hooks ...Hook) {
+	c.hooks.SearchClick = append(c.hooks.SearchClick, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `searchclick.Intercept(f(g(h())))`.
+func (c *SearchClickClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SearchClick = append(c.inters.SearchClick, interceptors...)
+}
+
+// Create returns a builder for creating a SearchClick entity.
+func (c *SearchClickClient) Create() *SearchClickCreate {
+	mutation := newSearchClickMutation(c.config, OpCreate)
+	return &SearchClickCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SearchClick entities.
+func (c *SearchClickClient) CreateBulk(builders ...*SearchClickCreate) *SearchClickCreateBulk {
+	return &SearchClickCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SearchClickClient) MapCreateBulk(slice any, setFunc func(*SearchClickCreate, int)) *SearchClickCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SearchClickCreateBulk{err: fmt.Errorf("calling to SearchClickClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SearchClickCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SearchClickCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SearchClick.
+func (c *SearchClickClient) Update() *SearchClickUpdate {
+	mutation := newSearchClickMutation(c.config, OpUpdate)
+	return &SearchClickUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SearchClickClient) UpdateOne(sc *SearchClick) *SearchClickUpdateOne {
+	mutation := newSearchClickMutation(c.config, OpUpdateOne, withSearchClick(sc))
+	return &SearchClickUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SearchClickClient) UpdateOneID(id int) *SearchClickUpdateOne {
+	mutation := newSearchClickMutation(c.config, OpUpdateOne, withSearchClickID(id))
+	return &SearchClickUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SearchClick.
+func (c *SearchClickClient) Delete() *SearchClickDelete {
+	mutation := newSearchClickMutation(c.config, OpDelete)
+	return &SearchClickDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SearchClickClient) DeleteOne(sc *SearchClick) *SearchClickDeleteOne {
+	return c.DeleteOneID(sc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SearchClickClient) DeleteOneID(id int) *SearchClickDeleteOne {
+	builder := c.Delete().Where(searchclick.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SearchClickDeleteOne{builder}
+}
+
+// Query returns a query builder for SearchClick.
+func (c *SearchClickClient) Query() *SearchClickQuery {
+	return &SearchClickQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSearchClick},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SearchClick entity by its id.
+func (c *SearchClickClient) Get(ctx context.Context, id int) (*SearchClick, error) {
+	return c.Query().Where(searchclick.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SearchClickClient) GetX(ctx context.Context, id int) *SearchClick {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SearchClickClient) Hooks() []Hook {
+	return c.hooks.SearchClick
+}
+
+// Interceptors returns the client interceptors.
+func (c *SearchClickClient) Interceptors() []Interceptor {
+	return c.inters.SearchClick
+}
+
+func (c *SearchClickClient) mutate(ctx context.Context, m *SearchClickMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SearchClickCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SearchClickUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SearchClickUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SearchClickDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SearchClick mutation op: %q", m.Op())
+	}
+}
+
+// SearchLogClient is a client for the SearchLog schema.
+type SearchLogClient struct {
+	config
+}
+
+// NewSearchLogClient returns a client for the SearchLog from the given config.
+func NewSearchLogClient(c config) *SearchLogClient {
+	return &SearchLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `searchlog.Hooks(f(g(h())))`.
+func (c *SearchLogClient) Use(hooks ...Hook) {
+	c.hooks.SearchLog = append(c.hooks.SearchLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `searchlog.Intercept(f(g(h())))`.
+func (c *SearchLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SearchLog = append(c.inters.SearchLog, interceptors...)
+}
+
+// Create returns a builder for creating a SearchLog entity.
+func (c *SearchLogClient) Create() *SearchLogCreate {
+	mutation := newSearchLogMutation(c.config, OpCreate)
+	return &SearchLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SearchLog entities.
+func (c *SearchLogClient) CreateBulk(builders ...*SearchLogCreate) *SearchLogCreateBulk {
+	return &SearchLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SearchLogClient) MapCreateBulk(slice any, setFunc func(*SearchLogCreate, int)) *SearchLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SearchLogCreateBulk{err: fmt.Errorf("calling to SearchLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SearchLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SearchLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SearchLog.
+func (c *SearchLogClient) Update() *SearchLogUpdate {
+	mutation := newSearchLogMutation(c.config, OpUpdate)
+	return &SearchLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SearchLogClient) UpdateOne(sl *SearchLog) *SearchLogUpdateOne {
+	mutation := newSearchLogMutation(c.config, OpUpdateOne, withSearchLog(sl))
+	return &SearchLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SearchLogClient) UpdateOneID(id int) *SearchLogUpdateOne {
+	mutation := newSearchLogMutation(c.config, OpUpdateOne, withSearchLogID(id))
+	return &SearchLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SearchLog.
+func (c *SearchLogClient) Delete() *SearchLogDelete {
+	mutation := newSearchLogMutation(c.config, OpDelete)
+	return &SearchLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SearchLogClient) DeleteOne(sl *SearchLog) *SearchLogDeleteOne {
+	return c.DeleteOneID(sl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SearchLogClient) DeleteOneID(id int) *SearchLogDeleteOne {
+	builder := c.Delete().Where(searchlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SearchLogDeleteOne{builder}
+}
+
+// Query returns a query builder for SearchLog.
+func (c *SearchLogClient) Query() *SearchLogQuery {
+	return &SearchLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSearchLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SearchLog entity by its id.
+func (c *SearchLogClient) Get(ctx context.Context, id int) (*SearchLog, error) {
+	return c.Query().Where(searchlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SearchLogClient) GetX(ctx context.Context, id int) *SearchLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SearchLogClient) Hooks() []Hook {
+	return c.hooks.SearchLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *SearchLogClient) Interceptors() []Interceptor {
+	return c.inters.SearchLog
+}
+
+func (c *SearchLogClient) mutate(ctx context.Context, m *SearchLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SearchLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SearchLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SearchLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SearchLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SearchLog mutation op: %q", m.Op())
+	}
+}
+
+// SearchOutboxClient is a client for the SearchOutbox schema.
+type SearchOutboxClient struct {
+	config
+}
+
+// NewSearchOutboxClient returns a client for the SearchOutbox from the given config.
+func NewSearchOutboxClient(c config) *SearchOutboxClient {
+	return &SearchOutboxClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `searchoutbox.Hooks(f(g(h())))`.
+func (c *SearchOutboxClient) Use(hooks ...Hook) {
+	c.hooks.SearchOutbox = append(c.hooks.SearchOutbox, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `searchoutbox.Intercept(f(g(h())))`.
+func (c *SearchOutboxClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SearchOutbox = append(c.inters.SearchOutbox, interceptors...)
+}
+
+// Create returns a builder for creating a SearchOutbox entity.
+func (c *SearchOutboxClient) Create() *SearchOutboxCreate {
+	mutation := newSearchOutboxMutation(c.config, OpCreate)
+	return &SearchOutboxCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SearchOutbox entities.
+func (c *SearchOutboxClient) CreateBulk(builders ...*SearchOutboxCreate) *SearchOutboxCreateBulk {
+	return &SearchOutboxCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SearchOutboxClient) MapCreateBulk(slice any, setFunc func(*SearchOutboxCreate, int)) *SearchOutboxCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SearchOutboxCreateBulk{err: fmt.Errorf("calling to SearchOutboxClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SearchOutboxCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SearchOutboxCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SearchOutbox.
+func (c *SearchOutboxClient) Update() *SearchOutboxUpdate {
+	mutation := newSearchOutboxMutation(c.config, OpUpdate)
+	return &SearchOutboxUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SearchOutboxClient) UpdateOne(so *SearchOutbox) *SearchOutboxUpdateOne {
+	mutation := newSearchOutboxMutation(c.config, OpUpdateOne, withSearchOutbox(so))
+	return &SearchOutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SearchOutboxClient) UpdateOneID(id int) *SearchOutboxUpdateOne {
+	mutation := newSearchOutboxMutation(c.config, OpUpdateOne, withSearchOutboxID(id))
+	return &SearchOutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SearchOutbox.
+func (c *SearchOutboxClient) Delete() *SearchOutboxDelete {
+	mutation := newSearchOutboxMutation(c.config, OpDelete)
+	return &SearchOutboxDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SearchOutboxClient) DeleteOne(so *SearchOutbox) *SearchOutboxDeleteOne {
+	return c.DeleteOneID(so.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SearchOutboxClient) DeleteOneID(id int) *SearchOutboxDeleteOne {
+	builder := c.Delete().Where(searchoutbox.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SearchOutboxDeleteOne{builder}
+}
+
+// Query returns a query builder for SearchOutbox.
+func (c *SearchOutboxClient) Query() *SearchOutboxQuery {
+	return &SearchOutboxQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSearchOutbox},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SearchOutbox entity by its id.
+func (c *SearchOutboxClient) Get(ctx context.Context, id int) (*SearchOutbox, error) {
+	return c.Query().Where(searchoutbox.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SearchOutboxClient) GetX(ctx context.Context, id int) *SearchOutbox {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SearchOutboxClient) Hooks() []Hook {
+	return c.hooks.SearchOutbox
+}
+
+// Interceptors returns the client interceptors.
+func (c *SearchOutboxClient) Interceptors() []Interceptor {
+	return c.inters.SearchOutbox
+}
+
+func (c *SearchOutboxClient) mutate(ctx context.Context, m *SearchOutboxMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SearchOutboxCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SearchOutboxUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SearchOutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SearchOutboxDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SearchOutbox mutation op: %q", m.Op())
+	}
+}
+
+// TagSuggestionClient is a client for the TagSuggestion schema.
+type TagSuggestionClient struct {
+	config
+}
+
+// NewTagSuggestionClient returns a client for the TagSuggestion from the given config.
+func NewTagSuggestionClient(c config) *TagSuggestionClient {
+	return &TagSuggestionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tagsuggestion.Hooks(f(g(h())))`.
+func (c *TagSuggestionClient) Use(hooks ...Hook) {
+	c.hooks.TagSuggestion = append(c.hooks.TagSuggestion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tagsuggestion.Intercept(f(g(h())))`.
+func (c *TagSuggestionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TagSuggestion = append(c.inters.TagSuggestion, interceptors...)
+}
+
+// Create returns a builder for creating a TagSuggestion entity.
+func (c *TagSuggestionClient) Create() *TagSuggestionCreate {
+	mutation := newTagSuggestionMutation(c.config, OpCreate)
+	return &TagSuggestionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TagSuggestion entities.
+func (c *TagSuggestionClient) CreateBulk(builders ...*TagSuggestionCreate) *TagSuggestionCreateBulk {
+	return &TagSuggestionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagSuggestionClient) MapCreateBulk(slice any, setFunc func(*TagSuggestionCreate, int)) *TagSuggestionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagSuggestionCreateBulk{err: fmt.Errorf("calling to TagSuggestionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagSuggestionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagSuggestionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TagSuggestion.
+func (c *TagSuggestionClient) Update() *TagSuggestionUpdate {
+	mutation := newTagSuggestionMutation(c.config, OpUpdate)
+	return &TagSuggestionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagSuggestionClient) UpdateOne(ts *TagSuggestion) *TagSuggestionUpdateOne {
+	mutation := newTagSuggestionMutation(c.config, OpUpdateOne, withTagSuggestion(ts))
+	return &TagSuggestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagSuggestionClient) UpdateOneID(id int) *TagSuggestionUpdateOne {
+	mutation := newTagSuggestionMutation(c.config, OpUpdateOne, withTagSuggestionID(id))
+	return &TagSuggestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TagSuggestion.
+func (c *TagSuggestionClient) Delete() *TagSuggestionDelete {
+	mutation := newTagSuggestionMutation(c.config, OpDelete)
+	return &TagSuggestionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagSuggestionClient) DeleteOne(ts *TagSuggestion) *TagSuggestionDeleteOne {
+	return c.DeleteOneID(ts.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TagSuggestionClient) DeleteOneID(id int) *TagSuggestionDeleteOne {
+	builder := c.Delete().Where(tagsuggestion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagSuggestionDeleteOne{builder}
+}
+
+// Query returns a query builder for TagSuggestion.
+func (c *TagSuggestionClient) Query() *TagSuggestionQuery {
+	return &TagSuggestionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTagSuggestion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TagSuggestion entity by its id.
+func (c *TagSuggestionClient) Get(ctx context.Context, id int) (*TagSuggestion, error) {
+	return c.Query().Where(tagsuggestion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagSuggestionClient) GetX(ctx context.Context, id int) *TagSuggestion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TagSuggestionClient) Hooks() []Hook {
+	return c.hooks.TagSuggestion
+}
+
+// Interceptors returns the client interceptors.
+func (c *TagSuggestionClient) Interceptors() []Interceptor {
+	return c.inters.TagSuggestion
+}
+
+func (c *TagSuggestionClient) mutate(ctx context.Context, m *TagSuggestionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TagSuggestionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TagSuggestionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TagSuggestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TagSuggestionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TagSuggestion mutation op: %q", m.Op())
+	}
+}
+
 // TodoClient is a client for the Todo schema.
 type TodoClient struct {
 	config
@@ -2534,11 +3384,37 @@ func (c *VideoClient) mutate(ctx context.Context, m *VideoMutation) (Value, erro
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Bucket, File, Group, Image, Keep, Mindmap, Moment, MomentImage, MomentVideo,
+		Bucket, File, Group, Image, IndexDeadLetter, Keep, KeepChunk, Mindmap, Moment,
+		MomentImage, MomentVideo, SearchClick, SearchLog, SearchOutbox, TagSuggestion,
 		Todo, User, Video []ent.Hook
 	}
 	inters struct {
-		Bucket, File, Group, Image, Keep, Mindmap, Moment, MomentImage, MomentVideo,
+		Bucket, File, Group, Image, IndexDeadLetter, Keep, KeepChunk, Mindmap, Moment,
+		MomentImage, MomentVideo, SearchClick, SearchLog, SearchOutbox, TagSuggestion,
 		Todo, User, Video []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
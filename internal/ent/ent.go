@@ -13,11 +13,17 @@ import (
 	"api.us4ever/internal/ent/file"
 	"api.us4ever/internal/ent/group"
 	"api.us4ever/internal/ent/image"
+	"api.us4ever/internal/ent/indexdeadletter"
 	"api.us4ever/internal/ent/keep"
+	"api.us4ever/internal/ent/keepchunk"
 	"api.us4ever/internal/ent/mindmap"
 	"api.us4ever/internal/ent/moment"
 	"api.us4ever/internal/ent/momentimage"
 	"api.us4ever/internal/ent/momentvideo"
+	"api.us4ever/internal/ent/searchclick"
+	"api.us4ever/internal/ent/searchlog"
+	"api.us4ever/internal/ent/searchoutbox"
+	"api.us4ever/internal/ent/tagsuggestion"
 	"api.us4ever/internal/ent/todo"
 	"api.us4ever/internal/ent/user"
 	"api.us4ever/internal/ent/video"
@@ -84,18 +90,24 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			bucket.Table:      bucket.ValidColumn,
-			file.Table:        file.ValidColumn,
-			group.Table:       group.ValidColumn,
-			image.Table:       image.ValidColumn,
-			keep.Table:        keep.ValidColumn,
-			mindmap.Table:     mindmap.ValidColumn,
-			moment.Table:      moment.ValidColumn,
-			momentimage.Table: momentimage.ValidColumn,
-			momentvideo.Table: momentvideo.ValidColumn,
-			todo.Table:        todo.ValidColumn,
-			user.Table:        user.ValidColumn,
-			video.Table:       video.ValidColumn,
+			bucket.Table:          bucket.ValidColumn,
+			file.Table:            file.ValidColumn,
+			group.Table:           group.ValidColumn,
+			image.Table:           image.ValidColumn,
+			indexdeadletter.Table: indexdeadletter.ValidColumn,
+			keep.Table:            keep.ValidColumn,
+			keepchunk.Table:       keepchunk.ValidColumn,
+			mindmap.Table:         mindmap.ValidColumn,
+			moment.Table:          moment.ValidColumn,
+			momentimage.Table:     momentimage.ValidColumn,
+			momentvideo.Table:     momentvideo.ValidColumn,
+			searchclick.Table:     searchclick.ValidColumn,
+			searchlog.Table:       searchlog.ValidColumn,
+			searchoutbox.Table:    searchoutbox.ValidColumn,
+			tagsuggestion.Table:   tagsuggestion.ValidColumn,
+			todo.Table:            todo.ValidColumn,
+			user.Table:            user.ValidColumn,
+			video.Table:           video.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -57,6 +57,18 @@ func (f ImageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ImageMutation", m)
 }
 
+// The IndexDeadLetterFunc type is an adapter to allow the use of ordinary
+// function as IndexDeadLetter mutator.
+type IndexDeadLetterFunc func(context.Context, *ent.IndexDeadLetterMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IndexDeadLetterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IndexDeadLetterMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IndexDeadLetterMutation", m)
+}
+
 // The KeepFunc type is an adapter to allow the use of ordinary
 // function as Keep mutator.
 type KeepFunc func(context.Context, *ent.KeepMutation) (ent.Value, error)
@@ -69,6 +81,18 @@ func (f KeepFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KeepMutation", m)
 }
 
+// The KeepChunkFunc type is an adapter to allow the use of ordinary
+// function as KeepChunk mutator.
+type KeepChunkFunc func(context.Context, *ent.KeepChunkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KeepChunkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KeepChunkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KeepChunkMutation", m)
+}
+
 // The MindmapFunc type is an adapter to allow the use of ordinary
 // function as Mindmap mutator.
 type MindmapFunc func(context.Context, *ent.MindmapMutation) (ent.Value, error)
@@ -117,6 +141,54 @@ func (f MomentVideoFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MomentVideoMutation", m)
 }
 
+// The SearchClickFunc type is an adapter to allow the use of ordinary
+// function as SearchClick mutator.
+type SearchClickFunc func(context.Context, *ent.SearchClickMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SearchClickFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SearchClickMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SearchClickMutation", m)
+}
+
+// The SearchLogFunc type is an adapter to allow the use of ordinary
+// function as SearchLog mutator.
+type SearchLogFunc func(context.Context, *ent.SearchLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SearchLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SearchLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SearchLogMutation", m)
+}
+
+// The SearchOutboxFunc type is an adapter to allow the use of ordinary
+// function as SearchOutbox mutator.
+type SearchOutboxFunc func(context.Context, *ent.SearchOutboxMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SearchOutboxFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SearchOutboxMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SearchOutboxMutation", m)
+}
+
+// The TagSuggestionFunc type is an adapter to allow the use of ordinary
+// function as TagSuggestion mutator.
+type TagSuggestionFunc func(context.Context, *ent.TagSuggestionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagSuggestionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TagSuggestionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagSuggestionMutation", m)
+}
+
 // The TodoFunc type is an adapter to allow the use of ordinary
 // function as Todo mutator.
 type TodoFunc func(context.Context, *ent.TodoMutation) (ent.Value, error)
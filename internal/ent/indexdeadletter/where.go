@@ -0,0 +1,655 @@
+// Code generated by ent, DO NOT EDIT.
+
+package indexdeadletter
+
+import (
+	"time"
+
+	"api.us4ever/internal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLTE(FieldID, id))
+}
+
+// Index applies equality check predicate on the "index" field. It's identical to IndexEQ.
+func Index(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldIndex, v))
+}
+
+// IndexName applies equality check predicate on the "indexName" field. It's identical to IndexNameEQ.
+func IndexName(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldIndexName, v))
+}
+
+// EntityId applies equality check predicate on the "entityId" field. It's identical to EntityIdEQ.
+func EntityId(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldEntityId, v))
+}
+
+// JobId applies equality check predicate on the "jobId" field. It's identical to JobIdEQ.
+func JobId(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldJobId, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldStatus, v))
+}
+
+// ErrorType applies equality check predicate on the "errorType" field. It's identical to ErrorTypeEQ.
+func ErrorType(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldErrorType, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldReason, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldAttempts, v))
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// IndexEQ applies the EQ predicate on the "index" field.
+func IndexEQ(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldIndex, v))
+}
+
+// IndexNEQ applies the NEQ predicate on the "index" field.
+func IndexNEQ(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNEQ(FieldIndex, v))
+}
+
+// IndexIn applies the In predicate on the "index" field.
+func IndexIn(vs ...string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldIn(FieldIndex, vs...))
+}
+
+// IndexNotIn applies the NotIn predicate on the "index" field.
+func IndexNotIn(vs ...string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNotIn(FieldIndex, vs...))
+}
+
+// IndexGT applies the GT predicate on the "index" field.
+func IndexGT(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGT(FieldIndex, v))
+}
+
+// IndexGTE applies the GTE predicate on the "index" field.
+func IndexGTE(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGTE(FieldIndex, v))
+}
+
+// IndexLT applies the LT predicate on the "index" field.
+func IndexLT(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLT(FieldIndex, v))
+}
+
+// IndexLTE applies the LTE predicate on the "index" field.
+func IndexLTE(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLTE(FieldIndex, v))
+}
+
+// IndexContains applies the Contains predicate on the "index" field.
+func IndexContains(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldContains(FieldIndex, v))
+}
+
+// IndexHasPrefix applies the HasPrefix predicate on the "index" field.
+func IndexHasPrefix(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldHasPrefix(FieldIndex, v))
+}
+
+// IndexHasSuffix applies the HasSuffix predicate on the "index" field.
+func IndexHasSuffix(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldHasSuffix(FieldIndex, v))
+}
+
+// IndexEqualFold applies the EqualFold predicate on the "index" field.
+func IndexEqualFold(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEqualFold(FieldIndex, v))
+}
+
+// IndexContainsFold applies the ContainsFold predicate on the "index" field.
+func IndexContainsFold(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldContainsFold(FieldIndex, v))
+}
+
+// IndexNameEQ applies the EQ predicate on the "indexName" field.
+func IndexNameEQ(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldIndexName, v))
+}
+
+// IndexNameNEQ applies the NEQ predicate on the "indexName" field.
+func IndexNameNEQ(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNEQ(FieldIndexName, v))
+}
+
+// IndexNameIn applies the In predicate on the "indexName" field.
+func IndexNameIn(vs ...string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldIn(FieldIndexName, vs...))
+}
+
+// IndexNameNotIn applies the NotIn predicate on the "indexName" field.
+func IndexNameNotIn(vs ...string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNotIn(FieldIndexName, vs...))
+}
+
+// IndexNameGT applies the GT predicate on the "indexName" field.
+func IndexNameGT(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGT(FieldIndexName, v))
+}
+
+// IndexNameGTE applies the GTE predicate on the "indexName" field.
+func IndexNameGTE(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGTE(FieldIndexName, v))
+}
+
+// IndexNameLT applies the LT predicate on the "indexName" field.
+func IndexNameLT(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLT(FieldIndexName, v))
+}
+
+// IndexNameLTE applies the LTE predicate on the "indexName" field.
+func IndexNameLTE(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLTE(FieldIndexName, v))
+}
+
+// IndexNameContains applies the Contains predicate on the "indexName" field.
+func IndexNameContains(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldContains(FieldIndexName, v))
+}
+
+// IndexNameHasPrefix applies the HasPrefix predicate on the "indexName" field.
+func IndexNameHasPrefix(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldHasPrefix(FieldIndexName, v))
+}
+
+// IndexNameHasSuffix applies the HasSuffix predicate on the "indexName" field.
+func IndexNameHasSuffix(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldHasSuffix(FieldIndexName, v))
+}
+
+// IndexNameEqualFold applies the EqualFold predicate on the "indexName" field.
+func IndexNameEqualFold(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEqualFold(FieldIndexName, v))
+}
+
+// IndexNameContainsFold applies the ContainsFold predicate on the "indexName" field.
+func IndexNameContainsFold(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldContainsFold(FieldIndexName, v))
+}
+
+// EntityIdEQ applies the EQ predicate on the "entityId" field.
+func EntityIdEQ(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldEntityId, v))
+}
+
+// EntityIdNEQ applies the NEQ predicate on the "entityId" field.
+func EntityIdNEQ(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNEQ(FieldEntityId, v))
+}
+
+// EntityIdIn applies the In predicate on the "entityId" field.
+func EntityIdIn(vs ...string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldIn(FieldEntityId, vs...))
+}
+
+// EntityIdNotIn applies the NotIn predicate on the "entityId" field.
+func EntityIdNotIn(vs ...string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNotIn(FieldEntityId, vs...))
+}
+
+// EntityIdGT applies the GT predicate on the "entityId" field.
+func EntityIdGT(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGT(FieldEntityId, v))
+}
+
+// EntityIdGTE applies the GTE predicate on the "entityId" field.
+func EntityIdGTE(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGTE(FieldEntityId, v))
+}
+
+// EntityIdLT applies the LT predicate on the "entityId" field.
+func EntityIdLT(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLT(FieldEntityId, v))
+}
+
+// EntityIdLTE applies the LTE predicate on the "entityId" field.
+func EntityIdLTE(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLTE(FieldEntityId, v))
+}
+
+// EntityIdContains applies the Contains predicate on the "entityId" field.
+func EntityIdContains(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldContains(FieldEntityId, v))
+}
+
+// EntityIdHasPrefix applies the HasPrefix predicate on the "entityId" field.
+func EntityIdHasPrefix(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldHasPrefix(FieldEntityId, v))
+}
+
+// EntityIdHasSuffix applies the HasSuffix predicate on the "entityId" field.
+func EntityIdHasSuffix(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldHasSuffix(FieldEntityId, v))
+}
+
+// EntityIdEqualFold applies the EqualFold predicate on the "entityId" field.
+func EntityIdEqualFold(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEqualFold(FieldEntityId, v))
+}
+
+// EntityIdContainsFold applies the ContainsFold predicate on the "entityId" field.
+func EntityIdContainsFold(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldContainsFold(FieldEntityId, v))
+}
+
+// JobIdEQ applies the EQ predicate on the "jobId" field.
+func JobIdEQ(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldJobId, v))
+}
+
+// JobIdNEQ applies the NEQ predicate on the "jobId" field.
+func JobIdNEQ(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNEQ(FieldJobId, v))
+}
+
+// JobIdIn applies the In predicate on the "jobId" field.
+func JobIdIn(vs ...string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldIn(FieldJobId, vs...))
+}
+
+// JobIdNotIn applies the NotIn predicate on the "jobId" field.
+func JobIdNotIn(vs ...string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNotIn(FieldJobId, vs...))
+}
+
+// JobIdGT applies the GT predicate on the "jobId" field.
+func JobIdGT(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGT(FieldJobId, v))
+}
+
+// JobIdGTE applies the GTE predicate on the "jobId" field.
+func JobIdGTE(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGTE(FieldJobId, v))
+}
+
+// JobIdLT applies the LT predicate on the "jobId" field.
+func JobIdLT(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLT(FieldJobId, v))
+}
+
+// JobIdLTE applies the LTE predicate on the "jobId" field.
+func JobIdLTE(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLTE(FieldJobId, v))
+}
+
+// JobIdContains applies the Contains predicate on the "jobId" field.
+func JobIdContains(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldContains(FieldJobId, v))
+}
+
+// JobIdHasPrefix applies the HasPrefix predicate on the "jobId" field.
+func JobIdHasPrefix(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldHasPrefix(FieldJobId, v))
+}
+
+// JobIdHasSuffix applies the HasSuffix predicate on the "jobId" field.
+func JobIdHasSuffix(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldHasSuffix(FieldJobId, v))
+}
+
+// JobIdIsNil applies the IsNil predicate on the "jobId" field.
+func JobIdIsNil() predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldIsNull(FieldJobId))
+}
+
+// JobIdNotNil applies the NotNil predicate on the "jobId" field.
+func JobIdNotNil() predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNotNull(FieldJobId))
+}
+
+// JobIdEqualFold applies the EqualFold predicate on the "jobId" field.
+func JobIdEqualFold(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEqualFold(FieldJobId, v))
+}
+
+// JobIdContainsFold applies the ContainsFold predicate on the "jobId" field.
+func JobIdContainsFold(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldContainsFold(FieldJobId, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLTE(FieldStatus, v))
+}
+
+// ErrorTypeEQ applies the EQ predicate on the "errorType" field.
+func ErrorTypeEQ(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldErrorType, v))
+}
+
+// ErrorTypeNEQ applies the NEQ predicate on the "errorType" field.
+func ErrorTypeNEQ(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNEQ(FieldErrorType, v))
+}
+
+// ErrorTypeIn applies the In predicate on the "errorType" field.
+func ErrorTypeIn(vs ...string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldIn(FieldErrorType, vs...))
+}
+
+// ErrorTypeNotIn applies the NotIn predicate on the "errorType" field.
+func ErrorTypeNotIn(vs ...string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNotIn(FieldErrorType, vs...))
+}
+
+// ErrorTypeGT applies the GT predicate on the "errorType" field.
+func ErrorTypeGT(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGT(FieldErrorType, v))
+}
+
+// ErrorTypeGTE applies the GTE predicate on the "errorType" field.
+func ErrorTypeGTE(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGTE(FieldErrorType, v))
+}
+
+// ErrorTypeLT applies the LT predicate on the "errorType" field.
+func ErrorTypeLT(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLT(FieldErrorType, v))
+}
+
+// ErrorTypeLTE applies the LTE predicate on the "errorType" field.
+func ErrorTypeLTE(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLTE(FieldErrorType, v))
+}
+
+// ErrorTypeContains applies the Contains predicate on the "errorType" field.
+func ErrorTypeContains(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldContains(FieldErrorType, v))
+}
+
+// ErrorTypeHasPrefix applies the HasPrefix predicate on the "errorType" field.
+func ErrorTypeHasPrefix(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldHasPrefix(FieldErrorType, v))
+}
+
+// ErrorTypeHasSuffix applies the HasSuffix predicate on the "errorType" field.
+func ErrorTypeHasSuffix(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldHasSuffix(FieldErrorType, v))
+}
+
+// ErrorTypeIsNil applies the IsNil predicate on the "errorType" field.
+func ErrorTypeIsNil() predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldIsNull(FieldErrorType))
+}
+
+// ErrorTypeNotNil applies the NotNil predicate on the "errorType" field.
+func ErrorTypeNotNil() predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNotNull(FieldErrorType))
+}
+
+// ErrorTypeEqualFold applies the EqualFold predicate on the "errorType" field.
+func ErrorTypeEqualFold(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEqualFold(FieldErrorType, v))
+}
+
+// ErrorTypeContainsFold applies the ContainsFold predicate on the "errorType" field.
+func ErrorTypeContainsFold(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldContainsFold(FieldErrorType, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldContainsFold(FieldReason, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLTE(FieldAttempts, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IndexDeadLetter) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IndexDeadLetter) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IndexDeadLetter) predicate.IndexDeadLetter {
+	return predicate.IndexDeadLetter(sql.NotPredicates(p))
+}
@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keepchunk
+
+import (
+	"time"
+
+	"api.us4ever/internal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLTE(FieldID, id))
+}
+
+// KeepId applies equality check predicate on the "keepId" field. It's identical to KeepIdEQ.
+func KeepId(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldKeepId, v))
+}
+
+// Ordinal applies equality check predicate on the "ordinal" field. It's identical to OrdinalEQ.
+func Ordinal(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldOrdinal, v))
+}
+
+// Heading applies equality check predicate on the "heading" field. It's identical to HeadingEQ.
+func Heading(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldHeading, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldContent, v))
+}
+
+// Start applies equality check predicate on the "start" field. It's identical to StartEQ.
+func Start(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldStart, v))
+}
+
+// End applies equality check predicate on the "end" field. It's identical to EndEQ.
+func End(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldEnd, v))
+}
+
+// ContentHash applies equality check predicate on the "contentHash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldContentHash, v))
+}
+
+// KeepUpdatedAt applies equality check predicate on the "keepUpdatedAt" field. It's identical to KeepUpdatedAtEQ.
+func KeepUpdatedAt(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldKeepUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updatedAt" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// KeepIdEQ applies the EQ predicate on the "keepId" field.
+func KeepIdEQ(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldKeepId, v))
+}
+
+// KeepIdNEQ applies the NEQ predicate on the "keepId" field.
+func KeepIdNEQ(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNEQ(FieldKeepId, v))
+}
+
+// KeepIdIn applies the In predicate on the "keepId" field.
+func KeepIdIn(vs ...string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldIn(FieldKeepId, vs...))
+}
+
+// KeepIdNotIn applies the NotIn predicate on the "keepId" field.
+func KeepIdNotIn(vs ...string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNotIn(FieldKeepId, vs...))
+}
+
+// KeepIdGT applies the GT predicate on the "keepId" field.
+func KeepIdGT(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGT(FieldKeepId, v))
+}
+
+// KeepIdGTE applies the GTE predicate on the "keepId" field.
+func KeepIdGTE(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGTE(FieldKeepId, v))
+}
+
+// KeepIdLT applies the LT predicate on the "keepId" field.
+func KeepIdLT(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLT(FieldKeepId, v))
+}
+
+// KeepIdLTE applies the LTE predicate on the "keepId" field.
+func KeepIdLTE(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLTE(FieldKeepId, v))
+}
+
+// KeepIdContains applies the Contains predicate on the "keepId" field.
+func KeepIdContains(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldContains(FieldKeepId, v))
+}
+
+// KeepIdHasPrefix applies the HasPrefix predicate on the "keepId" field.
+func KeepIdHasPrefix(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldHasPrefix(FieldKeepId, v))
+}
+
+// KeepIdHasSuffix applies the HasSuffix predicate on the "keepId" field.
+func KeepIdHasSuffix(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldHasSuffix(FieldKeepId, v))
+}
+
+// KeepIdEqualFold applies the EqualFold predicate on the "keepId" field.
+func KeepIdEqualFold(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEqualFold(FieldKeepId, v))
+}
+
+// KeepIdContainsFold applies the ContainsFold predicate on the "keepId" field.
+func KeepIdContainsFold(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldContainsFold(FieldKeepId, v))
+}
+
+// OrdinalEQ applies the EQ predicate on the "ordinal" field.
+func OrdinalEQ(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldOrdinal, v))
+}
+
+// OrdinalNEQ applies the NEQ predicate on the "ordinal" field.
+func OrdinalNEQ(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNEQ(FieldOrdinal, v))
+}
+
+// OrdinalIn applies the In predicate on the "ordinal" field.
+func OrdinalIn(vs ...int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldIn(FieldOrdinal, vs...))
+}
+
+// OrdinalNotIn applies the NotIn predicate on the "ordinal" field.
+func OrdinalNotIn(vs ...int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNotIn(FieldOrdinal, vs...))
+}
+
+// OrdinalGT applies the GT predicate on the "ordinal" field.
+func OrdinalGT(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGT(FieldOrdinal, v))
+}
+
+// OrdinalGTE applies the GTE predicate on the "ordinal" field.
+func OrdinalGTE(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGTE(FieldOrdinal, v))
+}
+
+// OrdinalLT applies the LT predicate on the "ordinal" field.
+func OrdinalLT(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLT(FieldOrdinal, v))
+}
+
+// OrdinalLTE applies the LTE predicate on the "ordinal" field.
+func OrdinalLTE(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLTE(FieldOrdinal, v))
+}
+
+// HeadingEQ applies the EQ predicate on the "heading" field.
+func HeadingEQ(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldHeading, v))
+}
+
+// HeadingNEQ applies the NEQ predicate on the "heading" field.
+func HeadingNEQ(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNEQ(FieldHeading, v))
+}
+
+// HeadingIn applies the In predicate on the "heading" field.
+func HeadingIn(vs ...string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldIn(FieldHeading, vs...))
+}
+
+// HeadingNotIn applies the NotIn predicate on the "heading" field.
+func HeadingNotIn(vs ...string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNotIn(FieldHeading, vs...))
+}
+
+// HeadingGT applies the GT predicate on the "heading" field.
+func HeadingGT(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGT(FieldHeading, v))
+}
+
+// HeadingGTE applies the GTE predicate on the "heading" field.
+func HeadingGTE(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGTE(FieldHeading, v))
+}
+
+// HeadingLT applies the LT predicate on the "heading" field.
+func HeadingLT(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLT(FieldHeading, v))
+}
+
+// HeadingLTE applies the LTE predicate on the "heading" field.
+func HeadingLTE(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLTE(FieldHeading, v))
+}
+
+// HeadingContains applies the Contains predicate on the "heading" field.
+func HeadingContains(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldContains(FieldHeading, v))
+}
+
+// HeadingHasPrefix applies the HasPrefix predicate on the "heading" field.
+func HeadingHasPrefix(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldHasPrefix(FieldHeading, v))
+}
+
+// HeadingHasSuffix applies the HasSuffix predicate on the "heading" field.
+func HeadingHasSuffix(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldHasSuffix(FieldHeading, v))
+}
+
+// HeadingEqualFold applies the EqualFold predicate on the "heading" field.
+func HeadingEqualFold(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEqualFold(FieldHeading, v))
+}
+
+// HeadingContainsFold applies the ContainsFold predicate on the "heading" field.
+func HeadingContainsFold(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldContainsFold(FieldHeading, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldContainsFold(FieldContent, v))
+}
+
+// StartEQ applies the EQ predicate on the "start" field.
+func StartEQ(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldStart, v))
+}
+
+// StartNEQ applies the NEQ predicate on the "start" field.
+func StartNEQ(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNEQ(FieldStart, v))
+}
+
+// StartIn applies the In predicate on the "start" field.
+func StartIn(vs ...int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldIn(FieldStart, vs...))
+}
+
+// StartNotIn applies the NotIn predicate on the "start" field.
+func StartNotIn(vs ...int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNotIn(FieldStart, vs...))
+}
+
+// StartGT applies the GT predicate on the "start" field.
+func StartGT(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGT(FieldStart, v))
+}
+
+// StartGTE applies the GTE predicate on the "start" field.
+func StartGTE(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGTE(FieldStart, v))
+}
+
+// StartLT applies the LT predicate on the "start" field.
+func StartLT(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLT(FieldStart, v))
+}
+
+// StartLTE applies the LTE predicate on the "start" field.
+func StartLTE(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLTE(FieldStart, v))
+}
+
+// EndEQ applies the EQ predicate on the "end" field.
+func EndEQ(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldEnd, v))
+}
+
+// EndNEQ applies the NEQ predicate on the "end" field.
+func EndNEQ(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNEQ(FieldEnd, v))
+}
+
+// EndIn applies the In predicate on the "end" field.
+func EndIn(vs ...int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldIn(FieldEnd, vs...))
+}
+
+// EndNotIn applies the NotIn predicate on the "end" field.
+func EndNotIn(vs ...int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNotIn(FieldEnd, vs...))
+}
+
+// EndGT applies the GT predicate on the "end" field.
+func EndGT(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGT(FieldEnd, v))
+}
+
+// EndGTE applies the GTE predicate on the "end" field.
+func EndGTE(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGTE(FieldEnd, v))
+}
+
+// EndLT applies the LT predicate on the "end" field.
+func EndLT(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLT(FieldEnd, v))
+}
+
+// EndLTE applies the LTE predicate on the "end" field.
+func EndLTE(v int) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLTE(FieldEnd, v))
+}
+
+// ContentHashEQ applies the EQ predicate on the "contentHash" field.
+func ContentHashEQ(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "contentHash" field.
+func ContentHashNEQ(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "contentHash" field.
+func ContentHashIn(vs ...string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "contentHash" field.
+func ContentHashNotIn(vs ...string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "contentHash" field.
+func ContentHashGT(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "contentHash" field.
+func ContentHashGTE(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "contentHash" field.
+func ContentHashLT(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "contentHash" field.
+func ContentHashLTE(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "contentHash" field.
+func ContentHashContains(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "contentHash" field.
+func ContentHashHasPrefix(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "contentHash" field.
+func ContentHashHasSuffix(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "contentHash" field.
+func ContentHashEqualFold(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "contentHash" field.
+func ContentHashContainsFold(v string) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldContainsFold(FieldContentHash, v))
+}
+
+// VectorIsNil applies the IsNil predicate on the "vector" field.
+func VectorIsNil() predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldIsNull(FieldVector))
+}
+
+// VectorNotNil applies the NotNil predicate on the "vector" field.
+func VectorNotNil() predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNotNull(FieldVector))
+}
+
+// KeepUpdatedAtEQ applies the EQ predicate on the "keepUpdatedAt" field.
+func KeepUpdatedAtEQ(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldKeepUpdatedAt, v))
+}
+
+// KeepUpdatedAtNEQ applies the NEQ predicate on the "keepUpdatedAt" field.
+func KeepUpdatedAtNEQ(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNEQ(FieldKeepUpdatedAt, v))
+}
+
+// KeepUpdatedAtIn applies the In predicate on the "keepUpdatedAt" field.
+func KeepUpdatedAtIn(vs ...time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldIn(FieldKeepUpdatedAt, vs...))
+}
+
+// KeepUpdatedAtNotIn applies the NotIn predicate on the "keepUpdatedAt" field.
+func KeepUpdatedAtNotIn(vs ...time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNotIn(FieldKeepUpdatedAt, vs...))
+}
+
+// KeepUpdatedAtGT applies the GT predicate on the "keepUpdatedAt" field.
+func KeepUpdatedAtGT(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGT(FieldKeepUpdatedAt, v))
+}
+
+// KeepUpdatedAtGTE applies the GTE predicate on the "keepUpdatedAt" field.
+func KeepUpdatedAtGTE(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGTE(FieldKeepUpdatedAt, v))
+}
+
+// KeepUpdatedAtLT applies the LT predicate on the "keepUpdatedAt" field.
+func KeepUpdatedAtLT(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLT(FieldKeepUpdatedAt, v))
+}
+
+// KeepUpdatedAtLTE applies the LTE predicate on the "keepUpdatedAt" field.
+func KeepUpdatedAtLTE(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLTE(FieldKeepUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updatedAt" field.
+func UpdatedAtEQ(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updatedAt" field.
+func UpdatedAtNEQ(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updatedAt" field.
+func UpdatedAtIn(vs ...time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updatedAt" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updatedAt" field.
+func UpdatedAtGT(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updatedAt" field.
+func UpdatedAtGTE(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updatedAt" field.
+func UpdatedAtLT(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updatedAt" field.
+func UpdatedAtLTE(v time.Time) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KeepChunk) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KeepChunk) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KeepChunk) predicate.KeepChunk {
+	return predicate.KeepChunk(sql.NotPredicates(p))
+}
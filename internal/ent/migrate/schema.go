@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -156,6 +157,32 @@ var (
 			},
 		},
 	}
+	// IndexDeadLetterColumns holds the columns for the "index_dead_letter" table.
+	IndexDeadLetterColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "index", Type: field.TypeString},
+		{Name: "indexName", Type: field.TypeString},
+		{Name: "entityId", Type: field.TypeString},
+		{Name: "jobId", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeInt},
+		{Name: "errorType", Type: field.TypeString, Nullable: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "attempts", Type: field.TypeInt},
+		{Name: "createdAt", Type: field.TypeTime},
+	}
+	// IndexDeadLetterTable holds the schema information for the "index_dead_letter" table.
+	IndexDeadLetterTable = &schema.Table{
+		Name:       "index_dead_letter",
+		Columns:    IndexDeadLetterColumns,
+		PrimaryKey: []*schema.Column{IndexDeadLetterColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "indexdeadletter_index_entityId",
+				Unique:  false,
+				Columns: []*schema.Column{IndexDeadLetterColumns[1], IndexDeadLetterColumns[3]},
+			},
+		},
+	}
 	// KeepsColumns holds the columns for the "keeps" table.
 	KeepsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -189,6 +216,34 @@ var (
 			},
 		},
 	}
+	// KeepChunkColumns holds the columns for the "keep_chunk" table.
+	KeepChunkColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "keepId", Type: field.TypeString},
+		{Name: "ordinal", Type: field.TypeInt},
+		{Name: "heading", Type: field.TypeString, Default: ""},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "start", Type: field.TypeInt},
+		{Name: "end", Type: field.TypeInt},
+		{Name: "contentHash", Type: field.TypeString},
+		{Name: "vector", Type: field.TypeJSON, Nullable: true},
+		{Name: "keepUpdatedAt", Type: field.TypeTime},
+		{Name: "createdAt", Type: field.TypeTime},
+		{Name: "updatedAt", Type: field.TypeTime},
+	}
+	// KeepChunkTable holds the schema information for the "keep_chunk" table.
+	KeepChunkTable = &schema.Table{
+		Name:       "keep_chunk",
+		Columns:    KeepChunkColumns,
+		PrimaryKey: []*schema.Column{KeepChunkColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "keepchunk_keepId_ordinal",
+				Unique:  true,
+				Columns: []*schema.Column{KeepChunkColumns[1], KeepChunkColumns[2]},
+			},
+		},
+	}
 	// MindmapsColumns holds the columns for the "mindmaps" table.
 	MindmapsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -306,6 +361,117 @@ var (
 			},
 		},
 	}
+	// SearchClickColumns holds the columns for the "search_click" table.
+	SearchClickColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "requestId", Type: field.TypeString},
+		{Name: "entityType", Type: field.TypeString},
+		{Name: "entityId", Type: field.TypeString},
+		{Name: "position", Type: field.TypeInt, Nullable: true},
+		{Name: "createdAt", Type: field.TypeTime},
+	}
+	// SearchClickTable holds the schema information for the "search_click" table.
+	SearchClickTable = &schema.Table{
+		Name:       "search_click",
+		Columns:    SearchClickColumns,
+		PrimaryKey: []*schema.Column{SearchClickColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "searchclick_requestId",
+				Unique:  false,
+				Columns: []*schema.Column{SearchClickColumns[1]},
+			},
+			{
+				Name:    "searchclick_createdAt",
+				Unique:  false,
+				Columns: []*schema.Column{SearchClickColumns[5]},
+			},
+		},
+	}
+	// SearchLogColumns holds the columns for the "search_log" table.
+	SearchLogColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "query", Type: field.TypeString, Size: 2147483647},
+		{Name: "type", Type: field.TypeString},
+		{Name: "backend", Type: field.TypeString, Nullable: true},
+		{Name: "resultCount", Type: field.TypeInt},
+		{Name: "latencyMs", Type: field.TypeInt},
+		{Name: "degraded", Type: field.TypeBool, Default: false},
+		{Name: "requestId", Type: field.TypeString, Nullable: true},
+		{Name: "createdAt", Type: field.TypeTime},
+	}
+	// SearchLogTable holds the schema information for the "search_log" table.
+	SearchLogTable = &schema.Table{
+		Name:       "search_log",
+		Columns:    SearchLogColumns,
+		PrimaryKey: []*schema.Column{SearchLogColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "searchlog_createdAt",
+				Unique:  false,
+				Columns: []*schema.Column{SearchLogColumns[8]},
+			},
+			{
+				Name:    "searchlog_requestId",
+				Unique:  false,
+				Columns: []*schema.Column{SearchLogColumns[7]},
+			},
+		},
+	}
+	// SearchOutboxColumns holds the columns for the "search_outbox" table.
+	SearchOutboxColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "entityType", Type: field.TypeString},
+		{Name: "entityId", Type: field.TypeString},
+		{Name: "op", Type: field.TypeEnum, Enums: []string{"upsert", "delete"}},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "lastError", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "availableAt", Type: field.TypeTime},
+		{Name: "createdAt", Type: field.TypeTime},
+	}
+	// SearchOutboxTable holds the schema information for the "search_outbox" table.
+	SearchOutboxTable = &schema.Table{
+		Name:       "search_outbox",
+		Columns:    SearchOutboxColumns,
+		PrimaryKey: []*schema.Column{SearchOutboxColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "searchoutbox_availableAt",
+				Unique:  false,
+				Columns: []*schema.Column{SearchOutboxColumns[6]},
+			},
+		},
+	}
+	// TagSuggestionColumns holds the columns for the "tag_suggestion" table.
+	TagSuggestionColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "entityType", Type: field.TypeString},
+		{Name: "entityId", Type: field.TypeString},
+		{Name: "ownerId", Type: field.TypeString, Nullable: true},
+		{Name: "tag", Type: field.TypeString},
+		{Name: "similarity", Type: field.TypeFloat64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"suggested", "accepted", "rejected"}, Default: "suggested"},
+		{Name: "createdAt", Type: field.TypeTime},
+		{Name: "reviewedAt", Type: field.TypeTime, Nullable: true},
+	}
+	// TagSuggestionTable holds the schema information for the "tag_suggestion" table.
+	TagSuggestionTable = &schema.Table{
+		Name:       "tag_suggestion",
+		Columns:    TagSuggestionColumns,
+		PrimaryKey: []*schema.Column{TagSuggestionColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tagsuggestion_entityType_entityId_tag",
+				Unique:  true,
+				Columns: []*schema.Column{TagSuggestionColumns[1], TagSuggestionColumns[2], TagSuggestionColumns[4]},
+			},
+			{
+				Name:    "tagsuggestion_status_createdAt",
+				Unique:  false,
+				Columns: []*schema.Column{TagSuggestionColumns[6], TagSuggestionColumns[7]},
+			},
+		},
+	}
 	// TodosColumns holds the columns for the "todos" table.
 	TodosColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -413,11 +579,17 @@ var (
 		FilesTable,
 		GroupsTable,
 		ImagesTable,
+		IndexDeadLetterTable,
 		KeepsTable,
+		KeepChunkTable,
 		MindmapsTable,
 		MomentsTable,
 		MomentImagesTable,
 		MomentVideosTable,
+		SearchClickTable,
+		SearchLogTable,
+		SearchOutboxTable,
+		TagSuggestionTable,
 		TodosTable,
 		UsersTable,
 		VideosTable,
@@ -433,13 +605,31 @@ func init() {
 	ImagesTable.ForeignKeys[2].RefTable = FilesTable
 	ImagesTable.ForeignKeys[3].RefTable = FilesTable
 	ImagesTable.ForeignKeys[4].RefTable = UsersTable
+	IndexDeadLetterTable.Annotation = &entsql.Annotation{
+		Table: "index_dead_letter",
+	}
 	KeepsTable.ForeignKeys[0].RefTable = UsersTable
+	KeepChunkTable.Annotation = &entsql.Annotation{
+		Table: "keep_chunk",
+	}
 	MindmapsTable.ForeignKeys[0].RefTable = UsersTable
 	MomentsTable.ForeignKeys[0].RefTable = UsersTable
 	MomentImagesTable.ForeignKeys[0].RefTable = ImagesTable
 	MomentImagesTable.ForeignKeys[1].RefTable = MomentsTable
 	MomentVideosTable.ForeignKeys[0].RefTable = MomentsTable
 	MomentVideosTable.ForeignKeys[1].RefTable = VideosTable
+	SearchClickTable.Annotation = &entsql.Annotation{
+		Table: "search_click",
+	}
+	SearchLogTable.Annotation = &entsql.Annotation{
+		Table: "search_log",
+	}
+	SearchOutboxTable.Annotation = &entsql.Annotation{
+		Table: "search_outbox",
+	}
+	TagSuggestionTable.Annotation = &entsql.Annotation{
+		Table: "tag_suggestion",
+	}
 	TodosTable.ForeignKeys[0].RefTable = UsersTable
 	UsersTable.ForeignKeys[0].RefTable = GroupsTable
 	VideosTable.ForeignKeys[0].RefTable = FilesTable
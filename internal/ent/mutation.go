@@ -14,12 +14,18 @@ import (
 	"api.us4ever/internal/ent/file"
 	"api.us4ever/internal/ent/group"
 	"api.us4ever/internal/ent/image"
+	"api.us4ever/internal/ent/indexdeadletter"
 	"api.us4ever/internal/ent/keep"
+	"api.us4ever/internal/ent/keepchunk"
 	"api.us4ever/internal/ent/mindmap"
 	"api.us4ever/internal/ent/moment"
 	"api.us4ever/internal/ent/momentimage"
 	"api.us4ever/internal/ent/momentvideo"
 	"api.us4ever/internal/ent/predicate"
+	"api.us4ever/internal/ent/searchclick"
+	"api.us4ever/internal/ent/searchlog"
+	"api.us4ever/internal/ent/searchoutbox"
+	"api.us4ever/internal/ent/tagsuggestion"
 	"api.us4ever/internal/ent/todo"
 	"api.us4ever/internal/ent/user"
 	"api.us4ever/internal/ent/video"
@@ -36,18 +42,24 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeBucket      = "Bucket"
-	TypeFile        = "File"
-	TypeGroup       = "Group"
-	TypeImage       = "Image"
-	TypeKeep        = "Keep"
-	TypeMindmap     = "Mindmap"
-	TypeMoment      = "Moment"
-	TypeMomentImage = "MomentImage"
-	TypeMomentVideo = "MomentVideo"
-	TypeTodo        = "Todo"
-	TypeUser        = "User"
-	TypeVideo       = "Video"
+	TypeBucket          = "Bucket"
+	TypeFile            = "File"
+	TypeGroup           = "Group"
+	TypeImage           = "Image"
+	TypeIndexDeadLetter = "IndexDeadLetter"
+	TypeKeep            = "Keep"
+	TypeKeepChunk       = "KeepChunk"
+	TypeMindmap         = "Mindmap"
+	TypeMoment          = "Moment"
+	TypeMomentImage     = "MomentImage"
+	TypeMomentVideo     = "MomentVideo"
+	TypeSearchClick     = "SearchClick"
+	TypeSearchLog       = "SearchLog"
+	TypeSearchOutbox    = "SearchOutbox"
+	TypeTagSuggestion   = "TagSuggestion"
+	TypeTodo            = "Todo"
+	TypeUser            = "User"
+	TypeVideo           = "Video"
 )
 
 // BucketMutation represents an operation that mutates the Bucket nodes in the graph.
@@ -5716,52 +5728,40 @@ func (m *ImageMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Image edge %s", name)
 }
 
-// KeepMutation represents an operation that mutates the Keep nodes in the graph.
-type KeepMutation struct {
+// IndexDeadLetterMutation represents an operation that mutates the IndexDeadLetter nodes in the graph.
+type IndexDeadLetterMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *string
-	title                *string
-	content              *string
-	isPublic             *bool
-	tags                 *json.RawMessage
-	appendtags           json.RawMessage
-	createdAt            *time.Time
-	updatedAt            *time.Time
-	category             *string
-	views                *int32
-	addviews             *int32
-	likes                *int32
-	addlikes             *int32
-	summary              *string
-	extraData            *json.RawMessage
-	appendextraData      json.RawMessage
-	content_vector       *json.RawMessage
-	appendcontent_vector json.RawMessage
-	summary_vector       *json.RawMessage
-	appendsummary_vector json.RawMessage
-	title_vector         *json.RawMessage
-	appendtitle_vector   json.RawMessage
-	clearedFields        map[string]struct{}
-	user                 *string
-	cleareduser          bool
-	done                 bool
-	oldValue             func(context.Context) (*Keep, error)
-	predicates           []predicate.Keep
+	op            Op
+	typ           string
+	id            *int
+	index         *string
+	indexName     *string
+	entityId      *string
+	jobId         *string
+	status        *int
+	addstatus     *int
+	errorType     *string
+	reason        *string
+	attempts      *int
+	addattempts   *int
+	createdAt     *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*IndexDeadLetter, error)
+	predicates    []predicate.IndexDeadLetter
 }
 
-var _ ent.Mutation = (*KeepMutation)(nil)
+var _ ent.Mutation = (*IndexDeadLetterMutation)(nil)
 
-// keepOption allows management of the mutation configuration using functional options.
-type keepOption func(*KeepMutation)
+// indexdeadletterOption allows management of the mutation configuration using functional options.
+type indexdeadletterOption func(*IndexDeadLetterMutation)
 
-// newKeepMutation creates new mutation for the Keep entity.
-func newKeepMutation(c config, op Op, opts ...keepOption) *KeepMutation {
-	m := &KeepMutation{
+// newIndexDeadLetterMutation creates new mutation for the IndexDeadLetter entity.
+func newIndexDeadLetterMutation(c config, op Op, opts ...indexdeadletterOption) *IndexDeadLetterMutation {
+	m := &IndexDeadLetterMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeKeep,
+		typ:           TypeIndexDeadLetter,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5770,20 +5770,20 @@ func newKeepMutation(c config, op Op, opts ...keepOption) *KeepMutation {
 	return m
 }
 
-// withKeepID sets the ID field of the mutation.
-func withKeepID(id string) keepOption {
-	return func(m *KeepMutation) {
+// withIndexDeadLetterID sets the ID field of the mutation.
+func withIndexDeadLetterID(id int) indexdeadletterOption {
+	return func(m *IndexDeadLetterMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Keep
+			value *IndexDeadLetter
 		)
-		m.oldValue = func(ctx context.Context) (*Keep, error) {
+		m.oldValue = func(ctx context.Context) (*IndexDeadLetter, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Keep.Get(ctx, id)
+					value, err = m.Client().IndexDeadLetter.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5792,10 +5792,10 @@ func withKeepID(id string) keepOption {
 	}
 }
 
-// withKeep sets the old Keep of the mutation.
-func withKeep(node *Keep) keepOption {
-	return func(m *KeepMutation) {
-		m.oldValue = func(context.Context) (*Keep, error) {
+// withIndexDeadLetter sets the old IndexDeadLetter of the mutation.
+func withIndexDeadLetter(node *IndexDeadLetter) indexdeadletterOption {
+	return func(m *IndexDeadLetterMutation) {
+		m.oldValue = func(context.Context) (*IndexDeadLetter, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5804,7 +5804,7 @@ func withKeep(node *Keep) keepOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m KeepMutation) Client() *Client {
+func (m IndexDeadLetterMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5812,7 +5812,7 @@ func (m KeepMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m KeepMutation) Tx() (*Tx, error) {
+func (m IndexDeadLetterMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5821,15 +5821,9 @@ func (m KeepMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Keep entities.
-func (m *KeepMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *KeepMutation) ID() (id string, exists bool) {
+func (m *IndexDeadLetterMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5840,1981 +5834,6768 @@ func (m *KeepMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *KeepMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *IndexDeadLetterMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Keep.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().IndexDeadLetter.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *KeepMutation) SetTitle(s string) {
-	m.title = &s
+// SetIndex sets the "index" field.
+func (m *IndexDeadLetterMutation) SetIndex(s string) {
+	m.index = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *KeepMutation) Title() (r string, exists bool) {
-	v := m.title
+// Index returns the value of the "index" field in the mutation.
+func (m *IndexDeadLetterMutation) Index() (r string, exists bool) {
+	v := m.index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Keep entity.
-// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// OldIndex returns the old "index" field's value of the IndexDeadLetter entity.
+// If the IndexDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeepMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *IndexDeadLetterMutation) OldIndex(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldIndex requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Index, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *KeepMutation) ResetTitle() {
-	m.title = nil
+// ResetIndex resets all changes to the "index" field.
+func (m *IndexDeadLetterMutation) ResetIndex() {
+	m.index = nil
 }
 
-// SetContent sets the "content" field.
-func (m *KeepMutation) SetContent(s string) {
-	m.content = &s
+// SetIndexName sets the "indexName" field.
+func (m *IndexDeadLetterMutation) SetIndexName(s string) {
+	m.indexName = &s
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *KeepMutation) Content() (r string, exists bool) {
-	v := m.content
+// IndexName returns the value of the "indexName" field in the mutation.
+func (m *IndexDeadLetterMutation) IndexName() (r string, exists bool) {
+	v := m.indexName
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Keep entity.
-// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// OldIndexName returns the old "indexName" field's value of the IndexDeadLetter entity.
+// If the IndexDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeepMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *IndexDeadLetterMutation) OldIndexName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldIndexName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldIndexName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldIndexName: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.IndexName, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *KeepMutation) ResetContent() {
-	m.content = nil
+// ResetIndexName resets all changes to the "indexName" field.
+func (m *IndexDeadLetterMutation) ResetIndexName() {
+	m.indexName = nil
 }
 
-// SetIsPublic sets the "isPublic" field.
-func (m *KeepMutation) SetIsPublic(b bool) {
-	m.isPublic = &b
+// SetEntityId sets the "entityId" field.
+func (m *IndexDeadLetterMutation) SetEntityId(s string) {
+	m.entityId = &s
 }
 
-// IsPublic returns the value of the "isPublic" field in the mutation.
-func (m *KeepMutation) IsPublic() (r bool, exists bool) {
-	v := m.isPublic
+// EntityId returns the value of the "entityId" field in the mutation.
+func (m *IndexDeadLetterMutation) EntityId() (r string, exists bool) {
+	v := m.entityId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsPublic returns the old "isPublic" field's value of the Keep entity.
-// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// OldEntityId returns the old "entityId" field's value of the IndexDeadLetter entity.
+// If the IndexDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeepMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
+func (m *IndexDeadLetterMutation) OldEntityId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+		return v, errors.New("OldEntityId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+		return v, errors.New("OldEntityId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+		return v, fmt.Errorf("querying old value for OldEntityId: %w", err)
 	}
-	return oldValue.IsPublic, nil
+	return oldValue.EntityId, nil
 }
 
-// ResetIsPublic resets all changes to the "isPublic" field.
-func (m *KeepMutation) ResetIsPublic() {
-	m.isPublic = nil
+// ResetEntityId resets all changes to the "entityId" field.
+func (m *IndexDeadLetterMutation) ResetEntityId() {
+	m.entityId = nil
 }
 
-// SetTags sets the "tags" field.
-func (m *KeepMutation) SetTags(jm json.RawMessage) {
-	m.tags = &jm
-	m.appendtags = nil
+// SetJobId sets the "jobId" field.
+func (m *IndexDeadLetterMutation) SetJobId(s string) {
+	m.jobId = &s
 }
 
-// Tags returns the value of the "tags" field in the mutation.
-func (m *KeepMutation) Tags() (r json.RawMessage, exists bool) {
-	v := m.tags
+// JobId returns the value of the "jobId" field in the mutation.
+func (m *IndexDeadLetterMutation) JobId() (r string, exists bool) {
+	v := m.jobId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTags returns the old "tags" field's value of the Keep entity.
-// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// OldJobId returns the old "jobId" field's value of the IndexDeadLetter entity.
+// If the IndexDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeepMutation) OldTags(ctx context.Context) (v json.RawMessage, err error) {
+func (m *IndexDeadLetterMutation) OldJobId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+		return v, errors.New("OldJobId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTags requires an ID field in the mutation")
+		return v, errors.New("OldJobId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+		return v, fmt.Errorf("querying old value for OldJobId: %w", err)
 	}
-	return oldValue.Tags, nil
+	return oldValue.JobId, nil
 }
 
-// AppendTags adds jm to the "tags" field.
-func (m *KeepMutation) AppendTags(jm json.RawMessage) {
-	m.appendtags = append(m.appendtags, jm...)
+// ClearJobId clears the value of the "jobId" field.
+func (m *IndexDeadLetterMutation) ClearJobId() {
+	m.jobId = nil
+	m.clearedFields[indexdeadletter.FieldJobId] = struct{}{}
 }
 
-// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
-func (m *KeepMutation) AppendedTags() (json.RawMessage, bool) {
-	if len(m.appendtags) == 0 {
-		return nil, false
-	}
-	return m.appendtags, true
+// JobIdCleared returns if the "jobId" field was cleared in this mutation.
+func (m *IndexDeadLetterMutation) JobIdCleared() bool {
+	_, ok := m.clearedFields[indexdeadletter.FieldJobId]
+	return ok
 }
 
-// ResetTags resets all changes to the "tags" field.
-func (m *KeepMutation) ResetTags() {
-	m.tags = nil
-	m.appendtags = nil
+// ResetJobId resets all changes to the "jobId" field.
+func (m *IndexDeadLetterMutation) ResetJobId() {
+	m.jobId = nil
+	delete(m.clearedFields, indexdeadletter.FieldJobId)
 }
 
-// SetCreatedAt sets the "createdAt" field.
-func (m *KeepMutation) SetCreatedAt(t time.Time) {
-	m.createdAt = &t
+// SetStatus sets the "status" field.
+func (m *IndexDeadLetterMutation) SetStatus(i int) {
+	m.status = &i
+	m.addstatus = nil
 }
 
-// CreatedAt returns the value of the "createdAt" field in the mutation.
-func (m *KeepMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.createdAt
+// Status returns the value of the "status" field in the mutation.
+func (m *IndexDeadLetterMutation) Status() (r int, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "createdAt" field's value of the Keep entity.
-// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the IndexDeadLetter entity.
+// If the IndexDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeepMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *IndexDeadLetterMutation) OldStatus(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "createdAt" field.
-func (m *KeepMutation) ResetCreatedAt() {
-	m.createdAt = nil
+	return oldValue.Status, nil
 }
 
-// SetUpdatedAt sets the "updatedAt" field.
-func (m *KeepMutation) SetUpdatedAt(t time.Time) {
-	m.updatedAt = &t
+// AddStatus adds i to the "status" field.
+func (m *IndexDeadLetterMutation) AddStatus(i int) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
 }
 
-// UpdatedAt returns the value of the "updatedAt" field in the mutation.
-func (m *KeepMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updatedAt
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *IndexDeadLetterMutation) AddedStatus() (r int, exists bool) {
+	v := m.addstatus
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updatedAt" field's value of the Keep entity.
-// If the Keep object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeepMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updatedAt" field.
-func (m *KeepMutation) ResetUpdatedAt() {
-	m.updatedAt = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *IndexDeadLetterMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
 }
 
-// SetOwnerId sets the "ownerId" field.
-func (m *KeepMutation) SetOwnerId(s string) {
-	m.user = &s
+// SetErrorType sets the "errorType" field.
+func (m *IndexDeadLetterMutation) SetErrorType(s string) {
+	m.errorType = &s
 }
 
-// OwnerId returns the value of the "ownerId" field in the mutation.
-func (m *KeepMutation) OwnerId() (r string, exists bool) {
-	v := m.user
+// ErrorType returns the value of the "errorType" field in the mutation.
+func (m *IndexDeadLetterMutation) ErrorType() (r string, exists bool) {
+	v := m.errorType
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerId returns the old "ownerId" field's value of the Keep entity.
-// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// OldErrorType returns the old "errorType" field's value of the IndexDeadLetter entity.
+// If the IndexDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeepMutation) OldOwnerId(ctx context.Context) (v string, err error) {
+func (m *IndexDeadLetterMutation) OldErrorType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerId is only allowed on UpdateOne operations")
+		return v, errors.New("OldErrorType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerId requires an ID field in the mutation")
+		return v, errors.New("OldErrorType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerId: %w", err)
+		return v, fmt.Errorf("querying old value for OldErrorType: %w", err)
 	}
-	return oldValue.OwnerId, nil
+	return oldValue.ErrorType, nil
 }
 
-// ClearOwnerId clears the value of the "ownerId" field.
-func (m *KeepMutation) ClearOwnerId() {
-	m.user = nil
-	m.clearedFields[keep.FieldOwnerId] = struct{}{}
+// ClearErrorType clears the value of the "errorType" field.
+func (m *IndexDeadLetterMutation) ClearErrorType() {
+	m.errorType = nil
+	m.clearedFields[indexdeadletter.FieldErrorType] = struct{}{}
 }
 
-// OwnerIdCleared returns if the "ownerId" field was cleared in this mutation.
-func (m *KeepMutation) OwnerIdCleared() bool {
-	_, ok := m.clearedFields[keep.FieldOwnerId]
+// ErrorTypeCleared returns if the "errorType" field was cleared in this mutation.
+func (m *IndexDeadLetterMutation) ErrorTypeCleared() bool {
+	_, ok := m.clearedFields[indexdeadletter.FieldErrorType]
 	return ok
 }
 
-// ResetOwnerId resets all changes to the "ownerId" field.
-func (m *KeepMutation) ResetOwnerId() {
-	m.user = nil
-	delete(m.clearedFields, keep.FieldOwnerId)
+// ResetErrorType resets all changes to the "errorType" field.
+func (m *IndexDeadLetterMutation) ResetErrorType() {
+	m.errorType = nil
+	delete(m.clearedFields, indexdeadletter.FieldErrorType)
 }
 
-// SetCategory sets the "category" field.
-func (m *KeepMutation) SetCategory(s string) {
-	m.category = &s
+// SetReason sets the "reason" field.
+func (m *IndexDeadLetterMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *KeepMutation) Category() (r string, exists bool) {
-	v := m.category
+// Reason returns the value of the "reason" field in the mutation.
+func (m *IndexDeadLetterMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the Keep entity.
-// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the IndexDeadLetter entity.
+// If the IndexDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeepMutation) OldCategory(ctx context.Context) (v string, err error) {
+func (m *IndexDeadLetterMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.Category, nil
+	return oldValue.Reason, nil
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *KeepMutation) ResetCategory() {
-	m.category = nil
+// ClearReason clears the value of the "reason" field.
+func (m *IndexDeadLetterMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[indexdeadletter.FieldReason] = struct{}{}
 }
 
-// SetViews sets the "views" field.
-func (m *KeepMutation) SetViews(i int32) {
-	m.views = &i
-	m.addviews = nil
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *IndexDeadLetterMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[indexdeadletter.FieldReason]
+	return ok
 }
 
-// Views returns the value of the "views" field in the mutation.
-func (m *KeepMutation) Views() (r int32, exists bool) {
-	v := m.views
+// ResetReason resets all changes to the "reason" field.
+func (m *IndexDeadLetterMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, indexdeadletter.FieldReason)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *IndexDeadLetterMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *IndexDeadLetterMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldViews returns the old "views" field's value of the Keep entity.
-// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the IndexDeadLetter entity.
+// If the IndexDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeepMutation) OldViews(ctx context.Context) (v int32, err error) {
+func (m *IndexDeadLetterMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldViews is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldViews requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldViews: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.Views, nil
+	return oldValue.Attempts, nil
 }
 
-// AddViews adds i to the "views" field.
-func (m *KeepMutation) AddViews(i int32) {
-	if m.addviews != nil {
-		*m.addviews += i
+// AddAttempts adds i to the "attempts" field.
+func (m *IndexDeadLetterMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
 	} else {
-		m.addviews = &i
+		m.addattempts = &i
 	}
 }
 
-// AddedViews returns the value that was added to the "views" field in this mutation.
-func (m *KeepMutation) AddedViews() (r int32, exists bool) {
-	v := m.addviews
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *IndexDeadLetterMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetViews resets all changes to the "views" field.
-func (m *KeepMutation) ResetViews() {
-	m.views = nil
-	m.addviews = nil
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *IndexDeadLetterMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetLikes sets the "likes" field.
-func (m *KeepMutation) SetLikes(i int32) {
-	m.likes = &i
-	m.addlikes = nil
+// SetCreatedAt sets the "createdAt" field.
+func (m *IndexDeadLetterMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
 }
 
-// Likes returns the value of the "likes" field in the mutation.
-func (m *KeepMutation) Likes() (r int32, exists bool) {
-	v := m.likes
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *IndexDeadLetterMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLikes returns the old "likes" field's value of the Keep entity.
-// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "createdAt" field's value of the IndexDeadLetter entity.
+// If the IndexDeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeepMutation) OldLikes(ctx context.Context) (v int32, err error) {
+func (m *IndexDeadLetterMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLikes is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLikes requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLikes: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Likes, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddLikes adds i to the "likes" field.
-func (m *KeepMutation) AddLikes(i int32) {
-	if m.addlikes != nil {
-		*m.addlikes += i
-	} else {
-		m.addlikes = &i
-	}
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *IndexDeadLetterMutation) ResetCreatedAt() {
+	m.createdAt = nil
 }
 
-// AddedLikes returns the value that was added to the "likes" field in this mutation.
-func (m *KeepMutation) AddedLikes() (r int32, exists bool) {
-	v := m.addlikes
-	if v == nil {
-		return
+// Where appends a list predicates to the IndexDeadLetterMutation builder.
+func (m *IndexDeadLetterMutation) Where(ps ...predicate.IndexDeadLetter) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IndexDeadLetterMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IndexDeadLetterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IndexDeadLetter, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetLikes resets all changes to the "likes" field.
-func (m *KeepMutation) ResetLikes() {
-	m.likes = nil
-	m.addlikes = nil
+// Op returns the operation name.
+func (m *IndexDeadLetterMutation) Op() Op {
+	return m.op
 }
 
-// SetSummary sets the "summary" field.
-func (m *KeepMutation) SetSummary(s string) {
-	m.summary = &s
+// SetOp allows setting the mutation operation.
+func (m *IndexDeadLetterMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Summary returns the value of the "summary" field in the mutation.
-func (m *KeepMutation) Summary() (r string, exists bool) {
-	v := m.summary
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (IndexDeadLetter).
+func (m *IndexDeadLetterMutation) Type() string {
+	return m.typ
 }
 
-// OldSummary returns the old "summary" field's value of the Keep entity.
-// If the Keep object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeepMutation) OldSummary(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IndexDeadLetterMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.index != nil {
+		fields = append(fields, indexdeadletter.FieldIndex)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSummary requires an ID field in the mutation")
+	if m.indexName != nil {
+		fields = append(fields, indexdeadletter.FieldIndexName)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+	if m.entityId != nil {
+		fields = append(fields, indexdeadletter.FieldEntityId)
 	}
-	return oldValue.Summary, nil
+	if m.jobId != nil {
+		fields = append(fields, indexdeadletter.FieldJobId)
+	}
+	if m.status != nil {
+		fields = append(fields, indexdeadletter.FieldStatus)
+	}
+	if m.errorType != nil {
+		fields = append(fields, indexdeadletter.FieldErrorType)
+	}
+	if m.reason != nil {
+		fields = append(fields, indexdeadletter.FieldReason)
+	}
+	if m.attempts != nil {
+		fields = append(fields, indexdeadletter.FieldAttempts)
+	}
+	if m.createdAt != nil {
+		fields = append(fields, indexdeadletter.FieldCreatedAt)
+	}
+	return fields
 }
 
-// ResetSummary resets all changes to the "summary" field.
-func (m *KeepMutation) ResetSummary() {
-	m.summary = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IndexDeadLetterMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case indexdeadletter.FieldIndex:
+		return m.Index()
+	case indexdeadletter.FieldIndexName:
+		return m.IndexName()
+	case indexdeadletter.FieldEntityId:
+		return m.EntityId()
+	case indexdeadletter.FieldJobId:
+		return m.JobId()
+	case indexdeadletter.FieldStatus:
+		return m.Status()
+	case indexdeadletter.FieldErrorType:
+		return m.ErrorType()
+	case indexdeadletter.FieldReason:
+		return m.Reason()
+	case indexdeadletter.FieldAttempts:
+		return m.Attempts()
+	case indexdeadletter.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
 }
 
-// SetExtraData sets the "extraData" field.
-func (m *KeepMutation) SetExtraData(jm json.RawMessage) {
-	m.extraData = &jm
-	m.appendextraData = nil
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IndexDeadLetterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case indexdeadletter.FieldIndex:
+		return m.OldIndex(ctx)
+	case indexdeadletter.FieldIndexName:
+		return m.OldIndexName(ctx)
+	case indexdeadletter.FieldEntityId:
+		return m.OldEntityId(ctx)
+	case indexdeadletter.FieldJobId:
+		return m.OldJobId(ctx)
+	case indexdeadletter.FieldStatus:
+		return m.OldStatus(ctx)
+	case indexdeadletter.FieldErrorType:
+		return m.OldErrorType(ctx)
+	case indexdeadletter.FieldReason:
+		return m.OldReason(ctx)
+	case indexdeadletter.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case indexdeadletter.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IndexDeadLetter field %s", name)
 }
 
-// ExtraData returns the value of the "extraData" field in the mutation.
-func (m *KeepMutation) ExtraData() (r json.RawMessage, exists bool) {
-	v := m.extraData
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IndexDeadLetterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case indexdeadletter.FieldIndex:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndex(v)
+		return nil
+	case indexdeadletter.FieldIndexName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndexName(v)
+		return nil
+	case indexdeadletter.FieldEntityId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityId(v)
+		return nil
+	case indexdeadletter.FieldJobId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobId(v)
+		return nil
+	case indexdeadletter.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case indexdeadletter.FieldErrorType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorType(v)
+		return nil
+	case indexdeadletter.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case indexdeadletter.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case indexdeadletter.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown IndexDeadLetter field %s", name)
 }
 
-// OldExtraData returns the old "extraData" field's value of the Keep entity.
-// If the Keep object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeepMutation) OldExtraData(ctx context.Context) (v json.RawMessage, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExtraData is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExtraData requires an ID field in the mutation")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IndexDeadLetterMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, indexdeadletter.FieldStatus)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExtraData: %w", err)
+	if m.addattempts != nil {
+		fields = append(fields, indexdeadletter.FieldAttempts)
 	}
-	return oldValue.ExtraData, nil
+	return fields
 }
 
-// AppendExtraData adds jm to the "extraData" field.
-func (m *KeepMutation) AppendExtraData(jm json.RawMessage) {
-	m.appendextraData = append(m.appendextraData, jm...)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IndexDeadLetterMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case indexdeadletter.FieldStatus:
+		return m.AddedStatus()
+	case indexdeadletter.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
 }
 
-// AppendedExtraData returns the list of values that were appended to the "extraData" field in this mutation.
-func (m *KeepMutation) AppendedExtraData() (json.RawMessage, bool) {
-	if len(m.appendextraData) == 0 {
-		return nil, false
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IndexDeadLetterMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case indexdeadletter.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case indexdeadletter.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
 	}
-	return m.appendextraData, true
+	return fmt.Errorf("unknown IndexDeadLetter numeric field %s", name)
 }
 
-// ResetExtraData resets all changes to the "extraData" field.
-func (m *KeepMutation) ResetExtraData() {
-	m.extraData = nil
-	m.appendextraData = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IndexDeadLetterMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(indexdeadletter.FieldJobId) {
+		fields = append(fields, indexdeadletter.FieldJobId)
+	}
+	if m.FieldCleared(indexdeadletter.FieldErrorType) {
+		fields = append(fields, indexdeadletter.FieldErrorType)
+	}
+	if m.FieldCleared(indexdeadletter.FieldReason) {
+		fields = append(fields, indexdeadletter.FieldReason)
+	}
+	return fields
 }
 
-// SetContentVector sets the "content_vector" field.
-func (m *KeepMutation) SetContentVector(jm json.RawMessage) {
-	m.content_vector = &jm
-	m.appendcontent_vector = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IndexDeadLetterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ContentVector returns the value of the "content_vector" field in the mutation.
-func (m *KeepMutation) ContentVector() (r json.RawMessage, exists bool) {
-	v := m.content_vector
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IndexDeadLetterMutation) ClearField(name string) error {
+	switch name {
+	case indexdeadletter.FieldJobId:
+		m.ClearJobId()
+		return nil
+	case indexdeadletter.FieldErrorType:
+		m.ClearErrorType()
+		return nil
+	case indexdeadletter.FieldReason:
+		m.ClearReason()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown IndexDeadLetter nullable field %s", name)
 }
 
-// OldContentVector returns the old "content_vector" field's value of the Keep entity.
-// If the Keep object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeepMutation) OldContentVector(ctx context.Context) (v json.RawMessage, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentVector is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentVector requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentVector: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IndexDeadLetterMutation) ResetField(name string) error {
+	switch name {
+	case indexdeadletter.FieldIndex:
+		m.ResetIndex()
+		return nil
+	case indexdeadletter.FieldIndexName:
+		m.ResetIndexName()
+		return nil
+	case indexdeadletter.FieldEntityId:
+		m.ResetEntityId()
+		return nil
+	case indexdeadletter.FieldJobId:
+		m.ResetJobId()
+		return nil
+	case indexdeadletter.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case indexdeadletter.FieldErrorType:
+		m.ResetErrorType()
+		return nil
+	case indexdeadletter.FieldReason:
+		m.ResetReason()
+		return nil
+	case indexdeadletter.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case indexdeadletter.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
-	return oldValue.ContentVector, nil
+	return fmt.Errorf("unknown IndexDeadLetter field %s", name)
 }
 
-// AppendContentVector adds jm to the "content_vector" field.
-func (m *KeepMutation) AppendContentVector(jm json.RawMessage) {
-	m.appendcontent_vector = append(m.appendcontent_vector, jm...)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IndexDeadLetterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// AppendedContentVector returns the list of values that were appended to the "content_vector" field in this mutation.
-func (m *KeepMutation) AppendedContentVector() (json.RawMessage, bool) {
-	if len(m.appendcontent_vector) == 0 {
-		return nil, false
-	}
-	return m.appendcontent_vector, true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IndexDeadLetterMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ClearContentVector clears the value of the "content_vector" field.
-func (m *KeepMutation) ClearContentVector() {
-	m.content_vector = nil
-	m.appendcontent_vector = nil
-	m.clearedFields[keep.FieldContentVector] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IndexDeadLetterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ContentVectorCleared returns if the "content_vector" field was cleared in this mutation.
-func (m *KeepMutation) ContentVectorCleared() bool {
-	_, ok := m.clearedFields[keep.FieldContentVector]
-	return ok
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IndexDeadLetterMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetContentVector resets all changes to the "content_vector" field.
-func (m *KeepMutation) ResetContentVector() {
-	m.content_vector = nil
-	m.appendcontent_vector = nil
-	delete(m.clearedFields, keep.FieldContentVector)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IndexDeadLetterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetSummaryVector sets the "summary_vector" field.
-func (m *KeepMutation) SetSummaryVector(jm json.RawMessage) {
-	m.summary_vector = &jm
-	m.appendsummary_vector = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IndexDeadLetterMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// SummaryVector returns the value of the "summary_vector" field in the mutation.
-func (m *KeepMutation) SummaryVector() (r json.RawMessage, exists bool) {
-	v := m.summary_vector
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IndexDeadLetterMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IndexDeadLetter unique edge %s", name)
 }
 
-// OldSummaryVector returns the old "summary_vector" field's value of the Keep entity.
-// If the Keep object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeepMutation) OldSummaryVector(ctx context.Context) (v json.RawMessage, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSummaryVector is only allowed on UpdateOne operations")
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IndexDeadLetterMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IndexDeadLetter edge %s", name)
+}
+
+// KeepMutation represents an operation that mutates the Keep nodes in the graph.
+type KeepMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *string
+	title                *string
+	content              *string
+	isPublic             *bool
+	tags                 *json.RawMessage
+	appendtags           json.RawMessage
+	createdAt            *time.Time
+	updatedAt            *time.Time
+	category             *string
+	views                *int32
+	addviews             *int32
+	likes                *int32
+	addlikes             *int32
+	summary              *string
+	extraData            *json.RawMessage
+	appendextraData      json.RawMessage
+	content_vector       *json.RawMessage
+	appendcontent_vector json.RawMessage
+	summary_vector       *json.RawMessage
+	appendsummary_vector json.RawMessage
+	title_vector         *json.RawMessage
+	appendtitle_vector   json.RawMessage
+	clearedFields        map[string]struct{}
+	user                 *string
+	cleareduser          bool
+	done                 bool
+	oldValue             func(context.Context) (*Keep, error)
+	predicates           []predicate.Keep
+}
+
+var _ ent.Mutation = (*KeepMutation)(nil)
+
+// keepOption allows management of the mutation configuration using functional options.
+type keepOption func(*KeepMutation)
+
+// newKeepMutation creates new mutation for the Keep entity.
+func newKeepMutation(c config, op Op, opts ...keepOption) *KeepMutation {
+	m := &KeepMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKeep,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSummaryVector requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSummaryVector: %w", err)
+	return m
+}
+
+// withKeepID sets the ID field of the mutation.
+func withKeepID(id string) keepOption {
+	return func(m *KeepMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Keep
+		)
+		m.oldValue = func(ctx context.Context) (*Keep, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Keep.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.SummaryVector, nil
 }
 
-// AppendSummaryVector adds jm to the "summary_vector" field.
-func (m *KeepMutation) AppendSummaryVector(jm json.RawMessage) {
-	m.appendsummary_vector = append(m.appendsummary_vector, jm...)
+// withKeep sets the old Keep of the mutation.
+func withKeep(node *Keep) keepOption {
+	return func(m *KeepMutation) {
+		m.oldValue = func(context.Context) (*Keep, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// AppendedSummaryVector returns the list of values that were appended to the "summary_vector" field in this mutation.
-func (m *KeepMutation) AppendedSummaryVector() (json.RawMessage, bool) {
-	if len(m.appendsummary_vector) == 0 {
-		return nil, false
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KeepMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KeepMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return m.appendsummary_vector, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearSummaryVector clears the value of the "summary_vector" field.
-func (m *KeepMutation) ClearSummaryVector() {
-	m.summary_vector = nil
-	m.appendsummary_vector = nil
-	m.clearedFields[keep.FieldSummaryVector] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Keep entities.
+func (m *KeepMutation) SetID(id string) {
+	m.id = &id
 }
 
-// SummaryVectorCleared returns if the "summary_vector" field was cleared in this mutation.
-func (m *KeepMutation) SummaryVectorCleared() bool {
-	_, ok := m.clearedFields[keep.FieldSummaryVector]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KeepMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetSummaryVector resets all changes to the "summary_vector" field.
-func (m *KeepMutation) ResetSummaryVector() {
-	m.summary_vector = nil
-	m.appendsummary_vector = nil
-	delete(m.clearedFields, keep.FieldSummaryVector)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KeepMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Keep.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetTitleVector sets the "title_vector" field.
-func (m *KeepMutation) SetTitleVector(jm json.RawMessage) {
-	m.title_vector = &jm
-	m.appendtitle_vector = nil
+// SetTitle sets the "title" field.
+func (m *KeepMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// TitleVector returns the value of the "title_vector" field in the mutation.
-func (m *KeepMutation) TitleVector() (r json.RawMessage, exists bool) {
-	v := m.title_vector
+// Title returns the value of the "title" field in the mutation.
+func (m *KeepMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitleVector returns the old "title_vector" field's value of the Keep entity.
+// OldTitle returns the old "title" field's value of the Keep entity.
 // If the Keep object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeepMutation) OldTitleVector(ctx context.Context) (v json.RawMessage, err error) {
+func (m *KeepMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitleVector is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitleVector requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitleVector: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.TitleVector, nil
+	return oldValue.Title, nil
 }
 
-// AppendTitleVector adds jm to the "title_vector" field.
-func (m *KeepMutation) AppendTitleVector(jm json.RawMessage) {
-	m.appendtitle_vector = append(m.appendtitle_vector, jm...)
+// ResetTitle resets all changes to the "title" field.
+func (m *KeepMutation) ResetTitle() {
+	m.title = nil
 }
 
-// AppendedTitleVector returns the list of values that were appended to the "title_vector" field in this mutation.
-func (m *KeepMutation) AppendedTitleVector() (json.RawMessage, bool) {
-	if len(m.appendtitle_vector) == 0 {
-		return nil, false
+// SetContent sets the "content" field.
+func (m *KeepMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *KeepMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
 	}
-	return m.appendtitle_vector, true
+	return *v, true
 }
 
-// ClearTitleVector clears the value of the "title_vector" field.
-func (m *KeepMutation) ClearTitleVector() {
-	m.title_vector = nil
-	m.appendtitle_vector = nil
-	m.clearedFields[keep.FieldTitleVector] = struct{}{}
+// OldContent returns the old "content" field's value of the Keep entity.
+// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
 }
 
-// TitleVectorCleared returns if the "title_vector" field was cleared in this mutation.
-func (m *KeepMutation) TitleVectorCleared() bool {
-	_, ok := m.clearedFields[keep.FieldTitleVector]
-	return ok
+// ResetContent resets all changes to the "content" field.
+func (m *KeepMutation) ResetContent() {
+	m.content = nil
 }
 
-// ResetTitleVector resets all changes to the "title_vector" field.
-func (m *KeepMutation) ResetTitleVector() {
-	m.title_vector = nil
-	m.appendtitle_vector = nil
-	delete(m.clearedFields, keep.FieldTitleVector)
+// SetIsPublic sets the "isPublic" field.
+func (m *KeepMutation) SetIsPublic(b bool) {
+	m.isPublic = &b
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *KeepMutation) SetUserID(id string) {
-	m.user = &id
+// IsPublic returns the value of the "isPublic" field in the mutation.
+func (m *KeepMutation) IsPublic() (r bool, exists bool) {
+	v := m.isPublic
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *KeepMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[keep.FieldOwnerId] = struct{}{}
+// OldIsPublic returns the old "isPublic" field's value of the Keep entity.
+// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+	}
+	return oldValue.IsPublic, nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *KeepMutation) UserCleared() bool {
-	return m.OwnerIdCleared() || m.cleareduser
+// ResetIsPublic resets all changes to the "isPublic" field.
+func (m *KeepMutation) ResetIsPublic() {
+	m.isPublic = nil
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *KeepMutation) UserID() (id string, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
+// SetTags sets the "tags" field.
+func (m *KeepMutation) SetTags(jm json.RawMessage) {
+	m.tags = &jm
+	m.appendtags = nil
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *KeepMutation) UserIDs() (ids []string) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// Tags returns the value of the "tags" field in the mutation.
+func (m *KeepMutation) Tags() (r json.RawMessage, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *KeepMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// OldTags returns the old "tags" field's value of the Keep entity.
+// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepMutation) OldTags(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
 }
 
-// Where appends a list predicates to the KeepMutation builder.
-func (m *KeepMutation) Where(ps ...predicate.Keep) {
-	m.predicates = append(m.predicates, ps...)
+// AppendTags adds jm to the "tags" field.
+func (m *KeepMutation) AppendTags(jm json.RawMessage) {
+	m.appendtags = append(m.appendtags, jm...)
 }
 
-// WhereP appends storage-level predicates to the KeepMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *KeepMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Keep, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *KeepMutation) AppendedTags() (json.RawMessage, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
 	}
-	m.Where(p...)
+	return m.appendtags, true
 }
 
-// Op returns the operation name.
-func (m *KeepMutation) Op() Op {
-	return m.op
+// ResetTags resets all changes to the "tags" field.
+func (m *KeepMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *KeepMutation) SetOp(op Op) {
-	m.op = op
+// SetCreatedAt sets the "createdAt" field.
+func (m *KeepMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
 }
 
-// Type returns the node type of this mutation (Keep).
-func (m *KeepMutation) Type() string {
-	return m.typ
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *KeepMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *KeepMutation) Fields() []string {
-	fields := make([]string, 0, 15)
-	if m.title != nil {
-		fields = append(fields, keep.FieldTitle)
-	}
-	if m.content != nil {
-		fields = append(fields, keep.FieldContent)
-	}
-	if m.isPublic != nil {
-		fields = append(fields, keep.FieldIsPublic)
-	}
-	if m.tags != nil {
-		fields = append(fields, keep.FieldTags)
-	}
-	if m.createdAt != nil {
-		fields = append(fields, keep.FieldCreatedAt)
+// OldCreatedAt returns the old "createdAt" field's value of the Keep entity.
+// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.updatedAt != nil {
-		fields = append(fields, keep.FieldUpdatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.user != nil {
-		fields = append(fields, keep.FieldOwnerId)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	if m.category != nil {
-		fields = append(fields, keep.FieldCategory)
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *KeepMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *KeepMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *KeepMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
 	}
-	if m.views != nil {
-		fields = append(fields, keep.FieldViews)
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the Keep entity.
+// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.likes != nil {
-		fields = append(fields, keep.FieldLikes)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	if m.summary != nil {
-		fields = append(fields, keep.FieldSummary)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *KeepMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// SetOwnerId sets the "ownerId" field.
+func (m *KeepMutation) SetOwnerId(s string) {
+	m.user = &s
+}
+
+// OwnerId returns the value of the "ownerId" field in the mutation.
+func (m *KeepMutation) OwnerId() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerId returns the old "ownerId" field's value of the Keep entity.
+// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepMutation) OldOwnerId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerId: %w", err)
+	}
+	return oldValue.OwnerId, nil
+}
+
+// ClearOwnerId clears the value of the "ownerId" field.
+func (m *KeepMutation) ClearOwnerId() {
+	m.user = nil
+	m.clearedFields[keep.FieldOwnerId] = struct{}{}
+}
+
+// OwnerIdCleared returns if the "ownerId" field was cleared in this mutation.
+func (m *KeepMutation) OwnerIdCleared() bool {
+	_, ok := m.clearedFields[keep.FieldOwnerId]
+	return ok
+}
+
+// ResetOwnerId resets all changes to the "ownerId" field.
+func (m *KeepMutation) ResetOwnerId() {
+	m.user = nil
+	delete(m.clearedFields, keep.FieldOwnerId)
+}
+
+// SetCategory sets the "category" field.
+func (m *KeepMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *KeepMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the Keep entity.
+// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *KeepMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetViews sets the "views" field.
+func (m *KeepMutation) SetViews(i int32) {
+	m.views = &i
+	m.addviews = nil
+}
+
+// Views returns the value of the "views" field in the mutation.
+func (m *KeepMutation) Views() (r int32, exists bool) {
+	v := m.views
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldViews returns the old "views" field's value of the Keep entity.
+// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepMutation) OldViews(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViews is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViews requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViews: %w", err)
+	}
+	return oldValue.Views, nil
+}
+
+// AddViews adds i to the "views" field.
+func (m *KeepMutation) AddViews(i int32) {
+	if m.addviews != nil {
+		*m.addviews += i
+	} else {
+		m.addviews = &i
+	}
+}
+
+// AddedViews returns the value that was added to the "views" field in this mutation.
+func (m *KeepMutation) AddedViews() (r int32, exists bool) {
+	v := m.addviews
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetViews resets all changes to the "views" field.
+func (m *KeepMutation) ResetViews() {
+	m.views = nil
+	m.addviews = nil
+}
+
+// SetLikes sets the "likes" field.
+func (m *KeepMutation) SetLikes(i int32) {
+	m.likes = &i
+	m.addlikes = nil
+}
+
+// Likes returns the value of the "likes" field in the mutation.
+func (m *KeepMutation) Likes() (r int32, exists bool) {
+	v := m.likes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLikes returns the old "likes" field's value of the Keep entity.
+// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepMutation) OldLikes(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLikes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLikes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLikes: %w", err)
+	}
+	return oldValue.Likes, nil
+}
+
+// AddLikes adds i to the "likes" field.
+func (m *KeepMutation) AddLikes(i int32) {
+	if m.addlikes != nil {
+		*m.addlikes += i
+	} else {
+		m.addlikes = &i
+	}
+}
+
+// AddedLikes returns the value that was added to the "likes" field in this mutation.
+func (m *KeepMutation) AddedLikes() (r int32, exists bool) {
+	v := m.addlikes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLikes resets all changes to the "likes" field.
+func (m *KeepMutation) ResetLikes() {
+	m.likes = nil
+	m.addlikes = nil
+}
+
+// SetSummary sets the "summary" field.
+func (m *KeepMutation) SetSummary(s string) {
+	m.summary = &s
+}
+
+// Summary returns the value of the "summary" field in the mutation.
+func (m *KeepMutation) Summary() (r string, exists bool) {
+	v := m.summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummary returns the old "summary" field's value of the Keep entity.
+// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepMutation) OldSummary(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+	}
+	return oldValue.Summary, nil
+}
+
+// ResetSummary resets all changes to the "summary" field.
+func (m *KeepMutation) ResetSummary() {
+	m.summary = nil
+}
+
+// SetExtraData sets the "extraData" field.
+func (m *KeepMutation) SetExtraData(jm json.RawMessage) {
+	m.extraData = &jm
+	m.appendextraData = nil
+}
+
+// ExtraData returns the value of the "extraData" field in the mutation.
+func (m *KeepMutation) ExtraData() (r json.RawMessage, exists bool) {
+	v := m.extraData
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExtraData returns the old "extraData" field's value of the Keep entity.
+// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepMutation) OldExtraData(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExtraData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExtraData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExtraData: %w", err)
+	}
+	return oldValue.ExtraData, nil
+}
+
+// AppendExtraData adds jm to the "extraData" field.
+func (m *KeepMutation) AppendExtraData(jm json.RawMessage) {
+	m.appendextraData = append(m.appendextraData, jm...)
+}
+
+// AppendedExtraData returns the list of values that were appended to the "extraData" field in this mutation.
+func (m *KeepMutation) AppendedExtraData() (json.RawMessage, bool) {
+	if len(m.appendextraData) == 0 {
+		return nil, false
+	}
+	return m.appendextraData, true
+}
+
+// ResetExtraData resets all changes to the "extraData" field.
+func (m *KeepMutation) ResetExtraData() {
+	m.extraData = nil
+	m.appendextraData = nil
+}
+
+// SetContentVector sets the "content_vector" field.
+func (m *KeepMutation) SetContentVector(jm json.RawMessage) {
+	m.content_vector = &jm
+	m.appendcontent_vector = nil
+}
+
+// ContentVector returns the value of the "content_vector" field in the mutation.
+func (m *KeepMutation) ContentVector() (r json.RawMessage, exists bool) {
+	v := m.content_vector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentVector returns the old "content_vector" field's value of the Keep entity.
+// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepMutation) OldContentVector(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentVector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentVector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentVector: %w", err)
+	}
+	return oldValue.ContentVector, nil
+}
+
+// AppendContentVector adds jm to the "content_vector" field.
+func (m *KeepMutation) AppendContentVector(jm json.RawMessage) {
+	m.appendcontent_vector = append(m.appendcontent_vector, jm...)
+}
+
+// AppendedContentVector returns the list of values that were appended to the "content_vector" field in this mutation.
+func (m *KeepMutation) AppendedContentVector() (json.RawMessage, bool) {
+	if len(m.appendcontent_vector) == 0 {
+		return nil, false
+	}
+	return m.appendcontent_vector, true
+}
+
+// ClearContentVector clears the value of the "content_vector" field.
+func (m *KeepMutation) ClearContentVector() {
+	m.content_vector = nil
+	m.appendcontent_vector = nil
+	m.clearedFields[keep.FieldContentVector] = struct{}{}
+}
+
+// ContentVectorCleared returns if the "content_vector" field was cleared in this mutation.
+func (m *KeepMutation) ContentVectorCleared() bool {
+	_, ok := m.clearedFields[keep.FieldContentVector]
+	return ok
+}
+
+// ResetContentVector resets all changes to the "content_vector" field.
+func (m *KeepMutation) ResetContentVector() {
+	m.content_vector = nil
+	m.appendcontent_vector = nil
+	delete(m.clearedFields, keep.FieldContentVector)
+}
+
+// SetSummaryVector sets the "summary_vector" field.
+func (m *KeepMutation) SetSummaryVector(jm json.RawMessage) {
+	m.summary_vector = &jm
+	m.appendsummary_vector = nil
+}
+
+// SummaryVector returns the value of the "summary_vector" field in the mutation.
+func (m *KeepMutation) SummaryVector() (r json.RawMessage, exists bool) {
+	v := m.summary_vector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummaryVector returns the old "summary_vector" field's value of the Keep entity.
+// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepMutation) OldSummaryVector(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummaryVector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummaryVector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummaryVector: %w", err)
+	}
+	return oldValue.SummaryVector, nil
+}
+
+// AppendSummaryVector adds jm to the "summary_vector" field.
+func (m *KeepMutation) AppendSummaryVector(jm json.RawMessage) {
+	m.appendsummary_vector = append(m.appendsummary_vector, jm...)
+}
+
+// AppendedSummaryVector returns the list of values that were appended to the "summary_vector" field in this mutation.
+func (m *KeepMutation) AppendedSummaryVector() (json.RawMessage, bool) {
+	if len(m.appendsummary_vector) == 0 {
+		return nil, false
+	}
+	return m.appendsummary_vector, true
+}
+
+// ClearSummaryVector clears the value of the "summary_vector" field.
+func (m *KeepMutation) ClearSummaryVector() {
+	m.summary_vector = nil
+	m.appendsummary_vector = nil
+	m.clearedFields[keep.FieldSummaryVector] = struct{}{}
+}
+
+// SummaryVectorCleared returns if the "summary_vector" field was cleared in this mutation.
+func (m *KeepMutation) SummaryVectorCleared() bool {
+	_, ok := m.clearedFields[keep.FieldSummaryVector]
+	return ok
+}
+
+// ResetSummaryVector resets all changes to the "summary_vector" field.
+func (m *KeepMutation) ResetSummaryVector() {
+	m.summary_vector = nil
+	m.appendsummary_vector = nil
+	delete(m.clearedFields, keep.FieldSummaryVector)
+}
+
+// SetTitleVector sets the "title_vector" field.
+func (m *KeepMutation) SetTitleVector(jm json.RawMessage) {
+	m.title_vector = &jm
+	m.appendtitle_vector = nil
+}
+
+// TitleVector returns the value of the "title_vector" field in the mutation.
+func (m *KeepMutation) TitleVector() (r json.RawMessage, exists bool) {
+	v := m.title_vector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitleVector returns the old "title_vector" field's value of the Keep entity.
+// If the Keep object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepMutation) OldTitleVector(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitleVector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitleVector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitleVector: %w", err)
+	}
+	return oldValue.TitleVector, nil
+}
+
+// AppendTitleVector adds jm to the "title_vector" field.
+func (m *KeepMutation) AppendTitleVector(jm json.RawMessage) {
+	m.appendtitle_vector = append(m.appendtitle_vector, jm...)
+}
+
+// AppendedTitleVector returns the list of values that were appended to the "title_vector" field in this mutation.
+func (m *KeepMutation) AppendedTitleVector() (json.RawMessage, bool) {
+	if len(m.appendtitle_vector) == 0 {
+		return nil, false
+	}
+	return m.appendtitle_vector, true
+}
+
+// ClearTitleVector clears the value of the "title_vector" field.
+func (m *KeepMutation) ClearTitleVector() {
+	m.title_vector = nil
+	m.appendtitle_vector = nil
+	m.clearedFields[keep.FieldTitleVector] = struct{}{}
+}
+
+// TitleVectorCleared returns if the "title_vector" field was cleared in this mutation.
+func (m *KeepMutation) TitleVectorCleared() bool {
+	_, ok := m.clearedFields[keep.FieldTitleVector]
+	return ok
+}
+
+// ResetTitleVector resets all changes to the "title_vector" field.
+func (m *KeepMutation) ResetTitleVector() {
+	m.title_vector = nil
+	m.appendtitle_vector = nil
+	delete(m.clearedFields, keep.FieldTitleVector)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *KeepMutation) SetUserID(id string) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *KeepMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[keep.FieldOwnerId] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *KeepMutation) UserCleared() bool {
+	return m.OwnerIdCleared() || m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *KeepMutation) UserID() (id string, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *KeepMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *KeepMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the KeepMutation builder.
+func (m *KeepMutation) Where(ps ...predicate.Keep) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KeepMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KeepMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Keep, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KeepMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KeepMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Keep).
+func (m *KeepMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KeepMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.title != nil {
+		fields = append(fields, keep.FieldTitle)
+	}
+	if m.content != nil {
+		fields = append(fields, keep.FieldContent)
+	}
+	if m.isPublic != nil {
+		fields = append(fields, keep.FieldIsPublic)
+	}
+	if m.tags != nil {
+		fields = append(fields, keep.FieldTags)
+	}
+	if m.createdAt != nil {
+		fields = append(fields, keep.FieldCreatedAt)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, keep.FieldUpdatedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, keep.FieldOwnerId)
+	}
+	if m.category != nil {
+		fields = append(fields, keep.FieldCategory)
+	}
+	if m.views != nil {
+		fields = append(fields, keep.FieldViews)
+	}
+	if m.likes != nil {
+		fields = append(fields, keep.FieldLikes)
+	}
+	if m.summary != nil {
+		fields = append(fields, keep.FieldSummary)
+	}
+	if m.extraData != nil {
+		fields = append(fields, keep.FieldExtraData)
+	}
+	if m.content_vector != nil {
+		fields = append(fields, keep.FieldContentVector)
+	}
+	if m.summary_vector != nil {
+		fields = append(fields, keep.FieldSummaryVector)
+	}
+	if m.title_vector != nil {
+		fields = append(fields, keep.FieldTitleVector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KeepMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case keep.FieldTitle:
+		return m.Title()
+	case keep.FieldContent:
+		return m.Content()
+	case keep.FieldIsPublic:
+		return m.IsPublic()
+	case keep.FieldTags:
+		return m.Tags()
+	case keep.FieldCreatedAt:
+		return m.CreatedAt()
+	case keep.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case keep.FieldOwnerId:
+		return m.OwnerId()
+	case keep.FieldCategory:
+		return m.Category()
+	case keep.FieldViews:
+		return m.Views()
+	case keep.FieldLikes:
+		return m.Likes()
+	case keep.FieldSummary:
+		return m.Summary()
+	case keep.FieldExtraData:
+		return m.ExtraData()
+	case keep.FieldContentVector:
+		return m.ContentVector()
+	case keep.FieldSummaryVector:
+		return m.SummaryVector()
+	case keep.FieldTitleVector:
+		return m.TitleVector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KeepMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case keep.FieldTitle:
+		return m.OldTitle(ctx)
+	case keep.FieldContent:
+		return m.OldContent(ctx)
+	case keep.FieldIsPublic:
+		return m.OldIsPublic(ctx)
+	case keep.FieldTags:
+		return m.OldTags(ctx)
+	case keep.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case keep.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case keep.FieldOwnerId:
+		return m.OldOwnerId(ctx)
+	case keep.FieldCategory:
+		return m.OldCategory(ctx)
+	case keep.FieldViews:
+		return m.OldViews(ctx)
+	case keep.FieldLikes:
+		return m.OldLikes(ctx)
+	case keep.FieldSummary:
+		return m.OldSummary(ctx)
+	case keep.FieldExtraData:
+		return m.OldExtraData(ctx)
+	case keep.FieldContentVector:
+		return m.OldContentVector(ctx)
+	case keep.FieldSummaryVector:
+		return m.OldSummaryVector(ctx)
+	case keep.FieldTitleVector:
+		return m.OldTitleVector(ctx)
+	}
+	return nil, fmt.Errorf("unknown Keep field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeepMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case keep.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case keep.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case keep.FieldIsPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPublic(v)
+		return nil
+	case keep.FieldTags:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case keep.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case keep.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case keep.FieldOwnerId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerId(v)
+		return nil
+	case keep.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case keep.FieldViews:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViews(v)
+		return nil
+	case keep.FieldLikes:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLikes(v)
+		return nil
+	case keep.FieldSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummary(v)
+		return nil
+	case keep.FieldExtraData:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExtraData(v)
+		return nil
+	case keep.FieldContentVector:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentVector(v)
+		return nil
+	case keep.FieldSummaryVector:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummaryVector(v)
+		return nil
+	case keep.FieldTitleVector:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitleVector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Keep field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KeepMutation) AddedFields() []string {
+	var fields []string
+	if m.addviews != nil {
+		fields = append(fields, keep.FieldViews)
+	}
+	if m.addlikes != nil {
+		fields = append(fields, keep.FieldLikes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KeepMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case keep.FieldViews:
+		return m.AddedViews()
+	case keep.FieldLikes:
+		return m.AddedLikes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeepMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case keep.FieldViews:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddViews(v)
+		return nil
+	case keep.FieldLikes:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLikes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Keep numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KeepMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(keep.FieldOwnerId) {
+		fields = append(fields, keep.FieldOwnerId)
+	}
+	if m.FieldCleared(keep.FieldContentVector) {
+		fields = append(fields, keep.FieldContentVector)
+	}
+	if m.FieldCleared(keep.FieldSummaryVector) {
+		fields = append(fields, keep.FieldSummaryVector)
+	}
+	if m.FieldCleared(keep.FieldTitleVector) {
+		fields = append(fields, keep.FieldTitleVector)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KeepMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KeepMutation) ClearField(name string) error {
+	switch name {
+	case keep.FieldOwnerId:
+		m.ClearOwnerId()
+		return nil
+	case keep.FieldContentVector:
+		m.ClearContentVector()
+		return nil
+	case keep.FieldSummaryVector:
+		m.ClearSummaryVector()
+		return nil
+	case keep.FieldTitleVector:
+		m.ClearTitleVector()
+		return nil
+	}
+	return fmt.Errorf("unknown Keep nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KeepMutation) ResetField(name string) error {
+	switch name {
+	case keep.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case keep.FieldContent:
+		m.ResetContent()
+		return nil
+	case keep.FieldIsPublic:
+		m.ResetIsPublic()
+		return nil
+	case keep.FieldTags:
+		m.ResetTags()
+		return nil
+	case keep.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case keep.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case keep.FieldOwnerId:
+		m.ResetOwnerId()
+		return nil
+	case keep.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case keep.FieldViews:
+		m.ResetViews()
+		return nil
+	case keep.FieldLikes:
+		m.ResetLikes()
+		return nil
+	case keep.FieldSummary:
+		m.ResetSummary()
+		return nil
+	case keep.FieldExtraData:
+		m.ResetExtraData()
+		return nil
+	case keep.FieldContentVector:
+		m.ResetContentVector()
+		return nil
+	case keep.FieldSummaryVector:
+		m.ResetSummaryVector()
+		return nil
+	case keep.FieldTitleVector:
+		m.ResetTitleVector()
+		return nil
+	}
+	return fmt.Errorf("unknown Keep field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KeepMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, keep.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KeepMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case keep.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KeepMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KeepMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KeepMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, keep.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KeepMutation) EdgeCleared(name string) bool {
+	switch name {
+	case keep.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KeepMutation) ClearEdge(name string) error {
+	switch name {
+	case keep.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Keep unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KeepMutation) ResetEdge(name string) error {
+	switch name {
+	case keep.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Keep edge %s", name)
+}
+
+// KeepChunkMutation represents an operation that mutates the KeepChunk nodes in the graph.
+type KeepChunkMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	keepId        *string
+	ordinal       *int
+	addordinal    *int
+	heading       *string
+	content       *string
+	start         *int
+	addstart      *int
+	end           *int
+	addend        *int
+	contentHash   *string
+	vector        *json.RawMessage
+	appendvector  json.RawMessage
+	keepUpdatedAt *time.Time
+	createdAt     *time.Time
+	updatedAt     *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*KeepChunk, error)
+	predicates    []predicate.KeepChunk
+}
+
+var _ ent.Mutation = (*KeepChunkMutation)(nil)
+
+// keepchunkOption allows management of the mutation configuration using functional options.
+type keepchunkOption func(*KeepChunkMutation)
+
+// newKeepChunkMutation creates new mutation for the KeepChunk entity.
+func newKeepChunkMutation(c config, op Op, opts ...keepchunkOption) *KeepChunkMutation {
+	m := &KeepChunkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKeepChunk,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKeepChunkID sets the ID field of the mutation.
+func withKeepChunkID(id int) keepchunkOption {
+	return func(m *KeepChunkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *KeepChunk
+		)
+		m.oldValue = func(ctx context.Context) (*KeepChunk, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().KeepChunk.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKeepChunk sets the old KeepChunk of the mutation.
+func withKeepChunk(node *KeepChunk) keepchunkOption {
+	return func(m *KeepChunkMutation) {
+		m.oldValue = func(context.Context) (*KeepChunk, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KeepChunkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KeepChunkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KeepChunkMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KeepChunkMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().KeepChunk.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKeepId sets the "keepId" field.
+func (m *KeepChunkMutation) SetKeepId(s string) {
+	m.keepId = &s
+}
+
+// KeepId returns the value of the "keepId" field in the mutation.
+func (m *KeepChunkMutation) KeepId() (r string, exists bool) {
+	v := m.keepId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeepId returns the old "keepId" field's value of the KeepChunk entity.
+// If the KeepChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepChunkMutation) OldKeepId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepId: %w", err)
+	}
+	return oldValue.KeepId, nil
+}
+
+// ResetKeepId resets all changes to the "keepId" field.
+func (m *KeepChunkMutation) ResetKeepId() {
+	m.keepId = nil
+}
+
+// SetOrdinal sets the "ordinal" field.
+func (m *KeepChunkMutation) SetOrdinal(i int) {
+	m.ordinal = &i
+	m.addordinal = nil
+}
+
+// Ordinal returns the value of the "ordinal" field in the mutation.
+func (m *KeepChunkMutation) Ordinal() (r int, exists bool) {
+	v := m.ordinal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrdinal returns the old "ordinal" field's value of the KeepChunk entity.
+// If the KeepChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepChunkMutation) OldOrdinal(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrdinal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrdinal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrdinal: %w", err)
+	}
+	return oldValue.Ordinal, nil
+}
+
+// AddOrdinal adds i to the "ordinal" field.
+func (m *KeepChunkMutation) AddOrdinal(i int) {
+	if m.addordinal != nil {
+		*m.addordinal += i
+	} else {
+		m.addordinal = &i
+	}
+}
+
+// AddedOrdinal returns the value that was added to the "ordinal" field in this mutation.
+func (m *KeepChunkMutation) AddedOrdinal() (r int, exists bool) {
+	v := m.addordinal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOrdinal resets all changes to the "ordinal" field.
+func (m *KeepChunkMutation) ResetOrdinal() {
+	m.ordinal = nil
+	m.addordinal = nil
+}
+
+// SetHeading sets the "heading" field.
+func (m *KeepChunkMutation) SetHeading(s string) {
+	m.heading = &s
+}
+
+// Heading returns the value of the "heading" field in the mutation.
+func (m *KeepChunkMutation) Heading() (r string, exists bool) {
+	v := m.heading
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeading returns the old "heading" field's value of the KeepChunk entity.
+// If the KeepChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepChunkMutation) OldHeading(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeading is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeading requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeading: %w", err)
+	}
+	return oldValue.Heading, nil
+}
+
+// ResetHeading resets all changes to the "heading" field.
+func (m *KeepChunkMutation) ResetHeading() {
+	m.heading = nil
+}
+
+// SetContent sets the "content" field.
+func (m *KeepChunkMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *KeepChunkMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the KeepChunk entity.
+// If the KeepChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepChunkMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *KeepChunkMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetStart sets the "start" field.
+func (m *KeepChunkMutation) SetStart(i int) {
+	m.start = &i
+	m.addstart = nil
+}
+
+// Start returns the value of the "start" field in the mutation.
+func (m *KeepChunkMutation) Start() (r int, exists bool) {
+	v := m.start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStart returns the old "start" field's value of the KeepChunk entity.
+// If the KeepChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepChunkMutation) OldStart(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStart: %w", err)
+	}
+	return oldValue.Start, nil
+}
+
+// AddStart adds i to the "start" field.
+func (m *KeepChunkMutation) AddStart(i int) {
+	if m.addstart != nil {
+		*m.addstart += i
+	} else {
+		m.addstart = &i
+	}
+}
+
+// AddedStart returns the value that was added to the "start" field in this mutation.
+func (m *KeepChunkMutation) AddedStart() (r int, exists bool) {
+	v := m.addstart
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStart resets all changes to the "start" field.
+func (m *KeepChunkMutation) ResetStart() {
+	m.start = nil
+	m.addstart = nil
+}
+
+// SetEnd sets the "end" field.
+func (m *KeepChunkMutation) SetEnd(i int) {
+	m.end = &i
+	m.addend = nil
+}
+
+// End returns the value of the "end" field in the mutation.
+func (m *KeepChunkMutation) End() (r int, exists bool) {
+	v := m.end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnd returns the old "end" field's value of the KeepChunk entity.
+// If the KeepChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepChunkMutation) OldEnd(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnd: %w", err)
+	}
+	return oldValue.End, nil
+}
+
+// AddEnd adds i to the "end" field.
+func (m *KeepChunkMutation) AddEnd(i int) {
+	if m.addend != nil {
+		*m.addend += i
+	} else {
+		m.addend = &i
+	}
+}
+
+// AddedEnd returns the value that was added to the "end" field in this mutation.
+func (m *KeepChunkMutation) AddedEnd() (r int, exists bool) {
+	v := m.addend
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEnd resets all changes to the "end" field.
+func (m *KeepChunkMutation) ResetEnd() {
+	m.end = nil
+	m.addend = nil
+}
+
+// SetContentHash sets the "contentHash" field.
+func (m *KeepChunkMutation) SetContentHash(s string) {
+	m.contentHash = &s
+}
+
+// ContentHash returns the value of the "contentHash" field in the mutation.
+func (m *KeepChunkMutation) ContentHash() (r string, exists bool) {
+	v := m.contentHash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHash returns the old "contentHash" field's value of the KeepChunk entity.
+// If the KeepChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepChunkMutation) OldContentHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
+}
+
+// ResetContentHash resets all changes to the "contentHash" field.
+func (m *KeepChunkMutation) ResetContentHash() {
+	m.contentHash = nil
+}
+
+// SetVector sets the "vector" field.
+func (m *KeepChunkMutation) SetVector(jm json.RawMessage) {
+	m.vector = &jm
+	m.appendvector = nil
+}
+
+// Vector returns the value of the "vector" field in the mutation.
+func (m *KeepChunkMutation) Vector() (r json.RawMessage, exists bool) {
+	v := m.vector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVector returns the old "vector" field's value of the KeepChunk entity.
+// If the KeepChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepChunkMutation) OldVector(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVector: %w", err)
+	}
+	return oldValue.Vector, nil
+}
+
+// AppendVector adds jm to the "vector" field.
+func (m *KeepChunkMutation) AppendVector(jm json.RawMessage) {
+	m.appendvector = append(m.appendvector, jm...)
+}
+
+// AppendedVector returns the list of values that were appended to the "vector" field in this mutation.
+func (m *KeepChunkMutation) AppendedVector() (json.RawMessage, bool) {
+	if len(m.appendvector) == 0 {
+		return nil, false
+	}
+	return m.appendvector, true
+}
+
+// ClearVector clears the value of the "vector" field.
+func (m *KeepChunkMutation) ClearVector() {
+	m.vector = nil
+	m.appendvector = nil
+	m.clearedFields[keepchunk.FieldVector] = struct{}{}
+}
+
+// VectorCleared returns if the "vector" field was cleared in this mutation.
+func (m *KeepChunkMutation) VectorCleared() bool {
+	_, ok := m.clearedFields[keepchunk.FieldVector]
+	return ok
+}
+
+// ResetVector resets all changes to the "vector" field.
+func (m *KeepChunkMutation) ResetVector() {
+	m.vector = nil
+	m.appendvector = nil
+	delete(m.clearedFields, keepchunk.FieldVector)
+}
+
+// SetKeepUpdatedAt sets the "keepUpdatedAt" field.
+func (m *KeepChunkMutation) SetKeepUpdatedAt(t time.Time) {
+	m.keepUpdatedAt = &t
+}
+
+// KeepUpdatedAt returns the value of the "keepUpdatedAt" field in the mutation.
+func (m *KeepChunkMutation) KeepUpdatedAt() (r time.Time, exists bool) {
+	v := m.keepUpdatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeepUpdatedAt returns the old "keepUpdatedAt" field's value of the KeepChunk entity.
+// If the KeepChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepChunkMutation) OldKeepUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepUpdatedAt: %w", err)
+	}
+	return oldValue.KeepUpdatedAt, nil
+}
+
+// ResetKeepUpdatedAt resets all changes to the "keepUpdatedAt" field.
+func (m *KeepChunkMutation) ResetKeepUpdatedAt() {
+	m.keepUpdatedAt = nil
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *KeepChunkMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *KeepChunkMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the KeepChunk entity.
+// If the KeepChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepChunkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *KeepChunkMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *KeepChunkMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *KeepChunkMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the KeepChunk entity.
+// If the KeepChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeepChunkMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *KeepChunkMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// Where appends a list predicates to the KeepChunkMutation builder.
+func (m *KeepChunkMutation) Where(ps ...predicate.KeepChunk) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KeepChunkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KeepChunkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KeepChunk, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KeepChunkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KeepChunkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (KeepChunk).
+func (m *KeepChunkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KeepChunkMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.keepId != nil {
+		fields = append(fields, keepchunk.FieldKeepId)
+	}
+	if m.ordinal != nil {
+		fields = append(fields, keepchunk.FieldOrdinal)
+	}
+	if m.heading != nil {
+		fields = append(fields, keepchunk.FieldHeading)
+	}
+	if m.content != nil {
+		fields = append(fields, keepchunk.FieldContent)
+	}
+	if m.start != nil {
+		fields = append(fields, keepchunk.FieldStart)
+	}
+	if m.end != nil {
+		fields = append(fields, keepchunk.FieldEnd)
+	}
+	if m.contentHash != nil {
+		fields = append(fields, keepchunk.FieldContentHash)
+	}
+	if m.vector != nil {
+		fields = append(fields, keepchunk.FieldVector)
+	}
+	if m.keepUpdatedAt != nil {
+		fields = append(fields, keepchunk.FieldKeepUpdatedAt)
+	}
+	if m.createdAt != nil {
+		fields = append(fields, keepchunk.FieldCreatedAt)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, keepchunk.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KeepChunkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case keepchunk.FieldKeepId:
+		return m.KeepId()
+	case keepchunk.FieldOrdinal:
+		return m.Ordinal()
+	case keepchunk.FieldHeading:
+		return m.Heading()
+	case keepchunk.FieldContent:
+		return m.Content()
+	case keepchunk.FieldStart:
+		return m.Start()
+	case keepchunk.FieldEnd:
+		return m.End()
+	case keepchunk.FieldContentHash:
+		return m.ContentHash()
+	case keepchunk.FieldVector:
+		return m.Vector()
+	case keepchunk.FieldKeepUpdatedAt:
+		return m.KeepUpdatedAt()
+	case keepchunk.FieldCreatedAt:
+		return m.CreatedAt()
+	case keepchunk.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KeepChunkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case keepchunk.FieldKeepId:
+		return m.OldKeepId(ctx)
+	case keepchunk.FieldOrdinal:
+		return m.OldOrdinal(ctx)
+	case keepchunk.FieldHeading:
+		return m.OldHeading(ctx)
+	case keepchunk.FieldContent:
+		return m.OldContent(ctx)
+	case keepchunk.FieldStart:
+		return m.OldStart(ctx)
+	case keepchunk.FieldEnd:
+		return m.OldEnd(ctx)
+	case keepchunk.FieldContentHash:
+		return m.OldContentHash(ctx)
+	case keepchunk.FieldVector:
+		return m.OldVector(ctx)
+	case keepchunk.FieldKeepUpdatedAt:
+		return m.OldKeepUpdatedAt(ctx)
+	case keepchunk.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case keepchunk.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown KeepChunk field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeepChunkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case keepchunk.FieldKeepId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepId(v)
+		return nil
+	case keepchunk.FieldOrdinal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrdinal(v)
+		return nil
+	case keepchunk.FieldHeading:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeading(v)
+		return nil
+	case keepchunk.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case keepchunk.FieldStart:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStart(v)
+		return nil
+	case keepchunk.FieldEnd:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnd(v)
+		return nil
+	case keepchunk.FieldContentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
+		return nil
+	case keepchunk.FieldVector:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVector(v)
+		return nil
+	case keepchunk.FieldKeepUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepUpdatedAt(v)
+		return nil
+	case keepchunk.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case keepchunk.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeepChunk field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KeepChunkMutation) AddedFields() []string {
+	var fields []string
+	if m.addordinal != nil {
+		fields = append(fields, keepchunk.FieldOrdinal)
+	}
+	if m.addstart != nil {
+		fields = append(fields, keepchunk.FieldStart)
+	}
+	if m.addend != nil {
+		fields = append(fields, keepchunk.FieldEnd)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KeepChunkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case keepchunk.FieldOrdinal:
+		return m.AddedOrdinal()
+	case keepchunk.FieldStart:
+		return m.AddedStart()
+	case keepchunk.FieldEnd:
+		return m.AddedEnd()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeepChunkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case keepchunk.FieldOrdinal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrdinal(v)
+		return nil
+	case keepchunk.FieldStart:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStart(v)
+		return nil
+	case keepchunk.FieldEnd:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEnd(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeepChunk numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KeepChunkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(keepchunk.FieldVector) {
+		fields = append(fields, keepchunk.FieldVector)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KeepChunkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KeepChunkMutation) ClearField(name string) error {
+	switch name {
+	case keepchunk.FieldVector:
+		m.ClearVector()
+		return nil
+	}
+	return fmt.Errorf("unknown KeepChunk nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KeepChunkMutation) ResetField(name string) error {
+	switch name {
+	case keepchunk.FieldKeepId:
+		m.ResetKeepId()
+		return nil
+	case keepchunk.FieldOrdinal:
+		m.ResetOrdinal()
+		return nil
+	case keepchunk.FieldHeading:
+		m.ResetHeading()
+		return nil
+	case keepchunk.FieldContent:
+		m.ResetContent()
+		return nil
+	case keepchunk.FieldStart:
+		m.ResetStart()
+		return nil
+	case keepchunk.FieldEnd:
+		m.ResetEnd()
+		return nil
+	case keepchunk.FieldContentHash:
+		m.ResetContentHash()
+		return nil
+	case keepchunk.FieldVector:
+		m.ResetVector()
+		return nil
+	case keepchunk.FieldKeepUpdatedAt:
+		m.ResetKeepUpdatedAt()
+		return nil
+	case keepchunk.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case keepchunk.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown KeepChunk field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KeepChunkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KeepChunkMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KeepChunkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KeepChunkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KeepChunkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KeepChunkMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KeepChunkMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown KeepChunk unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KeepChunkMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown KeepChunk edge %s", name)
+}
+
+// MindmapMutation represents an operation that mutates the Mindmap nodes in the graph.
+type MindmapMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *string
+	title           *string
+	content         *json.RawMessage
+	appendcontent   json.RawMessage
+	isPublic        *bool
+	tags            *json.RawMessage
+	appendtags      json.RawMessage
+	createdAt       *time.Time
+	updatedAt       *time.Time
+	views           *int32
+	addviews        *int32
+	likes           *int32
+	addlikes        *int32
+	summary         *string
+	extraData       *json.RawMessage
+	appendextraData json.RawMessage
+	category        *string
+	clearedFields   map[string]struct{}
+	user            *string
+	cleareduser     bool
+	done            bool
+	oldValue        func(context.Context) (*Mindmap, error)
+	predicates      []predicate.Mindmap
+}
+
+var _ ent.Mutation = (*MindmapMutation)(nil)
+
+// mindmapOption allows management of the mutation configuration using functional options.
+type mindmapOption func(*MindmapMutation)
+
+// newMindmapMutation creates new mutation for the Mindmap entity.
+func newMindmapMutation(c config, op Op, opts ...mindmapOption) *MindmapMutation {
+	m := &MindmapMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMindmap,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMindmapID sets the ID field of the mutation.
+func withMindmapID(id string) mindmapOption {
+	return func(m *MindmapMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Mindmap
+		)
+		m.oldValue = func(ctx context.Context) (*Mindmap, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Mindmap.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMindmap sets the old Mindmap of the mutation.
+func withMindmap(node *Mindmap) mindmapOption {
+	return func(m *MindmapMutation) {
+		m.oldValue = func(context.Context) (*Mindmap, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MindmapMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MindmapMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Mindmap entities.
+func (m *MindmapMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MindmapMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MindmapMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Mindmap.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *MindmapMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *MindmapMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Mindmap entity.
+// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *MindmapMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetContent sets the "content" field.
+func (m *MindmapMutation) SetContent(jm json.RawMessage) {
+	m.content = &jm
+	m.appendcontent = nil
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *MindmapMutation) Content() (r json.RawMessage, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Mindmap entity.
+// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapMutation) OldContent(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// AppendContent adds jm to the "content" field.
+func (m *MindmapMutation) AppendContent(jm json.RawMessage) {
+	m.appendcontent = append(m.appendcontent, jm...)
+}
+
+// AppendedContent returns the list of values that were appended to the "content" field in this mutation.
+func (m *MindmapMutation) AppendedContent() (json.RawMessage, bool) {
+	if len(m.appendcontent) == 0 {
+		return nil, false
+	}
+	return m.appendcontent, true
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *MindmapMutation) ResetContent() {
+	m.content = nil
+	m.appendcontent = nil
+}
+
+// SetIsPublic sets the "isPublic" field.
+func (m *MindmapMutation) SetIsPublic(b bool) {
+	m.isPublic = &b
+}
+
+// IsPublic returns the value of the "isPublic" field in the mutation.
+func (m *MindmapMutation) IsPublic() (r bool, exists bool) {
+	v := m.isPublic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPublic returns the old "isPublic" field's value of the Mindmap entity.
+// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+	}
+	return oldValue.IsPublic, nil
+}
+
+// ResetIsPublic resets all changes to the "isPublic" field.
+func (m *MindmapMutation) ResetIsPublic() {
+	m.isPublic = nil
+}
+
+// SetTags sets the "tags" field.
+func (m *MindmapMutation) SetTags(jm json.RawMessage) {
+	m.tags = &jm
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *MindmapMutation) Tags() (r json.RawMessage, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Mindmap entity.
+// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapMutation) OldTags(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds jm to the "tags" field.
+func (m *MindmapMutation) AppendTags(jm json.RawMessage) {
+	m.appendtags = append(m.appendtags, jm...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *MindmapMutation) AppendedTags() (json.RawMessage, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *MindmapMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *MindmapMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *MindmapMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the Mindmap entity.
+// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *MindmapMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *MindmapMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *MindmapMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the Mindmap entity.
+// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *MindmapMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// SetOwnerId sets the "ownerId" field.
+func (m *MindmapMutation) SetOwnerId(s string) {
+	m.user = &s
+}
+
+// OwnerId returns the value of the "ownerId" field in the mutation.
+func (m *MindmapMutation) OwnerId() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerId returns the old "ownerId" field's value of the Mindmap entity.
+// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapMutation) OldOwnerId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerId: %w", err)
+	}
+	return oldValue.OwnerId, nil
+}
+
+// ClearOwnerId clears the value of the "ownerId" field.
+func (m *MindmapMutation) ClearOwnerId() {
+	m.user = nil
+	m.clearedFields[mindmap.FieldOwnerId] = struct{}{}
+}
+
+// OwnerIdCleared returns if the "ownerId" field was cleared in this mutation.
+func (m *MindmapMutation) OwnerIdCleared() bool {
+	_, ok := m.clearedFields[mindmap.FieldOwnerId]
+	return ok
+}
+
+// ResetOwnerId resets all changes to the "ownerId" field.
+func (m *MindmapMutation) ResetOwnerId() {
+	m.user = nil
+	delete(m.clearedFields, mindmap.FieldOwnerId)
+}
+
+// SetViews sets the "views" field.
+func (m *MindmapMutation) SetViews(i int32) {
+	m.views = &i
+	m.addviews = nil
+}
+
+// Views returns the value of the "views" field in the mutation.
+func (m *MindmapMutation) Views() (r int32, exists bool) {
+	v := m.views
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldViews returns the old "views" field's value of the Mindmap entity.
+// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapMutation) OldViews(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViews is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViews requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViews: %w", err)
+	}
+	return oldValue.Views, nil
+}
+
+// AddViews adds i to the "views" field.
+func (m *MindmapMutation) AddViews(i int32) {
+	if m.addviews != nil {
+		*m.addviews += i
+	} else {
+		m.addviews = &i
+	}
+}
+
+// AddedViews returns the value that was added to the "views" field in this mutation.
+func (m *MindmapMutation) AddedViews() (r int32, exists bool) {
+	v := m.addviews
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetViews resets all changes to the "views" field.
+func (m *MindmapMutation) ResetViews() {
+	m.views = nil
+	m.addviews = nil
+}
+
+// SetLikes sets the "likes" field.
+func (m *MindmapMutation) SetLikes(i int32) {
+	m.likes = &i
+	m.addlikes = nil
+}
+
+// Likes returns the value of the "likes" field in the mutation.
+func (m *MindmapMutation) Likes() (r int32, exists bool) {
+	v := m.likes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLikes returns the old "likes" field's value of the Mindmap entity.
+// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapMutation) OldLikes(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLikes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLikes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLikes: %w", err)
+	}
+	return oldValue.Likes, nil
+}
+
+// AddLikes adds i to the "likes" field.
+func (m *MindmapMutation) AddLikes(i int32) {
+	if m.addlikes != nil {
+		*m.addlikes += i
+	} else {
+		m.addlikes = &i
+	}
+}
+
+// AddedLikes returns the value that was added to the "likes" field in this mutation.
+func (m *MindmapMutation) AddedLikes() (r int32, exists bool) {
+	v := m.addlikes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLikes resets all changes to the "likes" field.
+func (m *MindmapMutation) ResetLikes() {
+	m.likes = nil
+	m.addlikes = nil
+}
+
+// SetSummary sets the "summary" field.
+func (m *MindmapMutation) SetSummary(s string) {
+	m.summary = &s
+}
+
+// Summary returns the value of the "summary" field in the mutation.
+func (m *MindmapMutation) Summary() (r string, exists bool) {
+	v := m.summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummary returns the old "summary" field's value of the Mindmap entity.
+// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapMutation) OldSummary(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+	}
+	return oldValue.Summary, nil
+}
+
+// ResetSummary resets all changes to the "summary" field.
+func (m *MindmapMutation) ResetSummary() {
+	m.summary = nil
+}
+
+// SetExtraData sets the "extraData" field.
+func (m *MindmapMutation) SetExtraData(jm json.RawMessage) {
+	m.extraData = &jm
+	m.appendextraData = nil
+}
+
+// ExtraData returns the value of the "extraData" field in the mutation.
+func (m *MindmapMutation) ExtraData() (r json.RawMessage, exists bool) {
+	v := m.extraData
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExtraData returns the old "extraData" field's value of the Mindmap entity.
+// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapMutation) OldExtraData(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExtraData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExtraData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExtraData: %w", err)
+	}
+	return oldValue.ExtraData, nil
+}
+
+// AppendExtraData adds jm to the "extraData" field.
+func (m *MindmapMutation) AppendExtraData(jm json.RawMessage) {
+	m.appendextraData = append(m.appendextraData, jm...)
+}
+
+// AppendedExtraData returns the list of values that were appended to the "extraData" field in this mutation.
+func (m *MindmapMutation) AppendedExtraData() (json.RawMessage, bool) {
+	if len(m.appendextraData) == 0 {
+		return nil, false
+	}
+	return m.appendextraData, true
+}
+
+// ResetExtraData resets all changes to the "extraData" field.
+func (m *MindmapMutation) ResetExtraData() {
+	m.extraData = nil
+	m.appendextraData = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *MindmapMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *MindmapMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the Mindmap entity.
+// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MindmapMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *MindmapMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *MindmapMutation) SetUserID(id string) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MindmapMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[mindmap.FieldOwnerId] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MindmapMutation) UserCleared() bool {
+	return m.OwnerIdCleared() || m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *MindmapMutation) UserID() (id string, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MindmapMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MindmapMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the MindmapMutation builder.
+func (m *MindmapMutation) Where(ps ...predicate.Mindmap) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MindmapMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MindmapMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Mindmap, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MindmapMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MindmapMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Mindmap).
+func (m *MindmapMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MindmapMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.title != nil {
+		fields = append(fields, mindmap.FieldTitle)
+	}
+	if m.content != nil {
+		fields = append(fields, mindmap.FieldContent)
+	}
+	if m.isPublic != nil {
+		fields = append(fields, mindmap.FieldIsPublic)
+	}
+	if m.tags != nil {
+		fields = append(fields, mindmap.FieldTags)
+	}
+	if m.createdAt != nil {
+		fields = append(fields, mindmap.FieldCreatedAt)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, mindmap.FieldUpdatedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, mindmap.FieldOwnerId)
+	}
+	if m.views != nil {
+		fields = append(fields, mindmap.FieldViews)
+	}
+	if m.likes != nil {
+		fields = append(fields, mindmap.FieldLikes)
+	}
+	if m.summary != nil {
+		fields = append(fields, mindmap.FieldSummary)
+	}
+	if m.extraData != nil {
+		fields = append(fields, mindmap.FieldExtraData)
+	}
+	if m.category != nil {
+		fields = append(fields, mindmap.FieldCategory)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MindmapMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mindmap.FieldTitle:
+		return m.Title()
+	case mindmap.FieldContent:
+		return m.Content()
+	case mindmap.FieldIsPublic:
+		return m.IsPublic()
+	case mindmap.FieldTags:
+		return m.Tags()
+	case mindmap.FieldCreatedAt:
+		return m.CreatedAt()
+	case mindmap.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case mindmap.FieldOwnerId:
+		return m.OwnerId()
+	case mindmap.FieldViews:
+		return m.Views()
+	case mindmap.FieldLikes:
+		return m.Likes()
+	case mindmap.FieldSummary:
+		return m.Summary()
+	case mindmap.FieldExtraData:
+		return m.ExtraData()
+	case mindmap.FieldCategory:
+		return m.Category()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MindmapMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mindmap.FieldTitle:
+		return m.OldTitle(ctx)
+	case mindmap.FieldContent:
+		return m.OldContent(ctx)
+	case mindmap.FieldIsPublic:
+		return m.OldIsPublic(ctx)
+	case mindmap.FieldTags:
+		return m.OldTags(ctx)
+	case mindmap.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case mindmap.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case mindmap.FieldOwnerId:
+		return m.OldOwnerId(ctx)
+	case mindmap.FieldViews:
+		return m.OldViews(ctx)
+	case mindmap.FieldLikes:
+		return m.OldLikes(ctx)
+	case mindmap.FieldSummary:
+		return m.OldSummary(ctx)
+	case mindmap.FieldExtraData:
+		return m.OldExtraData(ctx)
+	case mindmap.FieldCategory:
+		return m.OldCategory(ctx)
+	}
+	return nil, fmt.Errorf("unknown Mindmap field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MindmapMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mindmap.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case mindmap.FieldContent:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case mindmap.FieldIsPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPublic(v)
+		return nil
+	case mindmap.FieldTags:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case mindmap.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case mindmap.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case mindmap.FieldOwnerId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerId(v)
+		return nil
+	case mindmap.FieldViews:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViews(v)
+		return nil
+	case mindmap.FieldLikes:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLikes(v)
+		return nil
+	case mindmap.FieldSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummary(v)
+		return nil
+	case mindmap.FieldExtraData:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExtraData(v)
+		return nil
+	case mindmap.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Mindmap field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MindmapMutation) AddedFields() []string {
+	var fields []string
+	if m.addviews != nil {
+		fields = append(fields, mindmap.FieldViews)
+	}
+	if m.addlikes != nil {
+		fields = append(fields, mindmap.FieldLikes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MindmapMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case mindmap.FieldViews:
+		return m.AddedViews()
+	case mindmap.FieldLikes:
+		return m.AddedLikes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MindmapMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case mindmap.FieldViews:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddViews(v)
+		return nil
+	case mindmap.FieldLikes:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLikes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Mindmap numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MindmapMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(mindmap.FieldOwnerId) {
+		fields = append(fields, mindmap.FieldOwnerId)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MindmapMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MindmapMutation) ClearField(name string) error {
+	switch name {
+	case mindmap.FieldOwnerId:
+		m.ClearOwnerId()
+		return nil
+	}
+	return fmt.Errorf("unknown Mindmap nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MindmapMutation) ResetField(name string) error {
+	switch name {
+	case mindmap.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case mindmap.FieldContent:
+		m.ResetContent()
+		return nil
+	case mindmap.FieldIsPublic:
+		m.ResetIsPublic()
+		return nil
+	case mindmap.FieldTags:
+		m.ResetTags()
+		return nil
+	case mindmap.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case mindmap.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case mindmap.FieldOwnerId:
+		m.ResetOwnerId()
+		return nil
+	case mindmap.FieldViews:
+		m.ResetViews()
+		return nil
+	case mindmap.FieldLikes:
+		m.ResetLikes()
+		return nil
+	case mindmap.FieldSummary:
+		m.ResetSummary()
+		return nil
+	case mindmap.FieldExtraData:
+		m.ResetExtraData()
+		return nil
+	case mindmap.FieldCategory:
+		m.ResetCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown Mindmap field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MindmapMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, mindmap.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MindmapMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mindmap.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MindmapMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MindmapMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MindmapMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, mindmap.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MindmapMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mindmap.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MindmapMutation) ClearEdge(name string) error {
+	switch name {
+	case mindmap.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Mindmap unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MindmapMutation) ResetEdge(name string) error {
+	switch name {
+	case mindmap.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Mindmap edge %s", name)
+}
+
+// MomentMutation represents an operation that mutates the Moment nodes in the graph.
+type MomentMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *string
+	content              *string
+	category             *string
+	createdAt            *time.Time
+	updatedAt            *time.Time
+	isPublic             *bool
+	likes                *int32
+	addlikes             *int32
+	tags                 *json.RawMessage
+	appendtags           json.RawMessage
+	views                *int32
+	addviews             *int32
+	extraData            *json.RawMessage
+	appendextraData      json.RawMessage
+	content_vector       *json.RawMessage
+	appendcontent_vector json.RawMessage
+	clearedFields        map[string]struct{}
+	moment_images        map[uint]struct{}
+	removedmoment_images map[uint]struct{}
+	clearedmoment_images bool
+	moment_videos        map[uint]struct{}
+	removedmoment_videos map[uint]struct{}
+	clearedmoment_videos bool
+	user                 *string
+	cleareduser          bool
+	done                 bool
+	oldValue             func(context.Context) (*Moment, error)
+	predicates           []predicate.Moment
+}
+
+var _ ent.Mutation = (*MomentMutation)(nil)
+
+// momentOption allows management of the mutation configuration using functional options.
+type momentOption func(*MomentMutation)
+
+// newMomentMutation creates new mutation for the Moment entity.
+func newMomentMutation(c config, op Op, opts ...momentOption) *MomentMutation {
+	m := &MomentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMoment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMomentID sets the ID field of the mutation.
+func withMomentID(id string) momentOption {
+	return func(m *MomentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Moment
+		)
+		m.oldValue = func(ctx context.Context) (*Moment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Moment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMoment sets the old Moment of the mutation.
+func withMoment(node *Moment) momentOption {
+	return func(m *MomentMutation) {
+		m.oldValue = func(context.Context) (*Moment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MomentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MomentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Moment entities.
+func (m *MomentMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MomentMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MomentMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Moment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetContent sets the "content" field.
+func (m *MomentMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *MomentMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Moment entity.
+// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *MomentMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *MomentMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *MomentMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the Moment entity.
+// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *MomentMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *MomentMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *MomentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the Moment entity.
+// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *MomentMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *MomentMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *MomentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updatedAt" field's value of the Moment entity.
+// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *MomentMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
+}
+
+// SetOwnerId sets the "ownerId" field.
+func (m *MomentMutation) SetOwnerId(s string) {
+	m.user = &s
+}
+
+// OwnerId returns the value of the "ownerId" field in the mutation.
+func (m *MomentMutation) OwnerId() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerId returns the old "ownerId" field's value of the Moment entity.
+// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentMutation) OldOwnerId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerId: %w", err)
+	}
+	return oldValue.OwnerId, nil
+}
+
+// ClearOwnerId clears the value of the "ownerId" field.
+func (m *MomentMutation) ClearOwnerId() {
+	m.user = nil
+	m.clearedFields[moment.FieldOwnerId] = struct{}{}
+}
+
+// OwnerIdCleared returns if the "ownerId" field was cleared in this mutation.
+func (m *MomentMutation) OwnerIdCleared() bool {
+	_, ok := m.clearedFields[moment.FieldOwnerId]
+	return ok
+}
+
+// ResetOwnerId resets all changes to the "ownerId" field.
+func (m *MomentMutation) ResetOwnerId() {
+	m.user = nil
+	delete(m.clearedFields, moment.FieldOwnerId)
+}
+
+// SetIsPublic sets the "isPublic" field.
+func (m *MomentMutation) SetIsPublic(b bool) {
+	m.isPublic = &b
+}
+
+// IsPublic returns the value of the "isPublic" field in the mutation.
+func (m *MomentMutation) IsPublic() (r bool, exists bool) {
+	v := m.isPublic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPublic returns the old "isPublic" field's value of the Moment entity.
+// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+	}
+	return oldValue.IsPublic, nil
+}
+
+// ResetIsPublic resets all changes to the "isPublic" field.
+func (m *MomentMutation) ResetIsPublic() {
+	m.isPublic = nil
+}
+
+// SetLikes sets the "likes" field.
+func (m *MomentMutation) SetLikes(i int32) {
+	m.likes = &i
+	m.addlikes = nil
+}
+
+// Likes returns the value of the "likes" field in the mutation.
+func (m *MomentMutation) Likes() (r int32, exists bool) {
+	v := m.likes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLikes returns the old "likes" field's value of the Moment entity.
+// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentMutation) OldLikes(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLikes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLikes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLikes: %w", err)
+	}
+	return oldValue.Likes, nil
+}
+
+// AddLikes adds i to the "likes" field.
+func (m *MomentMutation) AddLikes(i int32) {
+	if m.addlikes != nil {
+		*m.addlikes += i
+	} else {
+		m.addlikes = &i
+	}
+}
+
+// AddedLikes returns the value that was added to the "likes" field in this mutation.
+func (m *MomentMutation) AddedLikes() (r int32, exists bool) {
+	v := m.addlikes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLikes resets all changes to the "likes" field.
+func (m *MomentMutation) ResetLikes() {
+	m.likes = nil
+	m.addlikes = nil
+}
+
+// SetTags sets the "tags" field.
+func (m *MomentMutation) SetTags(jm json.RawMessage) {
+	m.tags = &jm
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *MomentMutation) Tags() (r json.RawMessage, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Moment entity.
+// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentMutation) OldTags(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds jm to the "tags" field.
+func (m *MomentMutation) AppendTags(jm json.RawMessage) {
+	m.appendtags = append(m.appendtags, jm...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *MomentMutation) AppendedTags() (json.RawMessage, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *MomentMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+}
+
+// SetViews sets the "views" field.
+func (m *MomentMutation) SetViews(i int32) {
+	m.views = &i
+	m.addviews = nil
+}
+
+// Views returns the value of the "views" field in the mutation.
+func (m *MomentMutation) Views() (r int32, exists bool) {
+	v := m.views
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldViews returns the old "views" field's value of the Moment entity.
+// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentMutation) OldViews(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViews is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViews requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViews: %w", err)
+	}
+	return oldValue.Views, nil
+}
+
+// AddViews adds i to the "views" field.
+func (m *MomentMutation) AddViews(i int32) {
+	if m.addviews != nil {
+		*m.addviews += i
+	} else {
+		m.addviews = &i
+	}
+}
+
+// AddedViews returns the value that was added to the "views" field in this mutation.
+func (m *MomentMutation) AddedViews() (r int32, exists bool) {
+	v := m.addviews
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetViews resets all changes to the "views" field.
+func (m *MomentMutation) ResetViews() {
+	m.views = nil
+	m.addviews = nil
+}
+
+// SetExtraData sets the "extraData" field.
+func (m *MomentMutation) SetExtraData(jm json.RawMessage) {
+	m.extraData = &jm
+	m.appendextraData = nil
+}
+
+// ExtraData returns the value of the "extraData" field in the mutation.
+func (m *MomentMutation) ExtraData() (r json.RawMessage, exists bool) {
+	v := m.extraData
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExtraData returns the old "extraData" field's value of the Moment entity.
+// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentMutation) OldExtraData(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExtraData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExtraData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExtraData: %w", err)
+	}
+	return oldValue.ExtraData, nil
+}
+
+// AppendExtraData adds jm to the "extraData" field.
+func (m *MomentMutation) AppendExtraData(jm json.RawMessage) {
+	m.appendextraData = append(m.appendextraData, jm...)
+}
+
+// AppendedExtraData returns the list of values that were appended to the "extraData" field in this mutation.
+func (m *MomentMutation) AppendedExtraData() (json.RawMessage, bool) {
+	if len(m.appendextraData) == 0 {
+		return nil, false
+	}
+	return m.appendextraData, true
+}
+
+// ResetExtraData resets all changes to the "extraData" field.
+func (m *MomentMutation) ResetExtraData() {
+	m.extraData = nil
+	m.appendextraData = nil
+}
+
+// SetContentVector sets the "content_vector" field.
+func (m *MomentMutation) SetContentVector(jm json.RawMessage) {
+	m.content_vector = &jm
+	m.appendcontent_vector = nil
+}
+
+// ContentVector returns the value of the "content_vector" field in the mutation.
+func (m *MomentMutation) ContentVector() (r json.RawMessage, exists bool) {
+	v := m.content_vector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentVector returns the old "content_vector" field's value of the Moment entity.
+// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentMutation) OldContentVector(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentVector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentVector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentVector: %w", err)
+	}
+	return oldValue.ContentVector, nil
+}
+
+// AppendContentVector adds jm to the "content_vector" field.
+func (m *MomentMutation) AppendContentVector(jm json.RawMessage) {
+	m.appendcontent_vector = append(m.appendcontent_vector, jm...)
+}
+
+// AppendedContentVector returns the list of values that were appended to the "content_vector" field in this mutation.
+func (m *MomentMutation) AppendedContentVector() (json.RawMessage, bool) {
+	if len(m.appendcontent_vector) == 0 {
+		return nil, false
+	}
+	return m.appendcontent_vector, true
+}
+
+// ClearContentVector clears the value of the "content_vector" field.
+func (m *MomentMutation) ClearContentVector() {
+	m.content_vector = nil
+	m.appendcontent_vector = nil
+	m.clearedFields[moment.FieldContentVector] = struct{}{}
+}
+
+// ContentVectorCleared returns if the "content_vector" field was cleared in this mutation.
+func (m *MomentMutation) ContentVectorCleared() bool {
+	_, ok := m.clearedFields[moment.FieldContentVector]
+	return ok
+}
+
+// ResetContentVector resets all changes to the "content_vector" field.
+func (m *MomentMutation) ResetContentVector() {
+	m.content_vector = nil
+	m.appendcontent_vector = nil
+	delete(m.clearedFields, moment.FieldContentVector)
+}
+
+// AddMomentImageIDs adds the "moment_images" edge to the MomentImage entity by ids.
+func (m *MomentMutation) AddMomentImageIDs(ids ...uint) {
+	if m.moment_images == nil {
+		m.moment_images = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.moment_images[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMomentImages clears the "moment_images" edge to the MomentImage entity.
+func (m *MomentMutation) ClearMomentImages() {
+	m.clearedmoment_images = true
+}
+
+// MomentImagesCleared reports if the "moment_images" edge to the MomentImage entity was cleared.
+func (m *MomentMutation) MomentImagesCleared() bool {
+	return m.clearedmoment_images
+}
+
+// RemoveMomentImageIDs removes the "moment_images" edge to the MomentImage entity by IDs.
+func (m *MomentMutation) RemoveMomentImageIDs(ids ...uint) {
+	if m.removedmoment_images == nil {
+		m.removedmoment_images = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.moment_images, ids[i])
+		m.removedmoment_images[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMomentImages returns the removed IDs of the "moment_images" edge to the MomentImage entity.
+func (m *MomentMutation) RemovedMomentImagesIDs() (ids []uint) {
+	for id := range m.removedmoment_images {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MomentImagesIDs returns the "moment_images" edge IDs in the mutation.
+func (m *MomentMutation) MomentImagesIDs() (ids []uint) {
+	for id := range m.moment_images {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMomentImages resets all changes to the "moment_images" edge.
+func (m *MomentMutation) ResetMomentImages() {
+	m.moment_images = nil
+	m.clearedmoment_images = false
+	m.removedmoment_images = nil
+}
+
+// AddMomentVideoIDs adds the "moment_videos" edge to the MomentVideo entity by ids.
+func (m *MomentMutation) AddMomentVideoIDs(ids ...uint) {
+	if m.moment_videos == nil {
+		m.moment_videos = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.moment_videos[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMomentVideos clears the "moment_videos" edge to the MomentVideo entity.
+func (m *MomentMutation) ClearMomentVideos() {
+	m.clearedmoment_videos = true
+}
+
+// MomentVideosCleared reports if the "moment_videos" edge to the MomentVideo entity was cleared.
+func (m *MomentMutation) MomentVideosCleared() bool {
+	return m.clearedmoment_videos
+}
+
+// RemoveMomentVideoIDs removes the "moment_videos" edge to the MomentVideo entity by IDs.
+func (m *MomentMutation) RemoveMomentVideoIDs(ids ...uint) {
+	if m.removedmoment_videos == nil {
+		m.removedmoment_videos = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.moment_videos, ids[i])
+		m.removedmoment_videos[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMomentVideos returns the removed IDs of the "moment_videos" edge to the MomentVideo entity.
+func (m *MomentMutation) RemovedMomentVideosIDs() (ids []uint) {
+	for id := range m.removedmoment_videos {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MomentVideosIDs returns the "moment_videos" edge IDs in the mutation.
+func (m *MomentMutation) MomentVideosIDs() (ids []uint) {
+	for id := range m.moment_videos {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMomentVideos resets all changes to the "moment_videos" edge.
+func (m *MomentMutation) ResetMomentVideos() {
+	m.moment_videos = nil
+	m.clearedmoment_videos = false
+	m.removedmoment_videos = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *MomentMutation) SetUserID(id string) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MomentMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[moment.FieldOwnerId] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MomentMutation) UserCleared() bool {
+	return m.OwnerIdCleared() || m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *MomentMutation) UserID() (id string, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MomentMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MomentMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the MomentMutation builder.
+func (m *MomentMutation) Where(ps ...predicate.Moment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MomentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MomentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Moment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MomentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MomentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Moment).
+func (m *MomentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MomentMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.content != nil {
+		fields = append(fields, moment.FieldContent)
+	}
+	if m.category != nil {
+		fields = append(fields, moment.FieldCategory)
+	}
+	if m.createdAt != nil {
+		fields = append(fields, moment.FieldCreatedAt)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, moment.FieldUpdatedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, moment.FieldOwnerId)
+	}
+	if m.isPublic != nil {
+		fields = append(fields, moment.FieldIsPublic)
+	}
+	if m.likes != nil {
+		fields = append(fields, moment.FieldLikes)
+	}
+	if m.tags != nil {
+		fields = append(fields, moment.FieldTags)
+	}
+	if m.views != nil {
+		fields = append(fields, moment.FieldViews)
 	}
 	if m.extraData != nil {
-		fields = append(fields, keep.FieldExtraData)
+		fields = append(fields, moment.FieldExtraData)
+	}
+	if m.content_vector != nil {
+		fields = append(fields, moment.FieldContentVector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MomentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case moment.FieldContent:
+		return m.Content()
+	case moment.FieldCategory:
+		return m.Category()
+	case moment.FieldCreatedAt:
+		return m.CreatedAt()
+	case moment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case moment.FieldOwnerId:
+		return m.OwnerId()
+	case moment.FieldIsPublic:
+		return m.IsPublic()
+	case moment.FieldLikes:
+		return m.Likes()
+	case moment.FieldTags:
+		return m.Tags()
+	case moment.FieldViews:
+		return m.Views()
+	case moment.FieldExtraData:
+		return m.ExtraData()
+	case moment.FieldContentVector:
+		return m.ContentVector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MomentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case moment.FieldContent:
+		return m.OldContent(ctx)
+	case moment.FieldCategory:
+		return m.OldCategory(ctx)
+	case moment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case moment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case moment.FieldOwnerId:
+		return m.OldOwnerId(ctx)
+	case moment.FieldIsPublic:
+		return m.OldIsPublic(ctx)
+	case moment.FieldLikes:
+		return m.OldLikes(ctx)
+	case moment.FieldTags:
+		return m.OldTags(ctx)
+	case moment.FieldViews:
+		return m.OldViews(ctx)
+	case moment.FieldExtraData:
+		return m.OldExtraData(ctx)
+	case moment.FieldContentVector:
+		return m.OldContentVector(ctx)
+	}
+	return nil, fmt.Errorf("unknown Moment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MomentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case moment.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case moment.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case moment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case moment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case moment.FieldOwnerId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerId(v)
+		return nil
+	case moment.FieldIsPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPublic(v)
+		return nil
+	case moment.FieldLikes:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLikes(v)
+		return nil
+	case moment.FieldTags:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case moment.FieldViews:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViews(v)
+		return nil
+	case moment.FieldExtraData:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExtraData(v)
+		return nil
+	case moment.FieldContentVector:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentVector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Moment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MomentMutation) AddedFields() []string {
+	var fields []string
+	if m.addlikes != nil {
+		fields = append(fields, moment.FieldLikes)
+	}
+	if m.addviews != nil {
+		fields = append(fields, moment.FieldViews)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MomentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case moment.FieldLikes:
+		return m.AddedLikes()
+	case moment.FieldViews:
+		return m.AddedViews()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MomentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case moment.FieldLikes:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLikes(v)
+		return nil
+	case moment.FieldViews:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddViews(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Moment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MomentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(moment.FieldOwnerId) {
+		fields = append(fields, moment.FieldOwnerId)
 	}
-	if m.content_vector != nil {
-		fields = append(fields, keep.FieldContentVector)
+	if m.FieldCleared(moment.FieldContentVector) {
+		fields = append(fields, moment.FieldContentVector)
 	}
-	if m.summary_vector != nil {
-		fields = append(fields, keep.FieldSummaryVector)
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MomentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MomentMutation) ClearField(name string) error {
+	switch name {
+	case moment.FieldOwnerId:
+		m.ClearOwnerId()
+		return nil
+	case moment.FieldContentVector:
+		m.ClearContentVector()
+		return nil
+	}
+	return fmt.Errorf("unknown Moment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MomentMutation) ResetField(name string) error {
+	switch name {
+	case moment.FieldContent:
+		m.ResetContent()
+		return nil
+	case moment.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case moment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case moment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case moment.FieldOwnerId:
+		m.ResetOwnerId()
+		return nil
+	case moment.FieldIsPublic:
+		m.ResetIsPublic()
+		return nil
+	case moment.FieldLikes:
+		m.ResetLikes()
+		return nil
+	case moment.FieldTags:
+		m.ResetTags()
+		return nil
+	case moment.FieldViews:
+		m.ResetViews()
+		return nil
+	case moment.FieldExtraData:
+		m.ResetExtraData()
+		return nil
+	case moment.FieldContentVector:
+		m.ResetContentVector()
+		return nil
+	}
+	return fmt.Errorf("unknown Moment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MomentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.moment_images != nil {
+		edges = append(edges, moment.EdgeMomentImages)
+	}
+	if m.moment_videos != nil {
+		edges = append(edges, moment.EdgeMomentVideos)
+	}
+	if m.user != nil {
+		edges = append(edges, moment.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MomentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case moment.EdgeMomentImages:
+		ids := make([]ent.Value, 0, len(m.moment_images))
+		for id := range m.moment_images {
+			ids = append(ids, id)
+		}
+		return ids
+	case moment.EdgeMomentVideos:
+		ids := make([]ent.Value, 0, len(m.moment_videos))
+		for id := range m.moment_videos {
+			ids = append(ids, id)
+		}
+		return ids
+	case moment.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MomentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedmoment_images != nil {
+		edges = append(edges, moment.EdgeMomentImages)
+	}
+	if m.removedmoment_videos != nil {
+		edges = append(edges, moment.EdgeMomentVideos)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MomentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case moment.EdgeMomentImages:
+		ids := make([]ent.Value, 0, len(m.removedmoment_images))
+		for id := range m.removedmoment_images {
+			ids = append(ids, id)
+		}
+		return ids
+	case moment.EdgeMomentVideos:
+		ids := make([]ent.Value, 0, len(m.removedmoment_videos))
+		for id := range m.removedmoment_videos {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MomentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedmoment_images {
+		edges = append(edges, moment.EdgeMomentImages)
+	}
+	if m.clearedmoment_videos {
+		edges = append(edges, moment.EdgeMomentVideos)
+	}
+	if m.cleareduser {
+		edges = append(edges, moment.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MomentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case moment.EdgeMomentImages:
+		return m.clearedmoment_images
+	case moment.EdgeMomentVideos:
+		return m.clearedmoment_videos
+	case moment.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MomentMutation) ClearEdge(name string) error {
+	switch name {
+	case moment.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Moment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MomentMutation) ResetEdge(name string) error {
+	switch name {
+	case moment.EdgeMomentImages:
+		m.ResetMomentImages()
+		return nil
+	case moment.EdgeMomentVideos:
+		m.ResetMomentVideos()
+		return nil
+	case moment.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Moment edge %s", name)
+}
+
+// MomentImageMutation represents an operation that mutates the MomentImage nodes in the graph.
+type MomentImageMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	sort          *int32
+	addsort       *int32
+	createdAt     *time.Time
+	updatedAt     *time.Time
+	clearedFields map[string]struct{}
+	image         *string
+	clearedimage  bool
+	moment        *string
+	clearedmoment bool
+	done          bool
+	oldValue      func(context.Context) (*MomentImage, error)
+	predicates    []predicate.MomentImage
+}
+
+var _ ent.Mutation = (*MomentImageMutation)(nil)
+
+// momentimageOption allows management of the mutation configuration using functional options.
+type momentimageOption func(*MomentImageMutation)
+
+// newMomentImageMutation creates new mutation for the MomentImage entity.
+func newMomentImageMutation(c config, op Op, opts ...momentimageOption) *MomentImageMutation {
+	m := &MomentImageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMomentImage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMomentImageID sets the ID field of the mutation.
+func withMomentImageID(id uint) momentimageOption {
+	return func(m *MomentImageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MomentImage
+		)
+		m.oldValue = func(ctx context.Context) (*MomentImage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MomentImage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.title_vector != nil {
-		fields = append(fields, keep.FieldTitleVector)
+}
+
+// withMomentImage sets the old MomentImage of the mutation.
+func withMomentImage(node *MomentImage) momentimageOption {
+	return func(m *MomentImageMutation) {
+		m.oldValue = func(context.Context) (*MomentImage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *KeepMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case keep.FieldTitle:
-		return m.Title()
-	case keep.FieldContent:
-		return m.Content()
-	case keep.FieldIsPublic:
-		return m.IsPublic()
-	case keep.FieldTags:
-		return m.Tags()
-	case keep.FieldCreatedAt:
-		return m.CreatedAt()
-	case keep.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case keep.FieldOwnerId:
-		return m.OwnerId()
-	case keep.FieldCategory:
-		return m.Category()
-	case keep.FieldViews:
-		return m.Views()
-	case keep.FieldLikes:
-		return m.Likes()
-	case keep.FieldSummary:
-		return m.Summary()
-	case keep.FieldExtraData:
-		return m.ExtraData()
-	case keep.FieldContentVector:
-		return m.ContentVector()
-	case keep.FieldSummaryVector:
-		return m.SummaryVector()
-	case keep.FieldTitleVector:
-		return m.TitleVector()
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MomentImageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MomentImageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return nil, false
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *KeepMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case keep.FieldTitle:
-		return m.OldTitle(ctx)
-	case keep.FieldContent:
-		return m.OldContent(ctx)
-	case keep.FieldIsPublic:
-		return m.OldIsPublic(ctx)
-	case keep.FieldTags:
-		return m.OldTags(ctx)
-	case keep.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case keep.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case keep.FieldOwnerId:
-		return m.OldOwnerId(ctx)
-	case keep.FieldCategory:
-		return m.OldCategory(ctx)
-	case keep.FieldViews:
-		return m.OldViews(ctx)
-	case keep.FieldLikes:
-		return m.OldLikes(ctx)
-	case keep.FieldSummary:
-		return m.OldSummary(ctx)
-	case keep.FieldExtraData:
-		return m.OldExtraData(ctx)
-	case keep.FieldContentVector:
-		return m.OldContentVector(ctx)
-	case keep.FieldSummaryVector:
-		return m.OldSummaryVector(ctx)
-	case keep.FieldTitleVector:
-		return m.OldTitleVector(ctx)
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MomentImage entities.
+func (m *MomentImageMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MomentImageMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Keep field %s", name)
+	return *m.id, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *KeepMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case keep.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case keep.FieldContent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContent(v)
-		return nil
-	case keep.FieldIsPublic:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsPublic(v)
-		return nil
-	case keep.FieldTags:
-		v, ok := value.(json.RawMessage)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTags(v)
-		return nil
-	case keep.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case keep.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case keep.FieldOwnerId:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwnerId(v)
-		return nil
-	case keep.FieldCategory:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCategory(v)
-		return nil
-	case keep.FieldViews:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetViews(v)
-		return nil
-	case keep.FieldLikes:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLikes(v)
-		return nil
-	case keep.FieldSummary:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSummary(v)
-		return nil
-	case keep.FieldExtraData:
-		v, ok := value.(json.RawMessage)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExtraData(v)
-		return nil
-	case keep.FieldContentVector:
-		v, ok := value.(json.RawMessage)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContentVector(v)
-		return nil
-	case keep.FieldSummaryVector:
-		v, ok := value.(json.RawMessage)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSummaryVector(v)
-		return nil
-	case keep.FieldTitleVector:
-		v, ok := value.(json.RawMessage)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MomentImageMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
 		}
-		m.SetTitleVector(v)
-		return nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MomentImage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return fmt.Errorf("unknown Keep field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *KeepMutation) AddedFields() []string {
-	var fields []string
-	if m.addviews != nil {
-		fields = append(fields, keep.FieldViews)
+// SetImageId sets the "imageId" field.
+func (m *MomentImageMutation) SetImageId(s string) {
+	m.image = &s
+}
+
+// ImageId returns the value of the "imageId" field in the mutation.
+func (m *MomentImageMutation) ImageId() (r string, exists bool) {
+	v := m.image
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageId returns the old "imageId" field's value of the MomentImage entity.
+// If the MomentImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentImageMutation) OldImageId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageId is only allowed on UpdateOne operations")
 	}
-	if m.addlikes != nil {
-		fields = append(fields, keep.FieldLikes)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageId requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageId: %w", err)
+	}
+	return oldValue.ImageId, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *KeepMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case keep.FieldViews:
-		return m.AddedViews()
-	case keep.FieldLikes:
-		return m.AddedLikes()
+// ClearImageId clears the value of the "imageId" field.
+func (m *MomentImageMutation) ClearImageId() {
+	m.image = nil
+	m.clearedFields[momentimage.FieldImageId] = struct{}{}
+}
+
+// ImageIdCleared returns if the "imageId" field was cleared in this mutation.
+func (m *MomentImageMutation) ImageIdCleared() bool {
+	_, ok := m.clearedFields[momentimage.FieldImageId]
+	return ok
+}
+
+// ResetImageId resets all changes to the "imageId" field.
+func (m *MomentImageMutation) ResetImageId() {
+	m.image = nil
+	delete(m.clearedFields, momentimage.FieldImageId)
+}
+
+// SetMomentId sets the "momentId" field.
+func (m *MomentImageMutation) SetMomentId(s string) {
+	m.moment = &s
+}
+
+// MomentId returns the value of the "momentId" field in the mutation.
+func (m *MomentImageMutation) MomentId() (r string, exists bool) {
+	v := m.moment
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *KeepMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case keep.FieldViews:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddViews(v)
-		return nil
-	case keep.FieldLikes:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLikes(v)
-		return nil
+// OldMomentId returns the old "momentId" field's value of the MomentImage entity.
+// If the MomentImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentImageMutation) OldMomentId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMomentId is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Keep numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMomentId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMomentId: %w", err)
+	}
+	return oldValue.MomentId, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *KeepMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(keep.FieldOwnerId) {
-		fields = append(fields, keep.FieldOwnerId)
+// ClearMomentId clears the value of the "momentId" field.
+func (m *MomentImageMutation) ClearMomentId() {
+	m.moment = nil
+	m.clearedFields[momentimage.FieldMomentId] = struct{}{}
+}
+
+// MomentIdCleared returns if the "momentId" field was cleared in this mutation.
+func (m *MomentImageMutation) MomentIdCleared() bool {
+	_, ok := m.clearedFields[momentimage.FieldMomentId]
+	return ok
+}
+
+// ResetMomentId resets all changes to the "momentId" field.
+func (m *MomentImageMutation) ResetMomentId() {
+	m.moment = nil
+	delete(m.clearedFields, momentimage.FieldMomentId)
+}
+
+// SetSort sets the "sort" field.
+func (m *MomentImageMutation) SetSort(i int32) {
+	m.sort = &i
+	m.addsort = nil
+}
+
+// Sort returns the value of the "sort" field in the mutation.
+func (m *MomentImageMutation) Sort() (r int32, exists bool) {
+	v := m.sort
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(keep.FieldContentVector) {
-		fields = append(fields, keep.FieldContentVector)
+	return *v, true
+}
+
+// OldSort returns the old "sort" field's value of the MomentImage entity.
+// If the MomentImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentImageMutation) OldSort(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSort is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(keep.FieldSummaryVector) {
-		fields = append(fields, keep.FieldSummaryVector)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSort requires an ID field in the mutation")
 	}
-	if m.FieldCleared(keep.FieldTitleVector) {
-		fields = append(fields, keep.FieldTitleVector)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSort: %w", err)
 	}
-	return fields
+	return oldValue.Sort, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *KeepMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AddSort adds i to the "sort" field.
+func (m *MomentImageMutation) AddSort(i int32) {
+	if m.addsort != nil {
+		*m.addsort += i
+	} else {
+		m.addsort = &i
+	}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *KeepMutation) ClearField(name string) error {
-	switch name {
-	case keep.FieldOwnerId:
-		m.ClearOwnerId()
-		return nil
-	case keep.FieldContentVector:
-		m.ClearContentVector()
-		return nil
-	case keep.FieldSummaryVector:
-		m.ClearSummaryVector()
-		return nil
-	case keep.FieldTitleVector:
-		m.ClearTitleVector()
-		return nil
+// AddedSort returns the value that was added to the "sort" field in this mutation.
+func (m *MomentImageMutation) AddedSort() (r int32, exists bool) {
+	v := m.addsort
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Keep nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *KeepMutation) ResetField(name string) error {
-	switch name {
-	case keep.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case keep.FieldContent:
-		m.ResetContent()
-		return nil
-	case keep.FieldIsPublic:
-		m.ResetIsPublic()
-		return nil
-	case keep.FieldTags:
-		m.ResetTags()
-		return nil
-	case keep.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case keep.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case keep.FieldOwnerId:
-		m.ResetOwnerId()
-		return nil
-	case keep.FieldCategory:
-		m.ResetCategory()
-		return nil
-	case keep.FieldViews:
-		m.ResetViews()
-		return nil
-	case keep.FieldLikes:
-		m.ResetLikes()
-		return nil
-	case keep.FieldSummary:
-		m.ResetSummary()
-		return nil
-	case keep.FieldExtraData:
-		m.ResetExtraData()
-		return nil
-	case keep.FieldContentVector:
-		m.ResetContentVector()
-		return nil
-	case keep.FieldSummaryVector:
-		m.ResetSummaryVector()
-		return nil
-	case keep.FieldTitleVector:
-		m.ResetTitleVector()
-		return nil
+// ResetSort resets all changes to the "sort" field.
+func (m *MomentImageMutation) ResetSort() {
+	m.sort = nil
+	m.addsort = nil
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *MomentImageMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *MomentImageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the MomentImage entity.
+// If the MomentImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentImageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Keep field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *KeepMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, keep.EdgeUser)
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *MomentImageMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *MomentImageMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
+}
+
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *MomentImageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *KeepMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case keep.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
+// OldUpdatedAt returns the old "updatedAt" field's value of the MomentImage entity.
+// If the MomentImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MomentImageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *KeepMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *MomentImageMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *KeepMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetImageID sets the "image" edge to the Image entity by id.
+func (m *MomentImageMutation) SetImageID(id string) {
+	m.image = &id
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *KeepMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, keep.EdgeUser)
-	}
-	return edges
+// ClearImage clears the "image" edge to the Image entity.
+func (m *MomentImageMutation) ClearImage() {
+	m.clearedimage = true
+	m.clearedFields[momentimage.FieldImageId] = struct{}{}
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *KeepMutation) EdgeCleared(name string) bool {
-	switch name {
-	case keep.EdgeUser:
-		return m.cleareduser
-	}
-	return false
+// ImageCleared reports if the "image" edge to the Image entity was cleared.
+func (m *MomentImageMutation) ImageCleared() bool {
+	return m.ImageIdCleared() || m.clearedimage
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *KeepMutation) ClearEdge(name string) error {
-	switch name {
-	case keep.EdgeUser:
-		m.ClearUser()
-		return nil
+// ImageID returns the "image" edge ID in the mutation.
+func (m *MomentImageMutation) ImageID() (id string, exists bool) {
+	if m.image != nil {
+		return *m.image, true
 	}
-	return fmt.Errorf("unknown Keep unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *KeepMutation) ResetEdge(name string) error {
-	switch name {
-	case keep.EdgeUser:
-		m.ResetUser()
-		return nil
+// ImageIDs returns the "image" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ImageID instead. It exists only for internal usage by the builders.
+func (m *MomentImageMutation) ImageIDs() (ids []string) {
+	if id := m.image; id != nil {
+		ids = append(ids, *id)
 	}
-	return fmt.Errorf("unknown Keep edge %s", name)
+	return
 }
 
-// MindmapMutation represents an operation that mutates the Mindmap nodes in the graph.
-type MindmapMutation struct {
-	config
-	op              Op
-	typ             string
-	id              *string
-	title           *string
-	content         *json.RawMessage
-	appendcontent   json.RawMessage
-	isPublic        *bool
-	tags            *json.RawMessage
-	appendtags      json.RawMessage
-	createdAt       *time.Time
-	updatedAt       *time.Time
-	views           *int32
-	addviews        *int32
-	likes           *int32
-	addlikes        *int32
-	summary         *string
-	extraData       *json.RawMessage
-	appendextraData json.RawMessage
-	category        *string
-	clearedFields   map[string]struct{}
-	user            *string
-	cleareduser     bool
-	done            bool
-	oldValue        func(context.Context) (*Mindmap, error)
-	predicates      []predicate.Mindmap
+// ResetImage resets all changes to the "image" edge.
+func (m *MomentImageMutation) ResetImage() {
+	m.image = nil
+	m.clearedimage = false
 }
 
-var _ ent.Mutation = (*MindmapMutation)(nil)
+// SetMomentID sets the "moment" edge to the Moment entity by id.
+func (m *MomentImageMutation) SetMomentID(id string) {
+	m.moment = &id
+}
 
-// mindmapOption allows management of the mutation configuration using functional options.
-type mindmapOption func(*MindmapMutation)
+// ClearMoment clears the "moment" edge to the Moment entity.
+func (m *MomentImageMutation) ClearMoment() {
+	m.clearedmoment = true
+	m.clearedFields[momentimage.FieldMomentId] = struct{}{}
+}
 
-// newMindmapMutation creates new mutation for the Mindmap entity.
-func newMindmapMutation(c config, op Op, opts ...mindmapOption) *MindmapMutation {
-	m := &MindmapMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeMindmap,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// MomentCleared reports if the "moment" edge to the Moment entity was cleared.
+func (m *MomentImageMutation) MomentCleared() bool {
+	return m.MomentIdCleared() || m.clearedmoment
 }
 
-// withMindmapID sets the ID field of the mutation.
-func withMindmapID(id string) mindmapOption {
-	return func(m *MindmapMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Mindmap
-		)
-		m.oldValue = func(ctx context.Context) (*Mindmap, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Mindmap.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// MomentID returns the "moment" edge ID in the mutation.
+func (m *MomentImageMutation) MomentID() (id string, exists bool) {
+	if m.moment != nil {
+		return *m.moment, true
 	}
+	return
 }
 
-// withMindmap sets the old Mindmap of the mutation.
-func withMindmap(node *Mindmap) mindmapOption {
-	return func(m *MindmapMutation) {
-		m.oldValue = func(context.Context) (*Mindmap, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// MomentIDs returns the "moment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MomentID instead. It exists only for internal usage by the builders.
+func (m *MomentImageMutation) MomentIDs() (ids []string) {
+	if id := m.moment; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MindmapMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetMoment resets all changes to the "moment" edge.
+func (m *MomentImageMutation) ResetMoment() {
+	m.moment = nil
+	m.clearedmoment = false
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m MindmapMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// Where appends a list predicates to the MomentImageMutation builder.
+func (m *MomentImageMutation) Where(ps ...predicate.MomentImage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MomentImageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MomentImageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MomentImage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MomentImageMutation) Op() Op {
+	return m.op
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Mindmap entities.
-func (m *MindmapMutation) SetID(id string) {
-	m.id = &id
+// SetOp allows setting the mutation operation.
+func (m *MomentImageMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *MindmapMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// Type returns the node type of this mutation (MomentImage).
+func (m *MomentImageMutation) Type() string {
+	return m.typ
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *MindmapMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Mindmap.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MomentImageMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.image != nil {
+		fields = append(fields, momentimage.FieldImageId)
+	}
+	if m.moment != nil {
+		fields = append(fields, momentimage.FieldMomentId)
+	}
+	if m.sort != nil {
+		fields = append(fields, momentimage.FieldSort)
+	}
+	if m.createdAt != nil {
+		fields = append(fields, momentimage.FieldCreatedAt)
+	}
+	if m.updatedAt != nil {
+		fields = append(fields, momentimage.FieldUpdatedAt)
 	}
+	return fields
 }
 
-// SetTitle sets the "title" field.
-func (m *MindmapMutation) SetTitle(s string) {
-	m.title = &s
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MomentImageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case momentimage.FieldImageId:
+		return m.ImageId()
+	case momentimage.FieldMomentId:
+		return m.MomentId()
+	case momentimage.FieldSort:
+		return m.Sort()
+	case momentimage.FieldCreatedAt:
+		return m.CreatedAt()
+	case momentimage.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *MindmapMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MomentImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case momentimage.FieldImageId:
+		return m.OldImageId(ctx)
+	case momentimage.FieldMomentId:
+		return m.OldMomentId(ctx)
+	case momentimage.FieldSort:
+		return m.OldSort(ctx)
+	case momentimage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case momentimage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown MomentImage field %s", name)
 }
 
-// OldTitle returns the old "title" field's value of the Mindmap entity.
-// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MomentImageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case momentimage.FieldImageId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageId(v)
+		return nil
+	case momentimage.FieldMomentId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMomentId(v)
+		return nil
+	case momentimage.FieldSort:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSort(v)
+		return nil
+	case momentimage.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case momentimage.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return oldValue.Title, nil
+	return fmt.Errorf("unknown MomentImage field %s", name)
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *MindmapMutation) ResetTitle() {
-	m.title = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MomentImageMutation) AddedFields() []string {
+	var fields []string
+	if m.addsort != nil {
+		fields = append(fields, momentimage.FieldSort)
+	}
+	return fields
 }
 
-// SetContent sets the "content" field.
-func (m *MindmapMutation) SetContent(jm json.RawMessage) {
-	m.content = &jm
-	m.appendcontent = nil
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MomentImageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case momentimage.FieldSort:
+		return m.AddedSort()
+	}
+	return nil, false
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *MindmapMutation) Content() (r json.RawMessage, exists bool) {
-	v := m.content
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MomentImageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case momentimage.FieldSort:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSort(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown MomentImage numeric field %s", name)
 }
 
-// OldContent returns the old "content" field's value of the Mindmap entity.
-// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapMutation) OldContent(ctx context.Context) (v json.RawMessage, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MomentImageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(momentimage.FieldImageId) {
+		fields = append(fields, momentimage.FieldImageId)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+	if m.FieldCleared(momentimage.FieldMomentId) {
+		fields = append(fields, momentimage.FieldMomentId)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MomentImageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MomentImageMutation) ClearField(name string) error {
+	switch name {
+	case momentimage.FieldImageId:
+		m.ClearImageId()
+		return nil
+	case momentimage.FieldMomentId:
+		m.ClearMomentId()
+		return nil
 	}
-	return oldValue.Content, nil
+	return fmt.Errorf("unknown MomentImage nullable field %s", name)
 }
 
-// AppendContent adds jm to the "content" field.
-func (m *MindmapMutation) AppendContent(jm json.RawMessage) {
-	m.appendcontent = append(m.appendcontent, jm...)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MomentImageMutation) ResetField(name string) error {
+	switch name {
+	case momentimage.FieldImageId:
+		m.ResetImageId()
+		return nil
+	case momentimage.FieldMomentId:
+		m.ResetMomentId()
+		return nil
+	case momentimage.FieldSort:
+		m.ResetSort()
+		return nil
+	case momentimage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case momentimage.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MomentImage field %s", name)
 }
 
-// AppendedContent returns the list of values that were appended to the "content" field in this mutation.
-func (m *MindmapMutation) AppendedContent() (json.RawMessage, bool) {
-	if len(m.appendcontent) == 0 {
-		return nil, false
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MomentImageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.image != nil {
+		edges = append(edges, momentimage.EdgeImage)
 	}
-	return m.appendcontent, true
+	if m.moment != nil {
+		edges = append(edges, momentimage.EdgeMoment)
+	}
+	return edges
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *MindmapMutation) ResetContent() {
-	m.content = nil
-	m.appendcontent = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MomentImageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case momentimage.EdgeImage:
+		if id := m.image; id != nil {
+			return []ent.Value{*id}
+		}
+	case momentimage.EdgeMoment:
+		if id := m.moment; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// SetIsPublic sets the "isPublic" field.
-func (m *MindmapMutation) SetIsPublic(b bool) {
-	m.isPublic = &b
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MomentImageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
 }
 
-// IsPublic returns the value of the "isPublic" field in the mutation.
-func (m *MindmapMutation) IsPublic() (r bool, exists bool) {
-	v := m.isPublic
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MomentImageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldIsPublic returns the old "isPublic" field's value of the Mindmap entity.
-// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MomentImageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedimage {
+		edges = append(edges, momentimage.EdgeImage)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+	if m.clearedmoment {
+		edges = append(edges, momentimage.EdgeMoment)
 	}
-	return oldValue.IsPublic, nil
-}
-
-// ResetIsPublic resets all changes to the "isPublic" field.
-func (m *MindmapMutation) ResetIsPublic() {
-	m.isPublic = nil
+	return edges
 }
 
-// SetTags sets the "tags" field.
-func (m *MindmapMutation) SetTags(jm json.RawMessage) {
-	m.tags = &jm
-	m.appendtags = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MomentImageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case momentimage.EdgeImage:
+		return m.clearedimage
+	case momentimage.EdgeMoment:
+		return m.clearedmoment
+	}
+	return false
 }
 
-// Tags returns the value of the "tags" field in the mutation.
-func (m *MindmapMutation) Tags() (r json.RawMessage, exists bool) {
-	v := m.tags
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MomentImageMutation) ClearEdge(name string) error {
+	switch name {
+	case momentimage.EdgeImage:
+		m.ClearImage()
+		return nil
+	case momentimage.EdgeMoment:
+		m.ClearMoment()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown MomentImage unique edge %s", name)
 }
 
-// OldTags returns the old "tags" field's value of the Mindmap entity.
-// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapMutation) OldTags(ctx context.Context) (v json.RawMessage, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTags is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTags requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MomentImageMutation) ResetEdge(name string) error {
+	switch name {
+	case momentimage.EdgeImage:
+		m.ResetImage()
+		return nil
+	case momentimage.EdgeMoment:
+		m.ResetMoment()
+		return nil
 	}
-	return oldValue.Tags, nil
+	return fmt.Errorf("unknown MomentImage edge %s", name)
 }
 
-// AppendTags adds jm to the "tags" field.
-func (m *MindmapMutation) AppendTags(jm json.RawMessage) {
-	m.appendtags = append(m.appendtags, jm...)
+// MomentVideoMutation represents an operation that mutates the MomentVideo nodes in the graph.
+type MomentVideoMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	sort          *int32
+	addsort       *int32
+	createdAt     *time.Time
+	updatedAt     *time.Time
+	clearedFields map[string]struct{}
+	moment        *string
+	clearedmoment bool
+	video         *string
+	clearedvideo  bool
+	done          bool
+	oldValue      func(context.Context) (*MomentVideo, error)
+	predicates    []predicate.MomentVideo
 }
 
-// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
-func (m *MindmapMutation) AppendedTags() (json.RawMessage, bool) {
-	if len(m.appendtags) == 0 {
-		return nil, false
-	}
-	return m.appendtags, true
-}
+var _ ent.Mutation = (*MomentVideoMutation)(nil)
 
-// ResetTags resets all changes to the "tags" field.
-func (m *MindmapMutation) ResetTags() {
-	m.tags = nil
-	m.appendtags = nil
-}
+// momentvideoOption allows management of the mutation configuration using functional options.
+type momentvideoOption func(*MomentVideoMutation)
 
-// SetCreatedAt sets the "createdAt" field.
-func (m *MindmapMutation) SetCreatedAt(t time.Time) {
-	m.createdAt = &t
+// newMomentVideoMutation creates new mutation for the MomentVideo entity.
+func newMomentVideoMutation(c config, op Op, opts ...momentvideoOption) *MomentVideoMutation {
+	m := &MomentVideoMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMomentVideo,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// CreatedAt returns the value of the "createdAt" field in the mutation.
-func (m *MindmapMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.createdAt
-	if v == nil {
-		return
+// withMomentVideoID sets the ID field of the mutation.
+func withMomentVideoID(id uint) momentvideoOption {
+	return func(m *MomentVideoMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MomentVideo
+		)
+		m.oldValue = func(ctx context.Context) (*MomentVideo, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MomentVideo.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return *v, true
 }
 
-// OldCreatedAt returns the old "createdAt" field's value of the Mindmap entity.
-// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// withMomentVideo sets the old MomentVideo of the mutation.
+func withMomentVideo(node *MomentVideo) momentvideoOption {
+	return func(m *MomentVideoMutation) {
+		m.oldValue = func(context.Context) (*MomentVideo, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "createdAt" field.
-func (m *MindmapMutation) ResetCreatedAt() {
-	m.createdAt = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MomentVideoMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetUpdatedAt sets the "updatedAt" field.
-func (m *MindmapMutation) SetUpdatedAt(t time.Time) {
-	m.updatedAt = &t
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MomentVideoMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MomentVideo entities.
+func (m *MomentVideoMutation) SetID(id uint) {
+	m.id = &id
 }
 
-// UpdatedAt returns the value of the "updatedAt" field in the mutation.
-func (m *MindmapMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updatedAt
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MomentVideoMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldUpdatedAt returns the old "updatedAt" field's value of the Mindmap entity.
-// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MomentVideoMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MomentVideo.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updatedAt" field.
-func (m *MindmapMutation) ResetUpdatedAt() {
-	m.updatedAt = nil
 }
 
-// SetOwnerId sets the "ownerId" field.
-func (m *MindmapMutation) SetOwnerId(s string) {
-	m.user = &s
+// SetVideoId sets the "videoId" field.
+func (m *MomentVideoMutation) SetVideoId(s string) {
+	m.video = &s
 }
 
-// OwnerId returns the value of the "ownerId" field in the mutation.
-func (m *MindmapMutation) OwnerId() (r string, exists bool) {
-	v := m.user
+// VideoId returns the value of the "videoId" field in the mutation.
+func (m *MomentVideoMutation) VideoId() (r string, exists bool) {
+	v := m.video
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerId returns the old "ownerId" field's value of the Mindmap entity.
-// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// OldVideoId returns the old "videoId" field's value of the MomentVideo entity.
+// If the MomentVideo object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapMutation) OldOwnerId(ctx context.Context) (v string, err error) {
+func (m *MomentVideoMutation) OldVideoId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerId is only allowed on UpdateOne operations")
+		return v, errors.New("OldVideoId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerId requires an ID field in the mutation")
+		return v, errors.New("OldVideoId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerId: %w", err)
+		return v, fmt.Errorf("querying old value for OldVideoId: %w", err)
 	}
-	return oldValue.OwnerId, nil
+	return oldValue.VideoId, nil
 }
 
-// ClearOwnerId clears the value of the "ownerId" field.
-func (m *MindmapMutation) ClearOwnerId() {
-	m.user = nil
-	m.clearedFields[mindmap.FieldOwnerId] = struct{}{}
+// ClearVideoId clears the value of the "videoId" field.
+func (m *MomentVideoMutation) ClearVideoId() {
+	m.video = nil
+	m.clearedFields[momentvideo.FieldVideoId] = struct{}{}
 }
 
-// OwnerIdCleared returns if the "ownerId" field was cleared in this mutation.
-func (m *MindmapMutation) OwnerIdCleared() bool {
-	_, ok := m.clearedFields[mindmap.FieldOwnerId]
+// VideoIdCleared returns if the "videoId" field was cleared in this mutation.
+func (m *MomentVideoMutation) VideoIdCleared() bool {
+	_, ok := m.clearedFields[momentvideo.FieldVideoId]
 	return ok
 }
 
-// ResetOwnerId resets all changes to the "ownerId" field.
-func (m *MindmapMutation) ResetOwnerId() {
-	m.user = nil
-	delete(m.clearedFields, mindmap.FieldOwnerId)
+// ResetVideoId resets all changes to the "videoId" field.
+func (m *MomentVideoMutation) ResetVideoId() {
+	m.video = nil
+	delete(m.clearedFields, momentvideo.FieldVideoId)
 }
 
-// SetViews sets the "views" field.
-func (m *MindmapMutation) SetViews(i int32) {
-	m.views = &i
-	m.addviews = nil
+// SetMomentId sets the "momentId" field.
+func (m *MomentVideoMutation) SetMomentId(s string) {
+	m.moment = &s
 }
 
-// Views returns the value of the "views" field in the mutation.
-func (m *MindmapMutation) Views() (r int32, exists bool) {
-	v := m.views
+// MomentId returns the value of the "momentId" field in the mutation.
+func (m *MomentVideoMutation) MomentId() (r string, exists bool) {
+	v := m.moment
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldViews returns the old "views" field's value of the Mindmap entity.
-// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// OldMomentId returns the old "momentId" field's value of the MomentVideo entity.
+// If the MomentVideo object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapMutation) OldViews(ctx context.Context) (v int32, err error) {
+func (m *MomentVideoMutation) OldMomentId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldViews is only allowed on UpdateOne operations")
+		return v, errors.New("OldMomentId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldViews requires an ID field in the mutation")
+		return v, errors.New("OldMomentId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldViews: %w", err)
+		return v, fmt.Errorf("querying old value for OldMomentId: %w", err)
 	}
-	return oldValue.Views, nil
+	return oldValue.MomentId, nil
 }
 
-// AddViews adds i to the "views" field.
-func (m *MindmapMutation) AddViews(i int32) {
-	if m.addviews != nil {
-		*m.addviews += i
-	} else {
-		m.addviews = &i
-	}
+// ClearMomentId clears the value of the "momentId" field.
+func (m *MomentVideoMutation) ClearMomentId() {
+	m.moment = nil
+	m.clearedFields[momentvideo.FieldMomentId] = struct{}{}
 }
 
-// AddedViews returns the value that was added to the "views" field in this mutation.
-func (m *MindmapMutation) AddedViews() (r int32, exists bool) {
-	v := m.addviews
-	if v == nil {
-		return
-	}
-	return *v, true
+// MomentIdCleared returns if the "momentId" field was cleared in this mutation.
+func (m *MomentVideoMutation) MomentIdCleared() bool {
+	_, ok := m.clearedFields[momentvideo.FieldMomentId]
+	return ok
 }
 
-// ResetViews resets all changes to the "views" field.
-func (m *MindmapMutation) ResetViews() {
-	m.views = nil
-	m.addviews = nil
+// ResetMomentId resets all changes to the "momentId" field.
+func (m *MomentVideoMutation) ResetMomentId() {
+	m.moment = nil
+	delete(m.clearedFields, momentvideo.FieldMomentId)
 }
 
-// SetLikes sets the "likes" field.
-func (m *MindmapMutation) SetLikes(i int32) {
-	m.likes = &i
-	m.addlikes = nil
+// SetSort sets the "sort" field.
+func (m *MomentVideoMutation) SetSort(i int32) {
+	m.sort = &i
+	m.addsort = nil
 }
 
-// Likes returns the value of the "likes" field in the mutation.
-func (m *MindmapMutation) Likes() (r int32, exists bool) {
-	v := m.likes
+// Sort returns the value of the "sort" field in the mutation.
+func (m *MomentVideoMutation) Sort() (r int32, exists bool) {
+	v := m.sort
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLikes returns the old "likes" field's value of the Mindmap entity.
-// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// OldSort returns the old "sort" field's value of the MomentVideo entity.
+// If the MomentVideo object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapMutation) OldLikes(ctx context.Context) (v int32, err error) {
+func (m *MomentVideoMutation) OldSort(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLikes is only allowed on UpdateOne operations")
+		return v, errors.New("OldSort is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLikes requires an ID field in the mutation")
+		return v, errors.New("OldSort requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLikes: %w", err)
+		return v, fmt.Errorf("querying old value for OldSort: %w", err)
 	}
-	return oldValue.Likes, nil
+	return oldValue.Sort, nil
 }
 
-// AddLikes adds i to the "likes" field.
-func (m *MindmapMutation) AddLikes(i int32) {
-	if m.addlikes != nil {
-		*m.addlikes += i
+// AddSort adds i to the "sort" field.
+func (m *MomentVideoMutation) AddSort(i int32) {
+	if m.addsort != nil {
+		*m.addsort += i
 	} else {
-		m.addlikes = &i
+		m.addsort = &i
 	}
 }
 
-// AddedLikes returns the value that was added to the "likes" field in this mutation.
-func (m *MindmapMutation) AddedLikes() (r int32, exists bool) {
-	v := m.addlikes
+// AddedSort returns the value that was added to the "sort" field in this mutation.
+func (m *MomentVideoMutation) AddedSort() (r int32, exists bool) {
+	v := m.addsort
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetLikes resets all changes to the "likes" field.
-func (m *MindmapMutation) ResetLikes() {
-	m.likes = nil
-	m.addlikes = nil
+// ResetSort resets all changes to the "sort" field.
+func (m *MomentVideoMutation) ResetSort() {
+	m.sort = nil
+	m.addsort = nil
 }
 
-// SetSummary sets the "summary" field.
-func (m *MindmapMutation) SetSummary(s string) {
-	m.summary = &s
+// SetCreatedAt sets the "createdAt" field.
+func (m *MomentVideoMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
 }
 
-// Summary returns the value of the "summary" field in the mutation.
-func (m *MindmapMutation) Summary() (r string, exists bool) {
-	v := m.summary
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *MomentVideoMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSummary returns the old "summary" field's value of the Mindmap entity.
-// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "createdAt" field's value of the MomentVideo entity.
+// If the MomentVideo object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapMutation) OldSummary(ctx context.Context) (v string, err error) {
+func (m *MomentVideoMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSummary requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Summary, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetSummary resets all changes to the "summary" field.
-func (m *MindmapMutation) ResetSummary() {
-	m.summary = nil
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *MomentVideoMutation) ResetCreatedAt() {
+	m.createdAt = nil
 }
 
-// SetExtraData sets the "extraData" field.
-func (m *MindmapMutation) SetExtraData(jm json.RawMessage) {
-	m.extraData = &jm
-	m.appendextraData = nil
+// SetUpdatedAt sets the "updatedAt" field.
+func (m *MomentVideoMutation) SetUpdatedAt(t time.Time) {
+	m.updatedAt = &t
 }
 
-// ExtraData returns the value of the "extraData" field in the mutation.
-func (m *MindmapMutation) ExtraData() (r json.RawMessage, exists bool) {
-	v := m.extraData
+// UpdatedAt returns the value of the "updatedAt" field in the mutation.
+func (m *MomentVideoMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updatedAt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExtraData returns the old "extraData" field's value of the Mindmap entity.
-// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updatedAt" field's value of the MomentVideo entity.
+// If the MomentVideo object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapMutation) OldExtraData(ctx context.Context) (v json.RawMessage, err error) {
+func (m *MomentVideoMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExtraData is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExtraData requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExtraData: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.ExtraData, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// AppendExtraData adds jm to the "extraData" field.
-func (m *MindmapMutation) AppendExtraData(jm json.RawMessage) {
-	m.appendextraData = append(m.appendextraData, jm...)
+// ResetUpdatedAt resets all changes to the "updatedAt" field.
+func (m *MomentVideoMutation) ResetUpdatedAt() {
+	m.updatedAt = nil
 }
 
-// AppendedExtraData returns the list of values that were appended to the "extraData" field in this mutation.
-func (m *MindmapMutation) AppendedExtraData() (json.RawMessage, bool) {
-	if len(m.appendextraData) == 0 {
-		return nil, false
-	}
-	return m.appendextraData, true
+// SetMomentID sets the "moment" edge to the Moment entity by id.
+func (m *MomentVideoMutation) SetMomentID(id string) {
+	m.moment = &id
 }
 
-// ResetExtraData resets all changes to the "extraData" field.
-func (m *MindmapMutation) ResetExtraData() {
-	m.extraData = nil
-	m.appendextraData = nil
+// ClearMoment clears the "moment" edge to the Moment entity.
+func (m *MomentVideoMutation) ClearMoment() {
+	m.clearedmoment = true
+	m.clearedFields[momentvideo.FieldMomentId] = struct{}{}
 }
 
-// SetCategory sets the "category" field.
-func (m *MindmapMutation) SetCategory(s string) {
-	m.category = &s
+// MomentCleared reports if the "moment" edge to the Moment entity was cleared.
+func (m *MomentVideoMutation) MomentCleared() bool {
+	return m.MomentIdCleared() || m.clearedmoment
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *MindmapMutation) Category() (r string, exists bool) {
-	v := m.category
-	if v == nil {
-		return
+// MomentID returns the "moment" edge ID in the mutation.
+func (m *MomentVideoMutation) MomentID() (id string, exists bool) {
+	if m.moment != nil {
+		return *m.moment, true
 	}
-	return *v, true
+	return
 }
 
-// OldCategory returns the old "category" field's value of the Mindmap entity.
-// If the Mindmap object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MindmapMutation) OldCategory(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+// MomentIDs returns the "moment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MomentID instead. It exists only for internal usage by the builders.
+func (m *MomentVideoMutation) MomentIDs() (ids []string) {
+	if id := m.moment; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Category, nil
+	return
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *MindmapMutation) ResetCategory() {
-	m.category = nil
+// ResetMoment resets all changes to the "moment" edge.
+func (m *MomentVideoMutation) ResetMoment() {
+	m.moment = nil
+	m.clearedmoment = false
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *MindmapMutation) SetUserID(id string) {
-	m.user = &id
+// SetVideoID sets the "video" edge to the Video entity by id.
+func (m *MomentVideoMutation) SetVideoID(id string) {
+	m.video = &id
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *MindmapMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[mindmap.FieldOwnerId] = struct{}{}
+// ClearVideo clears the "video" edge to the Video entity.
+func (m *MomentVideoMutation) ClearVideo() {
+	m.clearedvideo = true
+	m.clearedFields[momentvideo.FieldVideoId] = struct{}{}
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *MindmapMutation) UserCleared() bool {
-	return m.OwnerIdCleared() || m.cleareduser
+// VideoCleared reports if the "video" edge to the Video entity was cleared.
+func (m *MomentVideoMutation) VideoCleared() bool {
+	return m.VideoIdCleared() || m.clearedvideo
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *MindmapMutation) UserID() (id string, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// VideoID returns the "video" edge ID in the mutation.
+func (m *MomentVideoMutation) VideoID() (id string, exists bool) {
+	if m.video != nil {
+		return *m.video, true
 	}
 	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
+// VideoIDs returns the "video" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *MindmapMutation) UserIDs() (ids []string) {
-	if id := m.user; id != nil {
+// VideoID instead. It exists only for internal usage by the builders.
+func (m *MomentVideoMutation) VideoIDs() (ids []string) {
+	if id := m.video; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *MindmapMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetVideo resets all changes to the "video" edge.
+func (m *MomentVideoMutation) ResetVideo() {
+	m.video = nil
+	m.clearedvideo = false
 }
 
-// Where appends a list predicates to the MindmapMutation builder.
-func (m *MindmapMutation) Where(ps ...predicate.Mindmap) {
+// Where appends a list predicates to the MomentVideoMutation builder.
+func (m *MomentVideoMutation) Where(ps ...predicate.MomentVideo) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MindmapMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MomentVideoMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MindmapMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Mindmap, len(ps))
+func (m *MomentVideoMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MomentVideo, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7822,60 +12603,39 @@ func (m *MindmapMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MindmapMutation) Op() Op {
+func (m *MomentVideoMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MindmapMutation) SetOp(op Op) {
+func (m *MomentVideoMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Mindmap).
-func (m *MindmapMutation) Type() string {
+// Type returns the node type of this mutation (MomentVideo).
+func (m *MomentVideoMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MindmapMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.title != nil {
-		fields = append(fields, mindmap.FieldTitle)
-	}
-	if m.content != nil {
-		fields = append(fields, mindmap.FieldContent)
+func (m *MomentVideoMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.video != nil {
+		fields = append(fields, momentvideo.FieldVideoId)
 	}
-	if m.isPublic != nil {
-		fields = append(fields, mindmap.FieldIsPublic)
+	if m.moment != nil {
+		fields = append(fields, momentvideo.FieldMomentId)
 	}
-	if m.tags != nil {
-		fields = append(fields, mindmap.FieldTags)
+	if m.sort != nil {
+		fields = append(fields, momentvideo.FieldSort)
 	}
 	if m.createdAt != nil {
-		fields = append(fields, mindmap.FieldCreatedAt)
+		fields = append(fields, momentvideo.FieldCreatedAt)
 	}
 	if m.updatedAt != nil {
-		fields = append(fields, mindmap.FieldUpdatedAt)
-	}
-	if m.user != nil {
-		fields = append(fields, mindmap.FieldOwnerId)
-	}
-	if m.views != nil {
-		fields = append(fields, mindmap.FieldViews)
-	}
-	if m.likes != nil {
-		fields = append(fields, mindmap.FieldLikes)
-	}
-	if m.summary != nil {
-		fields = append(fields, mindmap.FieldSummary)
-	}
-	if m.extraData != nil {
-		fields = append(fields, mindmap.FieldExtraData)
-	}
-	if m.category != nil {
-		fields = append(fields, mindmap.FieldCategory)
+		fields = append(fields, momentvideo.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -7883,32 +12643,18 @@ func (m *MindmapMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MindmapMutation) Field(name string) (ent.Value, bool) {
+func (m *MomentVideoMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case mindmap.FieldTitle:
-		return m.Title()
-	case mindmap.FieldContent:
-		return m.Content()
-	case mindmap.FieldIsPublic:
-		return m.IsPublic()
-	case mindmap.FieldTags:
-		return m.Tags()
-	case mindmap.FieldCreatedAt:
+	case momentvideo.FieldVideoId:
+		return m.VideoId()
+	case momentvideo.FieldMomentId:
+		return m.MomentId()
+	case momentvideo.FieldSort:
+		return m.Sort()
+	case momentvideo.FieldCreatedAt:
 		return m.CreatedAt()
-	case mindmap.FieldUpdatedAt:
+	case momentvideo.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case mindmap.FieldOwnerId:
-		return m.OwnerId()
-	case mindmap.FieldViews:
-		return m.Views()
-	case mindmap.FieldLikes:
-		return m.Likes()
-	case mindmap.FieldSummary:
-		return m.Summary()
-	case mindmap.FieldExtraData:
-		return m.ExtraData()
-	case mindmap.FieldCategory:
-		return m.Category()
 	}
 	return nil, false
 }
@@ -7916,138 +12662,72 @@ func (m *MindmapMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MindmapMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MomentVideoMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case mindmap.FieldTitle:
-		return m.OldTitle(ctx)
-	case mindmap.FieldContent:
-		return m.OldContent(ctx)
-	case mindmap.FieldIsPublic:
-		return m.OldIsPublic(ctx)
-	case mindmap.FieldTags:
-		return m.OldTags(ctx)
-	case mindmap.FieldCreatedAt:
+	case momentvideo.FieldVideoId:
+		return m.OldVideoId(ctx)
+	case momentvideo.FieldMomentId:
+		return m.OldMomentId(ctx)
+	case momentvideo.FieldSort:
+		return m.OldSort(ctx)
+	case momentvideo.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case mindmap.FieldUpdatedAt:
+	case momentvideo.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case mindmap.FieldOwnerId:
-		return m.OldOwnerId(ctx)
-	case mindmap.FieldViews:
-		return m.OldViews(ctx)
-	case mindmap.FieldLikes:
-		return m.OldLikes(ctx)
-	case mindmap.FieldSummary:
-		return m.OldSummary(ctx)
-	case mindmap.FieldExtraData:
-		return m.OldExtraData(ctx)
-	case mindmap.FieldCategory:
-		return m.OldCategory(ctx)
 	}
-	return nil, fmt.Errorf("unknown Mindmap field %s", name)
+	return nil, fmt.Errorf("unknown MomentVideo field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MindmapMutation) SetField(name string, value ent.Value) error {
+func (m *MomentVideoMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case mindmap.FieldTitle:
+	case momentvideo.FieldVideoId:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
-		return nil
-	case mindmap.FieldContent:
-		v, ok := value.(json.RawMessage)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContent(v)
+		m.SetVideoId(v)
 		return nil
-	case mindmap.FieldIsPublic:
-		v, ok := value.(bool)
+	case momentvideo.FieldMomentId:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsPublic(v)
+		m.SetMomentId(v)
 		return nil
-	case mindmap.FieldTags:
-		v, ok := value.(json.RawMessage)
+	case momentvideo.FieldSort:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTags(v)
+		m.SetSort(v)
 		return nil
-	case mindmap.FieldCreatedAt:
+	case momentvideo.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case mindmap.FieldUpdatedAt:
+	case momentvideo.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case mindmap.FieldOwnerId:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwnerId(v)
-		return nil
-	case mindmap.FieldViews:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetViews(v)
-		return nil
-	case mindmap.FieldLikes:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLikes(v)
-		return nil
-	case mindmap.FieldSummary:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSummary(v)
-		return nil
-	case mindmap.FieldExtraData:
-		v, ok := value.(json.RawMessage)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExtraData(v)
-		return nil
-	case mindmap.FieldCategory:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCategory(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Mindmap field %s", name)
+	return fmt.Errorf("unknown MomentVideo field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MindmapMutation) AddedFields() []string {
+func (m *MomentVideoMutation) AddedFields() []string {
 	var fields []string
-	if m.addviews != nil {
-		fields = append(fields, mindmap.FieldViews)
-	}
-	if m.addlikes != nil {
-		fields = append(fields, mindmap.FieldLikes)
+	if m.addsort != nil {
+		fields = append(fields, momentvideo.FieldSort)
 	}
 	return fields
 }
@@ -8055,12 +12735,10 @@ func (m *MindmapMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MindmapMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MomentVideoMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case mindmap.FieldViews:
-		return m.AddedViews()
-	case mindmap.FieldLikes:
-		return m.AddedLikes()
+	case momentvideo.FieldSort:
+		return m.AddedSort()
 	}
 	return nil, false
 }
@@ -8068,113 +12746,98 @@ func (m *MindmapMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MindmapMutation) AddField(name string, value ent.Value) error {
+func (m *MomentVideoMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case mindmap.FieldViews:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddViews(v)
-		return nil
-	case mindmap.FieldLikes:
+	case momentvideo.FieldSort:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddLikes(v)
+		m.AddSort(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Mindmap numeric field %s", name)
+	return fmt.Errorf("unknown MomentVideo numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MindmapMutation) ClearedFields() []string {
+func (m *MomentVideoMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(mindmap.FieldOwnerId) {
-		fields = append(fields, mindmap.FieldOwnerId)
+	if m.FieldCleared(momentvideo.FieldVideoId) {
+		fields = append(fields, momentvideo.FieldVideoId)
+	}
+	if m.FieldCleared(momentvideo.FieldMomentId) {
+		fields = append(fields, momentvideo.FieldMomentId)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MindmapMutation) FieldCleared(name string) bool {
+func (m *MomentVideoMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MindmapMutation) ClearField(name string) error {
+func (m *MomentVideoMutation) ClearField(name string) error {
 	switch name {
-	case mindmap.FieldOwnerId:
-		m.ClearOwnerId()
+	case momentvideo.FieldVideoId:
+		m.ClearVideoId()
+		return nil
+	case momentvideo.FieldMomentId:
+		m.ClearMomentId()
 		return nil
 	}
-	return fmt.Errorf("unknown Mindmap nullable field %s", name)
+	return fmt.Errorf("unknown MomentVideo nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MindmapMutation) ResetField(name string) error {
+func (m *MomentVideoMutation) ResetField(name string) error {
 	switch name {
-	case mindmap.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case mindmap.FieldContent:
-		m.ResetContent()
+	case momentvideo.FieldVideoId:
+		m.ResetVideoId()
 		return nil
-	case mindmap.FieldIsPublic:
-		m.ResetIsPublic()
+	case momentvideo.FieldMomentId:
+		m.ResetMomentId()
 		return nil
-	case mindmap.FieldTags:
-		m.ResetTags()
+	case momentvideo.FieldSort:
+		m.ResetSort()
 		return nil
-	case mindmap.FieldCreatedAt:
+	case momentvideo.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case mindmap.FieldUpdatedAt:
+	case momentvideo.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case mindmap.FieldOwnerId:
-		m.ResetOwnerId()
-		return nil
-	case mindmap.FieldViews:
-		m.ResetViews()
-		return nil
-	case mindmap.FieldLikes:
-		m.ResetLikes()
-		return nil
-	case mindmap.FieldSummary:
-		m.ResetSummary()
-		return nil
-	case mindmap.FieldExtraData:
-		m.ResetExtraData()
-		return nil
-	case mindmap.FieldCategory:
-		m.ResetCategory()
-		return nil
 	}
-	return fmt.Errorf("unknown Mindmap field %s", name)
+	return fmt.Errorf("unknown MomentVideo field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MindmapMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, mindmap.EdgeUser)
+func (m *MomentVideoMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.moment != nil {
+		edges = append(edges, momentvideo.EdgeMoment)
+	}
+	if m.video != nil {
+		edges = append(edges, momentvideo.EdgeVideo)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MindmapMutation) AddedIDs(name string) []ent.Value {
+func (m *MomentVideoMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case mindmap.EdgeUser:
-		if id := m.user; id != nil {
+	case momentvideo.EdgeMoment:
+		if id := m.moment; id != nil {
+			return []ent.Value{*id}
+		}
+	case momentvideo.EdgeVideo:
+		if id := m.video; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -8182,104 +12845,98 @@ func (m *MindmapMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MindmapMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *MomentVideoMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MindmapMutation) RemovedIDs(name string) []ent.Value {
+func (m *MomentVideoMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MindmapMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, mindmap.EdgeUser)
+func (m *MomentVideoMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmoment {
+		edges = append(edges, momentvideo.EdgeMoment)
+	}
+	if m.clearedvideo {
+		edges = append(edges, momentvideo.EdgeVideo)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MindmapMutation) EdgeCleared(name string) bool {
+func (m *MomentVideoMutation) EdgeCleared(name string) bool {
 	switch name {
-	case mindmap.EdgeUser:
-		return m.cleareduser
+	case momentvideo.EdgeMoment:
+		return m.clearedmoment
+	case momentvideo.EdgeVideo:
+		return m.clearedvideo
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MindmapMutation) ClearEdge(name string) error {
-	switch name {
-	case mindmap.EdgeUser:
-		m.ClearUser()
-		return nil
-	}
-	return fmt.Errorf("unknown Mindmap unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *MindmapMutation) ResetEdge(name string) error {
+func (m *MomentVideoMutation) ClearEdge(name string) error {
 	switch name {
-	case mindmap.EdgeUser:
-		m.ResetUser()
-		return nil
-	}
-	return fmt.Errorf("unknown Mindmap edge %s", name)
-}
-
-// MomentMutation represents an operation that mutates the Moment nodes in the graph.
-type MomentMutation struct {
-	config
-	op                   Op
-	typ                  string
-	id                   *string
-	content              *string
-	category             *string
-	createdAt            *time.Time
-	updatedAt            *time.Time
-	isPublic             *bool
-	likes                *int32
-	addlikes             *int32
-	tags                 *json.RawMessage
-	appendtags           json.RawMessage
-	views                *int32
-	addviews             *int32
-	extraData            *json.RawMessage
-	appendextraData      json.RawMessage
-	content_vector       *json.RawMessage
-	appendcontent_vector json.RawMessage
-	clearedFields        map[string]struct{}
-	moment_images        map[uint]struct{}
-	removedmoment_images map[uint]struct{}
-	clearedmoment_images bool
-	moment_videos        map[uint]struct{}
-	removedmoment_videos map[uint]struct{}
-	clearedmoment_videos bool
-	user                 *string
-	cleareduser          bool
-	done                 bool
-	oldValue             func(context.Context) (*Moment, error)
-	predicates           []predicate.Moment
+	case momentvideo.EdgeMoment:
+		m.ClearMoment()
+		return nil
+	case momentvideo.EdgeVideo:
+		m.ClearVideo()
+		return nil
+	}
+	return fmt.Errorf("unknown MomentVideo unique edge %s", name)
 }
 
-var _ ent.Mutation = (*MomentMutation)(nil)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MomentVideoMutation) ResetEdge(name string) error {
+	switch name {
+	case momentvideo.EdgeMoment:
+		m.ResetMoment()
+		return nil
+	case momentvideo.EdgeVideo:
+		m.ResetVideo()
+		return nil
+	}
+	return fmt.Errorf("unknown MomentVideo edge %s", name)
+}
 
-// momentOption allows management of the mutation configuration using functional options.
-type momentOption func(*MomentMutation)
+// SearchClickMutation represents an operation that mutates the SearchClick nodes in the graph.
+type SearchClickMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	requestId     *string
+	entityType    *string
+	entityId      *string
+	position      *int
+	addposition   *int
+	createdAt     *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SearchClick, error)
+	predicates    []predicate.SearchClick
+}
 
-// newMomentMutation creates new mutation for the Moment entity.
-func newMomentMutation(c config, op Op, opts ...momentOption) *MomentMutation {
-	m := &MomentMutation{
+var _ ent.Mutation = (*SearchClickMutation)(nil)
+
+// searchclickOption allows management of the mutation configuration using functional options.
+type searchclickOption func(*SearchClickMutation)
+
+// newSearchClickMutation creates new mutation for the SearchClick entity.
+func newSearchClickMutation(c config, op Op, opts ...searchclickOption) *SearchClickMutation {
+	m := &SearchClickMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMoment,
+		typ:           TypeSearchClick,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8288,20 +12945,20 @@ func newMomentMutation(c config, op Op, opts ...momentOption) *MomentMutation {
 	return m
 }
 
-// withMomentID sets the ID field of the mutation.
-func withMomentID(id string) momentOption {
-	return func(m *MomentMutation) {
+// withSearchClickID sets the ID field of the mutation.
+func withSearchClickID(id int) searchclickOption {
+	return func(m *SearchClickMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Moment
+			value *SearchClick
 		)
-		m.oldValue = func(ctx context.Context) (*Moment, error) {
+		m.oldValue = func(ctx context.Context) (*SearchClick, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Moment.Get(ctx, id)
+					value, err = m.Client().SearchClick.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8310,10 +12967,10 @@ func withMomentID(id string) momentOption {
 	}
 }
 
-// withMoment sets the old Moment of the mutation.
-func withMoment(node *Moment) momentOption {
-	return func(m *MomentMutation) {
-		m.oldValue = func(context.Context) (*Moment, error) {
+// withSearchClick sets the old SearchClick of the mutation.
+func withSearchClick(node *SearchClick) searchclickOption {
+	return func(m *SearchClickMutation) {
+		m.oldValue = func(context.Context) (*SearchClick, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8322,7 +12979,7 @@ func withMoment(node *Moment) momentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MomentMutation) Client() *Client {
+func (m SearchClickMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8330,7 +12987,7 @@ func (m MomentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MomentMutation) Tx() (*Tx, error) {
+func (m SearchClickMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8339,15 +12996,9 @@ func (m MomentMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Moment entities.
-func (m *MomentMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MomentMutation) ID() (id string, exists bool) {
+func (m *SearchClickMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8358,686 +13009,989 @@ func (m *MomentMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MomentMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *SearchClickMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Moment.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SearchClick.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetContent sets the "content" field.
-func (m *MomentMutation) SetContent(s string) {
-	m.content = &s
+// SetRequestId sets the "requestId" field.
+func (m *SearchClickMutation) SetRequestId(s string) {
+	m.requestId = &s
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *MomentMutation) Content() (r string, exists bool) {
-	v := m.content
+// RequestId returns the value of the "requestId" field in the mutation.
+func (m *SearchClickMutation) RequestId() (r string, exists bool) {
+	v := m.requestId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Moment entity.
-// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// OldRequestId returns the old "requestId" field's value of the SearchClick entity.
+// If the SearchClick object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *SearchClickMutation) OldRequestId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequestId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldRequestId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequestId: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.RequestId, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *MomentMutation) ResetContent() {
-	m.content = nil
+// ResetRequestId resets all changes to the "requestId" field.
+func (m *SearchClickMutation) ResetRequestId() {
+	m.requestId = nil
 }
 
-// SetCategory sets the "category" field.
-func (m *MomentMutation) SetCategory(s string) {
-	m.category = &s
+// SetEntityType sets the "entityType" field.
+func (m *SearchClickMutation) SetEntityType(s string) {
+	m.entityType = &s
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *MomentMutation) Category() (r string, exists bool) {
-	v := m.category
+// EntityType returns the value of the "entityType" field in the mutation.
+func (m *SearchClickMutation) EntityType() (r string, exists bool) {
+	v := m.entityType
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the Moment entity.
-// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// OldEntityType returns the old "entityType" field's value of the SearchClick entity.
+// If the SearchClick object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentMutation) OldCategory(ctx context.Context) (v string, err error) {
+func (m *SearchClickMutation) OldEntityType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldEntityType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldEntityType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldEntityType: %w", err)
 	}
-	return oldValue.Category, nil
+	return oldValue.EntityType, nil
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *MomentMutation) ResetCategory() {
-	m.category = nil
+// ResetEntityType resets all changes to the "entityType" field.
+func (m *SearchClickMutation) ResetEntityType() {
+	m.entityType = nil
+}
+
+// SetEntityId sets the "entityId" field.
+func (m *SearchClickMutation) SetEntityId(s string) {
+	m.entityId = &s
+}
+
+// EntityId returns the value of the "entityId" field in the mutation.
+func (m *SearchClickMutation) EntityId() (r string, exists bool) {
+	v := m.entityId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityId returns the old "entityId" field's value of the SearchClick entity.
+// If the SearchClick object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchClickMutation) OldEntityId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityId: %w", err)
+	}
+	return oldValue.EntityId, nil
+}
+
+// ResetEntityId resets all changes to the "entityId" field.
+func (m *SearchClickMutation) ResetEntityId() {
+	m.entityId = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *SearchClickMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *SearchClickMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the SearchClick entity.
+// If the SearchClick object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchClickMutation) OldPosition(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *SearchClickMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *SearchClickMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPosition clears the value of the "position" field.
+func (m *SearchClickMutation) ClearPosition() {
+	m.position = nil
+	m.addposition = nil
+	m.clearedFields[searchclick.FieldPosition] = struct{}{}
+}
+
+// PositionCleared returns if the "position" field was cleared in this mutation.
+func (m *SearchClickMutation) PositionCleared() bool {
+	_, ok := m.clearedFields[searchclick.FieldPosition]
+	return ok
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *SearchClickMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+	delete(m.clearedFields, searchclick.FieldPosition)
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (m *SearchClickMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
+}
+
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *SearchClickMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "createdAt" field's value of the SearchClick entity.
+// If the SearchClick object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchClickMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *SearchClickMutation) ResetCreatedAt() {
+	m.createdAt = nil
+}
+
+// Where appends a list predicates to the SearchClickMutation builder.
+func (m *SearchClickMutation) Where(ps ...predicate.SearchClick) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SearchClickMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SearchClickMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SearchClick, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SearchClickMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SearchClickMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SearchClick).
+func (m *SearchClickMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SearchClickMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.requestId != nil {
+		fields = append(fields, searchclick.FieldRequestId)
+	}
+	if m.entityType != nil {
+		fields = append(fields, searchclick.FieldEntityType)
+	}
+	if m.entityId != nil {
+		fields = append(fields, searchclick.FieldEntityId)
+	}
+	if m.position != nil {
+		fields = append(fields, searchclick.FieldPosition)
+	}
+	if m.createdAt != nil {
+		fields = append(fields, searchclick.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SearchClickMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case searchclick.FieldRequestId:
+		return m.RequestId()
+	case searchclick.FieldEntityType:
+		return m.EntityType()
+	case searchclick.FieldEntityId:
+		return m.EntityId()
+	case searchclick.FieldPosition:
+		return m.Position()
+	case searchclick.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SearchClickMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case searchclick.FieldRequestId:
+		return m.OldRequestId(ctx)
+	case searchclick.FieldEntityType:
+		return m.OldEntityType(ctx)
+	case searchclick.FieldEntityId:
+		return m.OldEntityId(ctx)
+	case searchclick.FieldPosition:
+		return m.OldPosition(ctx)
+	case searchclick.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SearchClick field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SearchClickMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case searchclick.FieldRequestId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestId(v)
+		return nil
+	case searchclick.FieldEntityType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityType(v)
+		return nil
+	case searchclick.FieldEntityId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityId(v)
+		return nil
+	case searchclick.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case searchclick.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SearchClick field %s", name)
 }
 
-// SetCreatedAt sets the "createdAt" field.
-func (m *MomentMutation) SetCreatedAt(t time.Time) {
-	m.createdAt = &t
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SearchClickMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, searchclick.FieldPosition)
+	}
+	return fields
 }
 
-// CreatedAt returns the value of the "createdAt" field in the mutation.
-func (m *MomentMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.createdAt
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SearchClickMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case searchclick.FieldPosition:
+		return m.AddedPosition()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldCreatedAt returns the old "createdAt" field's value of the Moment entity.
-// If the Moment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SearchClickMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case searchclick.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
 	}
-	return oldValue.CreatedAt, nil
+	return fmt.Errorf("unknown SearchClick numeric field %s", name)
 }
 
-// ResetCreatedAt resets all changes to the "createdAt" field.
-func (m *MomentMutation) ResetCreatedAt() {
-	m.createdAt = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SearchClickMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(searchclick.FieldPosition) {
+		fields = append(fields, searchclick.FieldPosition)
+	}
+	return fields
 }
 
-// SetUpdatedAt sets the "updatedAt" field.
-func (m *MomentMutation) SetUpdatedAt(t time.Time) {
-	m.updatedAt = &t
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SearchClickMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updatedAt" field in the mutation.
-func (m *MomentMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updatedAt
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SearchClickMutation) ClearField(name string) error {
+	switch name {
+	case searchclick.FieldPosition:
+		m.ClearPosition()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown SearchClick nullable field %s", name)
 }
 
-// OldUpdatedAt returns the old "updatedAt" field's value of the Moment entity.
-// If the Moment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SearchClickMutation) ResetField(name string) error {
+	switch name {
+	case searchclick.FieldRequestId:
+		m.ResetRequestId()
+		return nil
+	case searchclick.FieldEntityType:
+		m.ResetEntityType()
+		return nil
+	case searchclick.FieldEntityId:
+		m.ResetEntityId()
+		return nil
+	case searchclick.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case searchclick.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updatedAt" field.
-func (m *MomentMutation) ResetUpdatedAt() {
-	m.updatedAt = nil
+	return fmt.Errorf("unknown SearchClick field %s", name)
 }
 
-// SetOwnerId sets the "ownerId" field.
-func (m *MomentMutation) SetOwnerId(s string) {
-	m.user = &s
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SearchClickMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OwnerId returns the value of the "ownerId" field in the mutation.
-func (m *MomentMutation) OwnerId() (r string, exists bool) {
-	v := m.user
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SearchClickMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldOwnerId returns the old "ownerId" field's value of the Moment entity.
-// If the Moment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentMutation) OldOwnerId(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerId is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerId requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerId: %w", err)
-	}
-	return oldValue.OwnerId, nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SearchClickMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearOwnerId clears the value of the "ownerId" field.
-func (m *MomentMutation) ClearOwnerId() {
-	m.user = nil
-	m.clearedFields[moment.FieldOwnerId] = struct{}{}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SearchClickMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OwnerIdCleared returns if the "ownerId" field was cleared in this mutation.
-func (m *MomentMutation) OwnerIdCleared() bool {
-	_, ok := m.clearedFields[moment.FieldOwnerId]
-	return ok
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SearchClickMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetOwnerId resets all changes to the "ownerId" field.
-func (m *MomentMutation) ResetOwnerId() {
-	m.user = nil
-	delete(m.clearedFields, moment.FieldOwnerId)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SearchClickMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// SetIsPublic sets the "isPublic" field.
-func (m *MomentMutation) SetIsPublic(b bool) {
-	m.isPublic = &b
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SearchClickMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SearchClick unique edge %s", name)
 }
 
-// IsPublic returns the value of the "isPublic" field in the mutation.
-func (m *MomentMutation) IsPublic() (r bool, exists bool) {
-	v := m.isPublic
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SearchClickMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SearchClick edge %s", name)
 }
 
-// OldIsPublic returns the old "isPublic" field's value of the Moment entity.
-// If the Moment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+// SearchLogMutation represents an operation that mutates the SearchLog nodes in the graph.
+type SearchLogMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	query          *string
+	_type          *string
+	backend        *string
+	resultCount    *int
+	addresultCount *int
+	latencyMs      *int
+	addlatencyMs   *int
+	degraded       *bool
+	requestId      *string
+	createdAt      *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*SearchLog, error)
+	predicates     []predicate.SearchLog
+}
+
+var _ ent.Mutation = (*SearchLogMutation)(nil)
+
+// searchlogOption allows management of the mutation configuration using functional options.
+type searchlogOption func(*SearchLogMutation)
+
+// newSearchLogMutation creates new mutation for the SearchLog entity.
+func newSearchLogMutation(c config, op Op, opts ...searchlogOption) *SearchLogMutation {
+	m := &SearchLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSearchLog,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.IsPublic, nil
-}
-
-// ResetIsPublic resets all changes to the "isPublic" field.
-func (m *MomentMutation) ResetIsPublic() {
-	m.isPublic = nil
+	return m
 }
 
-// SetLikes sets the "likes" field.
-func (m *MomentMutation) SetLikes(i int32) {
-	m.likes = &i
-	m.addlikes = nil
+// withSearchLogID sets the ID field of the mutation.
+func withSearchLogID(id int) searchlogOption {
+	return func(m *SearchLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SearchLog
+		)
+		m.oldValue = func(ctx context.Context) (*SearchLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SearchLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// Likes returns the value of the "likes" field in the mutation.
-func (m *MomentMutation) Likes() (r int32, exists bool) {
-	v := m.likes
-	if v == nil {
-		return
+// withSearchLog sets the old SearchLog of the mutation.
+func withSearchLog(node *SearchLog) searchlogOption {
+	return func(m *SearchLogMutation) {
+		m.oldValue = func(context.Context) (*SearchLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// OldLikes returns the old "likes" field's value of the Moment entity.
-// If the Moment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentMutation) OldLikes(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLikes is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLikes requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLikes: %w", err)
-	}
-	return oldValue.Likes, nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SearchLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddLikes adds i to the "likes" field.
-func (m *MomentMutation) AddLikes(i int32) {
-	if m.addlikes != nil {
-		*m.addlikes += i
-	} else {
-		m.addlikes = &i
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SearchLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddedLikes returns the value that was added to the "likes" field in this mutation.
-func (m *MomentMutation) AddedLikes() (r int32, exists bool) {
-	v := m.addlikes
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SearchLogMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetLikes resets all changes to the "likes" field.
-func (m *MomentMutation) ResetLikes() {
-	m.likes = nil
-	m.addlikes = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SearchLogMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SearchLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetTags sets the "tags" field.
-func (m *MomentMutation) SetTags(jm json.RawMessage) {
-	m.tags = &jm
-	m.appendtags = nil
+// SetQuery sets the "query" field.
+func (m *SearchLogMutation) SetQuery(s string) {
+	m.query = &s
 }
 
-// Tags returns the value of the "tags" field in the mutation.
-func (m *MomentMutation) Tags() (r json.RawMessage, exists bool) {
-	v := m.tags
+// Query returns the value of the "query" field in the mutation.
+func (m *SearchLogMutation) Query() (r string, exists bool) {
+	v := m.query
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTags returns the old "tags" field's value of the Moment entity.
-// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// OldQuery returns the old "query" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentMutation) OldTags(ctx context.Context) (v json.RawMessage, err error) {
+func (m *SearchLogMutation) OldQuery(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuery is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTags requires an ID field in the mutation")
+		return v, errors.New("OldQuery requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTags: %w", err)
-	}
-	return oldValue.Tags, nil
-}
-
-// AppendTags adds jm to the "tags" field.
-func (m *MomentMutation) AppendTags(jm json.RawMessage) {
-	m.appendtags = append(m.appendtags, jm...)
-}
-
-// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
-func (m *MomentMutation) AppendedTags() (json.RawMessage, bool) {
-	if len(m.appendtags) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldQuery: %w", err)
 	}
-	return m.appendtags, true
+	return oldValue.Query, nil
 }
 
-// ResetTags resets all changes to the "tags" field.
-func (m *MomentMutation) ResetTags() {
-	m.tags = nil
-	m.appendtags = nil
+// ResetQuery resets all changes to the "query" field.
+func (m *SearchLogMutation) ResetQuery() {
+	m.query = nil
 }
 
-// SetViews sets the "views" field.
-func (m *MomentMutation) SetViews(i int32) {
-	m.views = &i
-	m.addviews = nil
+// SetType sets the "type" field.
+func (m *SearchLogMutation) SetType(s string) {
+	m._type = &s
 }
 
-// Views returns the value of the "views" field in the mutation.
-func (m *MomentMutation) Views() (r int32, exists bool) {
-	v := m.views
+// GetType returns the value of the "type" field in the mutation.
+func (m *SearchLogMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldViews returns the old "views" field's value of the Moment entity.
-// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentMutation) OldViews(ctx context.Context) (v int32, err error) {
+func (m *SearchLogMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldViews is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldViews requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldViews: %w", err)
-	}
-	return oldValue.Views, nil
-}
-
-// AddViews adds i to the "views" field.
-func (m *MomentMutation) AddViews(i int32) {
-	if m.addviews != nil {
-		*m.addviews += i
-	} else {
-		m.addviews = &i
-	}
-}
-
-// AddedViews returns the value that was added to the "views" field in this mutation.
-func (m *MomentMutation) AddedViews() (r int32, exists bool) {
-	v := m.addviews
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return *v, true
+	return oldValue.Type, nil
 }
 
-// ResetViews resets all changes to the "views" field.
-func (m *MomentMutation) ResetViews() {
-	m.views = nil
-	m.addviews = nil
+// ResetType resets all changes to the "type" field.
+func (m *SearchLogMutation) ResetType() {
+	m._type = nil
 }
 
-// SetExtraData sets the "extraData" field.
-func (m *MomentMutation) SetExtraData(jm json.RawMessage) {
-	m.extraData = &jm
-	m.appendextraData = nil
+// SetBackend sets the "backend" field.
+func (m *SearchLogMutation) SetBackend(s string) {
+	m.backend = &s
 }
 
-// ExtraData returns the value of the "extraData" field in the mutation.
-func (m *MomentMutation) ExtraData() (r json.RawMessage, exists bool) {
-	v := m.extraData
+// Backend returns the value of the "backend" field in the mutation.
+func (m *SearchLogMutation) Backend() (r string, exists bool) {
+	v := m.backend
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExtraData returns the old "extraData" field's value of the Moment entity.
-// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// OldBackend returns the old "backend" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentMutation) OldExtraData(ctx context.Context) (v json.RawMessage, err error) {
+func (m *SearchLogMutation) OldBackend(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExtraData is only allowed on UpdateOne operations")
+		return v, errors.New("OldBackend is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExtraData requires an ID field in the mutation")
+		return v, errors.New("OldBackend requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExtraData: %w", err)
+		return v, fmt.Errorf("querying old value for OldBackend: %w", err)
 	}
-	return oldValue.ExtraData, nil
+	return oldValue.Backend, nil
 }
 
-// AppendExtraData adds jm to the "extraData" field.
-func (m *MomentMutation) AppendExtraData(jm json.RawMessage) {
-	m.appendextraData = append(m.appendextraData, jm...)
+// ClearBackend clears the value of the "backend" field.
+func (m *SearchLogMutation) ClearBackend() {
+	m.backend = nil
+	m.clearedFields[searchlog.FieldBackend] = struct{}{}
 }
 
-// AppendedExtraData returns the list of values that were appended to the "extraData" field in this mutation.
-func (m *MomentMutation) AppendedExtraData() (json.RawMessage, bool) {
-	if len(m.appendextraData) == 0 {
-		return nil, false
-	}
-	return m.appendextraData, true
+// BackendCleared returns if the "backend" field was cleared in this mutation.
+func (m *SearchLogMutation) BackendCleared() bool {
+	_, ok := m.clearedFields[searchlog.FieldBackend]
+	return ok
 }
 
-// ResetExtraData resets all changes to the "extraData" field.
-func (m *MomentMutation) ResetExtraData() {
-	m.extraData = nil
-	m.appendextraData = nil
+// ResetBackend resets all changes to the "backend" field.
+func (m *SearchLogMutation) ResetBackend() {
+	m.backend = nil
+	delete(m.clearedFields, searchlog.FieldBackend)
 }
 
-// SetContentVector sets the "content_vector" field.
-func (m *MomentMutation) SetContentVector(jm json.RawMessage) {
-	m.content_vector = &jm
-	m.appendcontent_vector = nil
+// SetResultCount sets the "resultCount" field.
+func (m *SearchLogMutation) SetResultCount(i int) {
+	m.resultCount = &i
+	m.addresultCount = nil
 }
 
-// ContentVector returns the value of the "content_vector" field in the mutation.
-func (m *MomentMutation) ContentVector() (r json.RawMessage, exists bool) {
-	v := m.content_vector
+// ResultCount returns the value of the "resultCount" field in the mutation.
+func (m *SearchLogMutation) ResultCount() (r int, exists bool) {
+	v := m.resultCount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContentVector returns the old "content_vector" field's value of the Moment entity.
-// If the Moment object wasn't provided to the builder, the object is fetched from the database.
+// OldResultCount returns the old "resultCount" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentMutation) OldContentVector(ctx context.Context) (v json.RawMessage, err error) {
+func (m *SearchLogMutation) OldResultCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentVector is only allowed on UpdateOne operations")
+		return v, errors.New("OldResultCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentVector requires an ID field in the mutation")
+		return v, errors.New("OldResultCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentVector: %w", err)
+		return v, fmt.Errorf("querying old value for OldResultCount: %w", err)
 	}
-	return oldValue.ContentVector, nil
-}
-
-// AppendContentVector adds jm to the "content_vector" field.
-func (m *MomentMutation) AppendContentVector(jm json.RawMessage) {
-	m.appendcontent_vector = append(m.appendcontent_vector, jm...)
+	return oldValue.ResultCount, nil
 }
 
-// AppendedContentVector returns the list of values that were appended to the "content_vector" field in this mutation.
-func (m *MomentMutation) AppendedContentVector() (json.RawMessage, bool) {
-	if len(m.appendcontent_vector) == 0 {
-		return nil, false
+// AddResultCount adds i to the "resultCount" field.
+func (m *SearchLogMutation) AddResultCount(i int) {
+	if m.addresultCount != nil {
+		*m.addresultCount += i
+	} else {
+		m.addresultCount = &i
 	}
-	return m.appendcontent_vector, true
 }
 
-// ClearContentVector clears the value of the "content_vector" field.
-func (m *MomentMutation) ClearContentVector() {
-	m.content_vector = nil
-	m.appendcontent_vector = nil
-	m.clearedFields[moment.FieldContentVector] = struct{}{}
+// AddedResultCount returns the value that was added to the "resultCount" field in this mutation.
+func (m *SearchLogMutation) AddedResultCount() (r int, exists bool) {
+	v := m.addresultCount
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ContentVectorCleared returns if the "content_vector" field was cleared in this mutation.
-func (m *MomentMutation) ContentVectorCleared() bool {
-	_, ok := m.clearedFields[moment.FieldContentVector]
-	return ok
+// ResetResultCount resets all changes to the "resultCount" field.
+func (m *SearchLogMutation) ResetResultCount() {
+	m.resultCount = nil
+	m.addresultCount = nil
 }
 
-// ResetContentVector resets all changes to the "content_vector" field.
-func (m *MomentMutation) ResetContentVector() {
-	m.content_vector = nil
-	m.appendcontent_vector = nil
-	delete(m.clearedFields, moment.FieldContentVector)
+// SetLatencyMs sets the "latencyMs" field.
+func (m *SearchLogMutation) SetLatencyMs(i int) {
+	m.latencyMs = &i
+	m.addlatencyMs = nil
 }
 
-// AddMomentImageIDs adds the "moment_images" edge to the MomentImage entity by ids.
-func (m *MomentMutation) AddMomentImageIDs(ids ...uint) {
-	if m.moment_images == nil {
-		m.moment_images = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.moment_images[ids[i]] = struct{}{}
+// LatencyMs returns the value of the "latencyMs" field in the mutation.
+func (m *SearchLogMutation) LatencyMs() (r int, exists bool) {
+	v := m.latencyMs
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearMomentImages clears the "moment_images" edge to the MomentImage entity.
-func (m *MomentMutation) ClearMomentImages() {
-	m.clearedmoment_images = true
-}
-
-// MomentImagesCleared reports if the "moment_images" edge to the MomentImage entity was cleared.
-func (m *MomentMutation) MomentImagesCleared() bool {
-	return m.clearedmoment_images
-}
-
-// RemoveMomentImageIDs removes the "moment_images" edge to the MomentImage entity by IDs.
-func (m *MomentMutation) RemoveMomentImageIDs(ids ...uint) {
-	if m.removedmoment_images == nil {
-		m.removedmoment_images = make(map[uint]struct{})
+// OldLatencyMs returns the old "latencyMs" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldLatencyMs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.moment_images, ids[i])
-		m.removedmoment_images[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
 	}
-}
-
-// RemovedMomentImages returns the removed IDs of the "moment_images" edge to the MomentImage entity.
-func (m *MomentMutation) RemovedMomentImagesIDs() (ids []uint) {
-	for id := range m.removedmoment_images {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
 	}
-	return
+	return oldValue.LatencyMs, nil
 }
 
-// MomentImagesIDs returns the "moment_images" edge IDs in the mutation.
-func (m *MomentMutation) MomentImagesIDs() (ids []uint) {
-	for id := range m.moment_images {
-		ids = append(ids, id)
+// AddLatencyMs adds i to the "latencyMs" field.
+func (m *SearchLogMutation) AddLatencyMs(i int) {
+	if m.addlatencyMs != nil {
+		*m.addlatencyMs += i
+	} else {
+		m.addlatencyMs = &i
 	}
-	return
 }
 
-// ResetMomentImages resets all changes to the "moment_images" edge.
-func (m *MomentMutation) ResetMomentImages() {
-	m.moment_images = nil
-	m.clearedmoment_images = false
-	m.removedmoment_images = nil
+// AddedLatencyMs returns the value that was added to the "latencyMs" field in this mutation.
+func (m *SearchLogMutation) AddedLatencyMs() (r int, exists bool) {
+	v := m.addlatencyMs
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddMomentVideoIDs adds the "moment_videos" edge to the MomentVideo entity by ids.
-func (m *MomentMutation) AddMomentVideoIDs(ids ...uint) {
-	if m.moment_videos == nil {
-		m.moment_videos = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.moment_videos[ids[i]] = struct{}{}
-	}
+// ResetLatencyMs resets all changes to the "latencyMs" field.
+func (m *SearchLogMutation) ResetLatencyMs() {
+	m.latencyMs = nil
+	m.addlatencyMs = nil
 }
 
-// ClearMomentVideos clears the "moment_videos" edge to the MomentVideo entity.
-func (m *MomentMutation) ClearMomentVideos() {
-	m.clearedmoment_videos = true
+// SetDegraded sets the "degraded" field.
+func (m *SearchLogMutation) SetDegraded(b bool) {
+	m.degraded = &b
 }
 
-// MomentVideosCleared reports if the "moment_videos" edge to the MomentVideo entity was cleared.
-func (m *MomentMutation) MomentVideosCleared() bool {
-	return m.clearedmoment_videos
+// Degraded returns the value of the "degraded" field in the mutation.
+func (m *SearchLogMutation) Degraded() (r bool, exists bool) {
+	v := m.degraded
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveMomentVideoIDs removes the "moment_videos" edge to the MomentVideo entity by IDs.
-func (m *MomentMutation) RemoveMomentVideoIDs(ids ...uint) {
-	if m.removedmoment_videos == nil {
-		m.removedmoment_videos = make(map[uint]struct{})
+// OldDegraded returns the old "degraded" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldDegraded(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDegraded is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.moment_videos, ids[i])
-		m.removedmoment_videos[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDegraded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDegraded: %w", err)
 	}
+	return oldValue.Degraded, nil
+}
+
+// ResetDegraded resets all changes to the "degraded" field.
+func (m *SearchLogMutation) ResetDegraded() {
+	m.degraded = nil
 }
 
-// RemovedMomentVideos returns the removed IDs of the "moment_videos" edge to the MomentVideo entity.
-func (m *MomentMutation) RemovedMomentVideosIDs() (ids []uint) {
-	for id := range m.removedmoment_videos {
-		ids = append(ids, id)
+// SetRequestId sets the "requestId" field.
+func (m *SearchLogMutation) SetRequestId(s string) {
+	m.requestId = &s
+}
+
+// RequestId returns the value of the "requestId" field in the mutation.
+func (m *SearchLogMutation) RequestId() (r string, exists bool) {
+	v := m.requestId
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MomentVideosIDs returns the "moment_videos" edge IDs in the mutation.
-func (m *MomentMutation) MomentVideosIDs() (ids []uint) {
-	for id := range m.moment_videos {
-		ids = append(ids, id)
+// OldRequestId returns the old "requestId" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldRequestId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestId is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestId: %w", err)
+	}
+	return oldValue.RequestId, nil
 }
 
-// ResetMomentVideos resets all changes to the "moment_videos" edge.
-func (m *MomentMutation) ResetMomentVideos() {
-	m.moment_videos = nil
-	m.clearedmoment_videos = false
-	m.removedmoment_videos = nil
+// ClearRequestId clears the value of the "requestId" field.
+func (m *SearchLogMutation) ClearRequestId() {
+	m.requestId = nil
+	m.clearedFields[searchlog.FieldRequestId] = struct{}{}
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *MomentMutation) SetUserID(id string) {
-	m.user = &id
+// RequestIdCleared returns if the "requestId" field was cleared in this mutation.
+func (m *SearchLogMutation) RequestIdCleared() bool {
+	_, ok := m.clearedFields[searchlog.FieldRequestId]
+	return ok
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *MomentMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[moment.FieldOwnerId] = struct{}{}
+// ResetRequestId resets all changes to the "requestId" field.
+func (m *SearchLogMutation) ResetRequestId() {
+	m.requestId = nil
+	delete(m.clearedFields, searchlog.FieldRequestId)
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *MomentMutation) UserCleared() bool {
-	return m.OwnerIdCleared() || m.cleareduser
+// SetCreatedAt sets the "createdAt" field.
+func (m *SearchLogMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *MomentMutation) UserID() (id string, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *SearchLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *MomentMutation) UserIDs() (ids []string) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "createdAt" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *MomentMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *SearchLogMutation) ResetCreatedAt() {
+	m.createdAt = nil
 }
 
-// Where appends a list predicates to the MomentMutation builder.
-func (m *MomentMutation) Where(ps ...predicate.Moment) {
+// Where appends a list predicates to the SearchLogMutation builder.
+func (m *SearchLogMutation) Where(ps ...predicate.SearchLog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MomentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SearchLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MomentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Moment, len(ps))
+func (m *SearchLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SearchLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9045,57 +13999,48 @@ func (m *MomentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MomentMutation) Op() Op {
+func (m *SearchLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MomentMutation) SetOp(op Op) {
+func (m *SearchLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Moment).
-func (m *MomentMutation) Type() string {
+// Type returns the node type of this mutation (SearchLog).
+func (m *SearchLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MomentMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.content != nil {
-		fields = append(fields, moment.FieldContent)
-	}
-	if m.category != nil {
-		fields = append(fields, moment.FieldCategory)
-	}
-	if m.createdAt != nil {
-		fields = append(fields, moment.FieldCreatedAt)
-	}
-	if m.updatedAt != nil {
-		fields = append(fields, moment.FieldUpdatedAt)
+func (m *SearchLogMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.query != nil {
+		fields = append(fields, searchlog.FieldQuery)
 	}
-	if m.user != nil {
-		fields = append(fields, moment.FieldOwnerId)
+	if m._type != nil {
+		fields = append(fields, searchlog.FieldType)
 	}
-	if m.isPublic != nil {
-		fields = append(fields, moment.FieldIsPublic)
+	if m.backend != nil {
+		fields = append(fields, searchlog.FieldBackend)
 	}
-	if m.likes != nil {
-		fields = append(fields, moment.FieldLikes)
+	if m.resultCount != nil {
+		fields = append(fields, searchlog.FieldResultCount)
 	}
-	if m.tags != nil {
-		fields = append(fields, moment.FieldTags)
+	if m.latencyMs != nil {
+		fields = append(fields, searchlog.FieldLatencyMs)
 	}
-	if m.views != nil {
-		fields = append(fields, moment.FieldViews)
+	if m.degraded != nil {
+		fields = append(fields, searchlog.FieldDegraded)
 	}
-	if m.extraData != nil {
-		fields = append(fields, moment.FieldExtraData)
+	if m.requestId != nil {
+		fields = append(fields, searchlog.FieldRequestId)
 	}
-	if m.content_vector != nil {
-		fields = append(fields, moment.FieldContentVector)
+	if m.createdAt != nil {
+		fields = append(fields, searchlog.FieldCreatedAt)
 	}
 	return fields
 }
@@ -9103,30 +14048,24 @@ func (m *MomentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MomentMutation) Field(name string) (ent.Value, bool) {
+func (m *SearchLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case moment.FieldContent:
-		return m.Content()
-	case moment.FieldCategory:
-		return m.Category()
-	case moment.FieldCreatedAt:
+	case searchlog.FieldQuery:
+		return m.Query()
+	case searchlog.FieldType:
+		return m.GetType()
+	case searchlog.FieldBackend:
+		return m.Backend()
+	case searchlog.FieldResultCount:
+		return m.ResultCount()
+	case searchlog.FieldLatencyMs:
+		return m.LatencyMs()
+	case searchlog.FieldDegraded:
+		return m.Degraded()
+	case searchlog.FieldRequestId:
+		return m.RequestId()
+	case searchlog.FieldCreatedAt:
 		return m.CreatedAt()
-	case moment.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case moment.FieldOwnerId:
-		return m.OwnerId()
-	case moment.FieldIsPublic:
-		return m.IsPublic()
-	case moment.FieldLikes:
-		return m.Likes()
-	case moment.FieldTags:
-		return m.Tags()
-	case moment.FieldViews:
-		return m.Views()
-	case moment.FieldExtraData:
-		return m.ExtraData()
-	case moment.FieldContentVector:
-		return m.ContentVector()
 	}
 	return nil, false
 }
@@ -9134,129 +14073,102 @@ func (m *MomentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MomentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SearchLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case moment.FieldContent:
-		return m.OldContent(ctx)
-	case moment.FieldCategory:
-		return m.OldCategory(ctx)
-	case moment.FieldCreatedAt:
+	case searchlog.FieldQuery:
+		return m.OldQuery(ctx)
+	case searchlog.FieldType:
+		return m.OldType(ctx)
+	case searchlog.FieldBackend:
+		return m.OldBackend(ctx)
+	case searchlog.FieldResultCount:
+		return m.OldResultCount(ctx)
+	case searchlog.FieldLatencyMs:
+		return m.OldLatencyMs(ctx)
+	case searchlog.FieldDegraded:
+		return m.OldDegraded(ctx)
+	case searchlog.FieldRequestId:
+		return m.OldRequestId(ctx)
+	case searchlog.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case moment.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case moment.FieldOwnerId:
-		return m.OldOwnerId(ctx)
-	case moment.FieldIsPublic:
-		return m.OldIsPublic(ctx)
-	case moment.FieldLikes:
-		return m.OldLikes(ctx)
-	case moment.FieldTags:
-		return m.OldTags(ctx)
-	case moment.FieldViews:
-		return m.OldViews(ctx)
-	case moment.FieldExtraData:
-		return m.OldExtraData(ctx)
-	case moment.FieldContentVector:
-		return m.OldContentVector(ctx)
 	}
-	return nil, fmt.Errorf("unknown Moment field %s", name)
+	return nil, fmt.Errorf("unknown SearchLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MomentMutation) SetField(name string, value ent.Value) error {
+func (m *SearchLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case moment.FieldContent:
+	case searchlog.FieldQuery:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetQuery(v)
 		return nil
-	case moment.FieldCategory:
+	case searchlog.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCategory(v)
-		return nil
-	case moment.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case moment.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
+		m.SetType(v)
 		return nil
-	case moment.FieldOwnerId:
+	case searchlog.FieldBackend:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwnerId(v)
-		return nil
-	case moment.FieldIsPublic:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsPublic(v)
+		m.SetBackend(v)
 		return nil
-	case moment.FieldLikes:
-		v, ok := value.(int32)
+	case searchlog.FieldResultCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLikes(v)
+		m.SetResultCount(v)
 		return nil
-	case moment.FieldTags:
-		v, ok := value.(json.RawMessage)
+	case searchlog.FieldLatencyMs:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTags(v)
+		m.SetLatencyMs(v)
 		return nil
-	case moment.FieldViews:
-		v, ok := value.(int32)
+	case searchlog.FieldDegraded:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetViews(v)
+		m.SetDegraded(v)
 		return nil
-	case moment.FieldExtraData:
-		v, ok := value.(json.RawMessage)
+	case searchlog.FieldRequestId:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExtraData(v)
+		m.SetRequestId(v)
 		return nil
-	case moment.FieldContentVector:
-		v, ok := value.(json.RawMessage)
+	case searchlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContentVector(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Moment field %s", name)
+	return fmt.Errorf("unknown SearchLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MomentMutation) AddedFields() []string {
+func (m *SearchLogMutation) AddedFields() []string {
 	var fields []string
-	if m.addlikes != nil {
-		fields = append(fields, moment.FieldLikes)
+	if m.addresultCount != nil {
+		fields = append(fields, searchlog.FieldResultCount)
 	}
-	if m.addviews != nil {
-		fields = append(fields, moment.FieldViews)
+	if m.addlatencyMs != nil {
+		fields = append(fields, searchlog.FieldLatencyMs)
 	}
 	return fields
 }
@@ -9264,12 +14176,12 @@ func (m *MomentMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MomentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SearchLogMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case moment.FieldLikes:
-		return m.AddedLikes()
-	case moment.FieldViews:
-		return m.AddedViews()
+	case searchlog.FieldResultCount:
+		return m.AddedResultCount()
+	case searchlog.FieldLatencyMs:
+		return m.AddedLatencyMs()
 	}
 	return nil, false
 }
@@ -9277,260 +14189,171 @@ func (m *MomentMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MomentMutation) AddField(name string, value ent.Value) error {
+func (m *SearchLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case moment.FieldLikes:
-		v, ok := value.(int32)
+	case searchlog.FieldResultCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddLikes(v)
+		m.AddResultCount(v)
 		return nil
-	case moment.FieldViews:
-		v, ok := value.(int32)
+	case searchlog.FieldLatencyMs:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddViews(v)
+		m.AddLatencyMs(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Moment numeric field %s", name)
+	return fmt.Errorf("unknown SearchLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MomentMutation) ClearedFields() []string {
+func (m *SearchLogMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(moment.FieldOwnerId) {
-		fields = append(fields, moment.FieldOwnerId)
+	if m.FieldCleared(searchlog.FieldBackend) {
+		fields = append(fields, searchlog.FieldBackend)
 	}
-	if m.FieldCleared(moment.FieldContentVector) {
-		fields = append(fields, moment.FieldContentVector)
+	if m.FieldCleared(searchlog.FieldRequestId) {
+		fields = append(fields, searchlog.FieldRequestId)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MomentMutation) FieldCleared(name string) bool {
+func (m *SearchLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MomentMutation) ClearField(name string) error {
+func (m *SearchLogMutation) ClearField(name string) error {
 	switch name {
-	case moment.FieldOwnerId:
-		m.ClearOwnerId()
+	case searchlog.FieldBackend:
+		m.ClearBackend()
 		return nil
-	case moment.FieldContentVector:
-		m.ClearContentVector()
+	case searchlog.FieldRequestId:
+		m.ClearRequestId()
 		return nil
 	}
-	return fmt.Errorf("unknown Moment nullable field %s", name)
+	return fmt.Errorf("unknown SearchLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *MomentMutation) ResetField(name string) error {
-	switch name {
-	case moment.FieldContent:
-		m.ResetContent()
-		return nil
-	case moment.FieldCategory:
-		m.ResetCategory()
-		return nil
-	case moment.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case moment.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+// It returns an error if the field is not defined in the schema.
+func (m *SearchLogMutation) ResetField(name string) error {
+	switch name {
+	case searchlog.FieldQuery:
+		m.ResetQuery()
 		return nil
-	case moment.FieldOwnerId:
-		m.ResetOwnerId()
+	case searchlog.FieldType:
+		m.ResetType()
 		return nil
-	case moment.FieldIsPublic:
-		m.ResetIsPublic()
+	case searchlog.FieldBackend:
+		m.ResetBackend()
 		return nil
-	case moment.FieldLikes:
-		m.ResetLikes()
+	case searchlog.FieldResultCount:
+		m.ResetResultCount()
 		return nil
-	case moment.FieldTags:
-		m.ResetTags()
+	case searchlog.FieldLatencyMs:
+		m.ResetLatencyMs()
 		return nil
-	case moment.FieldViews:
-		m.ResetViews()
+	case searchlog.FieldDegraded:
+		m.ResetDegraded()
 		return nil
-	case moment.FieldExtraData:
-		m.ResetExtraData()
+	case searchlog.FieldRequestId:
+		m.ResetRequestId()
 		return nil
-	case moment.FieldContentVector:
-		m.ResetContentVector()
+	case searchlog.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Moment field %s", name)
+	return fmt.Errorf("unknown SearchLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MomentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.moment_images != nil {
-		edges = append(edges, moment.EdgeMomentImages)
-	}
-	if m.moment_videos != nil {
-		edges = append(edges, moment.EdgeMomentVideos)
-	}
-	if m.user != nil {
-		edges = append(edges, moment.EdgeUser)
-	}
+func (m *SearchLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MomentMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case moment.EdgeMomentImages:
-		ids := make([]ent.Value, 0, len(m.moment_images))
-		for id := range m.moment_images {
-			ids = append(ids, id)
-		}
-		return ids
-	case moment.EdgeMomentVideos:
-		ids := make([]ent.Value, 0, len(m.moment_videos))
-		for id := range m.moment_videos {
-			ids = append(ids, id)
-		}
-		return ids
-	case moment.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *SearchLogMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MomentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedmoment_images != nil {
-		edges = append(edges, moment.EdgeMomentImages)
-	}
-	if m.removedmoment_videos != nil {
-		edges = append(edges, moment.EdgeMomentVideos)
-	}
+func (m *SearchLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MomentMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case moment.EdgeMomentImages:
-		ids := make([]ent.Value, 0, len(m.removedmoment_images))
-		for id := range m.removedmoment_images {
-			ids = append(ids, id)
-		}
-		return ids
-	case moment.EdgeMomentVideos:
-		ids := make([]ent.Value, 0, len(m.removedmoment_videos))
-		for id := range m.removedmoment_videos {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SearchLogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MomentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedmoment_images {
-		edges = append(edges, moment.EdgeMomentImages)
-	}
-	if m.clearedmoment_videos {
-		edges = append(edges, moment.EdgeMomentVideos)
-	}
-	if m.cleareduser {
-		edges = append(edges, moment.EdgeUser)
-	}
+func (m *SearchLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MomentMutation) EdgeCleared(name string) bool {
-	switch name {
-	case moment.EdgeMomentImages:
-		return m.clearedmoment_images
-	case moment.EdgeMomentVideos:
-		return m.clearedmoment_videos
-	case moment.EdgeUser:
-		return m.cleareduser
-	}
+func (m *SearchLogMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MomentMutation) ClearEdge(name string) error {
-	switch name {
-	case moment.EdgeUser:
-		m.ClearUser()
-		return nil
-	}
-	return fmt.Errorf("unknown Moment unique edge %s", name)
+func (m *SearchLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SearchLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MomentMutation) ResetEdge(name string) error {
-	switch name {
-	case moment.EdgeMomentImages:
-		m.ResetMomentImages()
-		return nil
-	case moment.EdgeMomentVideos:
-		m.ResetMomentVideos()
-		return nil
-	case moment.EdgeUser:
-		m.ResetUser()
-		return nil
-	}
-	return fmt.Errorf("unknown Moment edge %s", name)
+func (m *SearchLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SearchLog edge %s", name)
 }
 
-// MomentImageMutation represents an operation that mutates the MomentImage nodes in the graph.
-type MomentImageMutation struct {
+// SearchOutboxMutation represents an operation that mutates the SearchOutbox nodes in the graph.
+type SearchOutboxMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *uint
-	sort          *int32
-	addsort       *int32
+	id            *int
+	entityType    *string
+	entityId      *string
+	_op           *searchoutbox.Op
+	attempts      *int
+	addattempts   *int
+	lastError     *string
+	availableAt   *time.Time
 	createdAt     *time.Time
-	updatedAt     *time.Time
 	clearedFields map[string]struct{}
-	image         *string
-	clearedimage  bool
-	moment        *string
-	clearedmoment bool
 	done          bool
-	oldValue      func(context.Context) (*MomentImage, error)
-	predicates    []predicate.MomentImage
+	oldValue      func(context.Context) (*SearchOutbox, error)
+	predicates    []predicate.SearchOutbox
 }
 
-var _ ent.Mutation = (*MomentImageMutation)(nil)
+var _ ent.Mutation = (*SearchOutboxMutation)(nil)
 
-// momentimageOption allows management of the mutation configuration using functional options.
-type momentimageOption func(*MomentImageMutation)
+// searchoutboxOption allows management of the mutation configuration using functional options.
+type searchoutboxOption func(*SearchOutboxMutation)
 
-// newMomentImageMutation creates new mutation for the MomentImage entity.
-func newMomentImageMutation(c config, op Op, opts ...momentimageOption) *MomentImageMutation {
-	m := &MomentImageMutation{
+// newSearchOutboxMutation creates new mutation for the SearchOutbox entity.
+func newSearchOutboxMutation(c config, op Op, opts ...searchoutboxOption) *SearchOutboxMutation {
+	m := &SearchOutboxMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMomentImage,
+		typ:           TypeSearchOutbox,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9539,20 +14362,20 @@ func newMomentImageMutation(c config, op Op, opts ...momentimageOption) *MomentI
 	return m
 }
 
-// withMomentImageID sets the ID field of the mutation.
-func withMomentImageID(id uint) momentimageOption {
-	return func(m *MomentImageMutation) {
+// withSearchOutboxID sets the ID field of the mutation.
+func withSearchOutboxID(id int) searchoutboxOption {
+	return func(m *SearchOutboxMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MomentImage
+			value *SearchOutbox
 		)
-		m.oldValue = func(ctx context.Context) (*MomentImage, error) {
+		m.oldValue = func(ctx context.Context) (*SearchOutbox, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MomentImage.Get(ctx, id)
+					value, err = m.Client().SearchOutbox.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9561,10 +14384,10 @@ func withMomentImageID(id uint) momentimageOption {
 	}
 }
 
-// withMomentImage sets the old MomentImage of the mutation.
-func withMomentImage(node *MomentImage) momentimageOption {
-	return func(m *MomentImageMutation) {
-		m.oldValue = func(context.Context) (*MomentImage, error) {
+// withSearchOutbox sets the old SearchOutbox of the mutation.
+func withSearchOutbox(node *SearchOutbox) searchoutboxOption {
+	return func(m *SearchOutboxMutation) {
+		m.oldValue = func(context.Context) (*SearchOutbox, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9573,7 +14396,7 @@ func withMomentImage(node *MomentImage) momentimageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MomentImageMutation) Client() *Client {
+func (m SearchOutboxMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9581,7 +14404,7 @@ func (m MomentImageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MomentImageMutation) Tx() (*Tx, error) {
+func (m SearchOutboxMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -9590,15 +14413,9 @@ func (m MomentImageMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MomentImage entities.
-func (m *MomentImageMutation) SetID(id uint) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MomentImageMutation) ID() (id uint, exists bool) {
+func (m *SearchOutboxMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -9609,336 +14426,315 @@ func (m *MomentImageMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MomentImageMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *SearchOutboxMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uint{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MomentImage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SearchOutbox.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetImageId sets the "imageId" field.
-func (m *MomentImageMutation) SetImageId(s string) {
-	m.image = &s
+// SetEntityType sets the "entityType" field.
+func (m *SearchOutboxMutation) SetEntityType(s string) {
+	m.entityType = &s
 }
 
-// ImageId returns the value of the "imageId" field in the mutation.
-func (m *MomentImageMutation) ImageId() (r string, exists bool) {
-	v := m.image
+// EntityType returns the value of the "entityType" field in the mutation.
+func (m *SearchOutboxMutation) EntityType() (r string, exists bool) {
+	v := m.entityType
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImageId returns the old "imageId" field's value of the MomentImage entity.
-// If the MomentImage object wasn't provided to the builder, the object is fetched from the database.
+// OldEntityType returns the old "entityType" field's value of the SearchOutbox entity.
+// If the SearchOutbox object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentImageMutation) OldImageId(ctx context.Context) (v string, err error) {
+func (m *SearchOutboxMutation) OldEntityType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImageId is only allowed on UpdateOne operations")
+		return v, errors.New("OldEntityType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImageId requires an ID field in the mutation")
+		return v, errors.New("OldEntityType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImageId: %w", err)
+		return v, fmt.Errorf("querying old value for OldEntityType: %w", err)
 	}
-	return oldValue.ImageId, nil
-}
-
-// ClearImageId clears the value of the "imageId" field.
-func (m *MomentImageMutation) ClearImageId() {
-	m.image = nil
-	m.clearedFields[momentimage.FieldImageId] = struct{}{}
-}
-
-// ImageIdCleared returns if the "imageId" field was cleared in this mutation.
-func (m *MomentImageMutation) ImageIdCleared() bool {
-	_, ok := m.clearedFields[momentimage.FieldImageId]
-	return ok
+	return oldValue.EntityType, nil
 }
 
-// ResetImageId resets all changes to the "imageId" field.
-func (m *MomentImageMutation) ResetImageId() {
-	m.image = nil
-	delete(m.clearedFields, momentimage.FieldImageId)
+// ResetEntityType resets all changes to the "entityType" field.
+func (m *SearchOutboxMutation) ResetEntityType() {
+	m.entityType = nil
 }
 
-// SetMomentId sets the "momentId" field.
-func (m *MomentImageMutation) SetMomentId(s string) {
-	m.moment = &s
+// SetEntityId sets the "entityId" field.
+func (m *SearchOutboxMutation) SetEntityId(s string) {
+	m.entityId = &s
 }
 
-// MomentId returns the value of the "momentId" field in the mutation.
-func (m *MomentImageMutation) MomentId() (r string, exists bool) {
-	v := m.moment
+// EntityId returns the value of the "entityId" field in the mutation.
+func (m *SearchOutboxMutation) EntityId() (r string, exists bool) {
+	v := m.entityId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMomentId returns the old "momentId" field's value of the MomentImage entity.
-// If the MomentImage object wasn't provided to the builder, the object is fetched from the database.
+// OldEntityId returns the old "entityId" field's value of the SearchOutbox entity.
+// If the SearchOutbox object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentImageMutation) OldMomentId(ctx context.Context) (v string, err error) {
+func (m *SearchOutboxMutation) OldEntityId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMomentId is only allowed on UpdateOne operations")
+		return v, errors.New("OldEntityId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMomentId requires an ID field in the mutation")
+		return v, errors.New("OldEntityId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMomentId: %w", err)
+		return v, fmt.Errorf("querying old value for OldEntityId: %w", err)
 	}
-	return oldValue.MomentId, nil
-}
-
-// ClearMomentId clears the value of the "momentId" field.
-func (m *MomentImageMutation) ClearMomentId() {
-	m.moment = nil
-	m.clearedFields[momentimage.FieldMomentId] = struct{}{}
-}
-
-// MomentIdCleared returns if the "momentId" field was cleared in this mutation.
-func (m *MomentImageMutation) MomentIdCleared() bool {
-	_, ok := m.clearedFields[momentimage.FieldMomentId]
-	return ok
+	return oldValue.EntityId, nil
 }
 
-// ResetMomentId resets all changes to the "momentId" field.
-func (m *MomentImageMutation) ResetMomentId() {
-	m.moment = nil
-	delete(m.clearedFields, momentimage.FieldMomentId)
+// ResetEntityId resets all changes to the "entityId" field.
+func (m *SearchOutboxMutation) ResetEntityId() {
+	m.entityId = nil
 }
 
-// SetSort sets the "sort" field.
-func (m *MomentImageMutation) SetSort(i int32) {
-	m.sort = &i
-	m.addsort = nil
+// SetOpField sets the "op" field.
+func (m *SearchOutboxMutation) SetOpField(s searchoutbox.Op) {
+	m._op = &s
 }
 
-// Sort returns the value of the "sort" field in the mutation.
-func (m *MomentImageMutation) Sort() (r int32, exists bool) {
-	v := m.sort
+// GetOp returns the value of the "op" field in the mutation.
+func (m *SearchOutboxMutation) GetOp() (r searchoutbox.Op, exists bool) {
+	v := m._op
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSort returns the old "sort" field's value of the MomentImage entity.
-// If the MomentImage object wasn't provided to the builder, the object is fetched from the database.
+// OldOp returns the old "op" field's value of the SearchOutbox entity.
+// If the SearchOutbox object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentImageMutation) OldSort(ctx context.Context) (v int32, err error) {
+func (m *SearchOutboxMutation) OldOp(ctx context.Context) (v searchoutbox.Op, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSort is only allowed on UpdateOne operations")
+		return v, errors.New("OldOp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSort requires an ID field in the mutation")
+		return v, errors.New("OldOp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSort: %w", err)
+		return v, fmt.Errorf("querying old value for OldOp: %w", err)
 	}
-	return oldValue.Sort, nil
-}
-
-// AddSort adds i to the "sort" field.
-func (m *MomentImageMutation) AddSort(i int32) {
-	if m.addsort != nil {
-		*m.addsort += i
-	} else {
-		m.addsort = &i
-	}
-}
-
-// AddedSort returns the value that was added to the "sort" field in this mutation.
-func (m *MomentImageMutation) AddedSort() (r int32, exists bool) {
-	v := m.addsort
-	if v == nil {
-		return
-	}
-	return *v, true
+	return oldValue.Op, nil
 }
 
-// ResetSort resets all changes to the "sort" field.
-func (m *MomentImageMutation) ResetSort() {
-	m.sort = nil
-	m.addsort = nil
+// ResetOp resets all changes to the "op" field.
+func (m *SearchOutboxMutation) ResetOp() {
+	m._op = nil
 }
 
-// SetCreatedAt sets the "createdAt" field.
-func (m *MomentImageMutation) SetCreatedAt(t time.Time) {
-	m.createdAt = &t
+// SetAttempts sets the "attempts" field.
+func (m *SearchOutboxMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// CreatedAt returns the value of the "createdAt" field in the mutation.
-func (m *MomentImageMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.createdAt
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *SearchOutboxMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "createdAt" field's value of the MomentImage entity.
-// If the MomentImage object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the SearchOutbox entity.
+// If the SearchOutbox object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentImageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SearchOutboxMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Attempts, nil
 }
 
-// ResetCreatedAt resets all changes to the "createdAt" field.
-func (m *MomentImageMutation) ResetCreatedAt() {
-	m.createdAt = nil
+// AddAttempts adds i to the "attempts" field.
+func (m *SearchOutboxMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// SetUpdatedAt sets the "updatedAt" field.
-func (m *MomentImageMutation) SetUpdatedAt(t time.Time) {
-	m.updatedAt = &t
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *SearchOutboxMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updatedAt" field in the mutation.
-func (m *MomentImageMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updatedAt
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *SearchOutboxMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLastError sets the "lastError" field.
+func (m *SearchOutboxMutation) SetLastError(s string) {
+	m.lastError = &s
+}
+
+// LastError returns the value of the "lastError" field in the mutation.
+func (m *SearchOutboxMutation) LastError() (r string, exists bool) {
+	v := m.lastError
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updatedAt" field's value of the MomentImage entity.
-// If the MomentImage object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "lastError" field's value of the SearchOutbox entity.
+// If the SearchOutbox object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentImageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SearchOutboxMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.LastError, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updatedAt" field.
-func (m *MomentImageMutation) ResetUpdatedAt() {
-	m.updatedAt = nil
+// ClearLastError clears the value of the "lastError" field.
+func (m *SearchOutboxMutation) ClearLastError() {
+	m.lastError = nil
+	m.clearedFields[searchoutbox.FieldLastError] = struct{}{}
 }
 
-// SetImageID sets the "image" edge to the Image entity by id.
-func (m *MomentImageMutation) SetImageID(id string) {
-	m.image = &id
+// LastErrorCleared returns if the "lastError" field was cleared in this mutation.
+func (m *SearchOutboxMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[searchoutbox.FieldLastError]
+	return ok
 }
 
-// ClearImage clears the "image" edge to the Image entity.
-func (m *MomentImageMutation) ClearImage() {
-	m.clearedimage = true
-	m.clearedFields[momentimage.FieldImageId] = struct{}{}
+// ResetLastError resets all changes to the "lastError" field.
+func (m *SearchOutboxMutation) ResetLastError() {
+	m.lastError = nil
+	delete(m.clearedFields, searchoutbox.FieldLastError)
 }
 
-// ImageCleared reports if the "image" edge to the Image entity was cleared.
-func (m *MomentImageMutation) ImageCleared() bool {
-	return m.ImageIdCleared() || m.clearedimage
+// SetAvailableAt sets the "availableAt" field.
+func (m *SearchOutboxMutation) SetAvailableAt(t time.Time) {
+	m.availableAt = &t
 }
 
-// ImageID returns the "image" edge ID in the mutation.
-func (m *MomentImageMutation) ImageID() (id string, exists bool) {
-	if m.image != nil {
-		return *m.image, true
+// AvailableAt returns the value of the "availableAt" field in the mutation.
+func (m *SearchOutboxMutation) AvailableAt() (r time.Time, exists bool) {
+	v := m.availableAt
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ImageIDs returns the "image" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ImageID instead. It exists only for internal usage by the builders.
-func (m *MomentImageMutation) ImageIDs() (ids []string) {
-	if id := m.image; id != nil {
-		ids = append(ids, *id)
+// OldAvailableAt returns the old "availableAt" field's value of the SearchOutbox entity.
+// If the SearchOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchOutboxMutation) OldAvailableAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvailableAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetImage resets all changes to the "image" edge.
-func (m *MomentImageMutation) ResetImage() {
-	m.image = nil
-	m.clearedimage = false
-}
-
-// SetMomentID sets the "moment" edge to the Moment entity by id.
-func (m *MomentImageMutation) SetMomentID(id string) {
-	m.moment = &id
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvailableAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvailableAt: %w", err)
+	}
+	return oldValue.AvailableAt, nil
 }
 
-// ClearMoment clears the "moment" edge to the Moment entity.
-func (m *MomentImageMutation) ClearMoment() {
-	m.clearedmoment = true
-	m.clearedFields[momentimage.FieldMomentId] = struct{}{}
+// ResetAvailableAt resets all changes to the "availableAt" field.
+func (m *SearchOutboxMutation) ResetAvailableAt() {
+	m.availableAt = nil
 }
 
-// MomentCleared reports if the "moment" edge to the Moment entity was cleared.
-func (m *MomentImageMutation) MomentCleared() bool {
-	return m.MomentIdCleared() || m.clearedmoment
+// SetCreatedAt sets the "createdAt" field.
+func (m *SearchOutboxMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
 }
 
-// MomentID returns the "moment" edge ID in the mutation.
-func (m *MomentImageMutation) MomentID() (id string, exists bool) {
-	if m.moment != nil {
-		return *m.moment, true
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *SearchOutboxMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MomentIDs returns the "moment" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MomentID instead. It exists only for internal usage by the builders.
-func (m *MomentImageMutation) MomentIDs() (ids []string) {
-	if id := m.moment; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "createdAt" field's value of the SearchOutbox entity.
+// If the SearchOutbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchOutboxMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetMoment resets all changes to the "moment" edge.
-func (m *MomentImageMutation) ResetMoment() {
-	m.moment = nil
-	m.clearedmoment = false
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *SearchOutboxMutation) ResetCreatedAt() {
+	m.createdAt = nil
 }
 
-// Where appends a list predicates to the MomentImageMutation builder.
-func (m *MomentImageMutation) Where(ps ...predicate.MomentImage) {
+// Where appends a list predicates to the SearchOutboxMutation builder.
+func (m *SearchOutboxMutation) Where(ps ...predicate.SearchOutbox) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MomentImageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SearchOutboxMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MomentImageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MomentImage, len(ps))
+func (m *SearchOutboxMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SearchOutbox, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9946,39 +14742,45 @@ func (m *MomentImageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MomentImageMutation) Op() Op {
+func (m *SearchOutboxMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MomentImageMutation) SetOp(op Op) {
+func (m *SearchOutboxMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MomentImage).
-func (m *MomentImageMutation) Type() string {
+// Type returns the node type of this mutation (SearchOutbox).
+func (m *SearchOutboxMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MomentImageMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.image != nil {
-		fields = append(fields, momentimage.FieldImageId)
+func (m *SearchOutboxMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.entityType != nil {
+		fields = append(fields, searchoutbox.FieldEntityType)
 	}
-	if m.moment != nil {
-		fields = append(fields, momentimage.FieldMomentId)
+	if m.entityId != nil {
+		fields = append(fields, searchoutbox.FieldEntityId)
 	}
-	if m.sort != nil {
-		fields = append(fields, momentimage.FieldSort)
+	if m._op != nil {
+		fields = append(fields, searchoutbox.FieldOp)
 	}
-	if m.createdAt != nil {
-		fields = append(fields, momentimage.FieldCreatedAt)
+	if m.attempts != nil {
+		fields = append(fields, searchoutbox.FieldAttempts)
 	}
-	if m.updatedAt != nil {
-		fields = append(fields, momentimage.FieldUpdatedAt)
+	if m.lastError != nil {
+		fields = append(fields, searchoutbox.FieldLastError)
+	}
+	if m.availableAt != nil {
+		fields = append(fields, searchoutbox.FieldAvailableAt)
+	}
+	if m.createdAt != nil {
+		fields = append(fields, searchoutbox.FieldCreatedAt)
 	}
 	return fields
 }
@@ -9986,18 +14788,22 @@ func (m *MomentImageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MomentImageMutation) Field(name string) (ent.Value, bool) {
+func (m *SearchOutboxMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case momentimage.FieldImageId:
-		return m.ImageId()
-	case momentimage.FieldMomentId:
-		return m.MomentId()
-	case momentimage.FieldSort:
-		return m.Sort()
-	case momentimage.FieldCreatedAt:
+	case searchoutbox.FieldEntityType:
+		return m.EntityType()
+	case searchoutbox.FieldEntityId:
+		return m.EntityId()
+	case searchoutbox.FieldOp:
+		return m.GetOp()
+	case searchoutbox.FieldAttempts:
+		return m.Attempts()
+	case searchoutbox.FieldLastError:
+		return m.LastError()
+	case searchoutbox.FieldAvailableAt:
+		return m.AvailableAt()
+	case searchoutbox.FieldCreatedAt:
 		return m.CreatedAt()
-	case momentimage.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -10005,72 +14811,90 @@ func (m *MomentImageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MomentImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SearchOutboxMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case momentimage.FieldImageId:
-		return m.OldImageId(ctx)
-	case momentimage.FieldMomentId:
-		return m.OldMomentId(ctx)
-	case momentimage.FieldSort:
-		return m.OldSort(ctx)
-	case momentimage.FieldCreatedAt:
+	case searchoutbox.FieldEntityType:
+		return m.OldEntityType(ctx)
+	case searchoutbox.FieldEntityId:
+		return m.OldEntityId(ctx)
+	case searchoutbox.FieldOp:
+		return m.OldOp(ctx)
+	case searchoutbox.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case searchoutbox.FieldLastError:
+		return m.OldLastError(ctx)
+	case searchoutbox.FieldAvailableAt:
+		return m.OldAvailableAt(ctx)
+	case searchoutbox.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case momentimage.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown MomentImage field %s", name)
+	return nil, fmt.Errorf("unknown SearchOutbox field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MomentImageMutation) SetField(name string, value ent.Value) error {
+func (m *SearchOutboxMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case momentimage.FieldImageId:
+	case searchoutbox.FieldEntityType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImageId(v)
+		m.SetEntityType(v)
 		return nil
-	case momentimage.FieldMomentId:
+	case searchoutbox.FieldEntityId:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMomentId(v)
+		m.SetEntityId(v)
 		return nil
-	case momentimage.FieldSort:
-		v, ok := value.(int32)
+	case searchoutbox.FieldOp:
+		v, ok := value.(searchoutbox.Op)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSort(v)
+		m.SetOpField(v)
 		return nil
-	case momentimage.FieldCreatedAt:
+	case searchoutbox.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case searchoutbox.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case searchoutbox.FieldAvailableAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetAvailableAt(v)
 		return nil
-	case momentimage.FieldUpdatedAt:
+	case searchoutbox.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MomentImage field %s", name)
+	return fmt.Errorf("unknown SearchOutbox field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MomentImageMutation) AddedFields() []string {
+func (m *SearchOutboxMutation) AddedFields() []string {
 	var fields []string
-	if m.addsort != nil {
-		fields = append(fields, momentimage.FieldSort)
+	if m.addattempts != nil {
+		fields = append(fields, searchoutbox.FieldAttempts)
 	}
 	return fields
 }
@@ -10078,10 +14902,10 @@ func (m *MomentImageMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MomentImageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SearchOutboxMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case momentimage.FieldSort:
-		return m.AddedSort()
+	case searchoutbox.FieldAttempts:
+		return m.AddedAttempts()
 	}
 	return nil, false
 }
@@ -10089,199 +14913,156 @@ func (m *MomentImageMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MomentImageMutation) AddField(name string, value ent.Value) error {
+func (m *SearchOutboxMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case momentimage.FieldSort:
-		v, ok := value.(int32)
+	case searchoutbox.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSort(v)
+		m.AddAttempts(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MomentImage numeric field %s", name)
+	return fmt.Errorf("unknown SearchOutbox numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MomentImageMutation) ClearedFields() []string {
+func (m *SearchOutboxMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(momentimage.FieldImageId) {
-		fields = append(fields, momentimage.FieldImageId)
-	}
-	if m.FieldCleared(momentimage.FieldMomentId) {
-		fields = append(fields, momentimage.FieldMomentId)
+	if m.FieldCleared(searchoutbox.FieldLastError) {
+		fields = append(fields, searchoutbox.FieldLastError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MomentImageMutation) FieldCleared(name string) bool {
+func (m *SearchOutboxMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MomentImageMutation) ClearField(name string) error {
+func (m *SearchOutboxMutation) ClearField(name string) error {
 	switch name {
-	case momentimage.FieldImageId:
-		m.ClearImageId()
-		return nil
-	case momentimage.FieldMomentId:
-		m.ClearMomentId()
+	case searchoutbox.FieldLastError:
+		m.ClearLastError()
 		return nil
 	}
-	return fmt.Errorf("unknown MomentImage nullable field %s", name)
+	return fmt.Errorf("unknown SearchOutbox nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MomentImageMutation) ResetField(name string) error {
+func (m *SearchOutboxMutation) ResetField(name string) error {
 	switch name {
-	case momentimage.FieldImageId:
-		m.ResetImageId()
+	case searchoutbox.FieldEntityType:
+		m.ResetEntityType()
 		return nil
-	case momentimage.FieldMomentId:
-		m.ResetMomentId()
+	case searchoutbox.FieldEntityId:
+		m.ResetEntityId()
 		return nil
-	case momentimage.FieldSort:
-		m.ResetSort()
+	case searchoutbox.FieldOp:
+		m.ResetOp()
 		return nil
-	case momentimage.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case searchoutbox.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case momentimage.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case searchoutbox.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case searchoutbox.FieldAvailableAt:
+		m.ResetAvailableAt()
+		return nil
+	case searchoutbox.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown MomentImage field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MomentImageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.image != nil {
-		edges = append(edges, momentimage.EdgeImage)
-	}
-	if m.moment != nil {
-		edges = append(edges, momentimage.EdgeMoment)
-	}
-	return edges
+	return fmt.Errorf("unknown SearchOutbox field %s", name)
 }
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *MomentImageMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case momentimage.EdgeImage:
-		if id := m.image; id != nil {
-			return []ent.Value{*id}
-		}
-	case momentimage.EdgeMoment:
-		if id := m.moment; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SearchOutboxMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SearchOutboxMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MomentImageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *SearchOutboxMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MomentImageMutation) RemovedIDs(name string) []ent.Value {
+func (m *SearchOutboxMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MomentImageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedimage {
-		edges = append(edges, momentimage.EdgeImage)
-	}
-	if m.clearedmoment {
-		edges = append(edges, momentimage.EdgeMoment)
-	}
+func (m *SearchOutboxMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MomentImageMutation) EdgeCleared(name string) bool {
-	switch name {
-	case momentimage.EdgeImage:
-		return m.clearedimage
-	case momentimage.EdgeMoment:
-		return m.clearedmoment
-	}
+func (m *SearchOutboxMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MomentImageMutation) ClearEdge(name string) error {
-	switch name {
-	case momentimage.EdgeImage:
-		m.ClearImage()
-		return nil
-	case momentimage.EdgeMoment:
-		m.ClearMoment()
-		return nil
-	}
-	return fmt.Errorf("unknown MomentImage unique edge %s", name)
+func (m *SearchOutboxMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SearchOutbox unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MomentImageMutation) ResetEdge(name string) error {
-	switch name {
-	case momentimage.EdgeImage:
-		m.ResetImage()
-		return nil
-	case momentimage.EdgeMoment:
-		m.ResetMoment()
-		return nil
-	}
-	return fmt.Errorf("unknown MomentImage edge %s", name)
+func (m *SearchOutboxMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SearchOutbox edge %s", name)
 }
 
-// MomentVideoMutation represents an operation that mutates the MomentVideo nodes in the graph.
-type MomentVideoMutation struct {
+// TagSuggestionMutation represents an operation that mutates the TagSuggestion nodes in the graph.
+type TagSuggestionMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *uint
-	sort          *int32
-	addsort       *int32
+	id            *int
+	entityType    *string
+	entityId      *string
+	ownerId       *string
+	tag           *string
+	similarity    *float64
+	addsimilarity *float64
+	status        *tagsuggestion.Status
 	createdAt     *time.Time
-	updatedAt     *time.Time
+	reviewedAt    *time.Time
 	clearedFields map[string]struct{}
-	moment        *string
-	clearedmoment bool
-	video         *string
-	clearedvideo  bool
 	done          bool
-	oldValue      func(context.Context) (*MomentVideo, error)
-	predicates    []predicate.MomentVideo
+	oldValue      func(context.Context) (*TagSuggestion, error)
+	predicates    []predicate.TagSuggestion
 }
 
-var _ ent.Mutation = (*MomentVideoMutation)(nil)
+var _ ent.Mutation = (*TagSuggestionMutation)(nil)
 
-// momentvideoOption allows management of the mutation configuration using functional options.
-type momentvideoOption func(*MomentVideoMutation)
+// tagsuggestionOption allows management of the mutation configuration using functional options.
+type tagsuggestionOption func(*TagSuggestionMutation)
 
-// newMomentVideoMutation creates new mutation for the MomentVideo entity.
-func newMomentVideoMutation(c config, op Op, opts ...momentvideoOption) *MomentVideoMutation {
-	m := &MomentVideoMutation{
+// newTagSuggestionMutation creates new mutation for the TagSuggestion entity.
+func newTagSuggestionMutation(c config, op Op, opts ...tagsuggestionOption) *TagSuggestionMutation {
+	m := &TagSuggestionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMomentVideo,
+		typ:           TypeTagSuggestion,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10290,20 +15071,20 @@ func newMomentVideoMutation(c config, op Op, opts ...momentvideoOption) *MomentV
 	return m
 }
 
-// withMomentVideoID sets the ID field of the mutation.
-func withMomentVideoID(id uint) momentvideoOption {
-	return func(m *MomentVideoMutation) {
+// withTagSuggestionID sets the ID field of the mutation.
+func withTagSuggestionID(id int) tagsuggestionOption {
+	return func(m *TagSuggestionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MomentVideo
+			value *TagSuggestion
 		)
-		m.oldValue = func(ctx context.Context) (*MomentVideo, error) {
+		m.oldValue = func(ctx context.Context) (*TagSuggestion, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MomentVideo.Get(ctx, id)
+					value, err = m.Client().TagSuggestion.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -10312,10 +15093,10 @@ func withMomentVideoID(id uint) momentvideoOption {
 	}
 }
 
-// withMomentVideo sets the old MomentVideo of the mutation.
-func withMomentVideo(node *MomentVideo) momentvideoOption {
-	return func(m *MomentVideoMutation) {
-		m.oldValue = func(context.Context) (*MomentVideo, error) {
+// withTagSuggestion sets the old TagSuggestion of the mutation.
+func withTagSuggestion(node *TagSuggestion) tagsuggestionOption {
+	return func(m *TagSuggestionMutation) {
+		m.oldValue = func(context.Context) (*TagSuggestion, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -10324,7 +15105,7 @@ func withMomentVideo(node *MomentVideo) momentvideoOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MomentVideoMutation) Client() *Client {
+func (m TagSuggestionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -10332,7 +15113,7 @@ func (m MomentVideoMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MomentVideoMutation) Tx() (*Tx, error) {
+func (m TagSuggestionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -10341,15 +15122,9 @@ func (m MomentVideoMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MomentVideo entities.
-func (m *MomentVideoMutation) SetID(id uint) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MomentVideoMutation) ID() (id uint, exists bool) {
+func (m *TagSuggestionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -10360,336 +15135,364 @@ func (m *MomentVideoMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MomentVideoMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *TagSuggestionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uint{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MomentVideo.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TagSuggestion.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetVideoId sets the "videoId" field.
-func (m *MomentVideoMutation) SetVideoId(s string) {
-	m.video = &s
+// SetEntityType sets the "entityType" field.
+func (m *TagSuggestionMutation) SetEntityType(s string) {
+	m.entityType = &s
 }
 
-// VideoId returns the value of the "videoId" field in the mutation.
-func (m *MomentVideoMutation) VideoId() (r string, exists bool) {
-	v := m.video
+// EntityType returns the value of the "entityType" field in the mutation.
+func (m *TagSuggestionMutation) EntityType() (r string, exists bool) {
+	v := m.entityType
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVideoId returns the old "videoId" field's value of the MomentVideo entity.
-// If the MomentVideo object wasn't provided to the builder, the object is fetched from the database.
+// OldEntityType returns the old "entityType" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentVideoMutation) OldVideoId(ctx context.Context) (v string, err error) {
+func (m *TagSuggestionMutation) OldEntityType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVideoId is only allowed on UpdateOne operations")
+		return v, errors.New("OldEntityType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVideoId requires an ID field in the mutation")
+		return v, errors.New("OldEntityType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVideoId: %w", err)
+		return v, fmt.Errorf("querying old value for OldEntityType: %w", err)
 	}
-	return oldValue.VideoId, nil
+	return oldValue.EntityType, nil
 }
 
-// ClearVideoId clears the value of the "videoId" field.
-func (m *MomentVideoMutation) ClearVideoId() {
-	m.video = nil
-	m.clearedFields[momentvideo.FieldVideoId] = struct{}{}
+// ResetEntityType resets all changes to the "entityType" field.
+func (m *TagSuggestionMutation) ResetEntityType() {
+	m.entityType = nil
 }
 
-// VideoIdCleared returns if the "videoId" field was cleared in this mutation.
-func (m *MomentVideoMutation) VideoIdCleared() bool {
-	_, ok := m.clearedFields[momentvideo.FieldVideoId]
-	return ok
+// SetEntityId sets the "entityId" field.
+func (m *TagSuggestionMutation) SetEntityId(s string) {
+	m.entityId = &s
 }
 
-// ResetVideoId resets all changes to the "videoId" field.
-func (m *MomentVideoMutation) ResetVideoId() {
-	m.video = nil
-	delete(m.clearedFields, momentvideo.FieldVideoId)
+// EntityId returns the value of the "entityId" field in the mutation.
+func (m *TagSuggestionMutation) EntityId() (r string, exists bool) {
+	v := m.entityId
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetMomentId sets the "momentId" field.
-func (m *MomentVideoMutation) SetMomentId(s string) {
-	m.moment = &s
+// OldEntityId returns the old "entityId" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagSuggestionMutation) OldEntityId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityId: %w", err)
+	}
+	return oldValue.EntityId, nil
 }
 
-// MomentId returns the value of the "momentId" field in the mutation.
-func (m *MomentVideoMutation) MomentId() (r string, exists bool) {
-	v := m.moment
+// ResetEntityId resets all changes to the "entityId" field.
+func (m *TagSuggestionMutation) ResetEntityId() {
+	m.entityId = nil
+}
+
+// SetOwnerId sets the "ownerId" field.
+func (m *TagSuggestionMutation) SetOwnerId(s string) {
+	m.ownerId = &s
+}
+
+// OwnerId returns the value of the "ownerId" field in the mutation.
+func (m *TagSuggestionMutation) OwnerId() (r string, exists bool) {
+	v := m.ownerId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMomentId returns the old "momentId" field's value of the MomentVideo entity.
-// If the MomentVideo object wasn't provided to the builder, the object is fetched from the database.
+// OldOwnerId returns the old "ownerId" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentVideoMutation) OldMomentId(ctx context.Context) (v string, err error) {
+func (m *TagSuggestionMutation) OldOwnerId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMomentId is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwnerId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMomentId requires an ID field in the mutation")
+		return v, errors.New("OldOwnerId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMomentId: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwnerId: %w", err)
 	}
-	return oldValue.MomentId, nil
+	return oldValue.OwnerId, nil
 }
 
-// ClearMomentId clears the value of the "momentId" field.
-func (m *MomentVideoMutation) ClearMomentId() {
-	m.moment = nil
-	m.clearedFields[momentvideo.FieldMomentId] = struct{}{}
+// ClearOwnerId clears the value of the "ownerId" field.
+func (m *TagSuggestionMutation) ClearOwnerId() {
+	m.ownerId = nil
+	m.clearedFields[tagsuggestion.FieldOwnerId] = struct{}{}
 }
 
-// MomentIdCleared returns if the "momentId" field was cleared in this mutation.
-func (m *MomentVideoMutation) MomentIdCleared() bool {
-	_, ok := m.clearedFields[momentvideo.FieldMomentId]
+// OwnerIdCleared returns if the "ownerId" field was cleared in this mutation.
+func (m *TagSuggestionMutation) OwnerIdCleared() bool {
+	_, ok := m.clearedFields[tagsuggestion.FieldOwnerId]
 	return ok
 }
 
-// ResetMomentId resets all changes to the "momentId" field.
-func (m *MomentVideoMutation) ResetMomentId() {
-	m.moment = nil
-	delete(m.clearedFields, momentvideo.FieldMomentId)
+// ResetOwnerId resets all changes to the "ownerId" field.
+func (m *TagSuggestionMutation) ResetOwnerId() {
+	m.ownerId = nil
+	delete(m.clearedFields, tagsuggestion.FieldOwnerId)
 }
 
-// SetSort sets the "sort" field.
-func (m *MomentVideoMutation) SetSort(i int32) {
-	m.sort = &i
-	m.addsort = nil
+// SetTag sets the "tag" field.
+func (m *TagSuggestionMutation) SetTag(s string) {
+	m.tag = &s
 }
 
-// Sort returns the value of the "sort" field in the mutation.
-func (m *MomentVideoMutation) Sort() (r int32, exists bool) {
-	v := m.sort
+// Tag returns the value of the "tag" field in the mutation.
+func (m *TagSuggestionMutation) Tag() (r string, exists bool) {
+	v := m.tag
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSort returns the old "sort" field's value of the MomentVideo entity.
-// If the MomentVideo object wasn't provided to the builder, the object is fetched from the database.
+// OldTag returns the old "tag" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentVideoMutation) OldSort(ctx context.Context) (v int32, err error) {
+func (m *TagSuggestionMutation) OldTag(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSort is only allowed on UpdateOne operations")
+		return v, errors.New("OldTag is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSort requires an ID field in the mutation")
+		return v, errors.New("OldTag requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSort: %w", err)
-	}
-	return oldValue.Sort, nil
-}
-
-// AddSort adds i to the "sort" field.
-func (m *MomentVideoMutation) AddSort(i int32) {
-	if m.addsort != nil {
-		*m.addsort += i
-	} else {
-		m.addsort = &i
-	}
-}
-
-// AddedSort returns the value that was added to the "sort" field in this mutation.
-func (m *MomentVideoMutation) AddedSort() (r int32, exists bool) {
-	v := m.addsort
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldTag: %w", err)
 	}
-	return *v, true
+	return oldValue.Tag, nil
 }
 
-// ResetSort resets all changes to the "sort" field.
-func (m *MomentVideoMutation) ResetSort() {
-	m.sort = nil
-	m.addsort = nil
+// ResetTag resets all changes to the "tag" field.
+func (m *TagSuggestionMutation) ResetTag() {
+	m.tag = nil
 }
 
-// SetCreatedAt sets the "createdAt" field.
-func (m *MomentVideoMutation) SetCreatedAt(t time.Time) {
-	m.createdAt = &t
+// SetSimilarity sets the "similarity" field.
+func (m *TagSuggestionMutation) SetSimilarity(f float64) {
+	m.similarity = &f
+	m.addsimilarity = nil
 }
 
-// CreatedAt returns the value of the "createdAt" field in the mutation.
-func (m *MomentVideoMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.createdAt
+// Similarity returns the value of the "similarity" field in the mutation.
+func (m *TagSuggestionMutation) Similarity() (r float64, exists bool) {
+	v := m.similarity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "createdAt" field's value of the MomentVideo entity.
-// If the MomentVideo object wasn't provided to the builder, the object is fetched from the database.
+// OldSimilarity returns the old "similarity" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentVideoMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TagSuggestionMutation) OldSimilarity(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSimilarity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldSimilarity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSimilarity: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Similarity, nil
 }
 
-// ResetCreatedAt resets all changes to the "createdAt" field.
-func (m *MomentVideoMutation) ResetCreatedAt() {
-	m.createdAt = nil
+// AddSimilarity adds f to the "similarity" field.
+func (m *TagSuggestionMutation) AddSimilarity(f float64) {
+	if m.addsimilarity != nil {
+		*m.addsimilarity += f
+	} else {
+		m.addsimilarity = &f
+	}
 }
 
-// SetUpdatedAt sets the "updatedAt" field.
-func (m *MomentVideoMutation) SetUpdatedAt(t time.Time) {
-	m.updatedAt = &t
+// AddedSimilarity returns the value that was added to the "similarity" field in this mutation.
+func (m *TagSuggestionMutation) AddedSimilarity() (r float64, exists bool) {
+	v := m.addsimilarity
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updatedAt" field in the mutation.
-func (m *MomentVideoMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updatedAt
+// ResetSimilarity resets all changes to the "similarity" field.
+func (m *TagSuggestionMutation) ResetSimilarity() {
+	m.similarity = nil
+	m.addsimilarity = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *TagSuggestionMutation) SetStatus(t tagsuggestion.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TagSuggestionMutation) Status() (r tagsuggestion.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updatedAt" field's value of the MomentVideo entity.
-// If the MomentVideo object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MomentVideoMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TagSuggestionMutation) OldStatus(ctx context.Context) (v tagsuggestion.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updatedAt" field.
-func (m *MomentVideoMutation) ResetUpdatedAt() {
-	m.updatedAt = nil
-}
-
-// SetMomentID sets the "moment" edge to the Moment entity by id.
-func (m *MomentVideoMutation) SetMomentID(id string) {
-	m.moment = &id
+	return oldValue.Status, nil
 }
 
-// ClearMoment clears the "moment" edge to the Moment entity.
-func (m *MomentVideoMutation) ClearMoment() {
-	m.clearedmoment = true
-	m.clearedFields[momentvideo.FieldMomentId] = struct{}{}
+// ResetStatus resets all changes to the "status" field.
+func (m *TagSuggestionMutation) ResetStatus() {
+	m.status = nil
 }
 
-// MomentCleared reports if the "moment" edge to the Moment entity was cleared.
-func (m *MomentVideoMutation) MomentCleared() bool {
-	return m.MomentIdCleared() || m.clearedmoment
+// SetCreatedAt sets the "createdAt" field.
+func (m *TagSuggestionMutation) SetCreatedAt(t time.Time) {
+	m.createdAt = &t
 }
 
-// MomentID returns the "moment" edge ID in the mutation.
-func (m *MomentVideoMutation) MomentID() (id string, exists bool) {
-	if m.moment != nil {
-		return *m.moment, true
+// CreatedAt returns the value of the "createdAt" field in the mutation.
+func (m *TagSuggestionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.createdAt
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MomentIDs returns the "moment" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MomentID instead. It exists only for internal usage by the builders.
-func (m *MomentVideoMutation) MomentIDs() (ids []string) {
-	if id := m.moment; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "createdAt" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagSuggestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetMoment resets all changes to the "moment" edge.
-func (m *MomentVideoMutation) ResetMoment() {
-	m.moment = nil
-	m.clearedmoment = false
+// ResetCreatedAt resets all changes to the "createdAt" field.
+func (m *TagSuggestionMutation) ResetCreatedAt() {
+	m.createdAt = nil
 }
 
-// SetVideoID sets the "video" edge to the Video entity by id.
-func (m *MomentVideoMutation) SetVideoID(id string) {
-	m.video = &id
+// SetReviewedAt sets the "reviewedAt" field.
+func (m *TagSuggestionMutation) SetReviewedAt(t time.Time) {
+	m.reviewedAt = &t
 }
 
-// ClearVideo clears the "video" edge to the Video entity.
-func (m *MomentVideoMutation) ClearVideo() {
-	m.clearedvideo = true
-	m.clearedFields[momentvideo.FieldVideoId] = struct{}{}
+// ReviewedAt returns the value of the "reviewedAt" field in the mutation.
+func (m *TagSuggestionMutation) ReviewedAt() (r time.Time, exists bool) {
+	v := m.reviewedAt
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// VideoCleared reports if the "video" edge to the Video entity was cleared.
-func (m *MomentVideoMutation) VideoCleared() bool {
-	return m.VideoIdCleared() || m.clearedvideo
+// OldReviewedAt returns the old "reviewedAt" field's value of the TagSuggestion entity.
+// If the TagSuggestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagSuggestionMutation) OldReviewedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedAt: %w", err)
+	}
+	return oldValue.ReviewedAt, nil
 }
 
-// VideoID returns the "video" edge ID in the mutation.
-func (m *MomentVideoMutation) VideoID() (id string, exists bool) {
-	if m.video != nil {
-		return *m.video, true
-	}
-	return
+// ClearReviewedAt clears the value of the "reviewedAt" field.
+func (m *TagSuggestionMutation) ClearReviewedAt() {
+	m.reviewedAt = nil
+	m.clearedFields[tagsuggestion.FieldReviewedAt] = struct{}{}
 }
 
-// VideoIDs returns the "video" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// VideoID instead. It exists only for internal usage by the builders.
-func (m *MomentVideoMutation) VideoIDs() (ids []string) {
-	if id := m.video; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ReviewedAtCleared returns if the "reviewedAt" field was cleared in this mutation.
+func (m *TagSuggestionMutation) ReviewedAtCleared() bool {
+	_, ok := m.clearedFields[tagsuggestion.FieldReviewedAt]
+	return ok
 }
 
-// ResetVideo resets all changes to the "video" edge.
-func (m *MomentVideoMutation) ResetVideo() {
-	m.video = nil
-	m.clearedvideo = false
+// ResetReviewedAt resets all changes to the "reviewedAt" field.
+func (m *TagSuggestionMutation) ResetReviewedAt() {
+	m.reviewedAt = nil
+	delete(m.clearedFields, tagsuggestion.FieldReviewedAt)
 }
 
-// Where appends a list predicates to the MomentVideoMutation builder.
-func (m *MomentVideoMutation) Where(ps ...predicate.MomentVideo) {
+// Where appends a list predicates to the TagSuggestionMutation builder.
+func (m *TagSuggestionMutation) Where(ps ...predicate.TagSuggestion) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MomentVideoMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TagSuggestionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MomentVideoMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MomentVideo, len(ps))
+func (m *TagSuggestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TagSuggestion, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10697,39 +15500,48 @@ func (m *MomentVideoMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MomentVideoMutation) Op() Op {
+func (m *TagSuggestionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MomentVideoMutation) SetOp(op Op) {
+func (m *TagSuggestionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MomentVideo).
-func (m *MomentVideoMutation) Type() string {
+// Type returns the node type of this mutation (TagSuggestion).
+func (m *TagSuggestionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MomentVideoMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.video != nil {
-		fields = append(fields, momentvideo.FieldVideoId)
+func (m *TagSuggestionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.entityType != nil {
+		fields = append(fields, tagsuggestion.FieldEntityType)
 	}
-	if m.moment != nil {
-		fields = append(fields, momentvideo.FieldMomentId)
+	if m.entityId != nil {
+		fields = append(fields, tagsuggestion.FieldEntityId)
 	}
-	if m.sort != nil {
-		fields = append(fields, momentvideo.FieldSort)
+	if m.ownerId != nil {
+		fields = append(fields, tagsuggestion.FieldOwnerId)
+	}
+	if m.tag != nil {
+		fields = append(fields, tagsuggestion.FieldTag)
+	}
+	if m.similarity != nil {
+		fields = append(fields, tagsuggestion.FieldSimilarity)
+	}
+	if m.status != nil {
+		fields = append(fields, tagsuggestion.FieldStatus)
 	}
 	if m.createdAt != nil {
-		fields = append(fields, momentvideo.FieldCreatedAt)
+		fields = append(fields, tagsuggestion.FieldCreatedAt)
 	}
-	if m.updatedAt != nil {
-		fields = append(fields, momentvideo.FieldUpdatedAt)
+	if m.reviewedAt != nil {
+		fields = append(fields, tagsuggestion.FieldReviewedAt)
 	}
 	return fields
 }
@@ -10737,18 +15549,24 @@ func (m *MomentVideoMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MomentVideoMutation) Field(name string) (ent.Value, bool) {
+func (m *TagSuggestionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case momentvideo.FieldVideoId:
-		return m.VideoId()
-	case momentvideo.FieldMomentId:
-		return m.MomentId()
-	case momentvideo.FieldSort:
-		return m.Sort()
-	case momentvideo.FieldCreatedAt:
+	case tagsuggestion.FieldEntityType:
+		return m.EntityType()
+	case tagsuggestion.FieldEntityId:
+		return m.EntityId()
+	case tagsuggestion.FieldOwnerId:
+		return m.OwnerId()
+	case tagsuggestion.FieldTag:
+		return m.Tag()
+	case tagsuggestion.FieldSimilarity:
+		return m.Similarity()
+	case tagsuggestion.FieldStatus:
+		return m.Status()
+	case tagsuggestion.FieldCreatedAt:
 		return m.CreatedAt()
-	case momentvideo.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case tagsuggestion.FieldReviewedAt:
+		return m.ReviewedAt()
 	}
 	return nil, false
 }
@@ -10756,72 +15574,99 @@ func (m *MomentVideoMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MomentVideoMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TagSuggestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case momentvideo.FieldVideoId:
-		return m.OldVideoId(ctx)
-	case momentvideo.FieldMomentId:
-		return m.OldMomentId(ctx)
-	case momentvideo.FieldSort:
-		return m.OldSort(ctx)
-	case momentvideo.FieldCreatedAt:
+	case tagsuggestion.FieldEntityType:
+		return m.OldEntityType(ctx)
+	case tagsuggestion.FieldEntityId:
+		return m.OldEntityId(ctx)
+	case tagsuggestion.FieldOwnerId:
+		return m.OldOwnerId(ctx)
+	case tagsuggestion.FieldTag:
+		return m.OldTag(ctx)
+	case tagsuggestion.FieldSimilarity:
+		return m.OldSimilarity(ctx)
+	case tagsuggestion.FieldStatus:
+		return m.OldStatus(ctx)
+	case tagsuggestion.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case momentvideo.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case tagsuggestion.FieldReviewedAt:
+		return m.OldReviewedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown MomentVideo field %s", name)
+	return nil, fmt.Errorf("unknown TagSuggestion field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MomentVideoMutation) SetField(name string, value ent.Value) error {
+func (m *TagSuggestionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case momentvideo.FieldVideoId:
+	case tagsuggestion.FieldEntityType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVideoId(v)
+		m.SetEntityType(v)
 		return nil
-	case momentvideo.FieldMomentId:
+	case tagsuggestion.FieldEntityId:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMomentId(v)
+		m.SetEntityId(v)
 		return nil
-	case momentvideo.FieldSort:
-		v, ok := value.(int32)
+	case tagsuggestion.FieldOwnerId:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSort(v)
+		m.SetOwnerId(v)
 		return nil
-	case momentvideo.FieldCreatedAt:
+	case tagsuggestion.FieldTag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTag(v)
+		return nil
+	case tagsuggestion.FieldSimilarity:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSimilarity(v)
+		return nil
+	case tagsuggestion.FieldStatus:
+		v, ok := value.(tagsuggestion.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case tagsuggestion.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case momentvideo.FieldUpdatedAt:
+	case tagsuggestion.FieldReviewedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetReviewedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MomentVideo field %s", name)
+	return fmt.Errorf("unknown TagSuggestion field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MomentVideoMutation) AddedFields() []string {
+func (m *TagSuggestionMutation) AddedFields() []string {
 	var fields []string
-	if m.addsort != nil {
-		fields = append(fields, momentvideo.FieldSort)
+	if m.addsimilarity != nil {
+		fields = append(fields, tagsuggestion.FieldSimilarity)
 	}
 	return fields
 }
@@ -10829,10 +15674,10 @@ func (m *MomentVideoMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MomentVideoMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TagSuggestionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case momentvideo.FieldSort:
-		return m.AddedSort()
+	case tagsuggestion.FieldSimilarity:
+		return m.AddedSimilarity()
 	}
 	return nil, false
 }
@@ -10840,166 +15685,131 @@ func (m *MomentVideoMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MomentVideoMutation) AddField(name string, value ent.Value) error {
+func (m *TagSuggestionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case momentvideo.FieldSort:
-		v, ok := value.(int32)
+	case tagsuggestion.FieldSimilarity:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSort(v)
+		m.AddSimilarity(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MomentVideo numeric field %s", name)
+	return fmt.Errorf("unknown TagSuggestion numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MomentVideoMutation) ClearedFields() []string {
+func (m *TagSuggestionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(momentvideo.FieldVideoId) {
-		fields = append(fields, momentvideo.FieldVideoId)
+	if m.FieldCleared(tagsuggestion.FieldOwnerId) {
+		fields = append(fields, tagsuggestion.FieldOwnerId)
 	}
-	if m.FieldCleared(momentvideo.FieldMomentId) {
-		fields = append(fields, momentvideo.FieldMomentId)
+	if m.FieldCleared(tagsuggestion.FieldReviewedAt) {
+		fields = append(fields, tagsuggestion.FieldReviewedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MomentVideoMutation) FieldCleared(name string) bool {
+func (m *TagSuggestionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MomentVideoMutation) ClearField(name string) error {
+func (m *TagSuggestionMutation) ClearField(name string) error {
 	switch name {
-	case momentvideo.FieldVideoId:
-		m.ClearVideoId()
+	case tagsuggestion.FieldOwnerId:
+		m.ClearOwnerId()
 		return nil
-	case momentvideo.FieldMomentId:
-		m.ClearMomentId()
+	case tagsuggestion.FieldReviewedAt:
+		m.ClearReviewedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown MomentVideo nullable field %s", name)
+	return fmt.Errorf("unknown TagSuggestion nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MomentVideoMutation) ResetField(name string) error {
+func (m *TagSuggestionMutation) ResetField(name string) error {
 	switch name {
-	case momentvideo.FieldVideoId:
-		m.ResetVideoId()
+	case tagsuggestion.FieldEntityType:
+		m.ResetEntityType()
 		return nil
-	case momentvideo.FieldMomentId:
-		m.ResetMomentId()
+	case tagsuggestion.FieldEntityId:
+		m.ResetEntityId()
 		return nil
-	case momentvideo.FieldSort:
-		m.ResetSort()
+	case tagsuggestion.FieldOwnerId:
+		m.ResetOwnerId()
 		return nil
-	case momentvideo.FieldCreatedAt:
+	case tagsuggestion.FieldTag:
+		m.ResetTag()
+		return nil
+	case tagsuggestion.FieldSimilarity:
+		m.ResetSimilarity()
+		return nil
+	case tagsuggestion.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case tagsuggestion.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case momentvideo.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case tagsuggestion.FieldReviewedAt:
+		m.ResetReviewedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown MomentVideo field %s", name)
+	return fmt.Errorf("unknown TagSuggestion field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MomentVideoMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.moment != nil {
-		edges = append(edges, momentvideo.EdgeMoment)
-	}
-	if m.video != nil {
-		edges = append(edges, momentvideo.EdgeVideo)
-	}
+func (m *TagSuggestionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MomentVideoMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case momentvideo.EdgeMoment:
-		if id := m.moment; id != nil {
-			return []ent.Value{*id}
-		}
-	case momentvideo.EdgeVideo:
-		if id := m.video; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *TagSuggestionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MomentVideoMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *TagSuggestionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MomentVideoMutation) RemovedIDs(name string) []ent.Value {
+func (m *TagSuggestionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MomentVideoMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedmoment {
-		edges = append(edges, momentvideo.EdgeMoment)
-	}
-	if m.clearedvideo {
-		edges = append(edges, momentvideo.EdgeVideo)
-	}
+func (m *TagSuggestionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MomentVideoMutation) EdgeCleared(name string) bool {
-	switch name {
-	case momentvideo.EdgeMoment:
-		return m.clearedmoment
-	case momentvideo.EdgeVideo:
-		return m.clearedvideo
-	}
+func (m *TagSuggestionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MomentVideoMutation) ClearEdge(name string) error {
-	switch name {
-	case momentvideo.EdgeMoment:
-		m.ClearMoment()
-		return nil
-	case momentvideo.EdgeVideo:
-		m.ClearVideo()
-		return nil
-	}
-	return fmt.Errorf("unknown MomentVideo unique edge %s", name)
+func (m *TagSuggestionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TagSuggestion unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MomentVideoMutation) ResetEdge(name string) error {
-	switch name {
-	case momentvideo.EdgeMoment:
-		m.ResetMoment()
-		return nil
-	case momentvideo.EdgeVideo:
-		m.ResetVideo()
-		return nil
-	}
-	return fmt.Errorf("unknown MomentVideo edge %s", name)
+func (m *TagSuggestionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TagSuggestion edge %s", name)
 }
 
 // TodoMutation represents an operation that mutates the Todo nodes in the graph.
@@ -18,9 +18,15 @@ type Group func(*sql.Selector)
 // Image is the predicate function for image builders.
 type Image func(*sql.Selector)
 
+// IndexDeadLetter is the predicate function for indexdeadletter builders.
+type IndexDeadLetter func(*sql.Selector)
+
 // Keep is the predicate function for keep builders.
 type Keep func(*sql.Selector)
 
+// KeepChunk is the predicate function for keepchunk builders.
+type KeepChunk func(*sql.Selector)
+
 // Mindmap is the predicate function for mindmap builders.
 type Mindmap func(*sql.Selector)
 
@@ -33,6 +39,18 @@ type MomentImage func(*sql.Selector)
 // MomentVideo is the predicate function for momentvideo builders.
 type MomentVideo func(*sql.Selector)
 
+// SearchClick is the predicate function for searchclick builders.
+type SearchClick func(*sql.Selector)
+
+// SearchLog is the predicate function for searchlog builders.
+type SearchLog func(*sql.Selector)
+
+// SearchOutbox is the predicate function for searchoutbox builders.
+type SearchOutbox func(*sql.Selector)
+
+// TagSuggestion is the predicate function for tagsuggestion builders.
+type TagSuggestion func(*sql.Selector)
+
 // Todo is the predicate function for todo builders.
 type Todo func(*sql.Selector)
 
@@ -2,8 +2,78 @@
 
 package ent
 
+import (
+	"time"
+
+	"api.us4ever/internal/ent/indexdeadletter"
+	"api.us4ever/internal/ent/keepchunk"
+	"api.us4ever/internal/ent/schema"
+	"api.us4ever/internal/ent/searchclick"
+	"api.us4ever/internal/ent/searchlog"
+	"api.us4ever/internal/ent/searchoutbox"
+	"api.us4ever/internal/ent/tagsuggestion"
+)
+
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	indexdeadletterFields := schema.IndexDeadLetter{}.Fields()
+	_ = indexdeadletterFields
+	// indexdeadletterDescCreatedAt is the schema descriptor for createdAt field.
+	indexdeadletterDescCreatedAt := indexdeadletterFields[8].Descriptor()
+	// indexdeadletter.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	indexdeadletter.DefaultCreatedAt = indexdeadletterDescCreatedAt.Default.(func() time.Time)
+	keepchunkFields := schema.KeepChunk{}.Fields()
+	_ = keepchunkFields
+	// keepchunkDescHeading is the schema descriptor for heading field.
+	keepchunkDescHeading := keepchunkFields[2].Descriptor()
+	// keepchunk.DefaultHeading holds the default value on creation for the heading field.
+	keepchunk.DefaultHeading = keepchunkDescHeading.Default.(string)
+	// keepchunkDescCreatedAt is the schema descriptor for createdAt field.
+	keepchunkDescCreatedAt := keepchunkFields[9].Descriptor()
+	// keepchunk.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	keepchunk.DefaultCreatedAt = keepchunkDescCreatedAt.Default.(func() time.Time)
+	// keepchunkDescUpdatedAt is the schema descriptor for updatedAt field.
+	keepchunkDescUpdatedAt := keepchunkFields[10].Descriptor()
+	// keepchunk.DefaultUpdatedAt holds the default value on creation for the updatedAt field.
+	keepchunk.DefaultUpdatedAt = keepchunkDescUpdatedAt.Default.(func() time.Time)
+	// keepchunk.UpdateDefaultUpdatedAt holds the default value on update for the updatedAt field.
+	keepchunk.UpdateDefaultUpdatedAt = keepchunkDescUpdatedAt.UpdateDefault.(func() time.Time)
+	searchclickFields := schema.SearchClick{}.Fields()
+	_ = searchclickFields
+	// searchclickDescCreatedAt is the schema descriptor for createdAt field.
+	searchclickDescCreatedAt := searchclickFields[4].Descriptor()
+	// searchclick.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	searchclick.DefaultCreatedAt = searchclickDescCreatedAt.Default.(func() time.Time)
+	searchlogFields := schema.SearchLog{}.Fields()
+	_ = searchlogFields
+	// searchlogDescDegraded is the schema descriptor for degraded field.
+	searchlogDescDegraded := searchlogFields[5].Descriptor()
+	// searchlog.DefaultDegraded holds the default value on creation for the degraded field.
+	searchlog.DefaultDegraded = searchlogDescDegraded.Default.(bool)
+	// searchlogDescCreatedAt is the schema descriptor for createdAt field.
+	searchlogDescCreatedAt := searchlogFields[7].Descriptor()
+	// searchlog.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	searchlog.DefaultCreatedAt = searchlogDescCreatedAt.Default.(func() time.Time)
+	searchoutboxFields := schema.SearchOutbox{}.Fields()
+	_ = searchoutboxFields
+	// searchoutboxDescAttempts is the schema descriptor for attempts field.
+	searchoutboxDescAttempts := searchoutboxFields[3].Descriptor()
+	// searchoutbox.DefaultAttempts holds the default value on creation for the attempts field.
+	searchoutbox.DefaultAttempts = searchoutboxDescAttempts.Default.(int)
+	// searchoutboxDescAvailableAt is the schema descriptor for availableAt field.
+	searchoutboxDescAvailableAt := searchoutboxFields[5].Descriptor()
+	// searchoutbox.DefaultAvailableAt holds the default value on creation for the availableAt field.
+	searchoutbox.DefaultAvailableAt = searchoutboxDescAvailableAt.Default.(func() time.Time)
+	// searchoutboxDescCreatedAt is the schema descriptor for createdAt field.
+	searchoutboxDescCreatedAt := searchoutboxFields[6].Descriptor()
+	// searchoutbox.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	searchoutbox.DefaultCreatedAt = searchoutboxDescCreatedAt.Default.(func() time.Time)
+	tagsuggestionFields := schema.TagSuggestion{}.Fields()
+	_ = tagsuggestionFields
+	// tagsuggestionDescCreatedAt is the schema descriptor for createdAt field.
+	tagsuggestionDescCreatedAt := tagsuggestionFields[6].Descriptor()
+	// tagsuggestion.DefaultCreatedAt holds the default value on creation for the createdAt field.
+	tagsuggestion.DefaultCreatedAt = tagsuggestionDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IndexDeadLetter records a document a reindex could not write, after the
+// retries of transient rejections were used up or on a permanent error such
+// as a mapping conflict. The reindex still completes as long as the share of
+// failed documents stays under es.max_failure_ratio.
+//
+// Like SearchOutbox this table is owned by this service; create it with
+// `db-tools migrate`.
+type IndexDeadLetter struct {
+	ent.Schema
+}
+
+func (IndexDeadLetter) Fields() []ent.Field {
+	return []ent.Field{
+		// index is the definition name, e.g. "keeps"; indexName the generation written to
+		field.String("index").StorageKey("index"),
+		field.String("indexName").StorageKey("indexName"),
+		field.String("entityId").StorageKey("entityId"),
+		field.String("jobId").Optional().StorageKey("jobId"),
+		field.Int("status").StorageKey("status"),
+		field.String("errorType").Optional().StorageKey("errorType"),
+		field.Text("reason").Optional().StorageKey("reason"),
+		field.Int("attempts").StorageKey("attempts"),
+		field.Time("createdAt").Default(time.Now).Immutable().StorageKey("createdAt"),
+	}
+}
+
+func (IndexDeadLetter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("index", "entityId"),
+	}
+}
+
+func (IndexDeadLetter) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "index_dead_letter"},
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// KeepChunk is one passage of a keep, cut at Markdown headings and sentence
+// boundaries, with its own embedding. Long keeps get several overlapping
+// chunks so search can match the passage rather than a diluted vector of the
+// whole note. Chunks are rebuilt by the keep chunk embedding task whenever the
+// keep changes; unchanged passages keep their vector.
+//
+// Like SearchOutbox this table is owned by this service; create it with
+// `db-tools migrate`.
+type KeepChunk struct {
+	ent.Schema
+}
+
+func (KeepChunk) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("keepId").StorageKey("keepId"),
+		field.Int("ordinal").StorageKey("ordinal"),
+		// heading is the Markdown heading path of the passage, e.g. "安装 > Linux"
+		field.String("heading").Default("").StorageKey("heading"),
+		field.Text("content").StorageKey("content"),
+		// start and end are rune offsets of the passage in the keep content
+		field.Int("start").StorageKey("start"),
+		field.Int("end").StorageKey("end"),
+		// contentHash identifies the embedded text, so an unchanged passage keeps its vector
+		field.String("contentHash").StorageKey("contentHash"),
+		field.JSON("vector", json.RawMessage{}).Optional().StorageKey("vector"),
+		// keepUpdatedAt is the updatedAt of the keep the chunks were cut from
+		field.Time("keepUpdatedAt").StorageKey("keepUpdatedAt"),
+		field.Time("createdAt").Default(time.Now).Immutable().StorageKey("createdAt"),
+		field.Time("updatedAt").Default(time.Now).UpdateDefault(time.Now).StorageKey("updatedAt"),
+	}
+}
+
+func (KeepChunk) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("keepId", "ordinal").Unique(),
+	}
+}
+
+func (KeepChunk) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "keep_chunk"},
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SearchClick records a hit opened from the results of a search, identified
+// by the request ID of the search, which is returned in the X-Request-ID
+// header.
+//
+// Like SearchOutbox this table is owned by this service; create it with
+// `db-tools migrate`.
+type SearchClick struct {
+	ent.Schema
+}
+
+func (SearchClick) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("requestId").StorageKey("requestId"),
+		field.String("entityType").StorageKey("entityType"),
+		field.String("entityId").StorageKey("entityId"),
+		// position is the 0-based rank of the hit in the results
+		field.Int("position").Optional().Nillable().StorageKey("position"),
+		field.Time("createdAt").Default(time.Now).Immutable().StorageKey("createdAt"),
+	}
+}
+
+func (SearchClick) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("requestId"),
+		index.Fields("createdAt"),
+	}
+}
+
+func (SearchClick) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "search_click"},
+	}
+}
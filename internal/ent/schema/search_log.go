@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SearchLog records one search: what was asked, where, how many results came
+// back and how long it took. Clicks on its hits are recorded in SearchClick,
+// joined through the request ID.
+//
+// Like SearchOutbox this table is owned by this service; create it with
+// `db-tools migrate`.
+type SearchLog struct {
+	ent.Schema
+}
+
+func (SearchLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("query").StorageKey("query"),
+		// type is the index searched, e.g. "keeps", or "all" and "suggest"
+		field.String("type").StorageKey("type"),
+		field.String("backend").Optional().StorageKey("backend"),
+		field.Int("resultCount").StorageKey("resultCount"),
+		field.Int("latencyMs").StorageKey("latencyMs"),
+		field.Bool("degraded").Default(false).StorageKey("degraded"),
+		field.String("requestId").Optional().StorageKey("requestId"),
+		field.Time("createdAt").Default(time.Now).Immutable().StorageKey("createdAt"),
+	}
+}
+
+func (SearchLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("createdAt"),
+		index.Fields("requestId"),
+	}
+}
+
+func (SearchLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "search_log"},
+	}
+}
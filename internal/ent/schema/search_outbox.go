@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SearchOutbox holds search index changes waiting to be applied. Rows are
+// written by the mutation hooks in internal/database, inside the transaction of
+// the change itself when there is one, and drained by the search sync task.
+//
+// Unlike the entimport schemas this table is owned by this service rather than
+// the Prisma schema; create it with `db-tools migrate`.
+type SearchOutbox struct {
+	ent.Schema
+}
+
+func (SearchOutbox) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("entityType").StorageKey("entityType"),
+		field.String("entityId").StorageKey("entityId"),
+		field.Enum("op").Values("upsert", "delete").StorageKey("op"),
+		field.Int("attempts").Default(0).StorageKey("attempts"),
+		field.Text("lastError").Optional().StorageKey("lastError"),
+		field.Time("availableAt").Default(time.Now).StorageKey("availableAt"),
+		field.Time("createdAt").Default(time.Now).Immutable().StorageKey("createdAt"),
+	}
+}
+
+func (SearchOutbox) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("availableAt"),
+	}
+}
+
+func (SearchOutbox) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "search_outbox"},
+	}
+}
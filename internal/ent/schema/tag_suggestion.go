@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TagSuggestion marks a tag the topic clustering task wrote into the tags
+// column of a keep, moment, mindmap or file, so it can be told apart from the
+// tags users typed and reviewed. Accepting a suggestion keeps the tag;
+// rejecting it removes the tag, and the row stays so the same tag is never
+// suggested for that record again.
+//
+// Like SearchOutbox this table is owned by this service; create it with
+// `db-tools migrate`.
+type TagSuggestion struct {
+	ent.Schema
+}
+
+func (TagSuggestion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("entityType").StorageKey("entityType"),
+		field.String("entityId").StorageKey("entityId"),
+		field.String("ownerId").Optional().StorageKey("ownerId"),
+		field.String("tag").StorageKey("tag"),
+		// similarity is the cosine similarity of the record with the centre of its cluster
+		field.Float("similarity").StorageKey("similarity"),
+		field.Enum("status").Values("suggested", "accepted", "rejected").Default("suggested").StorageKey("status"),
+		field.Time("createdAt").Default(time.Now).Immutable().StorageKey("createdAt"),
+		field.Time("reviewedAt").Optional().Nillable().StorageKey("reviewedAt"),
+	}
+}
+
+func (TagSuggestion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entityType", "entityId", "tag").Unique(),
+		index.Fields("status", "createdAt"),
+	}
+}
+
+func (TagSuggestion) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "tag_suggestion"},
+	}
+}
@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package searchclick
+
+import (
+	"time"
+
+	"api.us4ever/internal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldLTE(FieldID, id))
+}
+
+// RequestId applies equality check predicate on the "requestId" field. It's identical to RequestIdEQ.
+func RequestId(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldEQ(FieldRequestId, v))
+}
+
+// EntityType applies equality check predicate on the "entityType" field. It's identical to EntityTypeEQ.
+func EntityType(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldEQ(FieldEntityType, v))
+}
+
+// EntityId applies equality check predicate on the "entityId" field. It's identical to EntityIdEQ.
+func EntityId(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldEQ(FieldEntityId, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldEQ(FieldPosition, v))
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RequestIdEQ applies the EQ predicate on the "requestId" field.
+func RequestIdEQ(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldEQ(FieldRequestId, v))
+}
+
+// RequestIdNEQ applies the NEQ predicate on the "requestId" field.
+func RequestIdNEQ(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldNEQ(FieldRequestId, v))
+}
+
+// RequestIdIn applies the In predicate on the "requestId" field.
+func RequestIdIn(vs ...string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldIn(FieldRequestId, vs...))
+}
+
+// RequestIdNotIn applies the NotIn predicate on the "requestId" field.
+func RequestIdNotIn(vs ...string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldNotIn(FieldRequestId, vs...))
+}
+
+// RequestIdGT applies the GT predicate on the "requestId" field.
+func RequestIdGT(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldGT(FieldRequestId, v))
+}
+
+// RequestIdGTE applies the GTE predicate on the "requestId" field.
+func RequestIdGTE(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldGTE(FieldRequestId, v))
+}
+
+// RequestIdLT applies the LT predicate on the "requestId" field.
+func RequestIdLT(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldLT(FieldRequestId, v))
+}
+
+// RequestIdLTE applies the LTE predicate on the "requestId" field.
+func RequestIdLTE(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldLTE(FieldRequestId, v))
+}
+
+// RequestIdContains applies the Contains predicate on the "requestId" field.
+func RequestIdContains(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldContains(FieldRequestId, v))
+}
+
+// RequestIdHasPrefix applies the HasPrefix predicate on the "requestId" field.
+func RequestIdHasPrefix(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldHasPrefix(FieldRequestId, v))
+}
+
+// RequestIdHasSuffix applies the HasSuffix predicate on the "requestId" field.
+func RequestIdHasSuffix(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldHasSuffix(FieldRequestId, v))
+}
+
+// RequestIdEqualFold applies the EqualFold predicate on the "requestId" field.
+func RequestIdEqualFold(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldEqualFold(FieldRequestId, v))
+}
+
+// RequestIdContainsFold applies the ContainsFold predicate on the "requestId" field.
+func RequestIdContainsFold(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldContainsFold(FieldRequestId, v))
+}
+
+// EntityTypeEQ applies the EQ predicate on the "entityType" field.
+func EntityTypeEQ(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldEQ(FieldEntityType, v))
+}
+
+// EntityTypeNEQ applies the NEQ predicate on the "entityType" field.
+func EntityTypeNEQ(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldNEQ(FieldEntityType, v))
+}
+
+// EntityTypeIn applies the In predicate on the "entityType" field.
+func EntityTypeIn(vs ...string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldIn(FieldEntityType, vs...))
+}
+
+// EntityTypeNotIn applies the NotIn predicate on the "entityType" field.
+func EntityTypeNotIn(vs ...string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldNotIn(FieldEntityType, vs...))
+}
+
+// EntityTypeGT applies the GT predicate on the "entityType" field.
+func EntityTypeGT(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldGT(FieldEntityType, v))
+}
+
+// EntityTypeGTE applies the GTE predicate on the "entityType" field.
+func EntityTypeGTE(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldGTE(FieldEntityType, v))
+}
+
+// EntityTypeLT applies the LT predicate on the "entityType" field.
+func EntityTypeLT(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldLT(FieldEntityType, v))
+}
+
+// EntityTypeLTE applies the LTE predicate on the "entityType" field.
+func EntityTypeLTE(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldLTE(FieldEntityType, v))
+}
+
+// EntityTypeContains applies the Contains predicate on the "entityType" field.
+func EntityTypeContains(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldContains(FieldEntityType, v))
+}
+
+// EntityTypeHasPrefix applies the HasPrefix predicate on the "entityType" field.
+func EntityTypeHasPrefix(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldHasPrefix(FieldEntityType, v))
+}
+
+// EntityTypeHasSuffix applies the HasSuffix predicate on the "entityType" field.
+func EntityTypeHasSuffix(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldHasSuffix(FieldEntityType, v))
+}
+
+// EntityTypeEqualFold applies the EqualFold predicate on the "entityType" field.
+func EntityTypeEqualFold(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldEqualFold(FieldEntityType, v))
+}
+
+// EntityTypeContainsFold applies the ContainsFold predicate on the "entityType" field.
+func EntityTypeContainsFold(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldContainsFold(FieldEntityType, v))
+}
+
+// EntityIdEQ applies the EQ predicate on the "entityId" field.
+func EntityIdEQ(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldEQ(FieldEntityId, v))
+}
+
+// EntityIdNEQ applies the NEQ predicate on the "entityId" field.
+func EntityIdNEQ(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldNEQ(FieldEntityId, v))
+}
+
+// EntityIdIn applies the In predicate on the "entityId" field.
+func EntityIdIn(vs ...string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldIn(FieldEntityId, vs...))
+}
+
+// EntityIdNotIn applies the NotIn predicate on the "entityId" field.
+func EntityIdNotIn(vs ...string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldNotIn(FieldEntityId, vs...))
+}
+
+// EntityIdGT applies the GT predicate on the "entityId" field.
+func EntityIdGT(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldGT(FieldEntityId, v))
+}
+
+// EntityIdGTE applies the GTE predicate on the "entityId" field.
+func EntityIdGTE(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldGTE(FieldEntityId, v))
+}
+
+// EntityIdLT applies the LT predicate on the "entityId" field.
+func EntityIdLT(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldLT(FieldEntityId, v))
+}
+
+// EntityIdLTE applies the LTE predicate on the "entityId" field.
+func EntityIdLTE(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldLTE(FieldEntityId, v))
+}
+
+// EntityIdContains applies the Contains predicate on the "entityId" field.
+func EntityIdContains(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldContains(FieldEntityId, v))
+}
+
+// EntityIdHasPrefix applies the HasPrefix predicate on the "entityId" field.
+func EntityIdHasPrefix(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldHasPrefix(FieldEntityId, v))
+}
+
+// EntityIdHasSuffix applies the HasSuffix predicate on the "entityId" field.
+func EntityIdHasSuffix(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldHasSuffix(FieldEntityId, v))
+}
+
+// EntityIdEqualFold applies the EqualFold predicate on the "entityId" field.
+func EntityIdEqualFold(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldEqualFold(FieldEntityId, v))
+}
+
+// EntityIdContainsFold applies the ContainsFold predicate on the "entityId" field.
+func EntityIdContainsFold(v string) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldContainsFold(FieldEntityId, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldLTE(FieldPosition, v))
+}
+
+// PositionIsNil applies the IsNil predicate on the "position" field.
+func PositionIsNil() predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldIsNull(FieldPosition))
+}
+
+// PositionNotNil applies the NotNil predicate on the "position" field.
+func PositionNotNil() predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldNotNull(FieldPosition))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.SearchClick {
+	return predicate.SearchClick(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SearchClick) predicate.SearchClick {
+	return predicate.SearchClick(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SearchClick) predicate.SearchClick {
+	return predicate.SearchClick(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SearchClick) predicate.SearchClick {
+	return predicate.SearchClick(sql.NotPredicates(p))
+}
@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package searchlog
+
+import (
+	"time"
+
+	"api.us4ever/internal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldID, id))
+}
+
+// Query applies equality check predicate on the "query" field. It's identical to QueryEQ.
+func Query(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldQuery, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldType, v))
+}
+
+// Backend applies equality check predicate on the "backend" field. It's identical to BackendEQ.
+func Backend(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldBackend, v))
+}
+
+// ResultCount applies equality check predicate on the "resultCount" field. It's identical to ResultCountEQ.
+func ResultCount(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldResultCount, v))
+}
+
+// LatencyMs applies equality check predicate on the "latencyMs" field. It's identical to LatencyMsEQ.
+func LatencyMs(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// Degraded applies equality check predicate on the "degraded" field. It's identical to DegradedEQ.
+func Degraded(v bool) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldDegraded, v))
+}
+
+// RequestId applies equality check predicate on the "requestId" field. It's identical to RequestIdEQ.
+func RequestId(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldRequestId, v))
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// QueryEQ applies the EQ predicate on the "query" field.
+func QueryEQ(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldQuery, v))
+}
+
+// QueryNEQ applies the NEQ predicate on the "query" field.
+func QueryNEQ(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldQuery, v))
+}
+
+// QueryIn applies the In predicate on the "query" field.
+func QueryIn(vs ...string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldQuery, vs...))
+}
+
+// QueryNotIn applies the NotIn predicate on the "query" field.
+func QueryNotIn(vs ...string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldQuery, vs...))
+}
+
+// QueryGT applies the GT predicate on the "query" field.
+func QueryGT(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldQuery, v))
+}
+
+// QueryGTE applies the GTE predicate on the "query" field.
+func QueryGTE(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldQuery, v))
+}
+
+// QueryLT applies the LT predicate on the "query" field.
+func QueryLT(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldQuery, v))
+}
+
+// QueryLTE applies the LTE predicate on the "query" field.
+func QueryLTE(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldQuery, v))
+}
+
+// QueryContains applies the Contains predicate on the "query" field.
+func QueryContains(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldContains(FieldQuery, v))
+}
+
+// QueryHasPrefix applies the HasPrefix predicate on the "query" field.
+func QueryHasPrefix(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldHasPrefix(FieldQuery, v))
+}
+
+// QueryHasSuffix applies the HasSuffix predicate on the "query" field.
+func QueryHasSuffix(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldHasSuffix(FieldQuery, v))
+}
+
+// QueryEqualFold applies the EqualFold predicate on the "query" field.
+func QueryEqualFold(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEqualFold(FieldQuery, v))
+}
+
+// QueryContainsFold applies the ContainsFold predicate on the "query" field.
+func QueryContainsFold(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldContainsFold(FieldQuery, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldContainsFold(FieldType, v))
+}
+
+// BackendEQ applies the EQ predicate on the "backend" field.
+func BackendEQ(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldBackend, v))
+}
+
+// BackendNEQ applies the NEQ predicate on the "backend" field.
+func BackendNEQ(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldBackend, v))
+}
+
+// BackendIn applies the In predicate on the "backend" field.
+func BackendIn(vs ...string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldBackend, vs...))
+}
+
+// BackendNotIn applies the NotIn predicate on the "backend" field.
+func BackendNotIn(vs ...string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldBackend, vs...))
+}
+
+// BackendGT applies the GT predicate on the "backend" field.
+func BackendGT(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldBackend, v))
+}
+
+// BackendGTE applies the GTE predicate on the "backend" field.
+func BackendGTE(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldBackend, v))
+}
+
+// BackendLT applies the LT predicate on the "backend" field.
+func BackendLT(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldBackend, v))
+}
+
+// BackendLTE applies the LTE predicate on the "backend" field.
+func BackendLTE(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldBackend, v))
+}
+
+// BackendContains applies the Contains predicate on the "backend" field.
+func BackendContains(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldContains(FieldBackend, v))
+}
+
+// BackendHasPrefix applies the HasPrefix predicate on the "backend" field.
+func BackendHasPrefix(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldHasPrefix(FieldBackend, v))
+}
+
+// BackendHasSuffix applies the HasSuffix predicate on the "backend" field.
+func BackendHasSuffix(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldHasSuffix(FieldBackend, v))
+}
+
+// BackendIsNil applies the IsNil predicate on the "backend" field.
+func BackendIsNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIsNull(FieldBackend))
+}
+
+// BackendNotNil applies the NotNil predicate on the "backend" field.
+func BackendNotNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotNull(FieldBackend))
+}
+
+// BackendEqualFold applies the EqualFold predicate on the "backend" field.
+func BackendEqualFold(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEqualFold(FieldBackend, v))
+}
+
+// BackendContainsFold applies the ContainsFold predicate on the "backend" field.
+func BackendContainsFold(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldContainsFold(FieldBackend, v))
+}
+
+// ResultCountEQ applies the EQ predicate on the "resultCount" field.
+func ResultCountEQ(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldResultCount, v))
+}
+
+// ResultCountNEQ applies the NEQ predicate on the "resultCount" field.
+func ResultCountNEQ(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldResultCount, v))
+}
+
+// ResultCountIn applies the In predicate on the "resultCount" field.
+func ResultCountIn(vs ...int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldResultCount, vs...))
+}
+
+// ResultCountNotIn applies the NotIn predicate on the "resultCount" field.
+func ResultCountNotIn(vs ...int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldResultCount, vs...))
+}
+
+// ResultCountGT applies the GT predicate on the "resultCount" field.
+func ResultCountGT(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldResultCount, v))
+}
+
+// ResultCountGTE applies the GTE predicate on the "resultCount" field.
+func ResultCountGTE(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldResultCount, v))
+}
+
+// ResultCountLT applies the LT predicate on the "resultCount" field.
+func ResultCountLT(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldResultCount, v))
+}
+
+// ResultCountLTE applies the LTE predicate on the "resultCount" field.
+func ResultCountLTE(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldResultCount, v))
+}
+
+// LatencyMsEQ applies the EQ predicate on the "latencyMs" field.
+func LatencyMsEQ(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsNEQ applies the NEQ predicate on the "latencyMs" field.
+func LatencyMsNEQ(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsIn applies the In predicate on the "latencyMs" field.
+func LatencyMsIn(vs ...int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsNotIn applies the NotIn predicate on the "latencyMs" field.
+func LatencyMsNotIn(vs ...int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsGT applies the GT predicate on the "latencyMs" field.
+func LatencyMsGT(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldLatencyMs, v))
+}
+
+// LatencyMsGTE applies the GTE predicate on the "latencyMs" field.
+func LatencyMsGTE(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldLatencyMs, v))
+}
+
+// LatencyMsLT applies the LT predicate on the "latencyMs" field.
+func LatencyMsLT(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldLatencyMs, v))
+}
+
+// LatencyMsLTE applies the LTE predicate on the "latencyMs" field.
+func LatencyMsLTE(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldLatencyMs, v))
+}
+
+// DegradedEQ applies the EQ predicate on the "degraded" field.
+func DegradedEQ(v bool) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldDegraded, v))
+}
+
+// DegradedNEQ applies the NEQ predicate on the "degraded" field.
+func DegradedNEQ(v bool) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldDegraded, v))
+}
+
+// RequestIdEQ applies the EQ predicate on the "requestId" field.
+func RequestIdEQ(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldRequestId, v))
+}
+
+// RequestIdNEQ applies the NEQ predicate on the "requestId" field.
+func RequestIdNEQ(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldRequestId, v))
+}
+
+// RequestIdIn applies the In predicate on the "requestId" field.
+func RequestIdIn(vs ...string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldRequestId, vs...))
+}
+
+// RequestIdNotIn applies the NotIn predicate on the "requestId" field.
+func RequestIdNotIn(vs ...string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldRequestId, vs...))
+}
+
+// RequestIdGT applies the GT predicate on the "requestId" field.
+func RequestIdGT(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldRequestId, v))
+}
+
+// RequestIdGTE applies the GTE predicate on the "requestId" field.
+func RequestIdGTE(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldRequestId, v))
+}
+
+// RequestIdLT applies the LT predicate on the "requestId" field.
+func RequestIdLT(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldRequestId, v))
+}
+
+// RequestIdLTE applies the LTE predicate on the "requestId" field.
+func RequestIdLTE(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldRequestId, v))
+}
+
+// RequestIdContains applies the Contains predicate on the "requestId" field.
+func RequestIdContains(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldContains(FieldRequestId, v))
+}
+
+// RequestIdHasPrefix applies the HasPrefix predicate on the "requestId" field.
+func RequestIdHasPrefix(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldHasPrefix(FieldRequestId, v))
+}
+
+// RequestIdHasSuffix applies the HasSuffix predicate on the "requestId" field.
+func RequestIdHasSuffix(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldHasSuffix(FieldRequestId, v))
+}
+
+// RequestIdIsNil applies the IsNil predicate on the "requestId" field.
+func RequestIdIsNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIsNull(FieldRequestId))
+}
+
+// RequestIdNotNil applies the NotNil predicate on the "requestId" field.
+func RequestIdNotNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotNull(FieldRequestId))
+}
+
+// RequestIdEqualFold applies the EqualFold predicate on the "requestId" field.
+func RequestIdEqualFold(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEqualFold(FieldRequestId, v))
+}
+
+// RequestIdContainsFold applies the ContainsFold predicate on the "requestId" field.
+func RequestIdContainsFold(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldContainsFold(FieldRequestId, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SearchLog) predicate.SearchLog {
+	return predicate.SearchLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SearchLog) predicate.SearchLog {
+	return predicate.SearchLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SearchLog) predicate.SearchLog {
+	return predicate.SearchLog(sql.NotPredicates(p))
+}
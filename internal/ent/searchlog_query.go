@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"api.us4ever/internal/ent/predicate"
+	"api.us4ever/internal/ent/searchlog"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchLogQuery is the builder for querying SearchLog entities.
+type SearchLogQuery struct {
+	config
+	ctx        *QueryContext
+	order      []searchlog.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SearchLog
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SearchLogQuery builder.
+func (slq *SearchLogQuery) Where(ps ...predicate.SearchLog) *SearchLogQuery {
+	slq.predicates = append(slq.predicates, ps...)
+	return slq
+}
+
+// Limit the number of records to be returned by this query.
+func (slq *SearchLogQuery) Limit(limit int) *SearchLogQuery {
+	slq.ctx.Limit = &limit
+	return slq
+}
+
+// Offset to start from.
+func (slq *SearchLogQuery) Offset(offset int) *SearchLogQuery {
+	slq.ctx.Offset = &offset
+	return slq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (slq *SearchLogQuery) Unique(unique bool) *SearchLogQuery {
+	slq.ctx.Unique = &unique
+	return slq
+}
+
+// Order specifies how the records should be ordered.
+func (slq *SearchLogQuery) Order(o ...searchlog.OrderOption) *SearchLogQuery {
+	slq.order = append(slq.order, o...)
+	return slq
+}
+
+// First returns the first SearchLog entity from the query.
+// Returns a *NotFoundError when no SearchLog was found.
+func (slq *SearchLogQuery) First(ctx context.Context) (*SearchLog, error) {
+	nodes, err := slq.Limit(1).All(setContextOp(ctx, slq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{searchlog.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (slq *SearchLogQuery) FirstX(ctx context.Context) *SearchLog {
+	node, err := slq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SearchLog ID from the query.
+// Returns a *NotFoundError when no SearchLog ID was found.
+func (slq *SearchLogQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = slq.Limit(1).IDs(setContextOp(ctx, slq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{searchlog.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (slq *SearchLogQuery) FirstIDX(ctx context.Context) int {
+	id, err := slq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SearchLog entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SearchLog entity is found.
+// Returns a *NotFoundError when no SearchLog entities are found.
+func (slq *SearchLogQuery) Only(ctx context.Context) (*SearchLog, error) {
+	nodes, err := slq.Limit(2).All(setContextOp(ctx, slq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{searchlog.Label}
+	default:
+		return nil, &NotSingularError{searchlog.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (slq *SearchLogQuery) OnlyX(ctx context.Context) *SearchLog {
+	node, err := slq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SearchLog ID in the query.
+// Returns a *NotSingularError when more than one SearchLog ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (slq *SearchLogQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = slq.Limit(2).IDs(setContextOp(ctx, slq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{searchlog.Label}
+	default:
+		err = &NotSingularError{searchlog.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (slq *SearchLogQuery) OnlyIDX(ctx context.Context) int {
+	id, err := slq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SearchLogs.
+func (slq *SearchLogQuery) All(ctx context.Context) ([]*SearchLog, error) {
+	ctx = setContextOp(ctx, slq.ctx, ent.OpQueryAll)
+	if err := slq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SearchLog, *SearchLogQuery]()
+	return withInterceptors[[]*SearchLog](ctx, slq, qr, slq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (slq *SearchLogQuery) AllX(ctx context.Context) []*SearchLog {
+	nodes, err := slq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SearchLog IDs.
+func (slq *SearchLogQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if slq.ctx.Unique == nil && slq.path != nil {
+		slq.Unique(true)
+	}
+	ctx = setContextOp(ctx, slq.ctx, ent.OpQueryIDs)
+	if err = slq.Select(searchlog.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (slq *SearchLogQuery) IDsX(ctx context.Context) []int {
+	ids, err := slq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (slq *SearchLogQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, slq.ctx, ent.OpQueryCount)
+	if err := slq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, slq, querierCount[*SearchLogQuery](), slq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (slq *SearchLogQuery) CountX(ctx context.Context) int {
+	count, err := slq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (slq *SearchLogQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, slq.ctx, ent.OpQueryExist)
+	switch _, err := slq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (slq *SearchLogQuery) ExistX(ctx context.Context) bool {
+	exist, err := slq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SearchLogQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (slq *SearchLogQuery) Clone() *SearchLogQuery {
+	if slq == nil {
+		return nil
+	}
+	return &SearchLogQuery{
+		config:     slq.config,
+		ctx:        slq.ctx.Clone(),
+		order:      append([]searchlog.OrderOption{}, slq.order...),
+		inters:     append([]Interceptor{}, slq.inters...),
+		predicates: append([]predicate.SearchLog{}, slq.predicates...),
+		// clone intermediate query.
+		sql:  slq.sql.Clone(),
+		path: slq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Query string `json:"query,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SearchLog.Query().
+//		GroupBy(searchlog.FieldQuery).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (slq *SearchLogQuery) GroupBy(field string, fields ...string) *SearchLogGroupBy {
+	slq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SearchLogGroupBy{build: slq}
+	grbuild.flds = &slq.ctx.Fields
+	grbuild.label = searchlog.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Query string `json:"query,omitempty"`
+//	}
+//
+//	client.SearchLog.Query().
+//		Select(searchlog.FieldQuery).
+//		Scan(ctx, &v)
+func (slq *SearchLogQuery) Select(fields ...string) *SearchLogSelect {
+	slq.ctx.Fields = append(slq.ctx.Fields, fields...)
+	sbuild := &SearchLogSelect{SearchLogQuery: slq}
+	sbuild.label = searchlog.Label
+	sbuild.flds, sbuild.scan = &slq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SearchLogSelect configured with the given aggregations.
+func (slq *SearchLogQuery) Aggregate(fns ...AggregateFunc) *SearchLogSelect {
+	return slq.Select().Aggregate(fns...)
+}
+
+func (slq *SearchLogQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range slq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, slq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range slq.ctx.Fields {
+		if !searchlog.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if slq.path != nil {
+		prev, err := slq.path(ctx)
+		if err != nil {
+			return err
+		}
+		slq.sql = prev
+	}
+	return nil
+}
+
+func (slq *SearchLogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SearchLog, error) {
+	var (
+		nodes = []*SearchLog{}
+		_spec = slq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SearchLog).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SearchLog{config: slq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, slq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (slq *SearchLogQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := slq.querySpec()
+	_spec.Node.Columns = slq.ctx.Fields
+	if len(slq.ctx.Fields) > 0 {
+		_spec.Unique = slq.ctx.Unique != nil && *slq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, slq.driver, _spec)
+}
+
+func (slq *SearchLogQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(searchlog.Table, searchlog.Columns, sqlgraph.NewFieldSpec(searchlog.FieldID, field.TypeInt))
+	_spec.From = slq.sql
+	if unique := slq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if slq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := slq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, searchlog.FieldID)
+		for i := range fields {
+			if fields[i] != searchlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := slq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := slq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := slq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := slq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (slq *SearchLogQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(slq.driver.Dialect())
+	t1 := builder.Table(searchlog.Table)
+	columns := slq.ctx.Fields
+	if len(columns) == 0 {
+		columns = searchlog.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if slq.sql != nil {
+		selector = slq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if slq.ctx.Unique != nil && *slq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range slq.predicates {
+		p(selector)
+	}
+	for _, p := range slq.order {
+		p(selector)
+	}
+	if offset := slq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := slq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SearchLogGroupBy is the group-by builder for SearchLog entities.
+type SearchLogGroupBy struct {
+	selector
+	build *SearchLogQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (slgb *SearchLogGroupBy) Aggregate(fns ...AggregateFunc) *SearchLogGroupBy {
+	slgb.fns = append(slgb.fns, fns...)
+	return slgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (slgb *SearchLogGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, slgb.build.ctx, ent.OpQueryGroupBy)
+	if err := slgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SearchLogQuery, *SearchLogGroupBy](ctx, slgb.build, slgb, slgb.build.inters, v)
+}
+
+func (slgb *SearchLogGroupBy) sqlScan(ctx context.Context, root *SearchLogQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(slgb.fns))
+	for _, fn := range slgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*slgb.flds)+len(slgb.fns))
+		for _, f := range *slgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*slgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := slgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SearchLogSelect is the builder for selecting fields of SearchLog entities.
+type SearchLogSelect struct {
+	*SearchLogQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sls *SearchLogSelect) Aggregate(fns ...AggregateFunc) *SearchLogSelect {
+	sls.fns = append(sls.fns, fns...)
+	return sls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sls *SearchLogSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sls.ctx, ent.OpQuerySelect)
+	if err := sls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SearchLogQuery, *SearchLogSelect](ctx, sls.SearchLogQuery, sls, sls.inters, v)
+}
+
+func (sls *SearchLogSelect) sqlScan(ctx context.Context, root *SearchLogQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sls.fns))
+	for _, fn := range sls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"api.us4ever/internal/ent/searchoutbox"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SearchOutbox is the model entity for the SearchOutbox schema.
+type SearchOutbox struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EntityType holds the value of the "entityType" field.
+	EntityType string `json:"entityType,omitempty"`
+	// EntityId holds the value of the "entityId" field.
+	EntityId string `json:"entityId,omitempty"`
+	// Op holds the value of the "op" field.
+	Op searchoutbox.Op `json:"op,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// LastError holds the value of the "lastError" field.
+	LastError string `json:"lastError,omitempty"`
+	// AvailableAt holds the value of the "availableAt" field.
+	AvailableAt time.Time `json:"availableAt,omitempty"`
+	// CreatedAt holds the value of the "createdAt" field.
+	CreatedAt    time.Time `json:"createdAt,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SearchOutbox) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case searchoutbox.FieldID, searchoutbox.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case searchoutbox.FieldEntityType, searchoutbox.FieldEntityId, searchoutbox.FieldOp, searchoutbox.FieldLastError:
+			values[i] = new(sql.NullString)
+		case searchoutbox.FieldAvailableAt, searchoutbox.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SearchOutbox fields.
+func (so *SearchOutbox) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case searchoutbox.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			so.ID = int(value.Int64)
+		case searchoutbox.FieldEntityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entityType", values[i])
+			} else if value.Valid {
+				so.EntityType = value.String
+			}
+		case searchoutbox.FieldEntityId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entityId", values[i])
+			} else if value.Valid {
+				so.EntityId = value.String
+			}
+		case searchoutbox.FieldOp:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field op", values[i])
+			} else if value.Valid {
+				so.Op = searchoutbox.Op(value.String)
+			}
+		case searchoutbox.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				so.Attempts = int(value.Int64)
+			}
+		case searchoutbox.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lastError", values[i])
+			} else if value.Valid {
+				so.LastError = value.String
+			}
+		case searchoutbox.FieldAvailableAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field availableAt", values[i])
+			} else if value.Valid {
+				so.AvailableAt = value.Time
+			}
+		case searchoutbox.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field createdAt", values[i])
+			} else if value.Valid {
+				so.CreatedAt = value.Time
+			}
+		default:
+			so.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SearchOutbox.
+// This includes values selected through modifiers, order, etc.
+func (so *SearchOutbox) Value(name string) (ent.Value, error) {
+	return so.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SearchOutbox.
+// Note that you need to call SearchOutbox.Unwrap() before calling this method if this SearchOutbox
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (so *SearchOutbox) Update() *SearchOutboxUpdateOne {
+	return NewSearchOutboxClient(so.config).UpdateOne(so)
+}
+
+// Unwrap unwraps the SearchOutbox entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (so *SearchOutbox) Unwrap() *SearchOutbox {
+	_tx, ok := so.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SearchOutbox is not a transactional entity")
+	}
+	so.config.driver = _tx.drv
+	return so
+}
+
+// String implements the fmt.Stringer.
+func (so *SearchOutbox) String() string {
+	var builder strings.Builder
+	builder.WriteString("SearchOutbox(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", so.ID))
+	builder.WriteString("entityType=")
+	builder.WriteString(so.EntityType)
+	builder.WriteString(", ")
+	builder.WriteString("entityId=")
+	builder.WriteString(so.EntityId)
+	builder.WriteString(", ")
+	builder.WriteString("op=")
+	builder.WriteString(fmt.Sprintf("%v", so.Op))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", so.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("lastError=")
+	builder.WriteString(so.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("availableAt=")
+	builder.WriteString(so.AvailableAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("createdAt=")
+	builder.WriteString(so.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SearchOutboxes is a parsable slice of SearchOutbox.
+type SearchOutboxes []*SearchOutbox
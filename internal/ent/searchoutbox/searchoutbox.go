@@ -0,0 +1,130 @@
+// Code generated by ent, DO NOT EDIT.
+
+package searchoutbox
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the searchoutbox type in the database.
+	Label = "search_outbox"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEntityType holds the string denoting the entitytype field in the database.
+	FieldEntityType = "entityType"
+	// FieldEntityId holds the string denoting the entityid field in the database.
+	FieldEntityId = "entityId"
+	// FieldOp holds the string denoting the op field in the database.
+	FieldOp = "op"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldLastError holds the string denoting the lasterror field in the database.
+	FieldLastError = "lastError"
+	// FieldAvailableAt holds the string denoting the availableat field in the database.
+	FieldAvailableAt = "availableAt"
+	// FieldCreatedAt holds the string denoting the createdat field in the database.
+	FieldCreatedAt = "createdAt"
+	// Table holds the table name of the searchoutbox in the database.
+	Table = "search_outbox"
+)
+
+// Columns holds all SQL columns for searchoutbox fields.
+var Columns = []string{
+	FieldID,
+	FieldEntityType,
+	FieldEntityId,
+	FieldOp,
+	FieldAttempts,
+	FieldLastError,
+	FieldAvailableAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultAvailableAt holds the default value on creation for the "availableAt" field.
+	DefaultAvailableAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "createdAt" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Op defines the type for the "op" enum field.
+type Op string
+
+// Op values.
+const (
+	OpUpsert Op = "upsert"
+	OpDelete Op = "delete"
+)
+
+func (_op Op) String() string {
+	return string(_op)
+}
+
+// OpValidator is a validator for the "op" field enum values. It is called by the builders before save.
+func OpValidator(_op Op) error {
+	switch _op {
+	case OpUpsert, OpDelete:
+		return nil
+	default:
+		return fmt.Errorf("searchoutbox: invalid enum value for op field: %q", _op)
+	}
+}
+
+// OrderOption defines the ordering options for the SearchOutbox queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEntityType orders the results by the entityType field.
+func ByEntityType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityType, opts...).ToFunc()
+}
+
+// ByEntityId orders the results by the entityId field.
+func ByEntityId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityId, opts...).ToFunc()
+}
+
+// ByOp orders the results by the op field.
+func ByOp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOp, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the lastError field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByAvailableAt orders the results by the availableAt field.
+func ByAvailableAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvailableAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the createdAt field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package searchoutbox
+
+import (
+	"time"
+
+	"api.us4ever/internal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldLTE(FieldID, id))
+}
+
+// EntityType applies equality check predicate on the "entityType" field. It's identical to EntityTypeEQ.
+func EntityType(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEQ(FieldEntityType, v))
+}
+
+// EntityId applies equality check predicate on the "entityId" field. It's identical to EntityIdEQ.
+func EntityId(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEQ(FieldEntityId, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEQ(FieldAttempts, v))
+}
+
+// LastError applies equality check predicate on the "lastError" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEQ(FieldLastError, v))
+}
+
+// AvailableAt applies equality check predicate on the "availableAt" field. It's identical to AvailableAtEQ.
+func AvailableAt(v time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEQ(FieldAvailableAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EntityTypeEQ applies the EQ predicate on the "entityType" field.
+func EntityTypeEQ(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEQ(FieldEntityType, v))
+}
+
+// EntityTypeNEQ applies the NEQ predicate on the "entityType" field.
+func EntityTypeNEQ(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNEQ(FieldEntityType, v))
+}
+
+// EntityTypeIn applies the In predicate on the "entityType" field.
+func EntityTypeIn(vs ...string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldIn(FieldEntityType, vs...))
+}
+
+// EntityTypeNotIn applies the NotIn predicate on the "entityType" field.
+func EntityTypeNotIn(vs ...string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNotIn(FieldEntityType, vs...))
+}
+
+// EntityTypeGT applies the GT predicate on the "entityType" field.
+func EntityTypeGT(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldGT(FieldEntityType, v))
+}
+
+// EntityTypeGTE applies the GTE predicate on the "entityType" field.
+func EntityTypeGTE(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldGTE(FieldEntityType, v))
+}
+
+// EntityTypeLT applies the LT predicate on the "entityType" field.
+func EntityTypeLT(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldLT(FieldEntityType, v))
+}
+
+// EntityTypeLTE applies the LTE predicate on the "entityType" field.
+func EntityTypeLTE(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldLTE(FieldEntityType, v))
+}
+
+// EntityTypeContains applies the Contains predicate on the "entityType" field.
+func EntityTypeContains(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldContains(FieldEntityType, v))
+}
+
+// EntityTypeHasPrefix applies the HasPrefix predicate on the "entityType" field.
+func EntityTypeHasPrefix(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldHasPrefix(FieldEntityType, v))
+}
+
+// EntityTypeHasSuffix applies the HasSuffix predicate on the "entityType" field.
+func EntityTypeHasSuffix(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldHasSuffix(FieldEntityType, v))
+}
+
+// EntityTypeEqualFold applies the EqualFold predicate on the "entityType" field.
+func EntityTypeEqualFold(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEqualFold(FieldEntityType, v))
+}
+
+// EntityTypeContainsFold applies the ContainsFold predicate on the "entityType" field.
+func EntityTypeContainsFold(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldContainsFold(FieldEntityType, v))
+}
+
+// EntityIdEQ applies the EQ predicate on the "entityId" field.
+func EntityIdEQ(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEQ(FieldEntityId, v))
+}
+
+// EntityIdNEQ applies the NEQ predicate on the "entityId" field.
+func EntityIdNEQ(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNEQ(FieldEntityId, v))
+}
+
+// EntityIdIn applies the In predicate on the "entityId" field.
+func EntityIdIn(vs ...string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldIn(FieldEntityId, vs...))
+}
+
+// EntityIdNotIn applies the NotIn predicate on the "entityId" field.
+func EntityIdNotIn(vs ...string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNotIn(FieldEntityId, vs...))
+}
+
+// EntityIdGT applies the GT predicate on the "entityId" field.
+func EntityIdGT(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldGT(FieldEntityId, v))
+}
+
+// EntityIdGTE applies the GTE predicate on the "entityId" field.
+func EntityIdGTE(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldGTE(FieldEntityId, v))
+}
+
+// EntityIdLT applies the LT predicate on the "entityId" field.
+func EntityIdLT(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldLT(FieldEntityId, v))
+}
+
+// EntityIdLTE applies the LTE predicate on the "entityId" field.
+func EntityIdLTE(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldLTE(FieldEntityId, v))
+}
+
+// EntityIdContains applies the Contains predicate on the "entityId" field.
+func EntityIdContains(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldContains(FieldEntityId, v))
+}
+
+// EntityIdHasPrefix applies the HasPrefix predicate on the "entityId" field.
+func EntityIdHasPrefix(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldHasPrefix(FieldEntityId, v))
+}
+
+// EntityIdHasSuffix applies the HasSuffix predicate on the "entityId" field.
+func EntityIdHasSuffix(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldHasSuffix(FieldEntityId, v))
+}
+
+// EntityIdEqualFold applies the EqualFold predicate on the "entityId" field.
+func EntityIdEqualFold(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEqualFold(FieldEntityId, v))
+}
+
+// EntityIdContainsFold applies the ContainsFold predicate on the "entityId" field.
+func EntityIdContainsFold(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldContainsFold(FieldEntityId, v))
+}
+
+// OpEQ applies the EQ predicate on the "op" field.
+func OpEQ(v Op) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEQ(FieldOp, v))
+}
+
+// OpNEQ applies the NEQ predicate on the "op" field.
+func OpNEQ(v Op) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNEQ(FieldOp, v))
+}
+
+// OpIn applies the In predicate on the "op" field.
+func OpIn(vs ...Op) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldIn(FieldOp, vs...))
+}
+
+// OpNotIn applies the NotIn predicate on the "op" field.
+func OpNotIn(vs ...Op) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNotIn(FieldOp, vs...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldLTE(FieldAttempts, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "lastError" field.
+func LastErrorEQ(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "lastError" field.
+func LastErrorNEQ(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "lastError" field.
+func LastErrorIn(vs ...string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "lastError" field.
+func LastErrorNotIn(vs ...string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "lastError" field.
+func LastErrorGT(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "lastError" field.
+func LastErrorGTE(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "lastError" field.
+func LastErrorLT(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "lastError" field.
+func LastErrorLTE(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "lastError" field.
+func LastErrorContains(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "lastError" field.
+func LastErrorHasPrefix(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "lastError" field.
+func LastErrorHasSuffix(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "lastError" field.
+func LastErrorIsNil() predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "lastError" field.
+func LastErrorNotNil() predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "lastError" field.
+func LastErrorEqualFold(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "lastError" field.
+func LastErrorContainsFold(v string) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// AvailableAtEQ applies the EQ predicate on the "availableAt" field.
+func AvailableAtEQ(v time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEQ(FieldAvailableAt, v))
+}
+
+// AvailableAtNEQ applies the NEQ predicate on the "availableAt" field.
+func AvailableAtNEQ(v time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNEQ(FieldAvailableAt, v))
+}
+
+// AvailableAtIn applies the In predicate on the "availableAt" field.
+func AvailableAtIn(vs ...time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldIn(FieldAvailableAt, vs...))
+}
+
+// AvailableAtNotIn applies the NotIn predicate on the "availableAt" field.
+func AvailableAtNotIn(vs ...time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNotIn(FieldAvailableAt, vs...))
+}
+
+// AvailableAtGT applies the GT predicate on the "availableAt" field.
+func AvailableAtGT(v time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldGT(FieldAvailableAt, v))
+}
+
+// AvailableAtGTE applies the GTE predicate on the "availableAt" field.
+func AvailableAtGTE(v time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldGTE(FieldAvailableAt, v))
+}
+
+// AvailableAtLT applies the LT predicate on the "availableAt" field.
+func AvailableAtLT(v time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldLT(FieldAvailableAt, v))
+}
+
+// AvailableAtLTE applies the LTE predicate on the "availableAt" field.
+func AvailableAtLTE(v time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldLTE(FieldAvailableAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SearchOutbox) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SearchOutbox) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SearchOutbox) predicate.SearchOutbox {
+	return predicate.SearchOutbox(sql.NotPredicates(p))
+}
@@ -0,0 +1,314 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"api.us4ever/internal/ent/searchoutbox"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchOutboxCreate is the builder for creating a SearchOutbox entity.
+type SearchOutboxCreate struct {
+	config
+	mutation *SearchOutboxMutation
+	hooks    []Hook
+}
+
+// SetEntityType sets the "entityType" field.
+func (soc *SearchOutboxCreate) SetEntityType(s string) *SearchOutboxCreate {
+	soc.mutation.SetEntityType(s)
+	return soc
+}
+
+// SetEntityId sets the "entityId" field.
+func (soc *SearchOutboxCreate) SetEntityId(s string) *SearchOutboxCreate {
+	soc.mutation.SetEntityId(s)
+	return soc
+}
+
+// SetOp sets the "op" field.
+func (soc *SearchOutboxCreate) SetOp(s searchoutbox.Op) *SearchOutboxCreate {
+	soc.mutation.SetOpField(s)
+	return soc
+}
+
+// SetAttempts sets the "attempts" field.
+func (soc *SearchOutboxCreate) SetAttempts(i int) *SearchOutboxCreate {
+	soc.mutation.SetAttempts(i)
+	return soc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (soc *SearchOutboxCreate) SetNillableAttempts(i *int) *SearchOutboxCreate {
+	if i != nil {
+		soc.SetAttempts(*i)
+	}
+	return soc
+}
+
+// SetLastError sets the "lastError" field.
+func (soc *SearchOutboxCreate) SetLastError(s string) *SearchOutboxCreate {
+	soc.mutation.SetLastError(s)
+	return soc
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (soc *SearchOutboxCreate) SetNillableLastError(s *string) *SearchOutboxCreate {
+	if s != nil {
+		soc.SetLastError(*s)
+	}
+	return soc
+}
+
+// SetAvailableAt sets the "availableAt" field.
+func (soc *SearchOutboxCreate) SetAvailableAt(t time.Time) *SearchOutboxCreate {
+	soc.mutation.SetAvailableAt(t)
+	return soc
+}
+
+// SetNillableAvailableAt sets the "availableAt" field if the given value is not nil.
+func (soc *SearchOutboxCreate) SetNillableAvailableAt(t *time.Time) *SearchOutboxCreate {
+	if t != nil {
+		soc.SetAvailableAt(*t)
+	}
+	return soc
+}
+
+// SetCreatedAt sets the "createdAt" field.
+func (soc *SearchOutboxCreate) SetCreatedAt(t time.Time) *SearchOutboxCreate {
+	soc.mutation.SetCreatedAt(t)
+	return soc
+}
+
+// SetNillableCreatedAt sets the "createdAt" field if the given value is not nil.
+func (soc *SearchOutboxCreate) SetNillableCreatedAt(t *time.Time) *SearchOutboxCreate {
+	if t != nil {
+		soc.SetCreatedAt(*t)
+	}
+	return soc
+}
+
+// Mutation returns the SearchOutboxMutation object of the builder.
+func (soc *SearchOutboxCreate) Mutation() *SearchOutboxMutation {
+	return soc.mutation
+}
+
+// Save creates the SearchOutbox in the database.
+func (soc *SearchOutboxCreate) Save(ctx context.Context) (*SearchOutbox, error) {
+	soc.defaults()
+	return withHooks(ctx, soc.sqlSave, soc.mutation, soc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (soc *SearchOutboxCreate) SaveX(ctx context.Context) *SearchOutbox {
+	v, err := soc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (soc *SearchOutboxCreate) Exec(ctx context.Context) error {
+	_, err := soc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (soc *SearchOutboxCreate) ExecX(ctx context.Context) {
+	if err := soc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (soc *SearchOutboxCreate) defaults() {
+	if _, ok := soc.mutation.Attempts(); !ok {
+		v := searchoutbox.DefaultAttempts
+		soc.mutation.SetAttempts(v)
+	}
+	if _, ok := soc.mutation.AvailableAt(); !ok {
+		v := searchoutbox.DefaultAvailableAt()
+		soc.mutation.SetAvailableAt(v)
+	}
+	if _, ok := soc.mutation.CreatedAt(); !ok {
+		v := searchoutbox.DefaultCreatedAt()
+		soc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (soc *SearchOutboxCreate) check() error {
+	if _, ok := soc.mutation.EntityType(); !ok {
+		return &ValidationError{Name: "entityType", err: errors.New(`ent: missing required field "SearchOutbox.entityType"`)}
+	}
+	if _, ok := soc.mutation.EntityId(); !ok {
+		return &ValidationError{Name: "entityId", err: errors.New(`ent: missing required field "SearchOutbox.entityId"`)}
+	}
+	if _, ok := soc.mutation.GetOp(); !ok {
+		return &ValidationError{Name: "op", err: errors.New(`ent: missing required field "SearchOutbox.op"`)}
+	}
+	if v, ok := soc.mutation.GetOp(); ok {
+		if err := searchoutbox.OpValidator(v); err != nil {
+			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "SearchOutbox.op": %w`, err)}
+		}
+	}
+	if _, ok := soc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "SearchOutbox.attempts"`)}
+	}
+	if _, ok := soc.mutation.AvailableAt(); !ok {
+		return &ValidationError{Name: "availableAt", err: errors.New(`ent: missing required field "SearchOutbox.availableAt"`)}
+	}
+	if _, ok := soc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "createdAt", err: errors.New(`ent: missing required field "SearchOutbox.createdAt"`)}
+	}
+	return nil
+}
+
+func (soc *SearchOutboxCreate) sqlSave(ctx context.Context) (*SearchOutbox, error) {
+	if err := soc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := soc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, soc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	soc.mutation.id = &_node.ID
+	soc.mutation.done = true
+	return _node, nil
+}
+
+func (soc *SearchOutboxCreate) createSpec() (*SearchOutbox, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SearchOutbox{config: soc.config}
+		_spec = sqlgraph.NewCreateSpec(searchoutbox.Table, sqlgraph.NewFieldSpec(searchoutbox.FieldID, field.TypeInt))
+	)
+	if value, ok := soc.mutation.EntityType(); ok {
+		_spec.SetField(searchoutbox.FieldEntityType, field.TypeString, value)
+		_node.EntityType = value
+	}
+	if value, ok := soc.mutation.EntityId(); ok {
+		_spec.SetField(searchoutbox.FieldEntityId, field.TypeString, value)
+		_node.EntityId = value
+	}
+	if value, ok := soc.mutation.GetOp(); ok {
+		_spec.SetField(searchoutbox.FieldOp, field.TypeEnum, value)
+		_node.Op = value
+	}
+	if value, ok := soc.mutation.Attempts(); ok {
+		_spec.SetField(searchoutbox.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := soc.mutation.LastError(); ok {
+		_spec.SetField(searchoutbox.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := soc.mutation.AvailableAt(); ok {
+		_spec.SetField(searchoutbox.FieldAvailableAt, field.TypeTime, value)
+		_node.AvailableAt = value
+	}
+	if value, ok := soc.mutation.CreatedAt(); ok {
+		_spec.SetField(searchoutbox.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// SearchOutboxCreateBulk is the builder for creating many SearchOutbox entities in bulk.
+type SearchOutboxCreateBulk struct {
+	config
+	err      error
+	builders []*SearchOutboxCreate
+}
+
+// Save creates the SearchOutbox entities in the database.
+func (socb *SearchOutboxCreateBulk) Save(ctx context.Context) ([]*SearchOutbox, error) {
+	if socb.err != nil {
+		return nil, socb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(socb.builders))
+	nodes := make([]*SearchOutbox, len(socb.builders))
+	mutators := make([]Mutator, len(socb.builders))
+	for i := range socb.builders {
+		func(i int, root context.Context) {
+			builder := socb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SearchOutboxMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, socb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, socb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, socb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (socb *SearchOutboxCreateBulk) SaveX(ctx context.Context) []*SearchOutbox {
+	v, err := socb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (socb *SearchOutboxCreateBulk) Exec(ctx context.Context) error {
+	_, err := socb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (socb *SearchOutboxCreateBulk) ExecX(ctx context.Context) {
+	if err := socb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"api.us4ever/internal/ent/predicate"
+	"api.us4ever/internal/ent/searchoutbox"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchOutboxDelete is the builder for deleting a SearchOutbox entity.
+type SearchOutboxDelete struct {
+	config
+	hooks    []Hook
+	mutation *SearchOutboxMutation
+}
+
+// Where appends a list predicates to the SearchOutboxDelete builder.
+func (sod *SearchOutboxDelete) Where(ps ...predicate.SearchOutbox) *SearchOutboxDelete {
+	sod.mutation.Where(ps...)
+	return sod
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sod *SearchOutboxDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sod.sqlExec, sod.mutation, sod.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sod *SearchOutboxDelete) ExecX(ctx context.Context) int {
+	n, err := sod.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sod *SearchOutboxDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(searchoutbox.Table, sqlgraph.NewFieldSpec(searchoutbox.FieldID, field.TypeInt))
+	if ps := sod.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sod.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sod.mutation.done = true
+	return affected, err
+}
+
+// SearchOutboxDeleteOne is the builder for deleting a single SearchOutbox entity.
+type SearchOutboxDeleteOne struct {
+	sod *SearchOutboxDelete
+}
+
+// Where appends a list predicates to the SearchOutboxDelete builder.
+func (sodo *SearchOutboxDeleteOne) Where(ps ...predicate.SearchOutbox) *SearchOutboxDeleteOne {
+	sodo.sod.mutation.Where(ps...)
+	return sodo
+}
+
+// Exec executes the deletion query.
+func (sodo *SearchOutboxDeleteOne) Exec(ctx context.Context) error {
+	n, err := sodo.sod.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{searchoutbox.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sodo *SearchOutboxDeleteOne) ExecX(ctx context.Context) {
+	if err := sodo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
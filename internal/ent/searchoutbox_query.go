@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"api.us4ever/internal/ent/predicate"
+	"api.us4ever/internal/ent/searchoutbox"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchOutboxQuery is the builder for querying SearchOutbox entities.
+type SearchOutboxQuery struct {
+	config
+	ctx        *QueryContext
+	order      []searchoutbox.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SearchOutbox
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SearchOutboxQuery builder.
+func (soq *SearchOutboxQuery) Where(ps ...predicate.SearchOutbox) *SearchOutboxQuery {
+	soq.predicates = append(soq.predicates, ps...)
+	return soq
+}
+
+// Limit the number of records to be returned by this query.
+func (soq *SearchOutboxQuery) Limit(limit int) *SearchOutboxQuery {
+	soq.ctx.Limit = &limit
+	return soq
+}
+
+// Offset to start from.
+func (soq *SearchOutboxQuery) Offset(offset int) *SearchOutboxQuery {
+	soq.ctx.Offset = &offset
+	return soq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (soq *SearchOutboxQuery) Unique(unique bool) *SearchOutboxQuery {
+	soq.ctx.Unique = &unique
+	return soq
+}
+
+// Order specifies how the records should be ordered.
+func (soq *SearchOutboxQuery) Order(o ...searchoutbox.OrderOption) *SearchOutboxQuery {
+	soq.order = append(soq.order, o...)
+	return soq
+}
+
+// First returns the first SearchOutbox entity from the query.
+// Returns a *NotFoundError when no SearchOutbox was found.
+func (soq *SearchOutboxQuery) First(ctx context.Context) (*SearchOutbox, error) {
+	nodes, err := soq.Limit(1).All(setContextOp(ctx, soq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{searchoutbox.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (soq *SearchOutboxQuery) FirstX(ctx context.Context) *SearchOutbox {
+	node, err := soq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SearchOutbox ID from the query.
+// Returns a *NotFoundError when no SearchOutbox ID was found.
+func (soq *SearchOutboxQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = soq.Limit(1).IDs(setContextOp(ctx, soq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{searchoutbox.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (soq *SearchOutboxQuery) FirstIDX(ctx context.Context) int {
+	id, err := soq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SearchOutbox entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SearchOutbox entity is found.
+// Returns a *NotFoundError when no SearchOutbox entities are found.
+func (soq *SearchOutboxQuery) Only(ctx context.Context) (*SearchOutbox, error) {
+	nodes, err := soq.Limit(2).All(setContextOp(ctx, soq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{searchoutbox.Label}
+	default:
+		return nil, &NotSingularError{searchoutbox.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (soq *SearchOutboxQuery) OnlyX(ctx context.Context) *SearchOutbox {
+	node, err := soq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SearchOutbox ID in the query.
+// Returns a *NotSingularError when more than one SearchOutbox ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (soq *SearchOutboxQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = soq.Limit(2).IDs(setContextOp(ctx, soq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{searchoutbox.Label}
+	default:
+		err = &NotSingularError{searchoutbox.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (soq *SearchOutboxQuery) OnlyIDX(ctx context.Context) int {
+	id, err := soq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SearchOutboxes.
+func (soq *SearchOutboxQuery) All(ctx context.Context) ([]*SearchOutbox, error) {
+	ctx = setContextOp(ctx, soq.ctx, ent.OpQueryAll)
+	if err := soq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SearchOutbox, *SearchOutboxQuery]()
+	return withInterceptors[[]*SearchOutbox](ctx, soq, qr, soq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (soq *SearchOutboxQuery) AllX(ctx context.Context) []*SearchOutbox {
+	nodes, err := soq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SearchOutbox IDs.
+func (soq *SearchOutboxQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if soq.ctx.Unique == nil && soq.path != nil {
+		soq.Unique(true)
+	}
+	ctx = setContextOp(ctx, soq.ctx, ent.OpQueryIDs)
+	if err = soq.Select(searchoutbox.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (soq *SearchOutboxQuery) IDsX(ctx context.Context) []int {
+	ids, err := soq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (soq *SearchOutboxQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, soq.ctx, ent.OpQueryCount)
+	if err := soq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, soq, querierCount[*SearchOutboxQuery](), soq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (soq *SearchOutboxQuery) CountX(ctx context.Context) int {
+	count, err := soq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (soq *SearchOutboxQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, soq.ctx, ent.OpQueryExist)
+	switch _, err := soq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (soq *SearchOutboxQuery) ExistX(ctx context.Context) bool {
+	exist, err := soq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SearchOutboxQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (soq *SearchOutboxQuery) Clone() *SearchOutboxQuery {
+	if soq == nil {
+		return nil
+	}
+	return &SearchOutboxQuery{
+		config:     soq.config,
+		ctx:        soq.ctx.Clone(),
+		order:      append([]searchoutbox.OrderOption{}, soq.order...),
+		inters:     append([]Interceptor{}, soq.inters...),
+		predicates: append([]predicate.SearchOutbox{}, soq.predicates...),
+		// clone intermediate query.
+		sql:  soq.sql.Clone(),
+		path: soq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EntityType string `json:"entityType,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SearchOutbox.Query().
+//		GroupBy(searchoutbox.FieldEntityType).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (soq *SearchOutboxQuery) GroupBy(field string, fields ...string) *SearchOutboxGroupBy {
+	soq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SearchOutboxGroupBy{build: soq}
+	grbuild.flds = &soq.ctx.Fields
+	grbuild.label = searchoutbox.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		EntityType string `json:"entityType,omitempty"`
+//	}
+//
+//	client.SearchOutbox.Query().
+//		Select(searchoutbox.FieldEntityType).
+//		Scan(ctx, &v)
+func (soq *SearchOutboxQuery) Select(fields ...string) *SearchOutboxSelect {
+	soq.ctx.Fields = append(soq.ctx.Fields, fields...)
+	sbuild := &SearchOutboxSelect{SearchOutboxQuery: soq}
+	sbuild.label = searchoutbox.Label
+	sbuild.flds, sbuild.scan = &soq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SearchOutboxSelect configured with the given aggregations.
+func (soq *SearchOutboxQuery) Aggregate(fns ...AggregateFunc) *SearchOutboxSelect {
+	return soq.Select().Aggregate(fns...)
+}
+
+func (soq *SearchOutboxQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range soq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, soq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range soq.ctx.Fields {
+		if !searchoutbox.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if soq.path != nil {
+		prev, err := soq.path(ctx)
+		if err != nil {
+			return err
+		}
+		soq.sql = prev
+	}
+	return nil
+}
+
+func (soq *SearchOutboxQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SearchOutbox, error) {
+	var (
+		nodes = []*SearchOutbox{}
+		_spec = soq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SearchOutbox).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SearchOutbox{config: soq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, soq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (soq *SearchOutboxQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := soq.querySpec()
+	_spec.Node.Columns = soq.ctx.Fields
+	if len(soq.ctx.Fields) > 0 {
+		_spec.Unique = soq.ctx.Unique != nil && *soq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, soq.driver, _spec)
+}
+
+func (soq *SearchOutboxQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(searchoutbox.Table, searchoutbox.Columns, sqlgraph.NewFieldSpec(searchoutbox.FieldID, field.TypeInt))
+	_spec.From = soq.sql
+	if unique := soq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if soq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := soq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, searchoutbox.FieldID)
+		for i := range fields {
+			if fields[i] != searchoutbox.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := soq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := soq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := soq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := soq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (soq *SearchOutboxQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(soq.driver.Dialect())
+	t1 := builder.Table(searchoutbox.Table)
+	columns := soq.ctx.Fields
+	if len(columns) == 0 {
+		columns = searchoutbox.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if soq.sql != nil {
+		selector = soq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if soq.ctx.Unique != nil && *soq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range soq.predicates {
+		p(selector)
+	}
+	for _, p := range soq.order {
+		p(selector)
+	}
+	if offset := soq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := soq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SearchOutboxGroupBy is the group-by builder for SearchOutbox entities.
+type SearchOutboxGroupBy struct {
+	selector
+	build *SearchOutboxQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sogb *SearchOutboxGroupBy) Aggregate(fns ...AggregateFunc) *SearchOutboxGroupBy {
+	sogb.fns = append(sogb.fns, fns...)
+	return sogb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sogb *SearchOutboxGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sogb.build.ctx, ent.OpQueryGroupBy)
+	if err := sogb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SearchOutboxQuery, *SearchOutboxGroupBy](ctx, sogb.build, sogb, sogb.build.inters, v)
+}
+
+func (sogb *SearchOutboxGroupBy) sqlScan(ctx context.Context, root *SearchOutboxQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sogb.fns))
+	for _, fn := range sogb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sogb.flds)+len(sogb.fns))
+		for _, f := range *sogb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sogb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sogb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SearchOutboxSelect is the builder for selecting fields of SearchOutbox entities.
+type SearchOutboxSelect struct {
+	*SearchOutboxQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sos *SearchOutboxSelect) Aggregate(fns ...AggregateFunc) *SearchOutboxSelect {
+	sos.fns = append(sos.fns, fns...)
+	return sos
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sos *SearchOutboxSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sos.ctx, ent.OpQuerySelect)
+	if err := sos.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SearchOutboxQuery, *SearchOutboxSelect](ctx, sos.SearchOutboxQuery, sos, sos.inters, v)
+}
+
+func (sos *SearchOutboxSelect) sqlScan(ctx context.Context, root *SearchOutboxQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sos.fns))
+	for _, fn := range sos.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sos.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sos.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
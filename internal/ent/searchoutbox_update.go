@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"api.us4ever/internal/ent/predicate"
+	"api.us4ever/internal/ent/searchoutbox"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchOutboxUpdate is the builder for updating SearchOutbox entities.
+type SearchOutboxUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SearchOutboxMutation
+}
+
+// Where appends a list predicates to the SearchOutboxUpdate builder.
+func (sou *SearchOutboxUpdate) Where(ps ...predicate.SearchOutbox) *SearchOutboxUpdate {
+	sou.mutation.Where(ps...)
+	return sou
+}
+
+// SetEntityType sets the "entityType" field.
+func (sou *SearchOutboxUpdate) SetEntityType(s string) *SearchOutboxUpdate {
+	sou.mutation.SetEntityType(s)
+	return sou
+}
+
+// SetNillableEntityType sets the "entityType" field if the given value is not nil.
+func (sou *SearchOutboxUpdate) SetNillableEntityType(s *string) *SearchOutboxUpdate {
+	if s != nil {
+		sou.SetEntityType(*s)
+	}
+	return sou
+}
+
+// SetEntityId sets the "entityId" field.
+func (sou *SearchOutboxUpdate) SetEntityId(s string) *SearchOutboxUpdate {
+	sou.mutation.SetEntityId(s)
+	return sou
+}
+
+// SetNillableEntityId sets the "entityId" field if the given value is not nil.
+func (sou *SearchOutboxUpdate) SetNillableEntityId(s *string) *SearchOutboxUpdate {
+	if s != nil {
+		sou.SetEntityId(*s)
+	}
+	return sou
+}
+
+// SetOp sets the "op" field.
+func (sou *SearchOutboxUpdate) SetOp(s searchoutbox.Op) *SearchOutboxUpdate {
+	sou.mutation.SetOpField(s)
+	return sou
+}
+
+// SetNillableOp sets the "op" field if the given value is not nil.
+func (sou *SearchOutboxUpdate) SetNillableOp(s *searchoutbox.Op) *SearchOutboxUpdate {
+	if s != nil {
+		sou.SetOp(*s)
+	}
+	return sou
+}
+
+// SetAttempts sets the "attempts" field.
+func (sou *SearchOutboxUpdate) SetAttempts(i int) *SearchOutboxUpdate {
+	sou.mutation.ResetAttempts()
+	sou.mutation.SetAttempts(i)
+	return sou
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (sou *SearchOutboxUpdate) SetNillableAttempts(i *int) *SearchOutboxUpdate {
+	if i != nil {
+		sou.SetAttempts(*i)
+	}
+	return sou
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (sou *SearchOutboxUpdate) AddAttempts(i int) *SearchOutboxUpdate {
+	sou.mutation.AddAttempts(i)
+	return sou
+}
+
+// SetLastError sets the "lastError" field.
+func (sou *SearchOutboxUpdate) SetLastError(s string) *SearchOutboxUpdate {
+	sou.mutation.SetLastError(s)
+	return sou
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (sou *SearchOutboxUpdate) SetNillableLastError(s *string) *SearchOutboxUpdate {
+	if s != nil {
+		sou.SetLastError(*s)
+	}
+	return sou
+}
+
+// ClearLastError clears the value of the "lastError" field.
+func (sou *SearchOutboxUpdate) ClearLastError() *SearchOutboxUpdate {
+	sou.mutation.ClearLastError()
+	return sou
+}
+
+// SetAvailableAt sets the "availableAt" field.
+func (sou *SearchOutboxUpdate) SetAvailableAt(t time.Time) *SearchOutboxUpdate {
+	sou.mutation.SetAvailableAt(t)
+	return sou
+}
+
+// SetNillableAvailableAt sets the "availableAt" field if the given value is not nil.
+func (sou *SearchOutboxUpdate) SetNillableAvailableAt(t *time.Time) *SearchOutboxUpdate {
+	if t != nil {
+		sou.SetAvailableAt(*t)
+	}
+	return sou
+}
+
+// Mutation returns the SearchOutboxMutation object of the builder.
+func (sou *SearchOutboxUpdate) Mutation() *SearchOutboxMutation {
+	return sou.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sou *SearchOutboxUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sou.sqlSave, sou.mutation, sou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sou *SearchOutboxUpdate) SaveX(ctx context.Context) int {
+	affected, err := sou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sou *SearchOutboxUpdate) Exec(ctx context.Context) error {
+	_, err := sou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sou *SearchOutboxUpdate) ExecX(ctx context.Context) {
+	if err := sou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sou *SearchOutboxUpdate) check() error {
+	if v, ok := sou.mutation.GetOp(); ok {
+		if err := searchoutbox.OpValidator(v); err != nil {
+			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "SearchOutbox.op": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sou *SearchOutboxUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchoutbox.Table, searchoutbox.Columns, sqlgraph.NewFieldSpec(searchoutbox.FieldID, field.TypeInt))
+	if ps := sou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sou.mutation.EntityType(); ok {
+		_spec.SetField(searchoutbox.FieldEntityType, field.TypeString, value)
+	}
+	if value, ok := sou.mutation.EntityId(); ok {
+		_spec.SetField(searchoutbox.FieldEntityId, field.TypeString, value)
+	}
+	if value, ok := sou.mutation.GetOp(); ok {
+		_spec.SetField(searchoutbox.FieldOp, field.TypeEnum, value)
+	}
+	if value, ok := sou.mutation.Attempts(); ok {
+		_spec.SetField(searchoutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := sou.mutation.AddedAttempts(); ok {
+		_spec.AddField(searchoutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := sou.mutation.LastError(); ok {
+		_spec.SetField(searchoutbox.FieldLastError, field.TypeString, value)
+	}
+	if sou.mutation.LastErrorCleared() {
+		_spec.ClearField(searchoutbox.FieldLastError, field.TypeString)
+	}
+	if value, ok := sou.mutation.AvailableAt(); ok {
+		_spec.SetField(searchoutbox.FieldAvailableAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchoutbox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sou.mutation.done = true
+	return n, nil
+}
+
+// SearchOutboxUpdateOne is the builder for updating a single SearchOutbox entity.
+type SearchOutboxUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SearchOutboxMutation
+}
+
+// SetEntityType sets the "entityType" field.
+func (souo *SearchOutboxUpdateOne) SetEntityType(s string) *SearchOutboxUpdateOne {
+	souo.mutation.SetEntityType(s)
+	return souo
+}
+
+// SetNillableEntityType sets the "entityType" field if the given value is not nil.
+func (souo *SearchOutboxUpdateOne) SetNillableEntityType(s *string) *SearchOutboxUpdateOne {
+	if s != nil {
+		souo.SetEntityType(*s)
+	}
+	return souo
+}
+
+// SetEntityId sets the "entityId" field.
+func (souo *SearchOutboxUpdateOne) SetEntityId(s string) *SearchOutboxUpdateOne {
+	souo.mutation.SetEntityId(s)
+	return souo
+}
+
+// SetNillableEntityId sets the "entityId" field if the given value is not nil.
+func (souo *SearchOutboxUpdateOne) SetNillableEntityId(s *string) *SearchOutboxUpdateOne {
+	if s != nil {
+		souo.SetEntityId(*s)
+	}
+	return souo
+}
+
+// SetOp sets the "op" field.
+func (souo *SearchOutboxUpdateOne) SetOp(s searchoutbox.Op) *SearchOutboxUpdateOne {
+	souo.mutation.SetOpField(s)
+	return souo
+}
+
+// SetNillableOp sets the "op" field if the given value is not nil.
+func (souo *SearchOutboxUpdateOne) SetNillableOp(s *searchoutbox.Op) *SearchOutboxUpdateOne {
+	if s != nil {
+		souo.SetOp(*s)
+	}
+	return souo
+}
+
+// SetAttempts sets the "attempts" field.
+func (souo *SearchOutboxUpdateOne) SetAttempts(i int) *SearchOutboxUpdateOne {
+	souo.mutation.ResetAttempts()
+	souo.mutation.SetAttempts(i)
+	return souo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (souo *SearchOutboxUpdateOne) SetNillableAttempts(i *int) *SearchOutboxUpdateOne {
+	if i != nil {
+		souo.SetAttempts(*i)
+	}
+	return souo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (souo *SearchOutboxUpdateOne) AddAttempts(i int) *SearchOutboxUpdateOne {
+	souo.mutation.AddAttempts(i)
+	return souo
+}
+
+// SetLastError sets the "lastError" field.
+func (souo *SearchOutboxUpdateOne) SetLastError(s string) *SearchOutboxUpdateOne {
+	souo.mutation.SetLastError(s)
+	return souo
+}
+
+// SetNillableLastError sets the "lastError" field if the given value is not nil.
+func (souo *SearchOutboxUpdateOne) SetNillableLastError(s *string) *SearchOutboxUpdateOne {
+	if s != nil {
+		souo.SetLastError(*s)
+	}
+	return souo
+}
+
+// ClearLastError clears the value of the "lastError" field.
+func (souo *SearchOutboxUpdateOne) ClearLastError() *SearchOutboxUpdateOne {
+	souo.mutation.ClearLastError()
+	return souo
+}
+
+// SetAvailableAt sets the "availableAt" field.
+func (souo *SearchOutboxUpdateOne) SetAvailableAt(t time.Time) *SearchOutboxUpdateOne {
+	souo.mutation.SetAvailableAt(t)
+	return souo
+}
+
+// SetNillableAvailableAt sets the "availableAt" field if the given value is not nil.
+func (souo *SearchOutboxUpdateOne) SetNillableAvailableAt(t *time.Time) *SearchOutboxUpdateOne {
+	if t != nil {
+		souo.SetAvailableAt(*t)
+	}
+	return souo
+}
+
+// Mutation returns the SearchOutboxMutation object of the builder.
+func (souo *SearchOutboxUpdateOne) Mutation() *SearchOutboxMutation {
+	return souo.mutation
+}
+
+// Where appends a list predicates to the SearchOutboxUpdate builder.
+func (souo *SearchOutboxUpdateOne) Where(ps ...predicate.SearchOutbox) *SearchOutboxUpdateOne {
+	souo.mutation.Where(ps...)
+	return souo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (souo *SearchOutboxUpdateOne) Select(field string, fields ...string) *SearchOutboxUpdateOne {
+	souo.fields = append([]string{field}, fields...)
+	return souo
+}
+
+// Save executes the query and returns the updated SearchOutbox entity.
+func (souo *SearchOutboxUpdateOne) Save(ctx context.Context) (*SearchOutbox, error) {
+	return withHooks(ctx, souo.sqlSave, souo.mutation, souo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (souo *SearchOutboxUpdateOne) SaveX(ctx context.Context) *SearchOutbox {
+	node, err := souo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (souo *SearchOutboxUpdateOne) Exec(ctx context.Context) error {
+	_, err := souo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (souo *SearchOutboxUpdateOne) ExecX(ctx context.Context) {
+	if err := souo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (souo *SearchOutboxUpdateOne) check() error {
+	if v, ok := souo.mutation.GetOp(); ok {
+		if err := searchoutbox.OpValidator(v); err != nil {
+			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "SearchOutbox.op": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (souo *SearchOutboxUpdateOne) sqlSave(ctx context.Context) (_node *SearchOutbox, err error) {
+	if err := souo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchoutbox.Table, searchoutbox.Columns, sqlgraph.NewFieldSpec(searchoutbox.FieldID, field.TypeInt))
+	id, ok := souo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SearchOutbox.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := souo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, searchoutbox.FieldID)
+		for _, f := range fields {
+			if !searchoutbox.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != searchoutbox.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := souo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := souo.mutation.EntityType(); ok {
+		_spec.SetField(searchoutbox.FieldEntityType, field.TypeString, value)
+	}
+	if value, ok := souo.mutation.EntityId(); ok {
+		_spec.SetField(searchoutbox.FieldEntityId, field.TypeString, value)
+	}
+	if value, ok := souo.mutation.GetOp(); ok {
+		_spec.SetField(searchoutbox.FieldOp, field.TypeEnum, value)
+	}
+	if value, ok := souo.mutation.Attempts(); ok {
+		_spec.SetField(searchoutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := souo.mutation.AddedAttempts(); ok {
+		_spec.AddField(searchoutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := souo.mutation.LastError(); ok {
+		_spec.SetField(searchoutbox.FieldLastError, field.TypeString, value)
+	}
+	if souo.mutation.LastErrorCleared() {
+		_spec.ClearField(searchoutbox.FieldLastError, field.TypeString)
+	}
+	if value, ok := souo.mutation.AvailableAt(); ok {
+		_spec.SetField(searchoutbox.FieldAvailableAt, field.TypeTime, value)
+	}
+	_node = &SearchOutbox{config: souo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, souo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchoutbox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	souo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tagsuggestion
+
+import (
+	"time"
+
+	"api.us4ever/internal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLTE(FieldID, id))
+}
+
+// EntityType applies equality check predicate on the "entityType" field. It's identical to EntityTypeEQ.
+func EntityType(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldEntityType, v))
+}
+
+// EntityId applies equality check predicate on the "entityId" field. It's identical to EntityIdEQ.
+func EntityId(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldEntityId, v))
+}
+
+// OwnerId applies equality check predicate on the "ownerId" field. It's identical to OwnerIdEQ.
+func OwnerId(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldOwnerId, v))
+}
+
+// Tag applies equality check predicate on the "tag" field. It's identical to TagEQ.
+func Tag(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldTag, v))
+}
+
+// Similarity applies equality check predicate on the "similarity" field. It's identical to SimilarityEQ.
+func Similarity(v float64) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldSimilarity, v))
+}
+
+// CreatedAt applies equality check predicate on the "createdAt" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewedAt" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// EntityTypeEQ applies the EQ predicate on the "entityType" field.
+func EntityTypeEQ(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldEntityType, v))
+}
+
+// EntityTypeNEQ applies the NEQ predicate on the "entityType" field.
+func EntityTypeNEQ(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNEQ(FieldEntityType, v))
+}
+
+// EntityTypeIn applies the In predicate on the "entityType" field.
+func EntityTypeIn(vs ...string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldIn(FieldEntityType, vs...))
+}
+
+// EntityTypeNotIn applies the NotIn predicate on the "entityType" field.
+func EntityTypeNotIn(vs ...string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNotIn(FieldEntityType, vs...))
+}
+
+// EntityTypeGT applies the GT predicate on the "entityType" field.
+func EntityTypeGT(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGT(FieldEntityType, v))
+}
+
+// EntityTypeGTE applies the GTE predicate on the "entityType" field.
+func EntityTypeGTE(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGTE(FieldEntityType, v))
+}
+
+// EntityTypeLT applies the LT predicate on the "entityType" field.
+func EntityTypeLT(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLT(FieldEntityType, v))
+}
+
+// EntityTypeLTE applies the LTE predicate on the "entityType" field.
+func EntityTypeLTE(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLTE(FieldEntityType, v))
+}
+
+// EntityTypeContains applies the Contains predicate on the "entityType" field.
+func EntityTypeContains(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldContains(FieldEntityType, v))
+}
+
+// EntityTypeHasPrefix applies the HasPrefix predicate on the "entityType" field.
+func EntityTypeHasPrefix(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldHasPrefix(FieldEntityType, v))
+}
+
+// EntityTypeHasSuffix applies the HasSuffix predicate on the "entityType" field.
+func EntityTypeHasSuffix(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldHasSuffix(FieldEntityType, v))
+}
+
+// EntityTypeEqualFold applies the EqualFold predicate on the "entityType" field.
+func EntityTypeEqualFold(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEqualFold(FieldEntityType, v))
+}
+
+// EntityTypeContainsFold applies the ContainsFold predicate on the "entityType" field.
+func EntityTypeContainsFold(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldContainsFold(FieldEntityType, v))
+}
+
+// EntityIdEQ applies the EQ predicate on the "entityId" field.
+func EntityIdEQ(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldEntityId, v))
+}
+
+// EntityIdNEQ applies the NEQ predicate on the "entityId" field.
+func EntityIdNEQ(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNEQ(FieldEntityId, v))
+}
+
+// EntityIdIn applies the In predicate on the "entityId" field.
+func EntityIdIn(vs ...string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldIn(FieldEntityId, vs...))
+}
+
+// EntityIdNotIn applies the NotIn predicate on the "entityId" field.
+func EntityIdNotIn(vs ...string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNotIn(FieldEntityId, vs...))
+}
+
+// EntityIdGT applies the GT predicate on the "entityId" field.
+func EntityIdGT(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGT(FieldEntityId, v))
+}
+
+// EntityIdGTE applies the GTE predicate on the "entityId" field.
+func EntityIdGTE(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGTE(FieldEntityId, v))
+}
+
+// EntityIdLT applies the LT predicate on the "entityId" field.
+func EntityIdLT(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLT(FieldEntityId, v))
+}
+
+// EntityIdLTE applies the LTE predicate on the "entityId" field.
+func EntityIdLTE(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLTE(FieldEntityId, v))
+}
+
+// EntityIdContains applies the Contains predicate on the "entityId" field.
+func EntityIdContains(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldContains(FieldEntityId, v))
+}
+
+// EntityIdHasPrefix applies the HasPrefix predicate on the "entityId" field.
+func EntityIdHasPrefix(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldHasPrefix(FieldEntityId, v))
+}
+
+// EntityIdHasSuffix applies the HasSuffix predicate on the "entityId" field.
+func EntityIdHasSuffix(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldHasSuffix(FieldEntityId, v))
+}
+
+// EntityIdEqualFold applies the EqualFold predicate on the "entityId" field.
+func EntityIdEqualFold(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEqualFold(FieldEntityId, v))
+}
+
+// EntityIdContainsFold applies the ContainsFold predicate on the "entityId" field.
+func EntityIdContainsFold(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldContainsFold(FieldEntityId, v))
+}
+
+// OwnerIdEQ applies the EQ predicate on the "ownerId" field.
+func OwnerIdEQ(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldOwnerId, v))
+}
+
+// OwnerIdNEQ applies the NEQ predicate on the "ownerId" field.
+func OwnerIdNEQ(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNEQ(FieldOwnerId, v))
+}
+
+// OwnerIdIn applies the In predicate on the "ownerId" field.
+func OwnerIdIn(vs ...string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldIn(FieldOwnerId, vs...))
+}
+
+// OwnerIdNotIn applies the NotIn predicate on the "ownerId" field.
+func OwnerIdNotIn(vs ...string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNotIn(FieldOwnerId, vs...))
+}
+
+// OwnerIdGT applies the GT predicate on the "ownerId" field.
+func OwnerIdGT(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGT(FieldOwnerId, v))
+}
+
+// OwnerIdGTE applies the GTE predicate on the "ownerId" field.
+func OwnerIdGTE(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGTE(FieldOwnerId, v))
+}
+
+// OwnerIdLT applies the LT predicate on the "ownerId" field.
+func OwnerIdLT(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLT(FieldOwnerId, v))
+}
+
+// OwnerIdLTE applies the LTE predicate on the "ownerId" field.
+func OwnerIdLTE(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLTE(FieldOwnerId, v))
+}
+
+// OwnerIdContains applies the Contains predicate on the "ownerId" field.
+func OwnerIdContains(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldContains(FieldOwnerId, v))
+}
+
+// OwnerIdHasPrefix applies the HasPrefix predicate on the "ownerId" field.
+func OwnerIdHasPrefix(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldHasPrefix(FieldOwnerId, v))
+}
+
+// OwnerIdHasSuffix applies the HasSuffix predicate on the "ownerId" field.
+func OwnerIdHasSuffix(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldHasSuffix(FieldOwnerId, v))
+}
+
+// OwnerIdIsNil applies the IsNil predicate on the "ownerId" field.
+func OwnerIdIsNil() predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldIsNull(FieldOwnerId))
+}
+
+// OwnerIdNotNil applies the NotNil predicate on the "ownerId" field.
+func OwnerIdNotNil() predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNotNull(FieldOwnerId))
+}
+
+// OwnerIdEqualFold applies the EqualFold predicate on the "ownerId" field.
+func OwnerIdEqualFold(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEqualFold(FieldOwnerId, v))
+}
+
+// OwnerIdContainsFold applies the ContainsFold predicate on the "ownerId" field.
+func OwnerIdContainsFold(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldContainsFold(FieldOwnerId, v))
+}
+
+// TagEQ applies the EQ predicate on the "tag" field.
+func TagEQ(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldTag, v))
+}
+
+// TagNEQ applies the NEQ predicate on the "tag" field.
+func TagNEQ(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNEQ(FieldTag, v))
+}
+
+// TagIn applies the In predicate on the "tag" field.
+func TagIn(vs ...string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldIn(FieldTag, vs...))
+}
+
+// TagNotIn applies the NotIn predicate on the "tag" field.
+func TagNotIn(vs ...string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNotIn(FieldTag, vs...))
+}
+
+// TagGT applies the GT predicate on the "tag" field.
+func TagGT(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGT(FieldTag, v))
+}
+
+// TagGTE applies the GTE predicate on the "tag" field.
+func TagGTE(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGTE(FieldTag, v))
+}
+
+// TagLT applies the LT predicate on the "tag" field.
+func TagLT(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLT(FieldTag, v))
+}
+
+// TagLTE applies the LTE predicate on the "tag" field.
+func TagLTE(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLTE(FieldTag, v))
+}
+
+// TagContains applies the Contains predicate on the "tag" field.
+func TagContains(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldContains(FieldTag, v))
+}
+
+// TagHasPrefix applies the HasPrefix predicate on the "tag" field.
+func TagHasPrefix(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldHasPrefix(FieldTag, v))
+}
+
+// TagHasSuffix applies the HasSuffix predicate on the "tag" field.
+func TagHasSuffix(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldHasSuffix(FieldTag, v))
+}
+
+// TagEqualFold applies the EqualFold predicate on the "tag" field.
+func TagEqualFold(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEqualFold(FieldTag, v))
+}
+
+// TagContainsFold applies the ContainsFold predicate on the "tag" field.
+func TagContainsFold(v string) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldContainsFold(FieldTag, v))
+}
+
+// SimilarityEQ applies the EQ predicate on the "similarity" field.
+func SimilarityEQ(v float64) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldSimilarity, v))
+}
+
+// SimilarityNEQ applies the NEQ predicate on the "similarity" field.
+func SimilarityNEQ(v float64) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNEQ(FieldSimilarity, v))
+}
+
+// SimilarityIn applies the In predicate on the "similarity" field.
+func SimilarityIn(vs ...float64) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldIn(FieldSimilarity, vs...))
+}
+
+// SimilarityNotIn applies the NotIn predicate on the "similarity" field.
+func SimilarityNotIn(vs ...float64) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNotIn(FieldSimilarity, vs...))
+}
+
+// SimilarityGT applies the GT predicate on the "similarity" field.
+func SimilarityGT(v float64) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGT(FieldSimilarity, v))
+}
+
+// SimilarityGTE applies the GTE predicate on the "similarity" field.
+func SimilarityGTE(v float64) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGTE(FieldSimilarity, v))
+}
+
+// SimilarityLT applies the LT predicate on the "similarity" field.
+func SimilarityLT(v float64) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLT(FieldSimilarity, v))
+}
+
+// SimilarityLTE applies the LTE predicate on the "similarity" field.
+func SimilarityLTE(v float64) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLTE(FieldSimilarity, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "createdAt" field.
+func CreatedAtEQ(v time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "createdAt" field.
+func CreatedAtNEQ(v time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "createdAt" field.
+func CreatedAtIn(vs ...time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "createdAt" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "createdAt" field.
+func CreatedAtGT(v time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "createdAt" field.
+func CreatedAtGTE(v time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "createdAt" field.
+func CreatedAtLT(v time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "createdAt" field.
+func CreatedAtLTE(v time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewedAt" field.
+func ReviewedAtEQ(v time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewedAt" field.
+func ReviewedAtNEQ(v time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewedAt" field.
+func ReviewedAtIn(vs ...time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewedAt" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewedAt" field.
+func ReviewedAtGT(v time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewedAt" field.
+func ReviewedAtGTE(v time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewedAt" field.
+func ReviewedAtLT(v time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewedAt" field.
+func ReviewedAtLTE(v time.Time) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewedAt" field.
+func ReviewedAtIsNil() predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewedAt" field.
+func ReviewedAtNotNil() predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TagSuggestion) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TagSuggestion) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TagSuggestion) predicate.TagSuggestion {
+	return predicate.TagSuggestion(sql.NotPredicates(p))
+}
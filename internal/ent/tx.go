@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -20,8 +22,12 @@ type Tx struct {
 	Group *GroupClient
 	// Image is the client for interacting with the Image builders.
 	Image *ImageClient
+	// IndexDeadLetter is the client for interacting with the IndexDeadLetter builders.
+	IndexDeadLetter *IndexDeadLetterClient
 	// Keep is the client for interacting with the Keep builders.
 	Keep *KeepClient
+	// KeepChunk is the client for interacting with the KeepChunk builders.
+	KeepChunk *KeepChunkClient
 	// Mindmap is the client for interacting with the Mindmap builders.
 	Mindmap *MindmapClient
 	// Moment is the client for interacting with the Moment builders.
@@ -30,6 +36,14 @@ type Tx struct {
 	MomentImage *MomentImageClient
 	// MomentVideo is the client for interacting with the MomentVideo builders.
 	MomentVideo *MomentVideoClient
+	// SearchClick is the client for interacting with the SearchClick builders.
+	SearchClick *SearchClickClient
+	// SearchLog is the client for interacting with the SearchLog builders.
+	SearchLog *SearchLogClient
+	// SearchOutbox is the client for interacting with the SearchOutbox builders.
+	SearchOutbox *SearchOutboxClient
+	// TagSuggestion is the client for interacting with the TagSuggestion builders.
+	TagSuggestion *TagSuggestionClient
 	// Todo is the client for interacting with the Todo builders.
 	Todo *TodoClient
 	// User is the client for interacting with the User builders.
@@ -171,11 +185,17 @@ func (tx *Tx) init() {
 	tx.File = NewFileClient(tx.config)
 	tx.Group = NewGroupClient(tx.config)
 	tx.Image = NewImageClient(tx.config)
+	tx.IndexDeadLetter = NewIndexDeadLetterClient(tx.config)
 	tx.Keep = NewKeepClient(tx.config)
+	tx.KeepChunk = NewKeepChunkClient(tx.config)
 	tx.Mindmap = NewMindmapClient(tx.config)
 	tx.Moment = NewMomentClient(tx.config)
 	tx.MomentImage = NewMomentImageClient(tx.config)
 	tx.MomentVideo = NewMomentVideoClient(tx.config)
+	tx.SearchClick = NewSearchClickClient(tx.config)
+	tx.SearchLog = NewSearchLogClient(tx.config)
+	tx.SearchOutbox = NewSearchOutboxClient(tx.config)
+	tx.TagSuggestion = NewTagSuggestionClient(tx.config)
 	tx.Todo = NewTodoClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.Video = NewVideoClient(tx.config)
@@ -241,3 +261,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,162 @@
+package es
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/keep"
+	"api.us4ever/internal/ent/keepchunk"
+	"github.com/tidwall/gjson"
+)
+
+const (
+	// ChunksField is the nested field holding the chunks of a keep
+	ChunksField = "chunks"
+
+	// Names of the inner hits the keeps search asks for, one per chunk clause
+	keywordChunkHits  = "keyword_chunk"
+	semanticChunkHits = "semantic_chunk"
+)
+
+// keepChunksField maps the passages of a keep with their own vectors.
+var keepChunksField = NestedField{
+	TextFields:   []string{"heading", "content"},
+	VectorFields: []string{"vector"},
+	Properties: map[string]any{
+		"ordinal": map[string]any{"type": "integer"},
+		"start":   map[string]any{"type": "integer"},
+		"end":     map[string]any{"type": "integer"},
+	},
+}
+
+// ChunkMatch is the chunk of a keep that matched a query, with its position
+// in the keep content. Highlight is only set for keyword matches.
+type ChunkMatch struct {
+	Ordinal   int      `json:"ordinal"`
+	Heading   string   `json:"heading,omitempty"`
+	Content   string   `json:"content"`
+	Start     int      `json:"start"`
+	End       int      `json:"end"`
+	Highlight []string `json:"highlight,omitempty"`
+}
+
+// loadKeeps streams the keeps with their chunks.
+func loadKeeps(ctx context.Context, db database.Service, pageSize int, fn func(docs []Document, progress database.Progress) error) error {
+	return db.KeepPages(ctx, pageSize, func(page []*ent.Keep, progress database.Progress) error {
+		docs, err := keepDocuments(ctx, db.Client(), page)
+		if err != nil {
+			return err
+		}
+		return fn(docs, progress)
+	})
+}
+
+// fetchKeeps loads the keeps of the given IDs with their chunks.
+func fetchKeeps(ctx context.Context, client *ent.Client, ids []string) ([]Document, error) {
+	keeps, err := client.Keep.Query().Where(keep.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return keepDocuments(ctx, client, keeps)
+}
+
+// keepDocuments projects keeps into documents, loading the chunks of all of
+// them in one query.
+func keepDocuments(ctx context.Context, client *ent.Client, keeps []*ent.Keep) ([]Document, error) {
+	ids := make([]string, 0, len(keeps))
+	for _, k := range keeps {
+		ids = append(ids, k.ID)
+	}
+	chunks, err := client.KeepChunk.Query().
+		Where(keepchunk.KeepIdIn(ids...)).
+		Order(ent.Asc(keepchunk.FieldKeepId), ent.Asc(keepchunk.FieldOrdinal)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load keep chunks: %w", err)
+	}
+	byKeep := make(map[string][]*ent.KeepChunk, len(keeps))
+	for _, c := range chunks {
+		byKeep[c.KeepId] = append(byKeep[c.KeepId], c)
+	}
+
+	docs := make([]Document, 0, len(keeps))
+	for _, k := range keeps {
+		docs = append(docs, Document{ID: k.ID, Source: KeepDocument(k, byKeep[k.ID])})
+	}
+	return docs, nil
+}
+
+// KeepChunkDocuments projects the chunks of a keep into its nested chunks field.
+func KeepChunkDocuments(chunks []*ent.KeepChunk) []map[string]any {
+	out := make([]map[string]any, 0, len(chunks))
+	for _, c := range chunks {
+		doc := map[string]any{
+			"ordinal": c.Ordinal,
+			"heading": c.Heading,
+			"content": c.Content,
+			"start":   c.Start,
+			"end":     c.End,
+		}
+		// 尚未生成向量的分块只参与关键词匹配
+		if len(c.Vector) > 0 {
+			doc["vector"] = c.Vector
+		}
+		out = append(out, doc)
+	}
+	return out
+}
+
+// chunkInnerHits asks for the best chunk of every hit, without its vector.
+func chunkInnerHits(name string, highlight bool) map[string]any {
+	innerHits := map[string]any{
+		"name": name,
+		"size": 1,
+		"_source": map[string]any{
+			"excludes": []string{ChunksField + ".vector"},
+		},
+	}
+	if highlight {
+		innerHits["highlight"] = map[string]any{
+			"pre_tags":  []string{"<mark>"},
+			"post_tags": []string{"</mark>"},
+			"fields": map[string]any{
+				ChunksField + ".content": map[string]any{
+					"number_of_fragments": 0,
+				},
+			},
+		}
+	}
+	return innerHits
+}
+
+// resolveBestChunks turns the chunk inner hits of every hit into BestChunk.
+// A keyword match is preferred, as it comes with a highlight; the nearest
+// chunk of the kNN clause stands in otherwise.
+func (r *SearchResult) resolveBestChunks() {
+	for i := range r.Hits.Hits {
+		h := &r.Hits.Hits[i]
+		if len(h.InnerHits) == 0 {
+			continue
+		}
+		for _, name := range []string{keywordChunkHits, semanticChunkHits} {
+			best := gjson.GetBytes(h.InnerHits, name+".hits.hits.0")
+			if !best.Exists() {
+				continue
+			}
+			var match ChunkMatch
+			if err := json.Unmarshal([]byte(best.Get("_source").Raw), &match); err != nil {
+				continue
+			}
+			best.Get("highlight." + gjson.Escape(ChunksField+".content")).ForEach(func(_, v gjson.Result) bool {
+				match.Highlight = append(match.Highlight, v.String())
+				return true
+			})
+			h.BestChunk = &match
+			break
+		}
+		h.InnerHits = nil
+	}
+}
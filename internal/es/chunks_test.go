@@ -0,0 +1,99 @@
+package es
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"api.us4ever/internal/ent"
+)
+
+func TestIndexDefinition_NestedChunks(t *testing.T) {
+	props := KeepIndex.indexBody(768, nil)["mappings"].(map[string]any)["properties"].(map[string]any)
+	chunks, ok := props[ChunksField].(map[string]any)
+	if !ok || chunks["type"] != "nested" {
+		t.Fatalf("expected %s to be mapped as nested, got %v", ChunksField, props[ChunksField])
+	}
+	inner := chunks["properties"].(map[string]any)
+	if v := inner["vector"].(map[string]any); v["type"] != "dense_vector" || v["dims"] != 768 {
+		t.Errorf("unexpected chunk vector mapping %v", v)
+	}
+	if _, ok := inner["content"].(map[string]any)["fields"]; !ok {
+		t.Error("expected chunk content to be analyzed like the keep content")
+	}
+	if got := KeepIndex.sourceExcludes(); !slices.Contains(got, ChunksField) {
+		t.Errorf("expected %s to be left out of hits, got %v", ChunksField, got)
+	}
+}
+
+func TestBuildKeepsSearchBody_Chunks(t *testing.T) {
+	body := buildKeepsSearchBody("安装", []float32{0.1, 0.2}, SearchOptions{})
+
+	var chunkKNN map[string]any
+	for _, c := range body["knn"].([]any) {
+		if c := c.(map[string]any); c["field"] == ChunksField+".vector" {
+			chunkKNN = c
+		}
+	}
+	if chunkKNN == nil || chunkKNN["inner_hits"].(map[string]any)["name"] != semanticChunkHits {
+		t.Fatalf("expected a chunk kNN clause with inner hits, got %v", body["knn"])
+	}
+
+	var nested map[string]any
+	for _, c := range body["query"].(map[string]any)["bool"].(map[string]any)["should"].([]any) {
+		if n, ok := c.(map[string]any)["nested"].(map[string]any); ok {
+			nested = n
+		}
+	}
+	if nested == nil || nested["path"] != ChunksField || nested["score_mode"] != "max" {
+		t.Fatalf("expected a nested chunk clause scored by the best chunk, got %v", nested)
+	}
+}
+
+func TestResolveBestChunks(t *testing.T) {
+	var r SearchResult
+	raw := `{"hits":{"hits":[
+		{"_id":"k1","inner_hits":{
+			"semantic_chunk":{"hits":{"hits":[{"_source":{"ordinal":3,"content":"near"}}]}},
+			"keyword_chunk":{"hits":{"hits":[{"_source":{"ordinal":1,"heading":"安装","content":"用 apt 安装","start":10,"end":18},
+				"highlight":{"chunks.content":["用 apt <mark>安装</mark>"]}}]}}}},
+		{"_id":"k2","inner_hits":{
+			"semantic_chunk":{"hits":{"hits":[{"_source":{"ordinal":2,"content":"near"}}]}},
+			"keyword_chunk":{"hits":{"hits":[]}}}},
+		{"_id":"k3"}
+	]}}`
+	if err := json.Unmarshal([]byte(raw), &r); err != nil {
+		t.Fatal(err)
+	}
+	r.resolveBestChunks()
+
+	hits := r.Hits.Hits
+	if c := hits[0].BestChunk; c == nil || c.Ordinal != 1 || c.Heading != "安装" || c.Start != 10 ||
+		!slices.Equal(c.Highlight, []string{"用 apt <mark>安装</mark>"}) {
+		t.Errorf("expected the keyword chunk with its highlight, got %+v", c)
+	}
+	if c := hits[1].BestChunk; c == nil || c.Ordinal != 2 || c.Highlight != nil {
+		t.Errorf("expected the semantic chunk, got %+v", c)
+	}
+	if hits[2].BestChunk != nil {
+		t.Errorf("expected no chunk without inner hits, got %+v", hits[2].BestChunk)
+	}
+	for _, h := range hits {
+		if h.InnerHits != nil {
+			t.Errorf("expected the inner hits of %s to be dropped", h.ID)
+		}
+	}
+}
+
+func TestKeepChunkDocuments(t *testing.T) {
+	docs := KeepChunkDocuments([]*ent.KeepChunk{
+		{Ordinal: 0, Content: "a", Vector: json.RawMessage(`[0.1]`)},
+		{Ordinal: 1, Content: "b"},
+	})
+	if _, ok := docs[0]["vector"]; !ok {
+		t.Error("expected the vector of an embedded chunk")
+	}
+	if _, ok := docs[1]["vector"]; ok {
+		t.Error("expected no vector for a chunk not embedded yet")
+	}
+}
@@ -0,0 +1,334 @@
+package es
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/metrics"
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+const (
+	// consistencyPageSize is the number of documents read per PIT search
+	consistencyPageSize = 1000
+	// consistencyKeepAlive keeps the point in time open between two pages
+	consistencyKeepAlive = "1m"
+	// consistencyGrace skips rows changed this shortly before a check; the
+	// search outbox is still applying them
+	consistencyGrace = time.Minute
+	// maxReportedIDs bounds the sample of differing IDs kept in a report
+	maxReportedIDs = 100
+)
+
+// ConsistencyOptions controls a consistency check.
+type ConsistencyOptions struct {
+	// Repair re-upserts missing and stale documents and deletes orphaned ones
+	Repair bool
+	// IncludeRecent also compares the rows changed within consistencyGrace,
+	// for an index the outbox no longer writes those changes to
+	IncludeRecent bool
+}
+
+// ConsistencyReport is the outcome of comparing an index with the database.
+// The ID lists are samples of at most 100 IDs; the counts are complete.
+type ConsistencyReport struct {
+	Index       string    `json:"index"`
+	Alias       string    `json:"alias"`
+	CheckedAt   time.Time `json:"checkedAt"`
+	DurationMs  int64     `json:"durationMs"`
+	DBCount     int       `json:"dbCount"`
+	ESCount     int       `json:"esCount"`
+	Missing     int       `json:"missing"`
+	Orphaned    int       `json:"orphaned"`
+	Stale       int       `json:"stale"`
+	MissingIDs  []string  `json:"missingIds"`
+	OrphanedIDs []string  `json:"orphanedIds"`
+	StaleIDs    []string  `json:"staleIds"`
+	Repaired    int       `json:"repaired"`
+	Errors      []string  `json:"errors,omitempty"`
+}
+
+// Consistent reports whether the index matched the database.
+func (r ConsistencyReport) Consistent() bool {
+	return r.Missing == 0 && r.Orphaned == 0 && r.Stale == 0
+}
+
+// versionDiff lists the IDs that differ between the database and an index.
+type versionDiff struct {
+	missing  []string // in the database, not in the index
+	orphaned []string // in the index, not in the database
+	stale    []string // in both, with a different updatedAt
+}
+
+// compareVersions compares the updatedAt of every row with the indexed one.
+// Rows updated after cutoff are skipped as the outbox may not have synced
+// them yet. Dates are compared at millisecond precision, the precision of an
+// Elasticsearch date.
+func compareVersions(db, indexed map[string]time.Time, cutoff time.Time) versionDiff {
+	var diff versionDiff
+	for id, updatedAt := range db {
+		if updatedAt.After(cutoff) {
+			continue
+		}
+		indexedAt, ok := indexed[id]
+		switch {
+		case !ok:
+			diff.missing = append(diff.missing, id)
+		case !updatedAt.Truncate(time.Millisecond).Equal(indexedAt.Truncate(time.Millisecond)):
+			diff.stale = append(diff.stale, id)
+		}
+	}
+	for id := range indexed {
+		if _, ok := db[id]; !ok {
+			diff.orphaned = append(diff.orphaned, id)
+		}
+	}
+	slices.Sort(diff.missing)
+	slices.Sort(diff.orphaned)
+	slices.Sort(diff.stale)
+	return diff
+}
+
+// indexVersions reads the ID and updatedAt of every document behind alias
+// through a point in time, so the walk sees one consistent snapshot. A
+// document without a parsable updatedAt gets the zero time and shows up as stale.
+func indexVersions(ctx context.Context, client *elasticsearch.Client, alias string) (map[string]time.Time, error) {
+	res, err := client.OpenPointInTime([]string{alias}, consistencyKeepAlive,
+		client.OpenPointInTime.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open point in time on %s: %w", alias, err)
+	}
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read point in time response body: %w", err)
+	}
+	if res.IsError() {
+		return nil, fmt.Errorf("failed to open point in time on %s: [%s] %s", alias, res.Status(), string(body))
+	}
+	pitID := gjson.GetBytes(body, "id").String()
+	defer func() {
+		closePointInTime(client, pitID)
+	}()
+
+	versions := make(map[string]time.Time)
+	var searchAfter []any
+	for {
+		query := map[string]any{
+			"size":             consistencyPageSize,
+			"_source":          []string{"updatedAt"},
+			"track_total_hits": false,
+			"pit":              map[string]any{"id": pitID, "keep_alive": consistencyKeepAlive},
+			"sort":             []any{map[string]any{"_shard_doc": "asc"}},
+		}
+		if searchAfter != nil {
+			query["search_after"] = searchAfter
+		}
+		var buf bytes.Buffer
+		if err := json.NewEncoder(&buf).Encode(query); err != nil {
+			return nil, fmt.Errorf("error encoding query: %w", err)
+		}
+
+		res, err := client.Search(
+			client.Search.WithContext(ctx),
+			client.Search.WithBody(&buf),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read documents of %s: %w", alias, err)
+		}
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read search response body: %w", err)
+		}
+		if res.IsError() {
+			return nil, fmt.Errorf("failed to read documents of %s: [%s] %s", alias, res.Status(), string(body))
+		}
+
+		// 每次响应都可能返回新的 PIT ID，后续请求必须使用最新的
+		if id := gjson.GetBytes(body, "pit_id").String(); id != "" {
+			pitID = id
+		}
+		hits := gjson.GetBytes(body, "hits.hits").Array()
+		for _, hit := range hits {
+			updatedAt, _ := time.Parse(time.RFC3339Nano, hit.Get("_source.updatedAt").String())
+			versions[hit.Get("_id").String()] = updatedAt
+		}
+		if len(hits) < consistencyPageSize {
+			return versions, nil
+		}
+		if err := json.Unmarshal([]byte(hits[len(hits)-1].Get("sort").Raw), &searchAfter); err != nil {
+			return nil, fmt.Errorf("failed to parse search_after of %s: %w", alias, err)
+		}
+	}
+}
+
+// closePointInTime releases a point in time. Failures are logged only: it
+// expires on its own after consistencyKeepAlive.
+func closePointInTime(client *elasticsearch.Client, pitID string) {
+	body, _ := json.Marshal(map[string]string{"id": pitID})
+	res, err := client.ClosePointInTime(client.ClosePointInTime.WithBody(bytes.NewReader(body)))
+	if err != nil {
+		indexerLogger.Warn("failed to close point in time", zap.Error(err))
+		return
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		indexerLogger.Warn("failed to close point in time", zap.String("status", res.Status()))
+	}
+}
+
+// CheckConsistency compares the documents behind alias with the rows of def
+// in the database, reporting documents that are missing from the index, left
+// over after their row was deleted, or stale. With opts.Repair only the
+// differing documents are re-upserted or deleted. The differences found are
+// exported as Prometheus gauges.
+func CheckConsistency(ctx context.Context, esClient *elasticsearch.Client, dbClient *ent.Client, def *IndexDefinition, alias string, opts ConsistencyOptions) (ConsistencyReport, error) {
+	start := time.Now()
+	report := ConsistencyReport{Index: def.Name, Alias: alias, CheckedAt: start}
+	if esClient == nil {
+		return report, fmt.Errorf("elasticsearch client is not initialized")
+	}
+
+	// 先读数据库：之后才写入索引的变更比截止时间新，会被跳过
+	dbVersions, err := def.Versions(ctx, dbClient)
+	if err != nil {
+		return report, fmt.Errorf("failed to load %s versions from database: %w", def.Name, err)
+	}
+	indexed, err := indexVersions(ctx, esClient, alias)
+	if err != nil {
+		return report, err
+	}
+
+	cutoff := start.Add(-consistencyGrace)
+	if opts.IncludeRecent {
+		cutoff = start
+	}
+	diff := compareVersions(dbVersions, indexed, cutoff)
+	report.DBCount, report.ESCount = len(dbVersions), len(indexed)
+	report.Missing, report.Orphaned, report.Stale = len(diff.missing), len(diff.orphaned), len(diff.stale)
+	report.MissingIDs = diff.missing[:min(len(diff.missing), maxReportedIDs)]
+	report.OrphanedIDs = diff.orphaned[:min(len(diff.orphaned), maxReportedIDs)]
+	report.StaleIDs = diff.stale[:min(len(diff.stale), maxReportedIDs)]
+
+	if opts.Repair && !report.Consistent() {
+		report.Repaired, report.Errors = repairDocuments(ctx, esClient, dbClient, def, alias, diff)
+	}
+	report.DurationMs = time.Since(start).Milliseconds()
+	metrics.RecordIndexConsistency(def.Name, report.Missing, report.Orphaned, report.Stale, report.Repaired)
+
+	indexerLogger.Info("index consistency check completed",
+		zap.String("index", def.Name),
+		zap.String("alias", alias),
+		zap.Int("db_count", report.DBCount),
+		zap.Int("es_count", report.ESCount),
+		zap.Int("missing", report.Missing),
+		zap.Int("orphaned", report.Orphaned),
+		zap.Int("stale", report.Stale),
+		zap.Int("repaired", report.Repaired),
+		zap.Int64("duration_ms", report.DurationMs),
+	)
+	return report, nil
+}
+
+// repairDocuments re-upserts the missing and stale documents of diff from the
+// database and deletes the orphaned ones, one bulk request per page. A row
+// deleted since the comparison is deleted from the index as well. It returns
+// how many documents were written and the errors of those that were not.
+func repairDocuments(ctx context.Context, esClient *elasticsearch.Client, dbClient *ent.Client, def *IndexDefinition, alias string, diff versionDiff) (int, []string) {
+	var (
+		repaired int
+		errs     []string
+	)
+	apply := func(changes []DocumentChange) {
+		failed, err := ApplyChanges(ctx, esClient, alias, changes)
+		if err != nil {
+			errs = append(errs, err.Error())
+			return
+		}
+		repaired += len(changes) - len(failed)
+		for _, err := range failed {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	upserts := append(slices.Clone(diff.missing), diff.stale...)
+	for ids := range slices.Chunk(upserts, reindexPageSize) {
+		docs, err := def.Fetch(ctx, dbClient, ids)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("failed to load %s from database: %v", def.Name, err))
+			continue
+		}
+		changes := make([]DocumentChange, 0, len(ids))
+		found := make(map[string]bool, len(docs))
+		for _, doc := range docs {
+			found[doc.ID] = true
+			changes = append(changes, DocumentChange{ID: doc.ID, Document: doc.Source})
+		}
+		for _, id := range ids {
+			if !found[id] {
+				changes = append(changes, DocumentChange{ID: id})
+			}
+		}
+		apply(changes)
+	}
+	for ids := range slices.Chunk(diff.orphaned, reindexPageSize) {
+		changes := make([]DocumentChange, 0, len(ids))
+		for _, id := range ids {
+			changes = append(changes, DocumentChange{ID: id})
+		}
+		apply(changes)
+	}
+
+	if len(errs) > maxReportedIDs {
+		errs = append(errs[:maxReportedIDs], fmt.Sprintf("... and %d more errors", len(errs)-maxReportedIDs))
+	}
+	indexerLogger.Info("repaired index documents",
+		zap.String("index", def.Name),
+		zap.String("alias", alias),
+		zap.Int("repaired", repaired),
+		zap.Int("errors", len(errs)),
+	)
+	return repaired, errs
+}
+
+// ConsistencyReports keeps the latest consistency report of every index.
+type ConsistencyReports struct {
+	mu      sync.RWMutex
+	reports map[string]ConsistencyReport
+}
+
+// NewConsistencyReports returns an empty report store.
+func NewConsistencyReports() *ConsistencyReports {
+	return &ConsistencyReports{reports: make(map[string]ConsistencyReport)}
+}
+
+// Record stores report as the latest one of its index.
+func (c *ConsistencyReports) Record(report ConsistencyReport) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reports[report.Index] = report
+}
+
+// Latest returns the latest report of every checked index, in registration order.
+func (c *ConsistencyReports) Latest() []ConsistencyReport {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	reports := make([]ConsistencyReport, 0, len(c.reports))
+	for _, def := range IndexDefinitions() {
+		if report, ok := c.reports[def.Name]; ok {
+			reports = append(reports, report)
+		}
+	}
+	return reports
+}
@@ -0,0 +1,105 @@
+package es
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tidwall/gjson"
+)
+
+func TestCompareVersions(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	cutoff := now.Add(-time.Minute)
+	db := map[string]time.Time{
+		"same":    now.Add(-time.Hour),
+		"micro":   now.Add(-time.Hour + 123*time.Microsecond),
+		"stale":   now.Add(-time.Hour),
+		"missing": now.Add(-time.Hour),
+		"recent":  now,
+	}
+	indexed := map[string]time.Time{
+		"same":     now.Add(-time.Hour),
+		"micro":    now.Add(-time.Hour),
+		"stale":    now.Add(-2 * time.Hour),
+		"orphaned": now.Add(-time.Hour),
+	}
+
+	diff := compareVersions(db, indexed, cutoff)
+	if !slices.Equal(diff.missing, []string{"missing"}) {
+		t.Errorf("expected only missing to be missing, got %v", diff.missing)
+	}
+	if !slices.Equal(diff.orphaned, []string{"orphaned"}) {
+		t.Errorf("expected only orphaned to be orphaned, got %v", diff.orphaned)
+	}
+	// 亚毫秒差异不算过期，刚更新的行留给 outbox
+	if !slices.Equal(diff.stale, []string{"stale"}) {
+		t.Errorf("expected only stale to be stale, got %v", diff.stale)
+	}
+}
+
+func TestIndexVersions_WalksPointInTime(t *testing.T) {
+	updatedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	total := consistencyPageSize + 5
+	var searches int
+	closed := false
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/app-keeps/_pit"):
+			fmt.Fprint(w, `{"id":"pit-0"}`)
+		case r.URL.Path == "/_pit" && r.Method == http.MethodDelete:
+			body, _ := io.ReadAll(r.Body)
+			if got := gjson.GetBytes(body, "id").String(); got != "pit-2" {
+				t.Errorf("expected the latest PIT ID to be closed, got %q", got)
+			}
+			closed = true
+			fmt.Fprint(w, `{"succeeded":true}`)
+		case r.URL.Path == "/_search":
+			body, _ := io.ReadAll(r.Body)
+			if got, want := gjson.GetBytes(body, "pit.id").String(), fmt.Sprintf("pit-%d", searches); got != want {
+				t.Errorf("expected search %d to use %s, got %s", searches, want, got)
+			}
+			start := searches * consistencyPageSize
+			if searches > 0 && gjson.GetBytes(body, "search_after.0").Int() != int64(start-1) {
+				t.Errorf("expected search_after to continue from %d, got %s", start-1, gjson.GetBytes(body, "search_after").Raw)
+			}
+			searches++
+
+			var hits []map[string]any
+			for i := start; i < min(start+consistencyPageSize, total); i++ {
+				hits = append(hits, map[string]any{
+					"_id":     fmt.Sprintf("k%d", i),
+					"_source": map[string]any{"updatedAt": updatedAt},
+					"sort":    []int{i},
+				})
+			}
+			json.NewEncoder(w).Encode(map[string]any{
+				"pit_id": fmt.Sprintf("pit-%d", searches),
+				"hits":   map[string]any{"hits": hits},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	versions, err := indexVersions(t.Context(), client, "app-keeps")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != total || searches != 2 {
+		t.Errorf("expected %d documents in 2 pages, got %d in %d", total, len(versions), searches)
+	}
+	if !versions["k1003"].Equal(updatedAt) {
+		t.Errorf("expected updatedAt to be parsed, got %v", versions["k1003"])
+	}
+	if !closed {
+		t.Error("expected the point in time to be closed")
+	}
+}
@@ -0,0 +1,299 @@
+package es
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"api.us4ever/internal/config"
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/ent"
+)
+
+const (
+	// defaultShards is used when a definition does not set Shards
+	defaultShards = 3
+)
+
+// Document is one entity projected for indexing.
+type Document struct {
+	ID     string
+	Source map[string]any
+}
+
+// DocumentLoader streams every document of an entity type from the database,
+// one page of at most pageSize documents at a time.
+type DocumentLoader func(ctx context.Context, db database.Service, pageSize int, fn func(docs []Document, progress database.Progress) error) error
+
+// DocumentFetcher loads the documents of the given IDs. IDs that no longer
+// exist are left out of the result.
+type DocumentFetcher func(ctx context.Context, client *ent.Client, ids []string) ([]Document, error)
+
+// DocumentVersions returns the updatedAt of every row of an entity type,
+// keyed by ID. The consistency check compares it with the indexed documents.
+type DocumentVersions func(ctx context.Context, client *ent.Client) (map[string]time.Time, error)
+
+// IndexDefinition declares one searchable entity type: how its index is
+// mapped, where its documents come from and how it is queried. Registering a
+// definition is all it takes for the type to be reindexed, kept in sync
+// through the search outbox and searched, alone or in the unified search.
+type IndexDefinition struct {
+	// Name is the plural used in the alias, the index names and the routes, e.g. "keeps"
+	Name string
+	// Type is the entity type used in search results and the search outbox, e.g. "keep"
+	Type string
+	// TextFields are analyzed with ik_cjk, plus an ngram subfield
+	TextFields []string
+	// SuggestFields are the TextFields that also get a search_as_you_type
+	// subfield, <name>.suggest, used by the search-as-you-type suggestions
+	SuggestFields []string
+	// VectorFields are dense vectors sized for the configured embedding model.
+	// The query is only embedded when searching a type with vector fields.
+	VectorFields []string
+	// Properties are additional mappings, e.g. dates and keywords; the
+	// SearchFilters fields are always added
+	Properties map[string]any
+	// Nested are arrays of objects mapped as nested documents, so each object
+	// is matched and ranked on its own, e.g. the chunks of a keep. They are
+	// left out of the _source of search hits.
+	Nested map[string]NestedField
+	// Shards defaults to 3 and Replicas to 0; both can be overridden per
+	// index through config.ESConfig.Indices
+	Shards   int
+	Replicas int
+
+	Load       DocumentLoader
+	Fetch      DocumentFetcher
+	Versions   DocumentVersions
+	SearchBody func(query string, vector []float32, opts SearchOptions) map[string]any
+}
+
+// NestedField maps the objects of a nested array like the top level of a
+// definition: analyzed text, dense vectors and additional properties.
+type NestedField struct {
+	TextFields   []string
+	VectorFields []string
+	Properties   map[string]any
+}
+
+// properties builds the mapping of a nested field.
+func (n NestedField) properties(dims int) map[string]any {
+	props := MergeTextFields(n.TextFields)
+	maps.Copy(props, n.Properties)
+	addVectorFields(props, n.VectorFields, dims)
+	return map[string]any{
+		"type":       "nested",
+		"properties": props,
+	}
+}
+
+// addVectorFields maps names as dense vectors of dims dimensions.
+func addVectorFields(props map[string]any, names []string, dims int) {
+	for _, name := range names {
+		props[name] = map[string]any{
+			"type":       "dense_vector",
+			"dims":       dims,
+			"index":      true,
+			"similarity": "cosine",
+		}
+	}
+}
+
+// sourceExcludes lists the fields left out of the _source of search hits:
+// the vectors and the nested arrays.
+func (d *IndexDefinition) sourceExcludes() []string {
+	excludes := append([]string(nil), d.VectorFields...)
+	for _, name := range slices.Sorted(maps.Keys(d.Nested)) {
+		excludes = append(excludes, name)
+	}
+	return excludes
+}
+
+// LoadPages builds a DocumentLoader from one of the database.Service page
+// iterators, e.g. database.Service.KeepPages, and the entity's document mapper.
+func LoadPages[T any](
+	pages func(db database.Service, ctx context.Context, pageSize int, fn func(page []T, progress database.Progress) error) error,
+	document func(T) Document,
+) DocumentLoader {
+	return func(ctx context.Context, db database.Service, pageSize int, fn func(docs []Document, progress database.Progress) error) error {
+		return pages(db, ctx, pageSize, func(page []T, progress database.Progress) error {
+			docs := make([]Document, 0, len(page))
+			for _, row := range page {
+				docs = append(docs, document(row))
+			}
+			return fn(docs, progress)
+		})
+	}
+}
+
+// FetchByIDs builds a DocumentFetcher from a query by IDs and the entity's
+// document mapper.
+func FetchByIDs[T any](
+	query func(ctx context.Context, client *ent.Client, ids []string) ([]T, error),
+	document func(T) Document,
+) DocumentFetcher {
+	return func(ctx context.Context, client *ent.Client, ids []string) ([]Document, error) {
+		rows, err := query(ctx, client, ids)
+		if err != nil {
+			return nil, err
+		}
+		docs := make([]Document, 0, len(rows))
+		for _, row := range rows {
+			docs = append(docs, document(row))
+		}
+		return docs, nil
+	}
+}
+
+// VersionsOf builds a DocumentVersions from a query selecting only the ID
+// and updatedAt columns of an entity.
+func VersionsOf[T any](
+	query func(ctx context.Context, client *ent.Client) ([]T, error),
+	version func(T) (string, time.Time),
+) DocumentVersions {
+	return func(ctx context.Context, client *ent.Client) (map[string]time.Time, error) {
+		rows, err := query(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		versions := make(map[string]time.Time, len(rows))
+		for _, row := range rows {
+			id, updatedAt := version(row)
+			versions[id] = updatedAt
+		}
+		return versions, nil
+	}
+}
+
+// indexBody builds the settings and mappings of a new index. overrides are
+// the per-index settings from the configuration, keyed by definition name.
+func (d *IndexDefinition) indexBody(dims int, overrides map[string]config.ESIndexConfig) map[string]any {
+	shards, replicas := d.Shards, d.Replicas
+	if shards <= 0 {
+		shards = defaultShards
+	}
+	if o, ok := overrides[d.Name]; ok {
+		if o.Shards != nil {
+			shards = *o.Shards
+		}
+		if o.Replicas != nil {
+			replicas = *o.Replicas
+		}
+	}
+
+	props := MergeTextFields(d.TextFields, d.SuggestFields...)
+	addFilterFields(props)
+	maps.Copy(props, d.Properties)
+	addVectorFields(props, d.VectorFields, dims)
+	for name, nested := range d.Nested {
+		props[name] = nested.properties(dims)
+	}
+
+	return map[string]any{
+		"settings": map[string]any{
+			"number_of_shards":   shards,
+			"number_of_replicas": replicas,
+			"max_ngram_diff":     2,
+			"analysis": map[string]any{
+				"tokenizer": map[string]any{
+					"cjk_ngram": map[string]any{
+						"type":     "ngram",
+						"min_gram": 2,
+						"max_gram": 4,
+					},
+				},
+				"analyzer": map[string]any{
+					"ik_cjk": map[string]any{
+						"tokenizer": "ik_max_word",
+					},
+					"cjk_ngram_analyzer": map[string]any{
+						"tokenizer": "cjk_ngram",
+						"filter":    []string{"lowercase"},
+					},
+					"suggest_analyzer": map[string]any{
+						"tokenizer": "standard",
+						"filter":    []string{"cjk_width", "lowercase"},
+					},
+				},
+			},
+		},
+		"mappings": map[string]any{
+			"properties": props,
+		},
+	}
+}
+
+var (
+	registryMu  sync.RWMutex
+	definitions []*IndexDefinition
+)
+
+// RegisterIndex adds a definition to the registry and returns it. It panics
+// on an incomplete definition or a duplicate name or type, as registration
+// happens at package initialization.
+func RegisterIndex(def *IndexDefinition) *IndexDefinition {
+	if def.Name == "" || def.Type == "" || def.Load == nil || def.Fetch == nil || def.Versions == nil || def.SearchBody == nil {
+		panic(fmt.Sprintf("es: incomplete index definition %q", def.Name))
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	for _, existing := range definitions {
+		if existing.Name == def.Name || existing.Type == def.Type {
+			panic(fmt.Sprintf("es: index definition %q/%q registered twice", def.Name, def.Type))
+		}
+	}
+	definitions = append(definitions, def)
+	return def
+}
+
+// IndexDefinitions returns the registered definitions in registration order.
+func IndexDefinitions() []*IndexDefinition {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return append([]*IndexDefinition(nil), definitions...)
+}
+
+// LookupIndex returns the definition with the given name, e.g. "keeps".
+func LookupIndex(name string) (*IndexDefinition, bool) {
+	for _, def := range IndexDefinitions() {
+		if def.Name == name {
+			return def, true
+		}
+	}
+	return nil, false
+}
+
+// LookupIndexByType returns the definition of the given entity type, e.g. "keep".
+func LookupIndexByType(entityType string) (*IndexDefinition, bool) {
+	for _, def := range IndexDefinitions() {
+		if def.Type == entityType {
+			return def, true
+		}
+	}
+	return nil, false
+}
+
+// IndexAliases maps a definition name to the alias its type is searched
+// through. Each alias points at the current timestamped index of its type.
+type IndexAliases map[string]string
+
+// NewIndexAliases derives the alias of every registered definition from the
+// application name.
+func NewIndexAliases(appName string) IndexAliases {
+	prefix := strings.ToLower(strings.ReplaceAll(appName, " ", "-"))
+	aliases := make(IndexAliases)
+	for _, def := range IndexDefinitions() {
+		aliases[def.Name] = prefix + "-" + def.Name
+	}
+	return aliases
+}
+
+// Of returns the alias of def.
+func (a IndexAliases) Of(def *IndexDefinition) string {
+	return a[def.Name]
+}
@@ -0,0 +1,82 @@
+package es
+
+import (
+	"testing"
+
+	"api.us4ever/internal/config"
+)
+
+func TestIndexDefinition_IndexBody(t *testing.T) {
+	body := ImageIndex.indexBody(768, nil)
+
+	settings := body["settings"].(map[string]any)
+	if settings["number_of_shards"] != defaultShards || settings["number_of_replicas"] != 0 {
+		t.Errorf("expected the default shards and replicas, got %v/%v", settings["number_of_shards"], settings["number_of_replicas"])
+	}
+
+	props := body["mappings"].(map[string]any)["properties"].(map[string]any)
+	for _, field := range []string{"name", "description", "tags", "ownerId", "exif", "width"} {
+		if _, ok := props[field]; !ok {
+			t.Errorf("expected field %s to be mapped", field)
+		}
+	}
+	vector := props["description_vector"].(map[string]any)
+	if vector["type"] != "dense_vector" || vector["dims"] != 768 {
+		t.Errorf("unexpected vector mapping %v", vector)
+	}
+}
+
+func TestIndexDefinition_SuggestFields(t *testing.T) {
+	props := KeepIndex.indexBody(768, nil)["mappings"].(map[string]any)["properties"].(map[string]any)
+	subfields := func(field string) map[string]any {
+		return props[field].(map[string]any)["fields"].(map[string]any)
+	}
+
+	suggest, ok := subfields("title")["suggest"].(map[string]any)
+	if !ok || suggest["type"] != "search_as_you_type" {
+		t.Errorf("expected title to have a search_as_you_type subfield, got %v", subfields("title"))
+	}
+	if _, ok := subfields("content")["suggest"]; ok {
+		t.Error("expected content to have no suggest subfield")
+	}
+}
+
+func TestIndexDefinition_IndexBodyOverrides(t *testing.T) {
+	shards, replicas := 1, 2
+	body := KeepIndex.indexBody(768, map[string]config.ESIndexConfig{
+		"keeps":   {Shards: &shards, Replicas: &replicas},
+		"moments": {Shards: &replicas},
+	})
+
+	settings := body["settings"].(map[string]any)
+	if settings["number_of_shards"] != 1 || settings["number_of_replicas"] != 2 {
+		t.Errorf("expected the configured shards and replicas, got %v/%v", settings["number_of_shards"], settings["number_of_replicas"])
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	for _, def := range IndexDefinitions() {
+		if got, ok := LookupIndex(def.Name); !ok || got != def {
+			t.Errorf("lookup by name %s failed", def.Name)
+		}
+		if got, ok := LookupIndexByType(def.Type); !ok || got != def {
+			t.Errorf("lookup by type %s failed", def.Type)
+		}
+	}
+
+	aliases := NewIndexAliases("My App")
+	if got := aliases.Of(KeepIndex); got != "my-app-keeps" {
+		t.Errorf("expected alias my-app-keeps, got %s", got)
+	}
+	if len(aliases) != len(IndexDefinitions()) {
+		t.Errorf("expected one alias per definition, got %v", aliases)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected registering a duplicate name to panic")
+		}
+	}()
+	dup := *KeepIndex
+	RegisterIndex(&dup)
+}
@@ -0,0 +1,140 @@
+package es
+
+import (
+	"context"
+	"time"
+
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/image"
+	"api.us4ever/internal/ent/keep"
+	"api.us4ever/internal/ent/mindmap"
+	"api.us4ever/internal/ent/moment"
+	"api.us4ever/internal/ent/todo"
+	"api.us4ever/internal/ent/video"
+)
+
+// 内置的可搜索实体，注册顺序即统一搜索与重索引路由的顺序
+var (
+	KeepIndex = RegisterIndex(&IndexDefinition{
+		Name:          "keeps",
+		Type:          TypeKeep,
+		TextFields:    []string{"title", "summary", "content"},
+		SuggestFields: []string{"title", "summary"},
+		VectorFields:  []string{"title_vector", "summary_vector", "content_vector"},
+		Nested:        map[string]NestedField{ChunksField: keepChunksField},
+		Load:          loadKeeps,
+		Fetch:         fetchKeeps,
+		Versions: VersionsOf(func(ctx context.Context, client *ent.Client) ([]*ent.Keep, error) {
+			return client.Keep.Query().Select(keep.FieldID, keep.FieldUpdatedAt).All(ctx)
+		}, keepVersion),
+		SearchBody: buildKeepsSearchBody,
+	})
+
+	MomentIndex = RegisterIndex(&IndexDefinition{
+		Name:         "moments",
+		Type:         TypeMoment,
+		TextFields:   []string{"content"},
+		VectorFields: []string{"content_vector"},
+		Load:         LoadPages(database.Service.MomentPages, momentDocument),
+		Fetch: FetchByIDs(func(ctx context.Context, client *ent.Client, ids []string) ([]*ent.Moment, error) {
+			return client.Moment.Query().
+				Where(moment.IDIn(ids...)).
+				WithMomentImages(func(q *ent.MomentImageQuery) {
+					q.WithImage()
+				}).
+				All(ctx)
+		}, momentDocument),
+		Versions: VersionsOf(func(ctx context.Context, client *ent.Client) ([]*ent.Moment, error) {
+			return client.Moment.Query().Select(moment.FieldID, moment.FieldUpdatedAt).All(ctx)
+		}, momentVersion),
+		SearchBody: buildMomentsSearchBody,
+	})
+
+	MindmapIndex = RegisterIndex(&IndexDefinition{
+		Name:       "mindmaps",
+		Type:       TypeMindmap,
+		TextFields: []string{"title", "summary", "content"},
+		Load:       LoadPages(database.Service.MindmapPages, mindmapDocument),
+		Fetch: FetchByIDs(func(ctx context.Context, client *ent.Client, ids []string) ([]*ent.Mindmap, error) {
+			return client.Mindmap.Query().Where(mindmap.IDIn(ids...)).All(ctx)
+		}, mindmapDocument),
+		Versions: VersionsOf(func(ctx context.Context, client *ent.Client) ([]*ent.Mindmap, error) {
+			return client.Mindmap.Query().Select(mindmap.FieldID, mindmap.FieldUpdatedAt).All(ctx)
+		}, mindmapVersion),
+		SearchBody: mindmapsSearch.body,
+	})
+
+	TodoIndex = RegisterIndex(&IndexDefinition{
+		Name:       "todos",
+		Type:       TypeTodo,
+		TextFields: []string{"title", "content"},
+		Properties: map[string]any{
+			"status":   map[string]any{"type": "boolean"},
+			"pinned":   map[string]any{"type": "boolean"},
+			"priority": map[string]any{"type": "integer"},
+			"dueDate":  map[string]any{"type": "date"},
+		},
+		Load: LoadPages(database.Service.TodoPages, todoDocument),
+		Fetch: FetchByIDs(func(ctx context.Context, client *ent.Client, ids []string) ([]*ent.Todo, error) {
+			return client.Todo.Query().Where(todo.IDIn(ids...)).All(ctx)
+		}, todoDocument),
+		Versions: VersionsOf(func(ctx context.Context, client *ent.Client) ([]*ent.Todo, error) {
+			return client.Todo.Query().Select(todo.FieldID, todo.FieldUpdatedAt).All(ctx)
+		}, todoVersion),
+		SearchBody: todosSearch.body,
+	})
+
+	ImageIndex = RegisterIndex(&IndexDefinition{
+		Name:         "images",
+		Type:         TypeImage,
+		TextFields:   []string{"name", "description"},
+		VectorFields: []string{"description_vector"},
+		Properties: map[string]any{
+			"type":   map[string]any{"type": "keyword"},
+			"width":  map[string]any{"type": "integer"},
+			"height": map[string]any{"type": "integer"},
+			// exif 的键不固定，用 flattened 避免映射膨胀
+			"exif": map[string]any{"type": "flattened", "ignore_above": 256},
+		},
+		Load: LoadPages(database.Service.ImagePages, imageDocument),
+		Fetch: FetchByIDs(func(ctx context.Context, client *ent.Client, ids []string) ([]*ent.Image, error) {
+			return client.Image.Query().Where(image.IDIn(ids...)).Select(database.ImageSearchFields...).All(ctx)
+		}, imageDocument),
+		Versions: VersionsOf(func(ctx context.Context, client *ent.Client) ([]*ent.Image, error) {
+			return client.Image.Query().Select(image.FieldID, image.FieldUpdatedAt).All(ctx)
+		}, imageVersion),
+		SearchBody: imagesSearch.body,
+	})
+
+	VideoIndex = RegisterIndex(&IndexDefinition{
+		Name:       "videos",
+		Type:       TypeVideo,
+		TextFields: []string{"name"},
+		Properties: map[string]any{
+			"type":     map[string]any{"type": "keyword"},
+			"duration": map[string]any{"type": "integer"},
+		},
+		Load: LoadPages(database.Service.VideoPages, videoDocument),
+		Fetch: FetchByIDs(func(ctx context.Context, client *ent.Client, ids []string) ([]*ent.Video, error) {
+			return client.Video.Query().Where(video.IDIn(ids...)).All(ctx)
+		}, videoDocument),
+		Versions: VersionsOf(func(ctx context.Context, client *ent.Client) ([]*ent.Video, error) {
+			return client.Video.Query().Select(video.FieldID, video.FieldUpdatedAt).All(ctx)
+		}, videoVersion),
+		SearchBody: videosSearch.body,
+	})
+)
+
+func momentDocument(m *ent.Moment) Document   { return Document{ID: m.ID, Source: MomentDocument(m)} }
+func mindmapDocument(m *ent.Mindmap) Document { return Document{ID: m.ID, Source: MindmapDocument(m)} }
+func todoDocument(t *ent.Todo) Document       { return Document{ID: t.ID, Source: TodoDocument(t)} }
+func imageDocument(i *ent.Image) Document     { return Document{ID: i.ID, Source: ImageDocument(i)} }
+func videoDocument(v *ent.Video) Document     { return Document{ID: v.ID, Source: VideoDocument(v)} }
+
+func keepVersion(k *ent.Keep) (string, time.Time)       { return k.ID, k.UpdatedAt }
+func momentVersion(m *ent.Moment) (string, time.Time)   { return m.ID, m.UpdatedAt }
+func mindmapVersion(m *ent.Mindmap) (string, time.Time) { return m.ID, m.UpdatedAt }
+func todoVersion(t *ent.Todo) (string, time.Time)       { return t.ID, t.UpdatedAt }
+func imageVersion(i *ent.Image) (string, time.Time)     { return i.ID, i.UpdatedAt }
+func videoVersion(v *ent.Video) (string, time.Time)     { return v.ID, v.UpdatedAt }
@@ -0,0 +1,208 @@
+package es
+
+import (
+	"encoding/json"
+	"strings"
+
+	"api.us4ever/internal/ent"
+	"github.com/tidwall/gjson"
+)
+
+// addFilterFields adds the keyword/boolean/date fields used by SearchFilters to an index mapping.
+func addFilterFields(props map[string]any) {
+	for _, name := range []string{"tags", "category", "ownerId"} {
+		props[name] = map[string]any{"type": "keyword"}
+	}
+	props["isPublic"] = map[string]any{"type": "boolean"}
+	props["createdAt"] = map[string]any{"type": "date"}
+	props["updatedAt"] = map[string]any{"type": "date"}
+}
+
+// decodeTags converts the JSON tags column into a list of tag names.
+// Anything that is not a JSON array of strings is ignored.
+func decodeTags(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return []string{}
+	}
+	var tags []string
+	if err := json.Unmarshal(raw, &tags); err != nil {
+		return []string{}
+	}
+	return tags
+}
+
+// KeepDocument projects a Keep, with its chunks, into the document stored in
+// the keeps index.
+func KeepDocument(keep *ent.Keep, chunks []*ent.KeepChunk) map[string]any {
+	return map[string]any{
+		"title":     keep.Title,
+		"summary":   keep.Summary,
+		"content":   keep.Content,
+		"tags":      decodeTags(keep.Tags),
+		"category":  keep.Category,
+		"isPublic":  keep.IsPublic,
+		"ownerId":   keep.OwnerId,
+		"createdAt": keep.CreatedAt,
+		"updatedAt": keep.UpdatedAt,
+		// 向量字段
+		"title_vector":   keep.TitleVector,
+		"summary_vector": keep.SummaryVector,
+		"content_vector": keep.ContentVector,
+		// 分块及其向量，按段落检索与高亮
+		ChunksField: KeepChunkDocuments(chunks),
+	}
+}
+
+// MomentDocument projects a Moment, with its eager-loaded images, into the
+// document stored in the moments index.
+func MomentDocument(moment *ent.Moment) map[string]any {
+	// Collect image data if available
+	var images []map[string]any
+	for _, mi := range moment.Edges.MomentImages {
+		if mi.Edges.Image != nil {
+			images = append(images, map[string]any{
+				"id":          mi.Edges.Image.ID,
+				"description": mi.Edges.Image.Description,
+			})
+		}
+	}
+
+	return map[string]any{
+		"id":        moment.ID,
+		"content":   moment.Content,
+		"images":    images,
+		"tags":      decodeTags(moment.Tags),
+		"category":  moment.Category,
+		"isPublic":  moment.IsPublic,
+		"ownerId":   moment.OwnerId,
+		"createdAt": moment.CreatedAt,
+		"updatedAt": moment.UpdatedAt,
+		// 向量字段
+		"content_vector": moment.ContentVector,
+	}
+}
+
+// mindmapTextKeys are the node keys holding text in the mindmap formats we
+// store (mind-elixir/jsMind "topic", markmap "content", xmind "title", ...).
+var mindmapTextKeys = map[string]bool{
+	"topic":   true,
+	"text":    true,
+	"title":   true,
+	"label":   true,
+	"content": true,
+	"note":    true,
+}
+
+// FlattenMindmap extracts the node text of a mindmap JSON document, one node
+// per line in document order. Unknown structures are walked as a whole, so
+// only the text keys matter, not the nesting.
+func FlattenMindmap(raw json.RawMessage) string {
+	if len(raw) == 0 || !gjson.ValidBytes(raw) {
+		return ""
+	}
+	var lines []string
+	var walk func(key string, value gjson.Result)
+	walk = func(key string, value gjson.Result) {
+		switch {
+		case value.IsObject() || value.IsArray():
+			value.ForEach(func(k, v gjson.Result) bool {
+				// 数组元素继承父级的 key，这样 "topic": ["a", "b"] 也能被收集
+				if value.IsObject() {
+					walk(k.String(), v)
+				} else {
+					walk(key, v)
+				}
+				return true
+			})
+		case value.Type == gjson.String && mindmapTextKeys[key]:
+			if text := strings.TrimSpace(value.String()); text != "" {
+				lines = append(lines, text)
+			}
+		}
+	}
+	walk("", gjson.ParseBytes(raw))
+	return strings.Join(lines, "\n")
+}
+
+// MindmapDocument projects a Mindmap into the document stored in the mindmaps
+// index. The JSON content is indexed as its flattened node text.
+func MindmapDocument(mindmap *ent.Mindmap) map[string]any {
+	return map[string]any{
+		"title":     mindmap.Title,
+		"summary":   mindmap.Summary,
+		"content":   FlattenMindmap(mindmap.Content),
+		"tags":      decodeTags(mindmap.Tags),
+		"category":  mindmap.Category,
+		"isPublic":  mindmap.IsPublic,
+		"ownerId":   mindmap.OwnerId,
+		"createdAt": mindmap.CreatedAt,
+		"updatedAt": mindmap.UpdatedAt,
+	}
+}
+
+// TodoDocument projects a Todo into the document stored in the todos index.
+func TodoDocument(todo *ent.Todo) map[string]any {
+	doc := map[string]any{
+		"title":     todo.Title,
+		"content":   todo.Content,
+		"status":    todo.Status,
+		"priority":  todo.Priority,
+		"pinned":    todo.Pinned,
+		"tags":      []string{},
+		"category":  todo.Category,
+		"isPublic":  todo.IsPublic,
+		"ownerId":   todo.OwnerId,
+		"createdAt": todo.CreatedAt,
+		"updatedAt": todo.UpdatedAt,
+	}
+	// 没有截止日期时不写入零值时间
+	if !todo.DueDate.IsZero() {
+		doc["dueDate"] = todo.DueDate
+	}
+	return doc
+}
+
+// ImageDocument projects an Image into the document stored in the images
+// index. The OCR description is the main searchable text.
+func ImageDocument(image *ent.Image) map[string]any {
+	return map[string]any{
+		"name":        image.Name,
+		"description": image.Description,
+		"type":        image.Type,
+		"width":       image.Width,
+		"height":      image.Height,
+		"exif":        jsonObject(image.Exif),
+		"tags":        decodeTags(image.Tags),
+		"category":    image.Category,
+		"isPublic":    image.IsPublic,
+		"ownerId":     image.UploadedBy,
+		"createdAt":   image.CreatedAt,
+		"updatedAt":   image.UpdatedAt,
+		// 向量字段
+		"description_vector": image.DescriptionVector,
+	}
+}
+
+// VideoDocument projects a Video into the document stored in the videos index.
+func VideoDocument(video *ent.Video) map[string]any {
+	return map[string]any{
+		"name":      video.Name,
+		"type":      video.Type,
+		"duration":  video.Duration,
+		"tags":      []string{},
+		"category":  video.Category,
+		"isPublic":  video.IsPublic,
+		"ownerId":   video.UploadedBy,
+		"createdAt": video.CreatedAt,
+		"updatedAt": video.UpdatedAt,
+	}
+}
+
+// jsonObject returns raw when it is a JSON object and nil otherwise, so a
+// malformed column cannot make the whole document fail to index.
+func jsonObject(raw json.RawMessage) json.RawMessage {
+	if !gjson.ValidBytes(raw) || !gjson.ParseBytes(raw).IsObject() {
+		return nil
+	}
+	return raw
+}
@@ -0,0 +1,66 @@
+package es
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"api.us4ever/internal/ent"
+)
+
+func TestFlattenMindmap(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{
+			name: "mind-elixir",
+			raw:  `{"nodeData":{"id":"root","topic":"计划","style":{"color":"#fff"},"children":[{"id":"a","topic":"读书"},{"id":"b","topic":" 运动 ","children":[{"id":"c","topic":"跑步"}]}]}}`,
+			want: "计划\n读书\n运动\n跑步",
+		},
+		{
+			name: "markmap",
+			raw:  `{"content":"Root","children":[{"content":"Child","payload":{"fold":1}}]}`,
+			want: "Root\nChild",
+		},
+		{
+			name: "array of text",
+			raw:  `{"text":["a","","b"]}`,
+			want: "a\nb",
+		},
+		{name: "empty", raw: ``, want: ""},
+		{name: "invalid", raw: `{"topic":`, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FlattenMindmap(json.RawMessage(tt.raw)); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTodoDocument_OmitsMissingDueDate(t *testing.T) {
+	doc := TodoDocument(&ent.Todo{ID: "t", Title: "x"})
+	if _, ok := doc["dueDate"]; ok {
+		t.Error("expected no dueDate without one set")
+	}
+
+	due := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	doc = TodoDocument(&ent.Todo{ID: "t", Title: "x", DueDate: due})
+	if doc["dueDate"] != due {
+		t.Errorf("expected dueDate %v, got %v", due, doc["dueDate"])
+	}
+}
+
+func TestImageDocument_DropsMalformedExif(t *testing.T) {
+	doc := ImageDocument(&ent.Image{ID: "i", Exif: json.RawMessage(`"not an object"`)})
+	if exif := doc["exif"].(json.RawMessage); exif != nil {
+		t.Errorf("expected malformed exif to be dropped, got %s", exif)
+	}
+	doc = ImageDocument(&ent.Image{ID: "i", Exif: json.RawMessage(`{"Make":"Canon"}`)})
+	if string(doc["exif"].(json.RawMessage)) != `{"Make":"Canon"}` {
+		t.Errorf("unexpected exif %s", doc["exif"])
+	}
+}
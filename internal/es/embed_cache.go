@@ -0,0 +1,185 @@
+package es
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"api.us4ever/internal/embedding"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	// queryEmbeddingCacheSize is the number of query vectors kept in process
+	queryEmbeddingCacheSize = 2048
+	// queryEmbeddingTTL is how long a query vector lives in Redis
+	queryEmbeddingTTL = 7 * 24 * time.Hour
+	// queryEmbeddingKeyPrefix namespaces query vectors in Redis
+	queryEmbeddingKeyPrefix = "embedding:query:"
+)
+
+// QueryEmbeddingCache caches query vectors in an in-process LRU, optionally
+// backed by Redis so that replicas share them.
+type QueryEmbeddingCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+	redis    *redis.Client
+}
+
+type lruEntry struct {
+	key    string
+	vector []float32
+}
+
+// NewQueryEmbeddingCache creates a cache holding up to capacity vectors in memory.
+// rdb may be nil, in which case only the in-process LRU is used.
+func NewQueryEmbeddingCache(capacity int, rdb *redis.Client) *QueryEmbeddingCache {
+	return &QueryEmbeddingCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+		redis:    rdb,
+	}
+}
+
+var queryEmbeddings = NewQueryEmbeddingCache(queryEmbeddingCacheSize, nil)
+
+// UseRedisForQueryEmbeddings adds a Redis layer to the query embedding cache.
+// Passing nil goes back to the in-process LRU only.
+func UseRedisForQueryEmbeddings(rdb *redis.Client) {
+	queryEmbeddings.mu.Lock()
+	defer queryEmbeddings.mu.Unlock()
+	queryEmbeddings.redis = rdb
+}
+
+// EmbedQuery embeds a search query, reusing cached vectors where possible.
+// The cache key is built from the normalized query, so "Go  ES" and "go es"
+// share one vector; the model still embeds the query as typed.
+func EmbedQuery(ctx context.Context, query string) ([]float32, error) {
+	embedder, err := embedding.Default()
+	if err != nil {
+		return nil, err
+	}
+	key := queryEmbeddingKey(embedder.Model(), normalizeQuery(query))
+
+	if vector, ok := queryEmbeddings.Get(ctx, key); ok {
+		return vector, nil
+	}
+
+	vector, err := requestEmbedding(ctx, embedder, query)
+	if err != nil {
+		return nil, err
+	}
+	queryEmbeddings.Set(ctx, key, vector)
+	return vector, nil
+}
+
+// Get looks the key up in memory first, then in Redis.
+func (c *QueryEmbeddingCache) Get(ctx context.Context, key string) ([]float32, bool) {
+	c.mu.Lock()
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		vector := el.Value.(*lruEntry).vector
+		c.mu.Unlock()
+		return vector, true
+	}
+	rdb := c.redis
+	c.mu.Unlock()
+
+	if rdb == nil {
+		return nil, false
+	}
+	raw, err := rdb.Get(ctx, queryEmbeddingKeyPrefix+key).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			searchLogger.Warn("failed to read query embedding from redis", zap.Error(err))
+		}
+		return nil, false
+	}
+	vector, ok := decodeVector(raw)
+	if !ok {
+		return nil, false
+	}
+	c.add(key, vector)
+	return vector, true
+}
+
+// Set stores the vector in memory and, when configured, in Redis.
+func (c *QueryEmbeddingCache) Set(ctx context.Context, key string, vector []float32) {
+	c.add(key, vector)
+
+	c.mu.Lock()
+	rdb := c.redis
+	c.mu.Unlock()
+	if rdb == nil {
+		return
+	}
+	if err := rdb.Set(ctx, queryEmbeddingKeyPrefix+key, encodeVector(vector), queryEmbeddingTTL).Err(); err != nil {
+		searchLogger.Warn("failed to write query embedding to redis", zap.Error(err))
+	}
+}
+
+// Len returns the number of vectors held in memory.
+func (c *QueryEmbeddingCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+func (c *QueryEmbeddingCache) add(key string, vector []float32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruEntry).vector = vector
+		c.ll.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&lruEntry{key: key, vector: vector})
+	for c.capacity > 0 && c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// normalizeQuery lowercases the query and collapses whitespace.
+func normalizeQuery(query string) string {
+	return strings.ToLower(strings.Join(strings.Fields(query), " "))
+}
+
+// queryEmbeddingKey hashes model and text so keys have a fixed length.
+func queryEmbeddingKey(model, normalized string) string {
+	sum := sha256.Sum256([]byte(model + "\x00" + normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// encodeVector packs the vector as little-endian float32s.
+func encodeVector(vector []float32) []byte {
+	buf := make([]byte, 4*len(vector))
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(buf[4*i:], math.Float32bits(v))
+	}
+	return buf
+}
+
+func decodeVector(raw []byte) ([]float32, bool) {
+	if len(raw) == 0 || len(raw)%4 != 0 {
+		return nil, false
+	}
+	vector := make([]float32, len(raw)/4)
+	for i := range vector {
+		vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(raw[4*i:]))
+	}
+	return vector, true
+}
@@ -0,0 +1,56 @@
+package es
+
+import (
+	"context"
+	"testing"
+)
+
+func TestQueryEmbeddingCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	cache := NewQueryEmbeddingCache(2, nil)
+
+	cache.Set(ctx, "a", []float32{1})
+	cache.Set(ctx, "b", []float32{2})
+	// Touch a so that b becomes the oldest entry
+	if _, ok := cache.Get(ctx, "a"); !ok {
+		t.Fatal("expected a to be cached")
+	}
+	cache.Set(ctx, "c", []float32{3})
+
+	if cache.Len() != 2 {
+		t.Fatalf("expected 2 cached vectors, got %d", cache.Len())
+	}
+	if _, ok := cache.Get(ctx, "b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	if v, ok := cache.Get(ctx, "c"); !ok || v[0] != 3 {
+		t.Errorf("expected c to be cached, got %v %v", v, ok)
+	}
+}
+
+func TestQueryEmbeddingKey_NormalizesText(t *testing.T) {
+	a := queryEmbeddingKey("model", normalizeQuery("  Go   Elasticsearch "))
+	b := queryEmbeddingKey("model", normalizeQuery("go elasticsearch"))
+	if a != b {
+		t.Error("expected equivalent queries to share a key")
+	}
+	if c := queryEmbeddingKey("other-model", normalizeQuery("go elasticsearch")); c == a {
+		t.Error("expected different models to use different keys")
+	}
+}
+
+func TestEncodeDecodeVector(t *testing.T) {
+	in := []float32{0.5, -1.25, 3}
+	out, ok := decodeVector(encodeVector(in))
+	if !ok || len(out) != len(in) {
+		t.Fatalf("round trip failed: %v %v", out, ok)
+	}
+	for i := range in {
+		if in[i] != out[i] {
+			t.Errorf("index %d: expected %v, got %v", i, in[i], out[i])
+		}
+	}
+	if _, ok := decodeVector([]byte{1, 2, 3}); ok {
+		t.Error("expected truncated payload to be rejected")
+	}
+}
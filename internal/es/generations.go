@@ -0,0 +1,304 @@
+package es
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"go.uber.org/zap"
+)
+
+const (
+	// generationLayout is the timestamp suffix of an index generation, alias_20060102150405
+	generationLayout = "20060102150405"
+
+	// defaultRetainIndices is how many generations of an alias are kept, the current one included
+	defaultRetainIndices = 3
+	// defaultMinDocRatio is the share of the current document count a new
+	// generation must reach before the alias is switched to it
+	defaultMinDocRatio = 0.9
+)
+
+var (
+	// ErrDocCountTooLow is returned when a new generation has too few
+	// documents compared to the one the alias points at.
+	ErrDocCountTooLow = errors.New("new index has too few documents")
+	// ErrNoPreviousGeneration is returned by Rollback when there is no older
+	// generation to roll back to.
+	ErrNoPreviousGeneration = errors.New("no previous index generation to roll back to")
+)
+
+// generationName returns the name of a new generation of alias.
+func generationName(alias string, at time.Time) string {
+	return alias + "_" + at.Format(generationLayout)
+}
+
+// isGeneration reports whether index is a generation of alias.
+func isGeneration(alias, index string) bool {
+	suffix, ok := strings.CutPrefix(index, alias+"_")
+	if !ok {
+		return false
+	}
+	_, err := time.Parse(generationLayout, suffix)
+	return err == nil
+}
+
+// listGenerations returns the generations of alias, oldest first. The
+// timestamp suffix makes name order creation order.
+func listGenerations(ctx context.Context, client *elasticsearch.Client, alias string) ([]string, error) {
+	pattern := alias + "_*"
+	res, err := client.Cat.Indices(
+		client.Cat.Indices.WithIndex(pattern),
+		client.Cat.Indices.WithContext(ctx),
+		client.Cat.Indices.WithH("index"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indices with pattern %s: %w", pattern, err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read indices list response body: %w", err)
+	}
+	if res.IsError() {
+		return nil, fmt.Errorf("failed to list indices with pattern %s: [%s] %s", pattern, res.Status(), string(body))
+	}
+
+	var generations []string
+	for _, line := range strings.Split(string(body), "\n") {
+		if index := strings.TrimSpace(line); isGeneration(alias, index) {
+			generations = append(generations, index)
+		}
+	}
+	slices.Sort(generations)
+	return generations, nil
+}
+
+// aliasTarget returns the index alias points at, or "" when the alias does
+// not exist yet.
+func aliasTarget(ctx context.Context, client *elasticsearch.Client, alias string) (string, error) {
+	res, err := client.Indices.GetAlias(
+		client.Indices.GetAlias.WithName(alias),
+		client.Indices.GetAlias.WithContext(ctx),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to get alias %s: %w", alias, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read alias response body: %w", err)
+	}
+	if res.IsError() {
+		return "", fmt.Errorf("failed to get alias %s: [%s] %s", alias, res.Status(), string(body))
+	}
+
+	// 响应以索引名为键；updateAlias 保证别名只指向一个索引
+	var indices map[string]json.RawMessage
+	if err := json.Unmarshal(body, &indices); err != nil {
+		return "", fmt.Errorf("failed to parse alias response: %w", err)
+	}
+	var target string
+	for index := range indices {
+		target = max(target, index)
+	}
+	return target, nil
+}
+
+// countDocuments returns the number of documents in index, which may also be an alias.
+func countDocuments(ctx context.Context, client *elasticsearch.Client, index string) (int64, error) {
+	res, err := client.Count(
+		client.Count.WithIndex(index),
+		client.Count.WithContext(ctx),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count documents in %s: %w", index, err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read count response body: %w", err)
+	}
+	if res.IsError() {
+		return 0, fmt.Errorf("failed to count documents in %s: [%s] %s", index, res.Status(), string(body))
+	}
+
+	var r struct {
+		Count int64 `json:"count"`
+	}
+	if err := json.Unmarshal(body, &r); err != nil {
+		return 0, fmt.Errorf("failed to parse count response: %w", err)
+	}
+	return r.Count, nil
+}
+
+// checkDocumentCount refuses a new generation holding less than minRatio of
+// the documents of the one alias points at. A negative minRatio, or an alias
+// that does not exist yet, skips the check.
+func checkDocumentCount(ctx context.Context, client *elasticsearch.Client, alias, newIndex string, minRatio float64) error {
+	if minRatio < 0 {
+		return nil
+	}
+	current, err := aliasTarget(ctx, client, alias)
+	if err != nil || current == "" {
+		return err
+	}
+
+	oldCount, err := countDocuments(ctx, client, current)
+	if err != nil {
+		return err
+	}
+	newCount, err := countDocuments(ctx, client, newIndex)
+	if err != nil {
+		return err
+	}
+	if !enoughDocuments(newCount, oldCount, minRatio) {
+		return fmt.Errorf("%w: %s has %d documents, %s has %d (minimum ratio %.2f)",
+			ErrDocCountTooLow, newIndex, newCount, current, oldCount, minRatio)
+	}
+	indexerLogger.Info("document count check passed",
+		zap.String("index_name", newIndex),
+		zap.Int64("new_count", newCount),
+		zap.Int64("old_count", oldCount),
+	)
+	return nil
+}
+
+func enoughDocuments(newCount, oldCount int64, minRatio float64) bool {
+	return float64(newCount) >= float64(oldCount)*minRatio
+}
+
+// expiredGenerations returns the generations to delete so that only the
+// newest retain remain. current, the index the alias points at, is always
+// kept, even after a rollback made it older than the others.
+func expiredGenerations(generations []string, current string, retain int) []string {
+	retain = max(retain, 1)
+	var expired []string
+	for i, index := range generations {
+		if i < len(generations)-retain && index != current {
+			expired = append(expired, index)
+		}
+	}
+	return expired
+}
+
+// previousGeneration returns the newest generation older than current.
+func previousGeneration(generations []string, current string) (string, bool) {
+	var previous string
+	for _, index := range generations {
+		if index < current {
+			previous = index
+		}
+	}
+	return previous, previous != ""
+}
+
+// pruneGenerations deletes the generations of alias beyond the newest retain.
+// Failures are logged only: a leftover index costs disk space, nothing else.
+func pruneGenerations(ctx context.Context, client *elasticsearch.Client, alias, current string, retain int) {
+	generations, err := listGenerations(ctx, client, alias)
+	if err != nil {
+		indexerLogger.Warn("failed to list index generations for cleanup",
+			zap.String("alias", alias),
+			zap.Error(err),
+		)
+		return
+	}
+
+	expired := expiredGenerations(generations, current, retain)
+	if len(expired) == 0 {
+		indexerLogger.Info("no old indices found to delete",
+			zap.String("alias", alias),
+			zap.Int("generations", len(generations)),
+		)
+		return
+	}
+
+	res, err := client.Indices.Delete(expired, client.Indices.Delete.WithContext(ctx), client.Indices.Delete.WithIgnoreUnavailable(true))
+	if err != nil {
+		indexerLogger.Warn("failed to delete old indices",
+			zap.Strings("indices", expired),
+			zap.Error(err),
+		)
+		return
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		body, _ := io.ReadAll(res.Body)
+		indexerLogger.Warn("failed to delete old indices",
+			zap.Strings("indices", expired),
+			zap.String("status", res.Status()),
+			zap.String("response", string(body)),
+		)
+		return
+	}
+
+	indexerLogger.Info("successfully deleted old indices",
+		zap.String("alias", alias),
+		zap.Strings("indices", expired),
+		zap.Int("retained", len(generations)-len(expired)),
+	)
+}
+
+// RollbackResult describes an alias switched back by Rollback.
+type RollbackResult struct {
+	Alias string `json:"alias"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// Rollback points alias back at the generation before the one it currently
+// points at. The newer generation is kept, so the rollback can be undone by
+// a reindex or inspected. It fails with ErrNoPreviousGeneration when there
+// is nothing older to roll back to.
+//
+// The previous generation lacks every change the search outbox applied since
+// it was built: edited documents are stale, new ones missing and deleted ones
+// still there. Run CheckConsistency with Repair and IncludeRecent afterwards
+// to bring it up to date.
+func Rollback(ctx context.Context, client *elasticsearch.Client, alias string) (RollbackResult, error) {
+	result := RollbackResult{Alias: alias}
+	if client == nil {
+		return result, fmt.Errorf("elasticsearch client is not initialized")
+	}
+
+	current, err := aliasTarget(ctx, client, alias)
+	if err != nil {
+		return result, err
+	}
+	if current == "" {
+		return result, fmt.Errorf("%w: alias %s does not exist", ErrNoPreviousGeneration, alias)
+	}
+	generations, err := listGenerations(ctx, client, alias)
+	if err != nil {
+		return result, err
+	}
+	previous, ok := previousGeneration(generations, current)
+	if !ok {
+		return result, fmt.Errorf("%w: %s is the oldest generation of %s", ErrNoPreviousGeneration, current, alias)
+	}
+
+	if err := updateAlias(ctx, client, alias, previous); err != nil {
+		return result, fmt.Errorf("failed to update alias %s: %w", alias, err)
+	}
+	result.From, result.To = current, previous
+	indexerLogger.Info("alias rolled back",
+		zap.String("alias", alias),
+		zap.String("from", current),
+		zap.String("to", previous),
+	)
+	return result, nil
+}
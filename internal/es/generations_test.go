@@ -0,0 +1,105 @@
+package es
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/tidwall/gjson"
+)
+
+func TestIsGeneration(t *testing.T) {
+	if !isGeneration("app-keeps", "app-keeps_20250101120000") {
+		t.Error("expected a timestamped index to be a generation")
+	}
+	for _, index := range []string{"app-keeps", "app-keeps_backup", "app-keeps-old_20250101120000"} {
+		if isGeneration("app-keeps", index) {
+			t.Errorf("expected %s not to be a generation", index)
+		}
+	}
+}
+
+func TestExpiredGenerations(t *testing.T) {
+	generations := []string{"a_1", "a_2", "a_3", "a_4", "a_5"}
+
+	if got := expiredGenerations(generations, "a_5", 3); !slices.Equal(got, []string{"a_1", "a_2"}) {
+		t.Errorf("expected the two oldest to expire, got %v", got)
+	}
+	// 回滚后别名指向的旧索引不能被删除
+	if got := expiredGenerations(generations, "a_1", 3); !slices.Equal(got, []string{"a_2"}) {
+		t.Errorf("expected the current generation to be kept, got %v", got)
+	}
+	if got := expiredGenerations(generations, "a_5", 0); len(got) != 4 {
+		t.Errorf("expected at least the current generation to be kept, got %v", got)
+	}
+}
+
+func TestPreviousGeneration(t *testing.T) {
+	generations := []string{"a_1", "a_2", "a_3"}
+	if got, ok := previousGeneration(generations, "a_3"); !ok || got != "a_2" {
+		t.Errorf("expected a_2, got %q", got)
+	}
+	if _, ok := previousGeneration(generations, "a_1"); ok {
+		t.Error("expected no generation before the oldest")
+	}
+}
+
+func TestEnoughDocuments(t *testing.T) {
+	if !enoughDocuments(90, 100, 0.9) || enoughDocuments(89, 100, 0.9) {
+		t.Error("expected 90% of the documents to be the threshold")
+	}
+	if !enoughDocuments(0, 0, 0.9) {
+		t.Error("expected an empty index to replace an empty one")
+	}
+}
+
+func TestRollback(t *testing.T) {
+	var switched string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/_alias/"):
+			_, _ = w.Write([]byte(`{"app-keeps_20250103000000":{"aliases":{"app-keeps":{}}}}`))
+		case strings.HasPrefix(r.URL.Path, "/_cat/indices/"):
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("app-keeps_20250102000000\napp-keeps_20250101000000\napp-keeps_20250103000000\n"))
+		case r.URL.Path == "/_aliases":
+			body, _ := io.ReadAll(r.Body)
+			switched = gjson.GetBytes(body, "actions.1.add.index").String()
+			_, _ = w.Write([]byte(`{"acknowledged":true}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	result, err := Rollback(context.Background(), client, "app-keeps")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.From != "app-keeps_20250103000000" || result.To != "app-keeps_20250102000000" {
+		t.Errorf("unexpected rollback %+v", result)
+	}
+	if switched != result.To {
+		t.Errorf("expected the alias to be switched to %s, got %q", result.To, switched)
+	}
+}
+
+func TestRollback_NoPreviousGeneration(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/_alias/"):
+			_, _ = w.Write([]byte(`{"app-keeps_20250101000000":{"aliases":{"app-keeps":{}}}}`))
+		case strings.HasPrefix(r.URL.Path, "/_cat/indices/"):
+			_, _ = w.Write([]byte("app-keeps_20250101000000\n"))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	if _, err := Rollback(context.Background(), client, "app-keeps"); !errors.Is(err, ErrNoPreviousGeneration) {
+		t.Errorf("expected ErrNoPreviousGeneration, got %v", err)
+	}
+}
@@ -4,13 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
+	"slices"
 	"time"
 	"unicode"
 
-	"api.us4ever/internal/config"
+	"api.us4ever/internal/embedding"
 	"api.us4ever/internal/logger"
 	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/textquerytype"
 
@@ -30,23 +28,12 @@ func init() {
 	}
 }
 
-const (
-	vectorDims = 1024
-)
-
 type SearchParams struct {
 	Keyword string
 	Fields  []string
 	Index   string
 }
 
-type EmbeddingReq struct {
-	Text string `json:"text"`
-}
-type EmbeddingResp struct {
-	Vector []float32 `json:"embedding"`
-}
-
 func containsChinese(s string) bool {
 	for _, r := range s {
 		if unicode.Is(unicode.Han, r) {
@@ -91,61 +78,49 @@ func BuildBody(p SearchParams) *bytes.Buffer {
 	return &buf
 }
 
+// Embed embeds one text with the embedder selected in config.EmbeddingConfig.
+// Failures are returned as errors wrapping embedding.ErrUnavailable or
+// embedding.ErrInvalidResponse; no placeholder vector is ever returned.
 func Embed(ctx context.Context, text string) ([]float32, error) {
-	// 超时 3 秒
-	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
-	defer cancel()
-	appConfig := config.GetAppConfig()
-	embedServiceURL := appConfig.Embedding.Endpoint
-
-	reqBody, _ := json.Marshal(EmbeddingReq{Text: text})
-	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, embedServiceURL, bytes.NewReader(reqBody))
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := http.DefaultClient.Do(req)
+	embedder, err := embedding.Default()
 	if err != nil {
-		helperLogger.Errorw("embed service error", "err", err)
-		// 返回一个模长极小的非零向量
-		dummy := make([]float32, 1024)
-		dummy[0] = 0.1
-		return dummy, nil
-	}
-	defer func(Body io.ReadCloser) {
-		err := Body.Close()
-		if err != nil {
-			fmt.Printf("Error closing response body: %v", err)
-		}
-	}(resp.Body)
-	if resp.StatusCode != 200 {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("embed service %d: %s", resp.StatusCode, body)
-	}
-	var er EmbeddingResp
-	if err := json.NewDecoder(resp.Body).Decode(&er); err != nil {
 		return nil, err
 	}
-	// 简单校验维度
-	if len(er.Vector) != vectorDims {
-		return nil, fmt.Errorf("expect %d dims, got %d", vectorDims, len(er.Vector))
-	}
-	return er.Vector, nil
+	return requestEmbedding(ctx, embedder, text)
+}
+
+// requestEmbedding embeds one text, bounded by a 3 second timeout including retries.
+func requestEmbedding(ctx context.Context, embedder embedding.Embedder, text string) ([]float32, error) {
+	// 超时 3 秒
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+	return embedding.EmbedOne(ctx, embedder, text)
 }
 
 // MergeTextFields -------- 把多个字段拼出同一套 mapping --------
-func MergeTextFields(names []string) map[string]any {
+// suggest 中的字段额外带一个 search_as_you_type 子字段 <name>.suggest，供输入提示使用
+func MergeTextFields(names []string, suggest ...string) map[string]any {
 	out := make(map[string]any)
 	for _, f := range names {
+		fields := map[string]any{
+			"ngram": map[string]any{
+				"type":            "text",
+				"analyzer":        "cjk_ngram_analyzer",
+				"search_analyzer": "ik_cjk",
+			},
+		}
+		if slices.Contains(suggest, f) {
+			// standard 分词把汉字逐字切开，shingle 子字段再拼回 2、3 字词组，中英文都能按前缀补全
+			fields["suggest"] = map[string]any{
+				"type":     "search_as_you_type",
+				"analyzer": "suggest_analyzer",
+			}
+		}
 		out[f] = map[string]any{
 			"type":            "text",
 			"analyzer":        "ik_cjk",
 			"search_analyzer": "ik_cjk",
-			"fields": map[string]any{
-				"ngram": map[string]any{
-					"type":            "text",
-					"analyzer":        "cjk_ngram_analyzer",
-					"search_analyzer": "ik_cjk",
-				},
-			},
+			"fields":          fields,
 		}
 	}
 	return out
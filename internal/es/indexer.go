@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"strings"
+	"net/http"
+	"slices"
 	"time"
 
+	"api.us4ever/internal/config"
 	"api.us4ever/internal/database"
+	"api.us4ever/internal/embedding"
 	"api.us4ever/internal/ent"
 	"api.us4ever/internal/logger"
 	"github.com/elastic/go-elasticsearch/v8"
@@ -33,11 +37,69 @@ const ( // Constants for bulk indexing
 	bulkIndexAction = `{ "index" : { "_index" : "%s", "_id" : "%s" } }`
 	bulkFlushBytes  = 5 * 1024 * 1024 // Flush threshold 5MB
 	bulkFlushItems  = 1000            // Flush threshold 1000 items
+	// bulkMaxAttempts is how often a rejected document is sent, the first attempt included
+	bulkMaxAttempts = 5
+	// reindexPageSize is how many rows are loaded from the database at a time;
+	// a keep carries three vectors, so pages stay small
+	reindexPageSize = 200
+
+	// defaultMaxFailureRatio is the share of documents that may fail for a
+	// reindex to still switch the alias
+	defaultMaxFailureRatio = 0.01
 )
 
-// IndexKeeps fetches all Keep records from the database and indexes them into a new
-// Elasticsearch index, then atomically switches the alias to point to the new index.
-func IndexKeeps(ctx context.Context, client *elasticsearch.Client, dbService database.Service, aliasName string) error {
+var (
+	// bulkRetryBaseDelay is the wait before the first retry of rejected documents
+	bulkRetryBaseDelay = 500 * time.Millisecond
+	bulkRetryMaxDelay  = 30 * time.Second
+)
+
+// ErrTooManyFailures is returned when more documents failed to index than
+// the configured failure ratio allows.
+var ErrTooManyFailures = errors.New("too many documents failed to index")
+
+// ReindexOptions tune a single Reindex run.
+type ReindexOptions struct {
+	// Force switches the alias even when the new index fails the document count check
+	Force bool
+}
+
+// reindexConfig is the part of config.ESConfig used by Reindex, with defaults applied.
+type reindexConfig struct {
+	overrides       map[string]config.ESIndexConfig
+	retain          int
+	minDocRatio     float64
+	maxFailureRatio float64
+}
+
+func loadReindexConfig() reindexConfig {
+	cfg := reindexConfig{retain: defaultRetainIndices, minDocRatio: defaultMinDocRatio, maxFailureRatio: defaultMaxFailureRatio}
+	appConfig := config.GetAppConfig()
+	if appConfig == nil {
+		return cfg
+	}
+	cfg.overrides = appConfig.ES.Indices
+	if appConfig.ES.RetainIndices > 0 {
+		cfg.retain = appConfig.ES.RetainIndices
+	}
+	if appConfig.ES.MinDocRatio != 0 {
+		cfg.minDocRatio = appConfig.ES.MinDocRatio
+	}
+	if appConfig.ES.MaxFailureRatio != 0 {
+		cfg.maxFailureRatio = appConfig.ES.MaxFailureRatio
+	}
+	return cfg
+}
+
+// Reindex rebuilds the index of def behind aliasName: it streams every
+// document from the database into a new timestamped generation, checks that
+// it holds enough documents compared to the current one, then atomically
+// switches the alias to point to it. Older generations are kept up to the
+// configured retention so the swap can be rolled back. Documents rejected
+// for good are recorded as dead letters; they only fail the run when their
+// share exceeds the configured failure ratio.
+// job, when not nil, is kept up to date with the phase and progress.
+func Reindex(ctx context.Context, client *elasticsearch.Client, dbService database.Service, def *IndexDefinition, aliasName string, job *ReindexJob, opts ReindexOptions) error {
 	if client == nil {
 		return fmt.Errorf("elasticsearch client is not initialized")
 	}
@@ -49,62 +111,24 @@ func IndexKeeps(ctx context.Context, client *elasticsearch.Client, dbService dat
 	}
 
 	indexerLogger.Info("starting re-indexing process",
+		zap.String("index", def.Name),
 		zap.String("alias", aliasName),
 	)
 
 	// 1. Create a new index with a timestamp
-	newIndexName := fmt.Sprintf("%s_%s", aliasName, time.Now().Format("20060102150405"))
+	job.setPhase(PhaseCreatingIndex)
+	newIndexName := generationName(aliasName, time.Now())
 	indexerLogger.Info("creating new index",
 		zap.String("index_name", newIndexName),
 	)
 
-	mapping := map[string]any{
-		"settings": map[string]any{
-			"number_of_shards":   3,
-			"number_of_replicas": 0,
-			"max_ngram_diff":     2,
-			"analysis": map[string]any{
-				"tokenizer": map[string]any{
-					"cjk_ngram": map[string]any{
-						"type":     "ngram",
-						"min_gram": 2,
-						"max_gram": 4,
-					},
-				},
-				"analyzer": map[string]any{
-					"ik_cjk": map[string]any{
-						"tokenizer": "ik_max_word",
-					},
-					"cjk_ngram_analyzer": map[string]any{
-						"tokenizer": "cjk_ngram",
-						"filter":    []string{"lowercase"},
-					},
-				},
-			},
-		},
-		"mappings": map[string]any{
-			"properties": MergeTextFields([]string{"title", "summary", "content"}),
-		},
-	}
-
-	// 向量字段统一追加
-	vecFields := map[string]string{
-		"title_vector":   "title_vector",
-		"summary_vector": "summary_vector",
-		"content_vector": "content_vector",
-	}
-	props := mapping["mappings"].(map[string]any)["properties"].(map[string]any)
-	for name := range vecFields {
-		props[name] = map[string]any{
-			"type":       "dense_vector",
-			"dims":       vectorDims,
-			"index":      true,
-			"similarity": "cosine",
-		}
+	cfg := loadReindexConfig()
+	// 向量字段维度取自当前配置的向量模型
+	body, err := json.Marshal(def.indexBody(embedding.Dimensions(), cfg.overrides))
+	if err != nil {
+		return fmt.Errorf("failed to marshal index mapping: %w", err)
 	}
 
-	body, _ := json.Marshal(mapping)
-
 	res, err := client.Indices.Create(
 		newIndexName,
 		client.Indices.Create.WithContext(ctx),
@@ -132,45 +156,37 @@ func IndexKeeps(ctx context.Context, client *elasticsearch.Client, dbService dat
 	if err != nil {
 		return err
 	} // Close successful response body
+	// 从此刻起搜索同步也写入新索引，切换别名时不会丢失期间的变更
+	job.setTarget(newIndexName)
 
-	// 2. Fetch data from the database
-	indexerLogger.Info("fetching keeps from database")
-	keeps, err := dbService.GetAllKeeps(ctx)
-	if err != nil {
-		// Consider deleting the newly created index if DB fetch fails
-		_, delErr := client.Indices.Delete([]string{newIndexName}, client.Indices.Delete.WithContext(ctx))
-		if delErr != nil {
-			indexerLogger.Error("failed to delete temporary index after DB error",
-				zap.String("index_name", newIndexName),
-				zap.Error(delErr),
-			)
-		}
-		return fmt.Errorf("failed to fetch keeps from database: %w", err)
-	}
-	indexerLogger.Info("fetched keeps from database",
-		zap.Int("count", len(keeps)),
-	)
-
-	// 3. Bulk index the data
+	// 2. Stream documents from the database into the new index, page by page
+	job.setPhase(PhaseIndexing)
 	indexerLogger.Info("starting bulk indexing",
 		zap.String("index_name", newIndexName),
 	)
-	if err := bulkIndexKeeps(ctx, client, newIndexName, keeps); err != nil {
-		// Consider deleting the newly created index if bulk indexing fails
-		_, delErr := client.Indices.Delete([]string{newIndexName}, client.Indices.Delete.WithContext(ctx))
-		if delErr != nil {
-			indexerLogger.Error("failed to delete temporary index after bulk index error",
-				zap.String("index_name", newIndexName),
-				zap.Error(delErr),
-			)
-		}
+	failures, err := bulkIndex(ctx, client, newIndexName, dbService, def, job, cfg.maxFailureRatio)
+	recordDeadLetters(ctx, dbService.Client(), def, newIndexName, job, failures)
+	if err != nil {
+		job.setTarget("")
+		deleteIndex(ctx, client, newIndexName)
 		return fmt.Errorf("bulk indexing failed: %w", err)
 	}
 	indexerLogger.Info("bulk indexing completed successfully",
 		zap.String("index_name", newIndexName),
 	)
 
+	// 3. Refuse to switch to an index that lost documents, unless forced
+	job.setPhase(PhaseVerifying)
+	if !opts.Force {
+		if err := checkDocumentCount(ctx, client, aliasName, newIndexName, cfg.minDocRatio); err != nil {
+			job.setTarget("")
+			deleteIndex(ctx, client, newIndexName)
+			return err
+		}
+	}
+
 	// 4. Atomically update the alias
+	job.setPhase(PhaseSwitchingAlias)
 	indexerLogger.Info("updating alias to point to new index",
 		zap.String("alias", aliasName),
 		zap.String("index_name", newIndexName),
@@ -184,15 +200,8 @@ func IndexKeeps(ctx context.Context, client *elasticsearch.Client, dbService dat
 		zap.String("alias", aliasName),
 	)
 
-	// 5. Delete old indices (run in background, log errors)
-	go func() {
-		if err := deleteOldIndices(context.Background(), client, aliasName, newIndexName); err != nil {
-			// Error is already logged within deleteOldIndices or the function returned nil on logged error
-			indexerLogger.Error("background deletion of old indices encountered an issue",
-				zap.Error(err),
-			) // Log any unexpected error return
-		}
-	}()
+	// 5. Delete the generations beyond the retention; failures are only logged
+	pruneGenerations(context.WithoutCancel(ctx), client, aliasName, newIndexName, cfg.retain)
 
 	indexerLogger.Info("re-indexing process completed successfully",
 		zap.String("alias", aliasName),
@@ -200,87 +209,244 @@ func IndexKeeps(ctx context.Context, client *elasticsearch.Client, dbService dat
 	return nil
 }
 
-// bulkIndexKeeps performs bulk indexing of Keep documents.
-func bulkIndexKeeps(ctx context.Context, client *elasticsearch.Client, indexName string, keeps []*ent.Keep) error {
-	var ( // Bulk buffer and counters
-		buf    bytes.Buffer
-		numOps int
-	)
+// bulkIndex streams the documents of def into indexName, one database page at
+// a time. It returns the documents that could not be written; the run only
+// fails on them when their share exceeds maxFailureRatio.
+func bulkIndex(ctx context.Context, client *elasticsearch.Client, indexName string, dbService database.Service, def *IndexDefinition, job *ReindexJob, maxFailureRatio float64) ([]BulkFailure, error) {
+	w := &bulkWriter{client: client, indexName: indexName}
+	err := def.Load(ctx, dbService, reindexPageSize, func(docs []Document, progress database.Progress) error {
+		for _, doc := range docs {
+			if err := w.add(ctx, doc.ID, doc.Source); err != nil {
+				return err
+			}
+		}
+		logProgress(indexName, progress)
+		job.setProgress(progress)
+		job.setFailed(len(w.failures))
+		return nil
+	})
+	if err != nil {
+		return w.failures, err
+	}
+	// 取消发生在最后一页之后时也不能切换别名
+	if err := ctx.Err(); err != nil {
+		return w.failures, err
+	}
+	if err := w.close(ctx); err != nil {
+		return w.failures, err
+	}
+	job.setFailed(len(w.failures))
 
-	for _, keep := range keeps {
-		// Prepare meta line (action and metadata)
-		meta := fmt.Sprintf(bulkIndexAction, indexName, keep.ID)
-		buf.WriteString(meta)
-		buf.WriteByte('\n')
+	total := w.written + len(w.failures)
+	if tooManyFailures(len(w.failures), total, maxFailureRatio) {
+		return w.failures, fmt.Errorf("%w: %d of %d documents failed (maximum ratio %.3f)",
+			ErrTooManyFailures, len(w.failures), total, max(maxFailureRatio, 0))
+	}
+	if len(w.failures) > 0 {
+		indexerLogger.Warn("bulk indexing completed with failed documents",
+			zap.String("index_name", indexName),
+			zap.Int("failed", len(w.failures)),
+			zap.Int("total", total),
+		)
+	}
+	return w.failures, nil
+}
 
-		title := keep.Title
-		summary := keep.Summary
-		content := keep.Content
-		titleVector := keep.TitleVector
-		summaryVector := keep.SummaryVector
-		contentVector := keep.ContentVector
-
-		// Prepare data line (document source)
-		// Convert ent.Keep to a suitable map/struct for JSON marshalling
-		// Only include fields relevant for search (title, summary, content)
-		doc := map[string]interface{}{
-			"title":   title,   // Assuming ent.Keep has these fields
-			"summary": summary, // Adjust field names as necessary
-			"content": content,
-			// Add other fields if needed for search or display
-			"title_vector":   titleVector,
-			"summary_vector": summaryVector,
-			"content_vector": contentVector,
-		}
-		data, err := json.Marshal(doc)
-		if err != nil {
-			indexerLogger.Error("error marshalling keep document",
-				zap.String("keep_id", keep.ID),
-				zap.Error(err),
-			)
-			buf.Reset() // Clear the buffer for this item
-			continue
-		}
-		buf.Write(data)
-		buf.WriteByte('\n')
+// tooManyFailures reports whether failed out of total documents exceeds
+// maxRatio. A negative maxRatio tolerates no failure at all.
+func tooManyFailures(failed, total int, maxRatio float64) bool {
+	return failed > 0 && float64(failed) > float64(total)*max(maxRatio, 0)
+}
 
-		numOps++
+// BulkFailure is a document a bulk write gave up on.
+type BulkFailure struct {
+	ID       string
+	Status   int
+	Type     string
+	Reason   string
+	Attempts int
+}
 
-		// Flush buffer if thresholds reached
-		if buf.Len() > bulkFlushBytes || numOps >= bulkFlushItems {
-			if err := flushBulkBuffer(ctx, client, &buf); err != nil {
-				return err // Propagate error up
-			}
-			numOps = 0 // Reset counter
-		}
+// bulkItem is one buffered document.
+type bulkItem struct {
+	id   string
+	data []byte
+}
+
+// bulkItemResult is the outcome of one item of a bulk request.
+type bulkItemResult struct {
+	status  int
+	errType string
+	reason  string
+}
+
+// bulkWriter buffers index actions and flushes them in bulk requests. Items
+// rejected with a retryable status are resent with exponential backoff;
+// the others are collected in failures.
+type bulkWriter struct {
+	client    *elasticsearch.Client
+	indexName string
+	items     []bulkItem
+	size      int
+	written   int
+	failures  []BulkFailure
+}
+
+// add appends one document, flushing when the buffer reaches a threshold.
+func (w *bulkWriter) add(ctx context.Context, id string, doc map[string]any) error {
+	// Prepare data line (document source)
+	data, err := json.Marshal(doc)
+	if err != nil {
+		indexerLogger.Error("error marshalling document",
+			zap.String("index_name", w.indexName),
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		return nil // Skip this document
 	}
 
+	w.items = append(w.items, bulkItem{id: id, data: data})
+	w.size += len(data)
+
+	// Flush buffer if thresholds reached
+	if w.size > bulkFlushBytes || len(w.items) >= bulkFlushItems {
+		return w.flush(ctx)
+	}
+	return nil
+}
+
+// close flushes the remaining documents and refreshes the index.
+func (w *bulkWriter) close(ctx context.Context) error {
 	// Flush any remaining items in the buffer
-	if buf.Len() > 0 {
-		if err := flushBulkBuffer(ctx, client, &buf); err != nil {
+	if len(w.items) > 0 {
+		if err := w.flush(ctx); err != nil {
 			return err
 		}
 	}
 
 	// Refresh the index to make changes searchable immediately
-	_, err := client.Indices.Refresh(client.Indices.Refresh.WithContext(ctx), client.Indices.Refresh.WithIndex(indexName))
+	_, err := w.client.Indices.Refresh(w.client.Indices.Refresh.WithContext(ctx), w.client.Indices.Refresh.WithIndex(w.indexName))
 	if err != nil {
 		indexerLogger.Warn("failed to refresh index after bulk indexing",
-			zap.String("index_name", indexName),
+			zap.String("index_name", w.indexName),
 			zap.Error(err),
 		)
 		// Don't fail the whole process, but log the warning
 	}
+	return nil
+}
 
+// flush sends the buffered items, retrying the ones rejected with a
+// retryable status until they succeed or bulkMaxAttempts is reached.
+func (w *bulkWriter) flush(ctx context.Context) error {
+	pending := w.items
+	w.items, w.size = nil, 0
+
+	for attempt := 1; len(pending) > 0; attempt++ {
+		if attempt > 1 {
+			delay := bulkRetryDelay(attempt - 1)
+			indexerLogger.Warn("retrying rejected bulk items",
+				zap.String("index_name", w.indexName),
+				zap.Int("items", len(pending)),
+				zap.Int("attempt", attempt),
+				zap.Duration("delay", delay),
+			)
+			if err := sleepContext(ctx, delay); err != nil {
+				return err
+			}
+		}
+
+		results, err := flushBulkBuffer(ctx, w.client, w.indexName, pending)
+		if err != nil {
+			return err
+		}
+
+		var retry []bulkItem
+		for i, item := range pending {
+			result := results[i]
+			switch {
+			case result.status < http.StatusMultipleChoices:
+				w.written++
+			case retryableStatus(result.status) && attempt < bulkMaxAttempts:
+				retry = append(retry, item)
+			default:
+				indexerLogger.Error("bulk index item error",
+					zap.String("index", w.indexName),
+					zap.String("id", item.id),
+					zap.Int("status", result.status),
+					zap.String("type", result.errType),
+					zap.String("reason", result.reason),
+					zap.Int("attempts", attempt),
+				)
+				w.failures = append(w.failures, BulkFailure{
+					ID:       item.id,
+					Status:   result.status,
+					Type:     result.errType,
+					Reason:   result.reason,
+					Attempts: attempt,
+				})
+			}
+		}
+		pending = retry
+	}
 	return nil
 }
 
-// flushBulkBuffer sends the bulk request to Elasticsearch.
-func flushBulkBuffer(ctx context.Context, client *elasticsearch.Client, buf *bytes.Buffer) error {
-	res, err := client.Bulk(bytes.NewReader(buf.Bytes()), client.Bulk.WithContext(ctx))
-	buf.Reset() // Reset buffer regardless of outcome
+// retryableStatus reports whether a rejected item may succeed when resent:
+// the cluster was overloaded or a node was briefly unavailable.
+func retryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// bulkRetryDelay doubles the wait after every retry, up to bulkRetryMaxDelay.
+func bulkRetryDelay(retry int) time.Duration {
+	delay := bulkRetryBaseDelay
+	for i := 1; i < retry && delay < bulkRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, bulkRetryMaxDelay)
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func logProgress(indexName string, progress database.Progress) {
+	indexerLogger.Info("reindex progress",
+		zap.String("index_name", indexName),
+		zap.Int("done", progress.Done),
+		zap.Int("total", progress.Total),
+	)
+}
+
+// flushBulkBuffer sends items to Elasticsearch in one bulk request and returns
+// the result of every item, in order. A request rejected as a whole with a
+// retryable status gives that status to every item, so they are all retried.
+func flushBulkBuffer(ctx context.Context, client *elasticsearch.Client, indexName string, items []bulkItem) ([]bulkItemResult, error) {
+	var buf bytes.Buffer
+	for _, item := range items {
+		// Prepare meta line (action and metadata) and data line
+		buf.WriteString(fmt.Sprintf(bulkIndexAction, indexName, item.id))
+		buf.WriteByte('\n')
+		buf.Write(item.data)
+		buf.WriteByte('\n')
+	}
+
+	res, err := client.Bulk(&buf, client.Bulk.WithContext(ctx))
 	if err != nil {
-		return fmt.Errorf("bulk request failed: %w", err)
+		return nil, fmt.Errorf("bulk request failed: %w", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -293,35 +459,42 @@ func flushBulkBuffer(ctx context.Context, client *elasticsearch.Client, buf *byt
 
 	bodyBytes, readErr := io.ReadAll(res.Body)
 	if readErr != nil {
-		return fmt.Errorf("failed to read bulk response body: %w", readErr)
+		return nil, fmt.Errorf("failed to read bulk response body: %w", readErr)
 	}
 
+	results := make([]bulkItemResult, len(items))
 	if res.IsError() {
-		return fmt.Errorf("bulk request returned error: [%s] %s", res.Status(), string(bodyBytes))
+		if !retryableStatus(res.StatusCode) {
+			return nil, fmt.Errorf("bulk request returned error: [%s] %s", res.Status(), string(bodyBytes))
+		}
+		for i := range results {
+			results[i] = bulkItemResult{status: res.StatusCode, errType: "bulk_rejected", reason: res.Status()}
+		}
+		return results, nil
 	}
 
 	// Check for item-level errors in the bulk response
 	jsonResponse := string(bodyBytes)
-	if gjson.Get(jsonResponse, "errors").Bool() {
-		var errorCount int
-		gjson.Get(jsonResponse, "items").ForEach(func(key, value gjson.Result) bool {
-			if value.Get("index.error").Exists() {
-				errorCount++
-				indexerLogger.Error("bulk index item error",
-					zap.String("index", value.Get("index._index").String()),
-					zap.String("id", value.Get("index._id").String()),
-					zap.Int64("status", value.Get("index.status").Int()),
-					zap.String("type", value.Get("index.error.type").String()),
-					zap.String("reason", value.Get("index.error.reason").String()),
-				)
-			}
-			return true // continue iterating
-		})
-		return fmt.Errorf("bulk request completed with %d item errors (see logs for details)", errorCount)
+	if !gjson.Get(jsonResponse, "errors").Bool() {
+		for i := range results {
+			results[i].status = http.StatusOK
+		}
+		indexerLogger.Info("bulk buffer flushed successfully")
+		return results, nil
 	}
 
-	indexerLogger.Info("bulk buffer flushed successfully")
-	return nil
+	responseItems := gjson.Get(jsonResponse, "items").Array()
+	if len(responseItems) != len(items) {
+		return nil, fmt.Errorf("bulk response has %d items for %d documents", len(responseItems), len(items))
+	}
+	for i, value := range responseItems {
+		results[i] = bulkItemResult{
+			status:  int(value.Get("index.status").Int()),
+			errType: value.Get("index.error.type").String(),
+			reason:  value.Get("index.error.reason").String(),
+		}
+	}
+	return results, nil
 }
 
 // updateAlias atomically switches the alias to point to the new index.
@@ -373,308 +546,52 @@ func updateAlias(ctx context.Context, client *elasticsearch.Client, aliasName, n
 	return nil
 }
 
-// deleteOldIndices finds and deletes indices matching the alias pattern, excluding the currently active one.
-func deleteOldIndices(ctx context.Context, client *elasticsearch.Client, aliasName, currentIndexName string) error {
-	indexerLogger.Info("starting cleanup of old indices",
-		zap.String("alias", aliasName),
-		zap.String("current_index_name", currentIndexName),
-	)
-
-	// Pattern to match indices for this alias
-	indexPattern := fmt.Sprintf("%s_*", aliasName)
-
-	// Use Cat Indices API to get a list of indices matching the pattern
-	res, err := client.Cat.Indices(client.Cat.Indices.WithIndex(indexPattern), client.Cat.Indices.WithContext(ctx), client.Cat.Indices.WithH("index"))
-	if err != nil {
-		return fmt.Errorf("failed to list indices with pattern %s: %w", indexPattern, err)
-	}
-	defer res.Body.Close()
-
-	if res.IsError() {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to list indices with pattern %s: [%s] %s", indexPattern, res.Status(), string(bodyBytes))
-	}
-
-	bodyBytes, readErr := io.ReadAll(res.Body)
-	if readErr != nil {
-		return fmt.Errorf("failed to read indices list response body: %w", readErr)
-	}
-
-	var indicesToDelete []string
-	lines := strings.Split(strings.TrimSpace(string(bodyBytes)), "\n")
-	for _, line := range lines {
-		indexName := strings.TrimSpace(line)
-		if indexName != "" && indexName != currentIndexName {
-			indicesToDelete = append(indicesToDelete, indexName)
-		}
-	}
-
-	if len(indicesToDelete) == 0 {
-		indexerLogger.Info("no old indices found to delete")
-		return nil
-	}
-
-	indexerLogger.Info("found old indices to delete",
-		zap.Int("count", len(indicesToDelete)),
-		zap.Strings("indices", indicesToDelete),
-	)
-
-	// Delete the old indices
-	delRes, err := client.Indices.Delete(indicesToDelete, client.Indices.Delete.WithContext(ctx), client.Indices.Delete.WithIgnoreUnavailable(true))
+// deleteIndex removes a generation that will not be switched to. ctx may
+// already be cancelled, so the request runs without its cancellation.
+func deleteIndex(ctx context.Context, client *elasticsearch.Client, indexName string) {
+	res, err := client.Indices.Delete([]string{indexName}, client.Indices.Delete.WithContext(context.WithoutCancel(ctx)))
 	if err != nil {
-		return fmt.Errorf("failed to submit delete request for old indices: %w", err)
-	}
-	defer delRes.Body.Close()
-
-	if delRes.IsError() {
-		delBodyBytes, _ := io.ReadAll(delRes.Body)
-		// Log error but don't fail the whole indexing process just because cleanup failed
-		indexerLogger.Warn("failed to delete old indices",
-			zap.String("status", delRes.Status()),
-			zap.String("response", string(delBodyBytes)),
+		indexerLogger.Error("failed to delete temporary index",
+			zap.String("index_name", indexName),
+			zap.Error(err),
 		)
-		return nil // Don't return error, just log it
+		return
 	}
-
-	indexerLogger.Info("successfully deleted old indices",
-		zap.Int("count", len(indicesToDelete)),
-	)
-	return nil
+	_ = res.Body.Close()
 }
 
-// IndexMoments fetches all Moment records from the database and indexes them into a new
-// Elasticsearch index, then atomically switches the alias to point to the new index.
-func IndexMoments(ctx context.Context, client *elasticsearch.Client, dbService database.Service, aliasName string) error {
-	if client == nil {
-		return fmt.Errorf("elasticsearch client is not initialized")
-	}
-	if dbService == nil {
-		return fmt.Errorf("database service is not initialized")
-	}
-	if aliasName == "" {
-		return fmt.Errorf("index alias name is required")
-	}
-
-	indexerLogger.Info("starting re-indexing process for moments",
-		zap.String("alias", aliasName),
-	)
-
-	// 1. Create a new index with a timestamp
-	newIndexName := fmt.Sprintf("%s_%s", aliasName, time.Now().Format("20060102150405"))
-
-	mapping := map[string]any{
-		"settings": map[string]any{
-			"number_of_shards":   3,
-			"number_of_replicas": 0,
-			"max_ngram_diff":     2,
-			"analysis": map[string]any{
-				"tokenizer": map[string]any{
-					"cjk_ngram": map[string]any{
-						"type":     "ngram",
-						"min_gram": 2,
-						"max_gram": 4,
-					},
-				},
-				"analyzer": map[string]any{
-					"ik_cjk": map[string]any{
-						"tokenizer": "ik_max_word",
-					},
-					"cjk_ngram_analyzer": map[string]any{
-						"tokenizer": "cjk_ngram",
-						"filter":    []string{"lowercase"},
-					},
-				},
-			},
-		},
-		"mappings": map[string]any{
-			"properties": MergeTextFields([]string{"content"}),
-		},
-	}
-
-	// 向量字段统一追加
-	vecFields := map[string]string{
-		"content_vector": "content_vector",
-	}
-	props := mapping["mappings"].(map[string]any)["properties"].(map[string]any)
-	for name := range vecFields {
-		props[name] = map[string]any{
-			"type":       "dense_vector",
-			"dims":       vectorDims,
-			"index":      true,
-			"similarity": "cosine",
+// recordDeadLetters stores the documents a reindex gave up on, so they can be
+// inspected and repaired. Failures to store them are logged only; the
+// documents were already logged one by one.
+func recordDeadLetters(ctx context.Context, client *ent.Client, def *IndexDefinition, indexName string, job *ReindexJob, failures []BulkFailure) {
+	if len(failures) == 0 {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	for chunk := range slices.Chunk(failures, bulkFlushItems) {
+		builders := make([]*ent.IndexDeadLetterCreate, 0, len(chunk))
+		for _, f := range chunk {
+			builders = append(builders, client.IndexDeadLetter.Create().
+				SetIndex(def.Name).
+				SetIndexName(indexName).
+				SetEntityId(f.ID).
+				SetJobId(job.ID()).
+				SetStatus(f.Status).
+				SetErrorType(f.Type).
+				SetReason(f.Reason).
+				SetAttempts(f.Attempts))
 		}
-	}
-
-	body, _ := json.Marshal(mapping)
-
-	indexerLogger.Info("creating new index for moments",
-		zap.String("index_name", newIndexName),
-	)
-	res, err := client.Indices.Create(
-		newIndexName,
-		client.Indices.Create.WithContext(ctx),
-		client.Indices.Create.WithBody(bytes.NewReader(body)),
-	)
-	if err != nil {
-		return fmt.Errorf("cannot create index %s: %w", newIndexName, err)
-	}
-	if res.IsError() {
-		defer func(Body io.ReadCloser) {
-			err := Body.Close()
-			if err != nil {
-				indexerLogger.Error("error closing response body",
-					zap.Error(err),
-				)
-			}
-		}(res.Body)
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("cannot create index %s: [%s] %s", newIndexName, res.Status(), string(bodyBytes))
-	}
-	indexerLogger.Info("index created successfully for moments",
-		zap.String("index_name", newIndexName),
-	)
-	err = res.Body.Close()
-	if err != nil {
-		return err
-	} // Close successful response body
-
-	// 2. Fetch data from the database with eager loading of images and their descriptions
-	indexerLogger.Info("fetching moments from the database")
-	moments, err := dbService.GetAllMoments(ctx)
-	if err != nil {
-		// 如果获取失败，删除刚创建的索引
-		_, delErr := client.Indices.Delete([]string{newIndexName}, client.Indices.Delete.WithContext(ctx))
-		if delErr != nil {
-			indexerLogger.Error("failed to delete temporary index after DB error",
-				zap.String("index_name", newIndexName),
-				zap.Error(delErr),
-			)
-		}
-		return fmt.Errorf("failed to fetch moments from database: %w", err)
-	}
-	indexerLogger.Info("fetched moments from the database",
-		zap.Int("count", len(moments)),
-	)
-
-	// 3. Index the data
-	indexerLogger.Info("indexing moments into the new index")
-	if err := bulkIndexMoments(ctx, client, newIndexName, moments); err != nil {
-		// 如果批量索引失败，删除刚创建的索引
-		_, delErr := client.Indices.Delete([]string{newIndexName}, client.Indices.Delete.WithContext(ctx))
-		if delErr != nil {
-			indexerLogger.Error("failed to delete temporary index after bulk index error",
-				zap.String("index_name", newIndexName),
-				zap.Error(delErr),
-			)
-		}
-		return fmt.Errorf("failed to index moments: %w", err)
-	}
-
-	// 4. Update alias to point to new index
-	indexerLogger.Info("updating alias to point to new index",
-		zap.String("alias", aliasName),
-		zap.String("index_name", newIndexName),
-	)
-	// 使用封装的 updateAlias 函数替代自定义实现
-	if err := updateAlias(ctx, client, aliasName, newIndexName); err != nil {
-		return fmt.Errorf("failed to update alias %s: %w", aliasName, err)
-	}
-	indexerLogger.Info("alias updated successfully for moments",
-		zap.String("alias", aliasName),
-	)
-
-	// 5. Delete old indices (run in background, log errors)
-	go func() {
-		if err := deleteOldIndices(context.Background(), client, aliasName, newIndexName); err != nil {
-			indexerLogger.Error("background deletion of old moment indices encountered an issue",
-				zap.Error(err),
-			)
-		}
-	}()
-
-	indexerLogger.Info("re-indexing process for moments completed successfully",
-		zap.String("alias", aliasName),
-	)
-	return nil
-}
-
-// bulkIndexMoments performs bulk indexing of Moment documents.
-func bulkIndexMoments(ctx context.Context, client *elasticsearch.Client, indexName string, moments []*ent.Moment) error {
-	var ( // Bulk buffer and counters
-		buf    bytes.Buffer
-		numOps int
-	)
-
-	for _, moment := range moments {
-		// Prepare meta line (action and metadata)
-		meta := fmt.Sprintf(bulkIndexAction, indexName, moment.ID)
-		buf.WriteString(meta)
-		buf.WriteByte('\n')
-
-		// Collect image data if available
-		var images []map[string]interface{}
-		if moment.Edges.MomentImages != nil {
-			for _, mi := range moment.Edges.MomentImages {
-				if mi.Edges.Image != nil {
-					img := map[string]interface{}{
-						"id":          mi.Edges.Image.ID,
-						"description": mi.Edges.Image.Description,
-					}
-					images = append(images, img)
-				}
-			}
-		}
-
-		content := moment.Content
-		contentVector := moment.ContentVector
-
-		// Prepare data line (document source)
-		doc := map[string]interface{}{
-			"id":      moment.ID,
-			"content": content,
-			"images":  images,
-			// Add other fields if needed for search or display
-			"content_vector": contentVector,
-		}
-		data, err := json.Marshal(doc)
-		if err != nil {
-			indexerLogger.Error("error marshalling moment document",
-				zap.String("moment_id", moment.ID),
+		if err := client.IndexDeadLetter.CreateBulk(builders...).Exec(ctx); err != nil {
+			indexerLogger.Error("failed to record dead letters",
+				zap.String("index_name", indexName),
+				zap.Int("documents", len(chunk)),
 				zap.Error(err),
 			)
-			buf.Reset() // Clear the buffer for this item
-			continue
-		}
-		buf.Write(data)
-		buf.WriteByte('\n')
-
-		numOps++
-
-		// Flush buffer if thresholds reached
-		if buf.Len() > bulkFlushBytes || numOps >= bulkFlushItems {
-			if err := flushBulkBuffer(ctx, client, &buf); err != nil {
-				return err // Propagate error up
-			}
-			numOps = 0 // Reset counter
+			return
 		}
 	}
-
-	// Flush any remaining items in the buffer
-	if buf.Len() > 0 {
-		if err := flushBulkBuffer(ctx, client, &buf); err != nil {
-			return err
-		}
-	}
-
-	// Refresh the index to make changes searchable immediately
-	_, err := client.Indices.Refresh(client.Indices.Refresh.WithIndex(indexName))
-	if err != nil {
-		indexerLogger.Warn("failed to refresh index after bulk indexing",
-			zap.String("index_name", indexName),
-			zap.Error(err),
-		)
-		// Don't fail the whole process, but log the warning
-	}
-
-	return nil
+	indexerLogger.Info("recorded dead letters",
+		zap.String("index_name", indexName),
+		zap.Int("documents", len(failures)),
+	)
 }
@@ -0,0 +1,195 @@
+package es
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/ent"
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/tidwall/gjson"
+)
+
+// pagedService hands out keeps in fixed pages, like Database.KeepPages.
+type pagedService struct {
+	database.Service
+	keeps []*ent.Keep
+}
+
+func (s *pagedService) KeepPages(ctx context.Context, pageSize int, fn func(page []*ent.Keep, progress database.Progress) error) error {
+	progress := database.Progress{Total: len(s.keeps)}
+	for start := 0; start < len(s.keeps); start += pageSize {
+		page := s.keeps[start:min(start+pageSize, len(s.keeps))]
+		progress.Done += len(page)
+		if err := fn(page, progress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pagedKeepIndex indexes the keeps of a pagedService, which has no ent
+// client to load their chunks from.
+var pagedKeepIndex = &IndexDefinition{
+	Name: KeepIndex.Name,
+	Type: KeepIndex.Type,
+	Load: LoadPages(database.Service.KeepPages, func(k *ent.Keep) Document {
+		return Document{ID: k.ID, Source: KeepDocument(k, nil)}
+	}),
+}
+
+// newTestClient returns a client talking to handler, which sees every request.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *elasticsearch.Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+		handler(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{srv.URL}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestBulkIndex_StreamsAllPages(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		indexed []string
+	)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/_bulk") {
+			mu.Lock()
+			scanner := bufio.NewScanner(r.Body)
+			scanner.Buffer(nil, bulkFlushBytes*2)
+			for scanner.Scan() {
+				if id := gjson.Get(scanner.Text(), "index._id"); id.Exists() {
+					indexed = append(indexed, id.String())
+				}
+			}
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"errors":false,"items":[]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	keeps := make([]*ent.Keep, reindexPageSize*2+1)
+	for i := range keeps {
+		keeps[i] = &ent.Keep{ID: fmt.Sprintf("keep-%03d", i)}
+	}
+
+	if _, err := bulkIndex(context.Background(), client, "keeps_test", &pagedService{keeps: keeps}, pagedKeepIndex, nil, 0); err != nil {
+		t.Fatal(err)
+	}
+	if len(indexed) != len(keeps) {
+		t.Fatalf("expected %d documents to be indexed, got %d", len(keeps), len(indexed))
+	}
+	for i, k := range keeps {
+		if indexed[i] != k.ID {
+			t.Fatalf("document %d: expected %s, got %s", i, k.ID, indexed[i])
+		}
+	}
+}
+
+// bulkResponse answers a bulk request with one item per document; status
+// returns the status of each document ID.
+func bulkResponse(w http.ResponseWriter, r *http.Request, status func(id string) int) {
+	var items []string
+	hasErrors := false
+	scanner := bufio.NewScanner(r.Body)
+	scanner.Buffer(nil, bulkFlushBytes*2)
+	for scanner.Scan() {
+		id := gjson.Get(scanner.Text(), "index._id")
+		if !id.Exists() {
+			continue
+		}
+		code := status(id.String())
+		item := fmt.Sprintf(`{"index":{"_id":%q,"status":%d}}`, id.String(), code)
+		if code >= 300 {
+			hasErrors = true
+			item = fmt.Sprintf(`{"index":{"_id":%q,"status":%d,"error":{"type":"test_error","reason":"rejected"}}}`, id.String(), code)
+		}
+		items = append(items, item)
+	}
+	_, _ = fmt.Fprintf(w, `{"errors":%t,"items":[%s]}`, hasErrors, strings.Join(items, ","))
+}
+
+func TestBulkIndex_RetriesRejectedItems(t *testing.T) {
+	bulkRetryBaseDelay = time.Millisecond
+	t.Cleanup(func() { bulkRetryBaseDelay = 500 * time.Millisecond })
+
+	attempts := make(map[string]int)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/_bulk") {
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		bulkResponse(w, r, func(id string) int {
+			attempts[id]++
+			switch {
+			// 第一次被限流，重试后成功
+			case id == "keep-1" && attempts[id] == 1:
+				return http.StatusTooManyRequests
+			case id == "keep-2":
+				return http.StatusBadRequest
+			case id == "keep-3":
+				return http.StatusServiceUnavailable
+			}
+			return http.StatusCreated
+		})
+	})
+
+	keeps := make([]*ent.Keep, 300)
+	for i := range keeps {
+		keeps[i] = &ent.Keep{ID: fmt.Sprintf("keep-%d", i)}
+	}
+
+	failures, err := bulkIndex(context.Background(), client, "keeps_test", &pagedService{keeps: keeps}, pagedKeepIndex, nil, 0.01)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attempts["keep-1"] != 2 {
+		t.Errorf("expected keep-1 to be retried once, got %d attempts", attempts["keep-1"])
+	}
+	if attempts["keep-2"] != 1 {
+		t.Errorf("expected a permanent error not to be retried, got %d attempts", attempts["keep-2"])
+	}
+	if attempts["keep-3"] != bulkMaxAttempts {
+		t.Errorf("expected keep-3 to be sent %d times, got %d", bulkMaxAttempts, attempts["keep-3"])
+	}
+	if len(failures) != 2 || failures[0].ID != "keep-2" || failures[1].ID != "keep-3" {
+		t.Fatalf("expected keep-2 and keep-3 to fail, got %+v", failures)
+	}
+	if failures[1].Status != http.StatusServiceUnavailable || failures[1].Attempts != bulkMaxAttempts {
+		t.Errorf("expected the last rejection to be recorded, got %+v", failures[1])
+	}
+
+	// 2/300 超过 0.5% 的阈值
+	if _, err := bulkIndex(context.Background(), client, "keeps_test", &pagedService{keeps: keeps}, pagedKeepIndex, nil, 0.005); !errors.Is(err, ErrTooManyFailures) {
+		t.Errorf("expected ErrTooManyFailures, got %v", err)
+	}
+}
+
+func TestTooManyFailures(t *testing.T) {
+	if tooManyFailures(0, 100, -1) {
+		t.Error("expected no failure to always pass")
+	}
+	if !tooManyFailures(1, 100, -1) {
+		t.Error("expected a negative ratio to tolerate no failure")
+	}
+	if tooManyFailures(1, 100, 0.01) || !tooManyFailures(2, 100, 0.01) {
+		t.Error("expected 1% of the documents to be the threshold")
+	}
+}
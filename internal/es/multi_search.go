@@ -0,0 +1,241 @@
+package es
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"go.uber.org/zap"
+)
+
+const (
+	// Entity types returned in unified search results
+	TypeKeep    = "keep"
+	TypeMoment  = "moment"
+	TypeMindmap = "mindmap"
+	TypeTodo    = "todo"
+	TypeImage   = "image"
+	TypeVideo   = "video"
+
+	// rrfRankConstant is the k in 1 / (k + rank), the value used by the RRF paper and by Elasticsearch
+	rrfRankConstant = 60
+)
+
+// IsSearchableType reports whether the entity type can be used in a unified search.
+func IsSearchableType(t string) bool {
+	_, ok := LookupIndexByType(t)
+	return ok
+}
+
+// SearchTarget is one entity type and the alias it is searched through.
+type SearchTarget struct {
+	Type  string
+	Alias string
+}
+
+// SearchHit is a single hit normalized across entity types.
+type SearchHit struct {
+	Type      string          `json:"type"`
+	ID        string          `json:"id"`
+	Score     float64         `json:"score"`
+	Highlight json.RawMessage `json:"highlight,omitempty"`
+	BestChunk *ChunkMatch     `json:"bestChunk,omitempty"`
+	Source    json.RawMessage `json:"source"`
+}
+
+// UnifiedSearchResult is the fused result of a cross-entity search.
+type UnifiedSearchResult struct {
+	Total  int            `json:"total"`
+	Totals map[string]int `json:"totals"`
+	Hits   []SearchHit    `json:"hits"`
+	// Degraded is set when the query could not be embedded and only the keyword (BM25) part ran
+	Degraded bool `json:"degraded,omitempty"`
+}
+
+// multiSearchResponse is the body returned by the _msearch API.
+type multiSearchResponse struct {
+	Responses []struct {
+		SearchResult
+		Status int                    `json:"status"`
+		Error  map[string]interface{} `json:"error,omitempty"`
+	} `json:"responses"`
+}
+
+// SearchAll runs the query against every target in one multi-search request and
+// merges the per-type rankings with reciprocal rank fusion. Each sub-search
+// fetches the first From+Size hits so the fused page can be cut out afterwards.
+// A failing target is logged and skipped; the call only fails if all of them do.
+// The query is only embedded when one of the targets has vector fields.
+func SearchAll(ctx context.Context, client *elasticsearch.Client, targets []SearchTarget, query string, opts SearchOptions) (UnifiedSearchResult, error) {
+	nilResult := UnifiedSearchResult{}
+
+	if client == nil {
+		return nilResult, fmt.Errorf("elasticsearch client is not initialized")
+	}
+	if len(targets) == 0 {
+		return nilResult, fmt.Errorf("no search targets provided")
+	}
+
+	opts = opts.normalize()
+	window := SearchOptions{From: 0, Size: opts.From + opts.Size, Filters: opts.Filters}
+
+	defs := make([]*IndexDefinition, len(targets))
+	semantic := false
+	for i, target := range targets {
+		def, ok := LookupIndexByType(target.Type)
+		if !ok {
+			return nilResult, fmt.Errorf("unsupported search type: %s", target.Type)
+		}
+		if target.Alias == "" {
+			return nilResult, fmt.Errorf("elasticsearch index alias is not provided for %s", target.Type)
+		}
+		defs[i] = def
+		semantic = semantic || len(def.VectorFields) > 0
+	}
+
+	// 只有选中的类型带向量字段时才需要嵌入查询
+	var vector []float32
+	if semantic {
+		vector = embedQueryOrDegrade(ctx, query)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for i, target := range targets {
+		def := defs[i]
+		if err := enc.Encode(map[string]any{"index": target.Alias}); err != nil {
+			return nilResult, err
+		}
+		if err := enc.Encode(def.SearchBody(query, vector, window)); err != nil {
+			return nilResult, err
+		}
+	}
+
+	res, err := client.Msearch(&buf, client.Msearch.WithContext(ctx))
+	if err != nil {
+		return nilResult, fmt.Errorf("error getting response: %w", err)
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			searchLogger.Error("error closing response body",
+				zap.Error(err),
+			)
+		}
+	}(res.Body)
+
+	if res.IsError() {
+		var e map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&e); err != nil {
+			return nilResult, fmt.Errorf("error parsing the response body: %w", err)
+		}
+		_, reason := errorTypeAndReason(e)
+		return nilResult, fmt.Errorf("elasticsearch multi search error: [%s] %s", res.Status(), reason)
+	}
+
+	var r multiSearchResponse
+	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+		return nilResult, fmt.Errorf("error parsing the response body: %w", err)
+	}
+	if len(r.Responses) != len(targets) {
+		return nilResult, fmt.Errorf("elasticsearch multi search returned %d responses for %d targets", len(r.Responses), len(targets))
+	}
+
+	result := UnifiedSearchResult{Totals: make(map[string]int, len(targets)), Degraded: semantic && vector == nil}
+	rankings := make([][]SearchHit, 0, len(targets))
+	failed := 0
+	for i, resp := range r.Responses {
+		target := targets[i]
+		if resp.Error != nil {
+			errType, reason := errorTypeAndReason(map[string]interface{}{"error": resp.Error})
+			searchLogger.Error("elasticsearch multi search item error",
+				zap.String("type", target.Type),
+				zap.String("index", target.Alias),
+				zap.Int("status", resp.Status),
+				zap.String("error_type", errType),
+				zap.String("reason", reason),
+			)
+			failed++
+			continue
+		}
+
+		resp.resolveBestChunks()
+		hits := make([]SearchHit, 0, len(resp.Hits.Hits))
+		for _, h := range resp.Hits.Hits {
+			hits = append(hits, SearchHit{
+				Type:      target.Type,
+				ID:        h.ID,
+				Score:     h.Score,
+				Highlight: h.Highlight,
+				BestChunk: h.BestChunk,
+				Source:    h.Source,
+			})
+		}
+		rankings = append(rankings, hits)
+		result.Totals[target.Type] = resp.Hits.Total.Value
+		result.Total += resp.Hits.Total.Value
+	}
+	if failed == len(targets) {
+		return nilResult, fmt.Errorf("all %d searches in the multi search failed", failed)
+	}
+
+	fused := fuseRRF(rankings, rrfRankConstant)
+	if opts.From >= len(fused) {
+		result.Hits = []SearchHit{}
+	} else {
+		result.Hits = fused[opts.From:min(opts.From+opts.Size, len(fused))]
+	}
+
+	searchLogger.Info("unified search completed",
+		zap.Int("targets", len(targets)),
+		zap.Int("failed", failed),
+		zap.Int("hits_count", len(result.Hits)),
+		zap.Int("total", result.Total),
+		zap.Bool("degraded", result.Degraded),
+	)
+
+	return result, nil
+}
+
+// FuseRankings merges rankings with reciprocal rank fusion, with the rank
+// constant SearchAll uses, for backends that search one type at a time.
+func FuseRankings(rankings [][]SearchHit) []SearchHit {
+	return fuseRRF(rankings, rrfRankConstant)
+}
+
+// fuseRRF merges several rankings with reciprocal rank fusion: every hit scores
+// the sum of 1 / (k + rank) over the rankings it appears in. The returned hits
+// carry the fused score, ordered from best to worst.
+func fuseRRF(rankings [][]SearchHit, k int) []SearchHit {
+	type key struct{ typ, id string }
+
+	scores := make(map[key]float64)
+	first := make(map[key]SearchHit)
+	var order []key
+	for _, ranking := range rankings {
+		for rank, hit := range ranking {
+			id := key{hit.Type, hit.ID}
+			if _, seen := first[id]; !seen {
+				first[id] = hit
+				order = append(order, id)
+			}
+			scores[id] += 1 / float64(k+rank+1)
+		}
+	}
+
+	fused := make([]SearchHit, 0, len(order))
+	for _, id := range order {
+		hit := first[id]
+		hit.Score = scores[id]
+		fused = append(fused, hit)
+	}
+	// Stable sort keeps first-seen order for equal scores, so ties are deterministic
+	sort.SliceStable(fused, func(i, j int) bool {
+		return fused[i].Score > fused[j].Score
+	})
+	return fused
+}
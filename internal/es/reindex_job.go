@@ -0,0 +1,283 @@
+package es
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"api.us4ever/internal/database"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ReindexPhase is the stage a reindex job is in.
+type ReindexPhase string
+
+const (
+	PhasePending        ReindexPhase = "pending"
+	PhaseCreatingIndex  ReindexPhase = "creating_index"
+	PhaseIndexing       ReindexPhase = "indexing"
+	PhaseVerifying      ReindexPhase = "verifying"
+	PhaseSwitchingAlias ReindexPhase = "switching_alias"
+	PhaseCompleted      ReindexPhase = "completed"
+	PhaseFailed         ReindexPhase = "failed"
+	PhaseCancelled      ReindexPhase = "cancelled"
+
+	// finishedJobsKept bounds how many finished jobs stay queryable
+	finishedJobsKept = 50
+)
+
+// ErrReindexRunning is returned when a reindex is started for an alias that
+// already has one running.
+var ErrReindexRunning = errors.New("a reindex is already running for this alias")
+
+// ReindexJob tracks one background reindex. A nil *ReindexJob is valid and
+// ignores all updates, so the indexers can run without one.
+type ReindexJob struct {
+	mu         sync.Mutex
+	id         string
+	alias      string
+	target     string // the new generation, once created
+	phase      ReindexPhase
+	indexed    int
+	total      int
+	failed     int
+	errors     []string
+	startedAt  time.Time
+	finishedAt time.Time
+	cancel     context.CancelFunc
+	done       chan struct{}
+}
+
+// ReindexJobStatus is a point-in-time view of a ReindexJob.
+type ReindexJobStatus struct {
+	ID      string       `json:"id"`
+	Alias   string       `json:"alias"`
+	Index   string       `json:"index,omitempty"` // the new generation, once created
+	Phase   ReindexPhase `json:"phase"`
+	Indexed int          `json:"indexed"`
+	Total   int          `json:"total"`
+	// Failed counts the documents that could not be written, see the index_dead_letter table
+	Failed     int        `json:"failed"`
+	Errors     []string   `json:"errors"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	// DurationMs runs until the job finishes
+	DurationMs int64 `json:"durationMs"`
+}
+
+// ID returns the job ID, or "" for a nil job.
+func (j *ReindexJob) ID() string {
+	if j == nil {
+		return ""
+	}
+	return j.id
+}
+
+// Status returns a snapshot of the job.
+func (j *ReindexJob) Status() ReindexJobStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	status := ReindexJobStatus{
+		ID:        j.id,
+		Alias:     j.alias,
+		Index:     j.target,
+		Phase:     j.phase,
+		Indexed:   j.indexed,
+		Total:     j.total,
+		Failed:    j.failed,
+		Errors:    append([]string{}, j.errors...),
+		StartedAt: j.startedAt,
+	}
+	end := time.Now()
+	if !j.finishedAt.IsZero() {
+		finishedAt := j.finishedAt
+		status.FinishedAt = &finishedAt
+		end = finishedAt
+	}
+	status.DurationMs = end.Sub(j.startedAt).Milliseconds()
+	return status
+}
+
+// Done is closed when the job has finished.
+func (j *ReindexJob) Done() <-chan struct{} {
+	return j.done
+}
+
+func (j *ReindexJob) setPhase(phase ReindexPhase) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.phase = phase
+}
+
+// setTarget records the generation the job writes to; "" once it is about to
+// be deleted, so that nothing writes to it any more.
+func (j *ReindexJob) setTarget(index string) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.target = index
+}
+
+func (j *ReindexJob) setProgress(progress database.Progress) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.indexed = progress.Done
+	j.total = progress.Total
+}
+
+func (j *ReindexJob) setFailed(failed int) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.failed = failed
+}
+
+// finish records the outcome. cancelled is passed separately because a
+// cancelled query does not always surface as context.Canceled.
+func (j *ReindexJob) finish(err error, cancelled bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.finishedAt = time.Now()
+	switch {
+	case err == nil:
+		j.phase = PhaseCompleted
+	case cancelled:
+		j.phase = PhaseCancelled
+	default:
+		j.phase = PhaseFailed
+	}
+	if err != nil {
+		j.errors = append(j.errors, err.Error())
+	}
+}
+
+// ReindexJobs runs reindex jobs in the background and keeps track of them, at
+// most one per alias at a time. Jobs live in memory only.
+type ReindexJobs struct {
+	mu       sync.Mutex
+	jobs     map[string]*ReindexJob
+	running  map[string]*ReindexJob // by alias
+	finished []string               // IDs, oldest first
+}
+
+// NewReindexJobs creates an empty job registry.
+func NewReindexJobs() *ReindexJobs {
+	return &ReindexJobs{
+		jobs:    make(map[string]*ReindexJob),
+		running: make(map[string]*ReindexJob),
+	}
+}
+
+// Start runs fn in the background as a job for alias. It fails with
+// ErrReindexRunning, and returns the running job, if alias already has one.
+func (r *ReindexJobs) Start(alias string, fn func(ctx context.Context, job *ReindexJob) error) (*ReindexJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if running, ok := r.running[alias]; ok {
+		return running, ErrReindexRunning
+	}
+
+	// 与请求无关的后台 context，只能通过 Cancel 取消
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &ReindexJob{
+		id:        uuid.NewString(),
+		alias:     alias,
+		phase:     PhasePending,
+		errors:    []string{},
+		startedAt: time.Now(),
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+	r.jobs[job.id] = job
+	r.running[alias] = job
+
+	go func() {
+		defer cancel()
+		err := fn(ctx, job)
+		job.finish(err, ctx.Err() != nil)
+
+		status := job.Status()
+		if err != nil {
+			indexerLogger.Error("reindex job finished with error",
+				zap.String("job_id", job.id),
+				zap.String("alias", alias),
+				zap.String("phase", string(status.Phase)),
+				zap.Error(err),
+			)
+		} else {
+			indexerLogger.Info("reindex job completed",
+				zap.String("job_id", job.id),
+				zap.String("alias", alias),
+				zap.Int("indexed", status.Indexed),
+				zap.Int64("duration_ms", status.DurationMs),
+			)
+		}
+
+		r.mu.Lock()
+		delete(r.running, alias)
+		r.finished = append(r.finished, job.id)
+		if len(r.finished) > finishedJobsKept {
+			delete(r.jobs, r.finished[0])
+			r.finished = r.finished[1:]
+		}
+		r.mu.Unlock()
+		close(job.done)
+	}()
+
+	return job, nil
+}
+
+// Get returns the job with the given ID.
+func (r *ReindexJobs) Get(id string) (*ReindexJob, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job, ok := r.jobs[id]
+	return job, ok
+}
+
+// Running reports whether alias has a job running.
+func (r *ReindexJobs) Running(alias string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.running[alias]
+	return ok
+}
+
+// Target returns the generation being built by the job running for alias.
+// Changes written to the alias meanwhile must go there too, or the swap would
+// lose them.
+func (r *ReindexJobs) Target(alias string) (string, bool) {
+	r.mu.Lock()
+	job, ok := r.running[alias]
+	r.mu.Unlock()
+	if !ok {
+		return "", false
+	}
+	job.mu.Lock()
+	defer job.mu.Unlock()
+	return job.target, job.target != ""
+}
+
+// Cancel asks the job with the given ID to stop. Cancelling a finished job
+// has no effect.
+func (r *ReindexJobs) Cancel(id string) (*ReindexJob, bool) {
+	job, ok := r.Get(id)
+	if ok {
+		job.cancel()
+	}
+	return job, ok
+}
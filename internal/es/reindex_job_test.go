@@ -0,0 +1,116 @@
+package es
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"api.us4ever/internal/database"
+)
+
+func waitDone(t *testing.T, job *ReindexJob) {
+	t.Helper()
+	select {
+	case <-job.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("job did not finish")
+	}
+}
+
+func TestReindexJobs_OnePerAlias(t *testing.T) {
+	jobs := NewReindexJobs()
+	started := make(chan struct{})
+
+	job, err := jobs.Start("keeps", func(ctx context.Context, job *ReindexJob) error {
+		job.setPhase(PhaseIndexing)
+		job.setProgress(database.Progress{Done: 10, Total: 20})
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-started
+
+	running, err := jobs.Start("keeps", func(ctx context.Context, job *ReindexJob) error { return nil })
+	if !errors.Is(err, ErrReindexRunning) || running.ID() != job.ID() {
+		t.Fatalf("expected the second job to be rejected in favour of %s, got %v", job.ID(), err)
+	}
+
+	other, err := jobs.Start("moments", func(ctx context.Context, job *ReindexJob) error { return nil })
+	if err != nil {
+		t.Fatalf("expected a job for another alias to start, got %v", err)
+	}
+	waitDone(t, other)
+	if got := other.Status().Phase; got != PhaseCompleted {
+		t.Errorf("expected completed, got %s", got)
+	}
+
+	status := job.Status()
+	if status.Phase != PhaseIndexing || status.Indexed != 10 || status.Total != 20 {
+		t.Errorf("unexpected status of the running job: %+v", status)
+	}
+
+	if _, ok := jobs.Cancel(job.ID()); !ok {
+		t.Fatal("expected the job to be found")
+	}
+	waitDone(t, job)
+	status = job.Status()
+	if status.Phase != PhaseCancelled || status.FinishedAt == nil || len(status.Errors) != 1 {
+		t.Errorf("unexpected status after cancel: %+v", status)
+	}
+	if jobs.Running("keeps") {
+		t.Error("expected the alias to be free again")
+	}
+}
+
+func TestReindexJobs_Failure(t *testing.T) {
+	jobs := NewReindexJobs()
+	job, _ := jobs.Start("keeps", func(ctx context.Context, job *ReindexJob) error {
+		return errors.New("boom")
+	})
+	waitDone(t, job)
+
+	status := job.Status()
+	if status.Phase != PhaseFailed || len(status.Errors) != 1 || status.Errors[0] != "boom" {
+		t.Errorf("unexpected status: %+v", status)
+	}
+	if got, ok := jobs.Get(job.ID()); !ok || got != job {
+		t.Error("expected the finished job to stay queryable")
+	}
+}
+
+func TestReindexJobs_Target(t *testing.T) {
+	jobs := NewReindexJobs()
+	created := make(chan struct{})
+	release := make(chan struct{})
+
+	job, _ := jobs.Start("keeps", func(ctx context.Context, job *ReindexJob) error {
+		if _, ok := jobs.Target("keeps"); ok {
+			t.Error("expected no target before the generation is created")
+		}
+		job.setTarget("keeps_20250101000000")
+		close(created)
+		<-release
+		return nil
+	})
+	<-created
+
+	if target, ok := jobs.Target("keeps"); !ok || target != "keeps_20250101000000" {
+		t.Errorf("expected the new generation as target, got %q %v", target, ok)
+	}
+	if _, ok := jobs.Target("moments"); ok {
+		t.Error("expected no target for an alias without a job")
+	}
+	if got := job.Status().Index; got != "keeps_20250101000000" {
+		t.Errorf("expected the generation in the status, got %q", got)
+	}
+
+	close(release)
+	waitDone(t, job)
+	if _, ok := jobs.Target("keeps"); ok {
+		t.Error("expected no target once the job finished")
+	}
+}
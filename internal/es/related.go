@@ -0,0 +1,172 @@
+package es
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultRelatedSize is the number of related documents returned by default
+	DefaultRelatedSize = 10
+	// MaxRelatedSize bounds the number of related documents
+	MaxRelatedSize = 50
+
+	// relatedVectorField is the stored vector the kNN query starts from
+	relatedVectorField = "content_vector"
+	// relatedNumCandidates is the minimum number of kNN candidates per shard
+	relatedNumCandidates = 100
+)
+
+var (
+	// ErrDocumentNotFound is returned when the document to find related ones for is not indexed.
+	ErrDocumentNotFound = errors.New("document not found")
+	// ErrRelatedUnsupported is returned for an index without a content_vector field.
+	ErrRelatedUnsupported = errors.New("related documents are not supported for this index")
+)
+
+// RelatedOptions controls a Related request.
+type RelatedOptions struct {
+	Size int
+	// MoreLikeThis mixes a more_like_this clause on the text fields into the kNN query
+	MoreLikeThis bool
+	Filters      SearchFilters
+}
+
+// Related finds the documents closest to the document id of def, using the
+// vector stored with it, so the embedding service is never called. The
+// document itself is excluded. A document indexed without a vector falls
+// back to more_like_this alone and the result is marked Degraded.
+func Related(ctx context.Context, client *elasticsearch.Client, def *IndexDefinition, alias, id string, opts RelatedOptions) (SearchResult, error) {
+	nilResult := SearchResult{}
+
+	if client == nil {
+		return nilResult, fmt.Errorf("elasticsearch client is not initialized")
+	}
+	if alias == "" {
+		return nilResult, fmt.Errorf("elasticsearch index alias is not provided")
+	}
+	if !slices.Contains(def.VectorFields, relatedVectorField) {
+		return nilResult, fmt.Errorf("%w: %s", ErrRelatedUnsupported, def.Name)
+	}
+
+	vector, err := storedVector(ctx, client, alias, id, relatedVectorField)
+	if err != nil {
+		return nilResult, err
+	}
+
+	r, err := executeSearch(ctx, client, alias, buildRelatedBody(def, id, vector, opts))
+	if err != nil {
+		return nilResult, err
+	}
+	r.Degraded = vector == nil
+
+	searchLogger.Info("related search completed",
+		zap.String("index", def.Name),
+		zap.String("id", id),
+		zap.Bool("more_like_this", opts.MoreLikeThis || vector == nil),
+		zap.Int("hits_count", len(r.Hits.Hits)),
+		zap.Bool("degraded", r.Degraded),
+	)
+	return r, nil
+}
+
+// storedVector reads one vector field of an indexed document. It returns nil
+// when the document was indexed without it, and ErrDocumentNotFound when the
+// document does not exist.
+func storedVector(ctx context.Context, client *elasticsearch.Client, alias, id, field string) ([]float32, error) {
+	res, err := client.Get(alias, id,
+		client.Get.WithContext(ctx),
+		client.Get.WithSourceIncludes(field),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document %s: %w", id, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", ErrDocumentNotFound, id)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document response body: %w", err)
+	}
+	if res.IsError() {
+		return nil, fmt.Errorf("failed to get document %s: [%s] %s", id, res.Status(), string(body))
+	}
+
+	raw := gjson.GetBytes(body, "_source."+field)
+	if !raw.IsArray() || len(raw.Array()) == 0 {
+		return nil, nil
+	}
+	var vector []float32
+	if err := json.Unmarshal([]byte(raw.Raw), &vector); err != nil {
+		return nil, fmt.Errorf("failed to parse %s of document %s: %w", field, id, err)
+	}
+	return vector, nil
+}
+
+// normalize fills in the default size and caps it at MaxRelatedSize.
+func (o RelatedOptions) normalize() RelatedOptions {
+	if o.Size <= 0 {
+		o.Size = DefaultRelatedSize
+	}
+	o.Size = min(o.Size, MaxRelatedSize)
+	return o
+}
+
+// buildRelatedBody builds the kNN query around vector, plus a more_like_this
+// clause when asked for or when there is no vector. Both exclude document id.
+func buildRelatedBody(def *IndexDefinition, id string, vector []float32, opts RelatedOptions) map[string]any {
+	opts = opts.normalize()
+
+	exclude := []any{map[string]any{"ids": map[string]any{"values": []string{id}}}}
+	filter := map[string]any{"must_not": exclude}
+	if filters := opts.Filters.clauses(); len(filters) > 0 {
+		filter["filter"] = filters
+	}
+
+	body := map[string]any{
+		"_source": map[string]any{
+			"excludes": def.sourceExcludes(),
+		},
+		"size": opts.Size,
+	}
+	if vector != nil {
+		body["knn"] = map[string]any{
+			"field":          relatedVectorField,
+			"query_vector":   vector,
+			"k":              opts.Size,
+			"num_candidates": max(relatedNumCandidates, opts.Size*2),
+			"filter":         map[string]any{"bool": filter},
+		}
+	}
+	if opts.MoreLikeThis || vector == nil {
+		boolQuery := map[string]any{
+			"should": []any{
+				map[string]any{
+					"more_like_this": map[string]any{
+						"fields":          def.TextFields,
+						"like":            []any{map[string]any{"_id": id}},
+						"min_term_freq":   1,
+						"min_doc_freq":    2,
+						"max_query_terms": 25,
+					},
+				},
+			},
+			"minimum_should_match": 1,
+		}
+		maps.Copy(boolQuery, filter)
+		body["query"] = map[string]any{"bool": boolQuery}
+	}
+	return body
+}
@@ -0,0 +1,86 @@
+package es
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/tidwall/gjson"
+)
+
+func TestBuildRelatedBody(t *testing.T) {
+	raw, _ := json.Marshal(buildRelatedBody(KeepIndex, "k1", []float32{0.1, 0.2}, RelatedOptions{Size: 5}))
+	if got := gjson.GetBytes(raw, "knn.field").String(); got != "content_vector" {
+		t.Errorf("expected a kNN query on content_vector, got %q", got)
+	}
+	if got := gjson.GetBytes(raw, "knn.filter.bool.must_not.0.ids.values.0").String(); got != "k1" {
+		t.Errorf("expected the document itself to be excluded, got %s", gjson.GetBytes(raw, "knn.filter").Raw)
+	}
+	if gjson.GetBytes(raw, "query").Exists() {
+		t.Error("expected no more_like_this clause unless asked for")
+	}
+
+	raw, _ = json.Marshal(buildRelatedBody(MomentIndex, "m1", []float32{0.1}, RelatedOptions{MoreLikeThis: true, Filters: SearchFilters{OwnerID: "u1"}}))
+	if got := gjson.GetBytes(raw, "query.bool.should.0.more_like_this.like.0._id").String(); got != "m1" {
+		t.Errorf("expected more_like_this to start from m1, got %s", gjson.GetBytes(raw, "query").Raw)
+	}
+	if got := gjson.GetBytes(raw, "query.bool.must_not.0.ids.values.0").String(); got != "m1" {
+		t.Error("expected more_like_this to exclude the document itself")
+	}
+	if got := gjson.GetBytes(raw, "knn.filter.bool.filter.0.term.ownerId").String(); got != "u1" {
+		t.Errorf("expected the kNN query to be filtered, got %s", gjson.GetBytes(raw, "knn.filter").Raw)
+	}
+	if got := gjson.GetBytes(raw, "size").Int(); got != DefaultRelatedSize {
+		t.Errorf("expected the default size, got %d", got)
+	}
+}
+
+func TestRelated(t *testing.T) {
+	var searchBody []byte
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/app-keeps/_doc/k1":
+			fmt.Fprint(w, `{"_id":"k1","found":true,"_source":{"content_vector":[0.5,0.25]}}`)
+		case "/app-keeps/_doc/k2":
+			fmt.Fprint(w, `{"_id":"k2","found":true,"_source":{}}`)
+		case "/app-keeps/_doc/missing":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"_id":"missing","found":false}`)
+		case "/app-keeps/_search":
+			searchBody, _ = io.ReadAll(r.Body)
+			fmt.Fprint(w, `{"hits":{"total":{"value":1},"hits":[{"_id":"k3","_score":1}]}}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	result, err := Related(t.Context(), client, KeepIndex, "app-keeps", "k1", RelatedOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Degraded || len(result.Hits.Hits) != 1 {
+		t.Errorf("unexpected result %+v", result)
+	}
+	if got := gjson.GetBytes(searchBody, "knn.query_vector").Raw; got != "[0.5,0.25]" {
+		t.Errorf("expected the stored vector to be used, got %s", got)
+	}
+
+	// 没有存储向量时退回 more_like_this
+	result, err = Related(t.Context(), client, KeepIndex, "app-keeps", "k2", RelatedOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Degraded || gjson.GetBytes(searchBody, "knn").Exists() || !gjson.GetBytes(searchBody, "query.bool.should.0.more_like_this").Exists() {
+		t.Errorf("expected a degraded more_like_this search, got %s", searchBody)
+	}
+
+	if _, err := Related(t.Context(), client, KeepIndex, "app-keeps", "missing", RelatedOptions{}); !errors.Is(err, ErrDocumentNotFound) {
+		t.Errorf("expected ErrDocumentNotFound, got %v", err)
+	}
+	if _, err := Related(t.Context(), client, TodoIndex, "app-todos", "t1", RelatedOptions{}); !errors.Is(err, ErrRelatedUnsupported) {
+		t.Errorf("expected ErrRelatedUnsupported, got %v", err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"time"
 
 	"api.us4ever/internal/logger"
 	"github.com/elastic/go-elasticsearch/v8"
@@ -24,6 +25,14 @@ func init() {
 	}
 }
 
+const (
+	// DefaultSearchSize is used when the caller does not ask for a page size
+	DefaultSearchSize = 10
+	// MaxResultWindow is the deepest hit Elasticsearch returns (from + size),
+	// and the largest k of a kNN clause
+	MaxResultWindow = 10000
+)
+
 // SearchResult represents the structure of the Elasticsearch search response
 type SearchResult struct {
 	Hits struct {
@@ -31,97 +40,168 @@ type SearchResult struct {
 			Value    int    `json:"value"`
 			Relation string `json:"relation"`
 		} `json:"total"`
-		Hits []struct {
-			Index     string          `json:"_index"`
-			ID        string          `json:"_id"`
-			Score     float64         `json:"_score"`
-			Source    json.RawMessage `json:"_source"` // Use RawMessage to delay parsing
-			Highlight json.RawMessage `json:"highlight,omitempty"`
-		} `json:"hits"`
+		Hits []ResultHit `json:"hits"`
 	} `json:"hits"`
+	// Aggregations is only set for requests that ask for aggregations, e.g. Suggest
+	Aggregations json.RawMessage `json:"aggregations,omitempty"`
+	// Degraded is set when the query could not be embedded and only the keyword (BM25) part ran
+	Degraded bool `json:"degraded,omitempty"`
 }
 
-// SearchKeeps performs a search query against the specified index alias using the provided client.
-func SearchKeeps(ctx context.Context, client *elasticsearch.Client, indexAlias string, query string) (SearchResult, error) {
-	nilResult := SearchResult{}
+// ResultHit is one document of a SearchResult.
+type ResultHit struct {
+	Index     string          `json:"_index"`
+	ID        string          `json:"_id"`
+	Score     float64         `json:"_score"`
+	Source    json.RawMessage `json:"_source"` // Use RawMessage to delay parsing
+	Highlight json.RawMessage `json:"highlight,omitempty"`
+	// InnerHits holds the nested matches until they are resolved into BestChunk
+	InnerHits json.RawMessage `json:"inner_hits,omitempty"`
+	// BestChunk is the passage of a keep that matched the query best
+	BestChunk *ChunkMatch `json:"bestChunk,omitempty"`
+}
 
-	if client == nil {
-		return nilResult, fmt.Errorf("elasticsearch client is not initialized")
+// SearchOptions controls pagination and filtering of a search request.
+type SearchOptions struct {
+	From    int
+	Size    int
+	Filters SearchFilters
+}
+
+// SearchFilters are applied as non-scoring filter clauses, both on the keyword
+// query and on every kNN clause, so semantic hits respect them as well.
+type SearchFilters struct {
+	Tags        []string
+	Category    string
+	IsPublic    *bool
+	OwnerID     string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+// clauses converts the filters into Elasticsearch filter clauses.
+func (f SearchFilters) clauses() []any {
+	var out []any
+	if len(f.Tags) > 0 {
+		out = append(out, map[string]any{"terms": map[string]any{"tags": f.Tags}})
 	}
-	if indexAlias == "" {
-		return nilResult, fmt.Errorf("elasticsearch index alias is not provided")
+	if f.Category != "" {
+		out = append(out, map[string]any{"term": map[string]any{"category": f.Category}})
 	}
+	if f.IsPublic != nil {
+		out = append(out, map[string]any{"term": map[string]any{"isPublic": *f.IsPublic}})
+	}
+	if f.OwnerID != "" {
+		out = append(out, map[string]any{"term": map[string]any{"ownerId": f.OwnerID}})
+	}
+	if f.CreatedFrom != nil || f.CreatedTo != nil {
+		rng := map[string]any{}
+		if f.CreatedFrom != nil {
+			rng["gte"] = f.CreatedFrom.Format(time.RFC3339)
+		}
+		if f.CreatedTo != nil {
+			rng["lte"] = f.CreatedTo.Format(time.RFC3339)
+		}
+		out = append(out, map[string]any{"range": map[string]any{"createdAt": rng}})
+	}
+	return out
+}
 
-	tv, err := Embed(ctx, query)
-	if err != nil {
-		return nilResult, fmt.Errorf("embedding error: %w", err)
+// normalize fills in the default page size.
+func (o SearchOptions) normalize() SearchOptions {
+	if o.Size <= 0 {
+		o.Size = DefaultSearchSize
 	}
-	sv, err := Embed(ctx, query)
-	if err != nil {
-		return nilResult, fmt.Errorf("embedding error: %w", err)
+	if o.From < 0 {
+		o.From = 0
 	}
-	cv, err := Embed(ctx, query)
-	if err != nil {
-		return nilResult, fmt.Errorf("embedding error: %w", err)
+	return o
+}
+
+// knnClause builds one kNN clause. k grows with the requested page so deep pages
+// still have enough semantic candidates to fill them.
+func knnClause(field string, vector []float32, k, numCandidates int, boost float64, opts SearchOptions) map[string]any {
+	if need := opts.From + opts.Size; need > k {
+		k = min(need, MaxResultWindow)
+	}
+	if numCandidates < k {
+		numCandidates = k
 	}
+	numCandidates = min(numCandidates, MaxResultWindow)
+	clause := map[string]any{
+		"field":          field,
+		"query_vector":   vector,
+		"k":              k,
+		"num_candidates": numCandidates,
+		"boost":          boost,
+	}
+	if filters := opts.Filters.clauses(); len(filters) > 0 {
+		clause["filter"] = filters
+	}
+	return clause
+}
 
-	body := map[string]any{
-		// 语义召回（保持不变）
-		"knn": []any{
+// buildKeepsSearchBody builds the hybrid (kNN + keyword) query for keeps. The
+// query vector is shared by the title, summary and content kNN clauses; a nil
+// vector leaves them out.
+func buildKeepsSearchBody(query string, vector []float32, opts SearchOptions) map[string]any {
+	opts = opts.normalize()
+
+	boolQuery := map[string]any{
+		"should": []any{
+			// ① 两个词都得出现
 			map[string]any{
-				"field":          "title_vector",
-				"query_vector":   tv,
-				"k":              20,
-				"num_candidates": 60,
-				"boost":          7,
+				"multi_match": map[string]any{
+					"query":    query,
+					"fields":   []string{"title^3", "summary^2", "content"},
+					"type":     "best_fields",
+					"operator": "and", // 两词必须都在
+					"boost":    3,     // 关键词 boost
+				},
 			},
+			// ② 强力短语 boost
 			map[string]any{
-				"field":          "summary_vector",
-				"query_vector":   sv,
-				"k":              20,
-				"num_candidates": 60,
-				"boost":          6,
+				"multi_match": map[string]any{
+					"query":  query,
+					"fields": []string{"title^3", "summary^2", "content"},
+					"type":   "phrase",
+					"slop":   2, // 允许错位
+					"boost":  5, // 短语 boost
+				},
 			},
+			// ③ 按命中最好的分块给 keep 打分，并取回该分块
 			map[string]any{
-				"field":          "content_vector",
-				"query_vector":   cv,
-				"k":              30,
-				"num_candidates": 100,
-				"boost":          5,
-			},
-		},
-		"_source": map[string]any{
-			"excludes": []string{"title_vector", "summary_vector", "content_vector"},
-		},
-		// 关键词 + 短语两路并行
-		"query": map[string]any{
-			"bool": map[string]any{
-				"should": []any{
-					// ① 两个词都得出现
-					map[string]any{
+				"nested": map[string]any{
+					"path":       ChunksField,
+					"score_mode": "max",
+					"query": map[string]any{
 						"multi_match": map[string]any{
 							"query":    query,
-							"fields":   []string{"title^3", "summary^2", "content"},
+							"fields":   []string{ChunksField + ".heading", ChunksField + ".content"},
 							"type":     "best_fields",
-							"operator": "and", // 两词必须都在
-							"boost":    3,     // 关键词 boost
-						},
-					},
-					// ② 强力短语 boost
-					map[string]any{
-						"multi_match": map[string]any{
-							"query":  query,
-							"fields": []string{"title^3", "summary^2", "content"},
-							"type":   "phrase",
-							"slop":   2, // 允许错位
-							"boost":  5, // 短语 boost
+							"operator": "and",
 						},
 					},
+					"inner_hits": chunkInnerHits(keywordChunkHits, true),
+					"boost":      3,
 				},
-				// should 至少命中一条即可
-				"minimum_should_match": 1,
 			},
 		},
+		// should 至少命中一条即可
+		"minimum_should_match": 1,
+	}
+	if filters := opts.Filters.clauses(); len(filters) > 0 {
+		boolQuery["filter"] = filters
+	}
+
+	body := map[string]any{
+		"_source": map[string]any{
+			"excludes": []string{"title_vector", "summary_vector", "content_vector", ChunksField},
+		},
+		// 关键词 + 短语两路并行
+		"query": map[string]any{
+			"bool": boolQuery,
+		},
 		// ③ 高亮：跟短语完全一致
 		"highlight": map[string]any{
 			"pre_tags":  []string{"<mark>"},
@@ -146,120 +226,71 @@ func SearchKeeps(ctx context.Context, client *elasticsearch.Client, indexAlias s
 				},
 			},
 		},
-		"size": 10,
+		"from": opts.From,
+		"size": opts.Size,
 	}
-
-	var buf bytes.Buffer
-	err = json.NewEncoder(&buf).Encode(body)
-	if err != nil {
-		return SearchResult{}, err
-	}
-
-	res, err := client.Search(
-		client.Search.WithContext(ctx),
-		client.Search.WithIndex(indexAlias), // Use the provided index alias
-		client.Search.WithBody(&buf),
-		client.Search.WithPretty(),
-	)
-	if err != nil {
-		return nilResult, fmt.Errorf("error getting response: %w", err)
-	}
-	defer func(Body io.ReadCloser) {
-		err := Body.Close()
-		if err != nil {
-			searchLogger.Error("error closing response body",
-				zap.Error(err),
-			)
+	// 语义召回；没有查询向量时（降级）只跑关键词检索
+	if vector != nil {
+		body["knn"] = []any{
+			knnClause("title_vector", vector, 20, 60, 7, opts),
+			knnClause("summary_vector", vector, 20, 60, 6, opts),
+			knnClause("content_vector", vector, 30, 100, 5, opts),
+			chunkKNNClause(vector, opts),
 		}
-	}(res.Body)
-
-	if res.IsError() {
-		var e map[string]interface{}
-		if err := json.NewDecoder(res.Body).Decode(&e); err != nil {
-			return nilResult, fmt.Errorf("error parsing the response body: %w", err)
-		} else {
-			// Print the error response body for debugging.
-			searchLogger.Error("elasticsearch search error",
-				zap.String("status", res.Status()),
-				zap.Any("type", e["error"].(map[string]interface{})["type"]),
-				zap.Any("reason", e["error"].(map[string]interface{})["reason"]),
-			)
-			return nilResult, fmt.Errorf("elasticsearch search error: [%s] %s", res.Status(), e["error"].(map[string]interface{})["reason"])
-		}
-	}
-
-	var r SearchResult
-	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
-		return nilResult, fmt.Errorf("error parsing the response body: %w", err)
 	}
+	return body
+}
 
-	searchLogger.Info("search completed",
-		zap.String("status", res.Status()),
-		zap.Int("hits_count", len(r.Hits.Hits)),
-		zap.Int("total", r.Hits.Total.Value),
-	)
-
-	return r, nil
+// chunkKNNClause ranks keeps by their nearest chunk and returns that chunk.
+// Long keeps are matched on the passage rather than on one diluted vector.
+func chunkKNNClause(vector []float32, opts SearchOptions) map[string]any {
+	clause := knnClause(ChunksField+".vector", vector, 30, 100, 6, opts)
+	clause["inner_hits"] = chunkInnerHits(semanticChunkHits, false)
+	return clause
 }
 
-// SearchMoments performs a search query against the specified moments index using the provided client.
-func SearchMoments(ctx context.Context, client *elasticsearch.Client, indexAlias string, query string) (SearchResult, error) {
-	nilResult := SearchResult{}
+// buildMomentsSearchBody builds the hybrid (kNN + keyword) query for moments.
+// A nil vector leaves the kNN clause out.
+func buildMomentsSearchBody(query string, vector []float32, opts SearchOptions) map[string]any {
+	opts = opts.normalize()
 
-	if client == nil {
-		return nilResult, fmt.Errorf("elasticsearch client is not initialized")
-	}
-	if indexAlias == "" {
-		return nilResult, fmt.Errorf("elasticsearch index alias is not provided")
+	boolQuery := map[string]any{
+		"should": []any{
+			// ① 两个词都得出现
+			map[string]any{
+				"multi_match": map[string]any{
+					"query":    query,
+					"fields":   []string{"content", "images.description"},
+					"type":     "best_fields",
+					"operator": "and", // 两词必须都在
+					"boost":    3,     // 关键词 boost
+				},
+			},
+			// ② 强力短语 boost
+			map[string]any{
+				"multi_match": map[string]any{
+					"query":  query,
+					"fields": []string{"content", "images.description"},
+					"type":   "phrase",
+					"slop":   2, // 允许错位
+					"boost":  5, // 短语 boost
+				},
+			},
+		},
+		// should 至少命中一条即可
+		"minimum_should_match": 1,
 	}
-
-	cv, err := Embed(ctx, query)
-	if err != nil {
-		return nilResult, fmt.Errorf("embedding error: %w", err)
+	if filters := opts.Filters.clauses(); len(filters) > 0 {
+		boolQuery["filter"] = filters
 	}
 
 	body := map[string]any{
-		// 语义召回（保持不变）
-		"knn": []any{
-			map[string]any{
-				"field":          "content_vector",
-				"query_vector":   cv,
-				"k":              30,
-				"num_candidates": 100,
-				"boost":          5, // 语义召回权重
-			},
-		},
 		"_source": map[string]any{
 			"excludes": []string{"content_vector"},
 		},
 		// 关键词 + 短语两路并行
 		"query": map[string]any{
-			"bool": map[string]any{
-				"should": []any{
-					// ① 两个词都得出现
-					map[string]any{
-						"multi_match": map[string]any{
-							"query":    query,
-							"fields":   []string{"content", "images.description"},
-							"type":     "best_fields",
-							"operator": "and", // 两词必须都在
-							"boost":    3,     // 关键词 boost
-						},
-					},
-					// ② 强力短语 boost
-					map[string]any{
-						"multi_match": map[string]any{
-							"query":  query,
-							"fields": []string{"content", "images.description"},
-							"type":   "phrase",
-							"slop":   2, // 允许错位
-							"boost":  5, // 短语 boost
-						},
-					},
-				},
-				// should 至少命中一条即可
-				"minimum_should_match": 1,
-			},
+			"bool": boolQuery,
 		},
 		// ③ 高亮：跟短语完全一致
 		"highlight": map[string]any{
@@ -279,20 +310,88 @@ func SearchMoments(ctx context.Context, client *elasticsearch.Client, indexAlias
 				},
 			},
 		},
-		"size": 10,
+		"from": opts.From,
+		"size": opts.Size,
+	}
+	// 语义召回；没有查询向量时（降级）只跑关键词检索
+	if vector != nil {
+		body["knn"] = []any{
+			knnClause("content_vector", vector, 30, 100, 5, opts), // 语义召回权重
+		}
 	}
+	return body
+}
 
-	var buf bytes.Buffer
-	err = json.NewEncoder(&buf).Encode(body)
+// SearchKeeps performs a search query against the specified index alias using the provided client.
+func SearchKeeps(ctx context.Context, client *elasticsearch.Client, indexAlias string, query string, opts SearchOptions) (SearchResult, error) {
+	return Search(ctx, client, KeepIndex, indexAlias, query, opts)
+}
+
+// SearchMoments performs a search query against the specified moments index using the provided client.
+func SearchMoments(ctx context.Context, client *elasticsearch.Client, indexAlias string, query string, opts SearchOptions) (SearchResult, error) {
+	return Search(ctx, client, MomentIndex, indexAlias, query, opts)
+}
+
+// Search runs the query of def against indexAlias. The query is only
+// embedded for types with vector fields.
+func Search(ctx context.Context, client *elasticsearch.Client, def *IndexDefinition, indexAlias string, query string, opts SearchOptions) (SearchResult, error) {
+	nilResult := SearchResult{}
+
+	if client == nil {
+		return nilResult, fmt.Errorf("elasticsearch client is not initialized")
+	}
+	if indexAlias == "" {
+		return nilResult, fmt.Errorf("elasticsearch index alias is not provided")
+	}
+
+	var vector []float32
+	semantic := len(def.VectorFields) > 0
+	if semantic {
+		vector = embedQueryOrDegrade(ctx, query)
+	}
+
+	r, err := executeSearch(ctx, client, indexAlias, def.SearchBody(query, vector, opts))
 	if err != nil {
 		return nilResult, err
 	}
+	r.Degraded = semantic && vector == nil
+
+	searchLogger.Info("search completed",
+		zap.String("index", def.Name),
+		zap.Int("hits_count", len(r.Hits.Hits)),
+		zap.Int("total", r.Hits.Total.Value),
+		zap.Bool("degraded", r.Degraded),
+	)
+
+	return r, nil
+}
+
+// embedQueryOrDegrade embeds the query, returning nil when that fails so the
+// caller falls back to a keyword-only (BM25) search instead of failing outright.
+func embedQueryOrDegrade(ctx context.Context, query string) []float32 {
+	vector, err := EmbedQuery(ctx, query)
+	if err != nil {
+		searchLogger.Warn("query embedding failed, falling back to keyword-only search",
+			zap.Error(err),
+		)
+		return nil
+	}
+	return vector
+}
+
+// executeSearch sends the search body to the given index and decodes the response.
+func executeSearch(ctx context.Context, client *elasticsearch.Client, index string, body map[string]any) (SearchResult, error) {
+	nilResult := SearchResult{}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		return nilResult, err
+	}
 
 	res, err := client.Search(
 		client.Search.WithContext(ctx),
-		client.Search.WithIndex(indexAlias), // Use the provided index alias
+		client.Search.WithIndex(index), // Use the provided index alias
 		client.Search.WithBody(&buf),
-		client.Search.WithPretty(),
 	)
 	if err != nil {
 		return nilResult, fmt.Errorf("error getting response: %w", err)
@@ -310,27 +409,128 @@ func SearchMoments(ctx context.Context, client *elasticsearch.Client, indexAlias
 		var e map[string]interface{}
 		if err := json.NewDecoder(res.Body).Decode(&e); err != nil {
 			return nilResult, fmt.Errorf("error parsing the response body: %w", err)
-		} else {
-			// Print the error response body for debugging.
-			searchLogger.Error("elasticsearch search error for moments",
-				zap.String("status", res.Status()),
-				zap.Any("type", e["error"].(map[string]interface{})["type"]),
-				zap.Any("reason", e["error"].(map[string]interface{})["reason"]),
-			)
-			return nilResult, fmt.Errorf("elasticsearch search error: [%s] %s", res.Status(), e["error"].(map[string]interface{})["reason"])
 		}
+		errType, reason := errorTypeAndReason(e)
+		// Print the error response body for debugging.
+		searchLogger.Error("elasticsearch search error",
+			zap.String("index", index),
+			zap.String("status", res.Status()),
+			zap.String("type", errType),
+			zap.String("reason", reason),
+		)
+		return nilResult, fmt.Errorf("elasticsearch search error: [%s] %s", res.Status(), reason)
 	}
 
 	var r SearchResult
 	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
 		return nilResult, fmt.Errorf("error parsing the response body: %w", err)
 	}
+	r.resolveBestChunks()
+	return r, nil
+}
 
-	searchLogger.Info("moments search completed",
-		zap.String("status", res.Status()),
-		zap.Int("hits_count", len(r.Hits.Hits)),
-		zap.Int("total", r.Hits.Total.Value),
-	)
+// errorTypeAndReason extracts error.type and error.reason from an Elasticsearch error body.
+func errorTypeAndReason(e map[string]interface{}) (string, string) {
+	errObj, ok := e["error"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Sprintf("%v", e["error"])
+	}
+	errType, _ := errObj["type"].(string)
+	reason, _ := errObj["reason"].(string)
+	return errType, reason
+}
 
-	return r, nil
+// searchSpec describes the hybrid query of an entity type that needs nothing
+// beyond boosted text fields and, optionally, one stored vector.
+type searchSpec struct {
+	// fields are the multi_match fields, with boosts
+	fields []string
+	// highlight are the fields returned with <mark> highlights
+	highlight []string
+	// vectorField is the kNN field; empty for keyword-only types
+	vectorField string
+}
+
+var (
+	mindmapsSearch = searchSpec{
+		fields:    []string{"title^3", "summary^2", "content"},
+		highlight: []string{"title", "summary", "content"},
+	}
+	todosSearch = searchSpec{
+		fields:    []string{"title^3", "content"},
+		highlight: []string{"title", "content"},
+	}
+	imagesSearch = searchSpec{
+		fields:      []string{"description^2", "name"},
+		highlight:   []string{"description", "name"},
+		vectorField: "description_vector",
+	}
+	videosSearch = searchSpec{
+		fields:    []string{"name"},
+		highlight: []string{"name"},
+	}
+)
+
+// body builds the query like buildMomentsSearchBody. A nil vector, or a spec
+// without a vector field, leaves the kNN clause out.
+func (s searchSpec) body(query string, vector []float32, opts SearchOptions) map[string]any {
+	opts = opts.normalize()
+
+	boolQuery := map[string]any{
+		"should": []any{
+			// ① 两个词都得出现
+			map[string]any{
+				"multi_match": map[string]any{
+					"query":    query,
+					"fields":   s.fields,
+					"type":     "best_fields",
+					"operator": "and",
+					"boost":    3,
+				},
+			},
+			// ② 强力短语 boost
+			map[string]any{
+				"multi_match": map[string]any{
+					"query":  query,
+					"fields": s.fields,
+					"type":   "phrase",
+					"slop":   2,
+					"boost":  5,
+				},
+			},
+		},
+		"minimum_should_match": 1,
+	}
+	if filters := opts.Filters.clauses(); len(filters) > 0 {
+		boolQuery["filter"] = filters
+	}
+
+	highlightFields := make(map[string]any, len(s.highlight))
+	for _, field := range s.highlight {
+		highlightFields[field] = map[string]any{"number_of_fragments": 0}
+	}
+
+	body := map[string]any{
+		"query": map[string]any{
+			"bool": boolQuery,
+		},
+		"highlight": map[string]any{
+			"pre_tags":  []string{"<mark>"},
+			"post_tags": []string{"</mark>"},
+			"fields":    highlightFields,
+		},
+		"from": opts.From,
+		"size": opts.Size,
+	}
+	if s.vectorField != "" {
+		body["_source"] = map[string]any{
+			"excludes": []string{s.vectorField},
+		}
+		if vector != nil {
+			body["knn"] = []any{
+				knnClause(s.vectorField, vector, 30, 100, 5, opts),
+			}
+		}
+	}
+	return body
 }
@@ -0,0 +1,206 @@
+package es
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildKeepsSearchBody_Pagination(t *testing.T) {
+	vec := []float32{0.1, 0.2}
+	body := buildKeepsSearchBody("hello", vec, SearchOptions{From: 40, Size: 20})
+
+	if body["from"] != 40 || body["size"] != 20 {
+		t.Fatalf("expected from=40 size=20, got from=%v size=%v", body["from"], body["size"])
+	}
+
+	// k must cover the requested page
+	for _, clause := range body["knn"].([]any) {
+		knn := clause.(map[string]any)
+		if knn["k"].(int) < 60 {
+			t.Errorf("knn %s: expected k >= 60, got %v", knn["field"], knn["k"])
+		}
+		if knn["num_candidates"].(int) < knn["k"].(int) {
+			t.Errorf("knn %s: num_candidates %v smaller than k %v", knn["field"], knn["num_candidates"], knn["k"])
+		}
+		if _, ok := knn["filter"]; ok {
+			t.Errorf("knn %s: unexpected filter without SearchFilters", knn["field"])
+		}
+	}
+}
+
+func TestBuildKeepsSearchBody_DefaultSize(t *testing.T) {
+	body := buildKeepsSearchBody("hello", []float32{0.1}, SearchOptions{})
+	if body["size"] != DefaultSearchSize {
+		t.Errorf("expected default size %d, got %v", DefaultSearchSize, body["size"])
+	}
+}
+
+func TestBuildSearchBody_DegradedWithoutVector(t *testing.T) {
+	for name, body := range map[string]map[string]any{
+		"keeps":   buildKeepsSearchBody("hello", nil, SearchOptions{}),
+		"moments": buildMomentsSearchBody("hello", nil, SearchOptions{}),
+	} {
+		if _, ok := body["knn"]; ok {
+			t.Errorf("%s: expected no knn clause without a query vector", name)
+		}
+		if _, ok := body["query"]; !ok {
+			t.Errorf("%s: expected the keyword query to remain", name)
+		}
+	}
+}
+
+func TestBuildMomentsSearchBody_Filters(t *testing.T) {
+	isPublic := true
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	opts := SearchOptions{
+		Filters: SearchFilters{
+			Tags:        []string{"go", "es"},
+			Category:    "note",
+			IsPublic:    &isPublic,
+			OwnerID:     "user-1",
+			CreatedFrom: &from,
+		},
+	}
+	body := buildMomentsSearchBody("hello", []float32{0.1}, opts)
+
+	boolQuery := body["query"].(map[string]any)["bool"].(map[string]any)
+	filters, ok := boolQuery["filter"].([]any)
+	if !ok || len(filters) != 5 {
+		t.Fatalf("expected 5 filter clauses on the keyword query, got %v", boolQuery["filter"])
+	}
+
+	knn := body["knn"].([]any)[0].(map[string]any)
+	if knnFilters, ok := knn["filter"].([]any); !ok || len(knnFilters) != 5 {
+		t.Fatalf("expected 5 filter clauses on the knn clause, got %v", knn["filter"])
+	}
+
+	rng := filters[4].(map[string]any)["range"].(map[string]any)["createdAt"].(map[string]any)
+	if rng["gte"] != "2024-01-01T00:00:00Z" {
+		t.Errorf("unexpected createdAt lower bound: %v", rng["gte"])
+	}
+	if _, ok := rng["lte"]; ok {
+		t.Errorf("unexpected createdAt upper bound: %v", rng["lte"])
+	}
+}
+
+func TestDecodeTags(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want int
+	}{
+		{name: "string array", raw: `["a","b"]`, want: 2},
+		{name: "empty", raw: ``, want: 0},
+		{name: "not an array", raw: `{"a":1}`, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := decodeTags([]byte(tt.raw)); len(got) != tt.want {
+				t.Errorf("decodeTags(%s) = %v, want %d tags", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFuseRRF(t *testing.T) {
+	keeps := []SearchHit{
+		{Type: TypeKeep, ID: "k1", Score: 12},
+		{Type: TypeKeep, ID: "k2", Score: 9},
+	}
+	moments := []SearchHit{
+		{Type: TypeMoment, ID: "m1", Score: 3},
+		{Type: TypeMoment, ID: "k1", Score: 2}, // same id, different type
+	}
+
+	fused := fuseRRF([][]SearchHit{keeps, moments}, rrfRankConstant)
+	if len(fused) != 4 {
+		t.Fatalf("expected 4 fused hits, got %d", len(fused))
+	}
+
+	// Rank 1 of each list ties; first-seen order wins
+	if fused[0].Type != TypeKeep || fused[0].ID != "k1" {
+		t.Errorf("expected keep k1 first, got %s %s", fused[0].Type, fused[0].ID)
+	}
+	if fused[1].Type != TypeMoment || fused[1].ID != "m1" {
+		t.Errorf("expected moment m1 second, got %s %s", fused[1].Type, fused[1].ID)
+	}
+	if want := 1.0 / 61; fused[0].Score != want {
+		t.Errorf("expected fused score %v, got %v", want, fused[0].Score)
+	}
+}
+
+func TestFuseRRF_SharedHitAccumulates(t *testing.T) {
+	a := []SearchHit{{Type: TypeKeep, ID: "x"}, {Type: TypeKeep, ID: "y"}}
+	b := []SearchHit{{Type: TypeKeep, ID: "y"}, {Type: TypeKeep, ID: "z"}}
+
+	fused := fuseRRF([][]SearchHit{a, b}, rrfRankConstant)
+	if fused[0].ID != "y" {
+		t.Fatalf("expected y to rank first after fusion, got %s", fused[0].ID)
+	}
+	if want := 1.0/62 + 1.0/61; math.Abs(fused[0].Score-want) > 1e-12 {
+		t.Errorf("expected fused score %v, got %v", want, fused[0].Score)
+	}
+}
+
+func TestSearchSpecBody_VectorOnlyWhenConfigured(t *testing.T) {
+	vec := []float32{0.1, 0.2}
+
+	todos := todosSearch.body("hello", vec, SearchOptions{})
+	if _, ok := todos["knn"]; ok {
+		t.Error("todos: expected no knn clause for a keyword-only type")
+	}
+
+	images := imagesSearch.body("hello", vec, SearchOptions{From: 0, Size: 50})
+	clauses, ok := images["knn"].([]any)
+	if !ok || len(clauses) != 1 {
+		t.Fatalf("images: expected one knn clause, got %v", images["knn"])
+	}
+	knn := clauses[0].(map[string]any)
+	if knn["field"] != "description_vector" || knn["k"].(int) < 50 {
+		t.Errorf("images: unexpected knn clause %v", knn)
+	}
+	excludes := images["_source"].(map[string]any)["excludes"].([]string)
+	if len(excludes) != 1 || excludes[0] != "description_vector" {
+		t.Errorf("images: expected the vector to be excluded from _source, got %v", excludes)
+	}
+}
+
+func TestBuildKeepsSearchBody_KNNWithinResultWindow(t *testing.T) {
+	body := buildKeepsSearchBody("hello", []float32{0.1}, SearchOptions{From: 9950, Size: 100})
+	for _, clause := range body["knn"].([]any) {
+		knn := clause.(map[string]any)
+		if knn["k"] != MaxResultWindow || knn["num_candidates"] != MaxResultWindow {
+			t.Errorf("knn %s: expected k and num_candidates capped at %d, got %v and %v",
+				knn["field"], MaxResultWindow, knn["k"], knn["num_candidates"])
+		}
+	}
+}
+
+func TestSearchAll_SkipsEmbeddingWithoutVectorTargets(t *testing.T) {
+	var body string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/_msearch" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		raw, _ := io.ReadAll(r.Body)
+		body = string(raw)
+		fmt.Fprint(w, `{"responses":[{"status":200,"hits":{"total":{"value":0},"hits":[]}},{"status":200,"hits":{"total":{"value":0},"hits":[]}}]}`)
+	})
+
+	targets := []SearchTarget{{Type: TypeMindmap, Alias: "app-mindmaps"}, {Type: TypeTodo, Alias: "app-todos"}}
+	result, err := SearchAll(t.Context(), client, targets, "test", SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Degraded {
+		t.Error("expected types without vector fields not to degrade the search")
+	}
+	if strings.Contains(body, "knn") {
+		t.Errorf("expected keyword-only sub-searches, got %s", body)
+	}
+}
@@ -0,0 +1,232 @@
+package es
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultSuggestSize is the number of suggestions of each kind returned by default
+	DefaultSuggestSize = 5
+	// MaxSuggestSize bounds the number of suggestions of each kind
+	MaxSuggestSize = 10
+	// suggestFragmentSize is the length of a phrase completion taken from a summary
+	suggestFragmentSize = 40
+)
+
+// SuggestOptions controls a Suggest request.
+type SuggestOptions struct {
+	Size    int
+	Filters SearchFilters
+}
+
+// Suggestion is a completion taken from one keep. Highlight marks the typed
+// prefix with <mark>; Text is the same completion without the marks.
+type Suggestion struct {
+	ID        string  `json:"id"`
+	Text      string  `json:"text"`
+	Highlight string  `json:"highlight"`
+	Score     float64 `json:"score"`
+}
+
+// TagSuggestion is a tag starting with the typed prefix and the number of
+// keeps carrying it.
+type TagSuggestion struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+// SuggestResult holds the completions of a prefix: keep titles, phrases from
+// keep summaries and tags.
+type SuggestResult struct {
+	Titles  []Suggestion    `json:"titles"`
+	Phrases []Suggestion    `json:"phrases"`
+	Tags    []TagSuggestion `json:"tags"`
+}
+
+// Suggest completes a prefix typed by the user from the title and summary
+// suggest subfields of the keeps index and from its tags, in one search
+// request. Unlike Search it never embeds the query, so it stays fast enough
+// to run on every keystroke. Keeps indexed before the suggest subfields were
+// added to the mapping only show up after a reindex.
+func Suggest(ctx context.Context, client *elasticsearch.Client, alias, prefix string, opts SuggestOptions) (SuggestResult, error) {
+	result := SuggestResult{Titles: []Suggestion{}, Phrases: []Suggestion{}, Tags: []TagSuggestion{}}
+	if client == nil {
+		return result, fmt.Errorf("elasticsearch client is not initialized")
+	}
+	if alias == "" {
+		return result, fmt.Errorf("elasticsearch index alias is not provided")
+	}
+
+	opts = opts.normalize()
+	r, err := executeSearch(ctx, client, alias, buildSuggestBody(prefix, opts))
+	if err != nil {
+		return result, err
+	}
+	result = parseSuggestResult(r, opts.Size)
+
+	searchLogger.Info("suggest completed",
+		zap.String("prefix", prefix),
+		zap.Int("titles", len(result.Titles)),
+		zap.Int("phrases", len(result.Phrases)),
+		zap.Int("tags", len(result.Tags)),
+	)
+	return result, nil
+}
+
+// normalize fills in the default size and caps it at MaxSuggestSize.
+func (o SuggestOptions) normalize() SuggestOptions {
+	if o.Size <= 0 {
+		o.Size = DefaultSuggestSize
+	}
+	o.Size = min(o.Size, MaxSuggestSize)
+	return o
+}
+
+// suggestFields returns the search_as_you_type subfield of field and its shingle subfields.
+func suggestFields(field string) []string {
+	return []string{field + ".suggest", field + ".suggest._2gram", field + ".suggest._3gram"}
+}
+
+// buildSuggestBody builds the prefix query on the title and summary suggest
+// subfields. The tag aggregation runs under a global aggregation, so tags are
+// suggested from every keep matching the filters, not only from the hits.
+func buildSuggestBody(prefix string, opts SuggestOptions) map[string]any {
+	opts = opts.normalize()
+	size := opts.Size
+
+	prefixQuery := func(field string, boost float64) map[string]any {
+		return map[string]any{
+			"multi_match": map[string]any{
+				"query": prefix,
+				// 最后一个词按前缀匹配，其余词必须完整出现
+				"type":     "bool_prefix",
+				"fields":   suggestFields(field),
+				"operator": "and",
+				"boost":    boost,
+			},
+		}
+	}
+
+	filters := opts.Filters.clauses()
+	boolQuery := map[string]any{
+		"should": []any{
+			prefixQuery("title", 3),
+			prefixQuery("summary", 1),
+		},
+		"minimum_should_match": 1,
+	}
+	if len(filters) > 0 {
+		boolQuery["filter"] = filters
+	}
+
+	tagFilter := map[string]any{"match_all": map[string]any{}}
+	if len(filters) > 0 {
+		tagFilter = map[string]any{"bool": map[string]any{"filter": filters}}
+	}
+
+	return map[string]any{
+		// 一条命中可能同时给出标题和短语补全，多取一些以填满两类
+		"size":             size * 2,
+		"_source":          []string{"title"},
+		"track_total_hits": false,
+		"query": map[string]any{
+			"bool": boolQuery,
+		},
+		"highlight": map[string]any{
+			"pre_tags":  []string{"<mark>"},
+			"post_tags": []string{"</mark>"},
+			"fields": map[string]any{
+				"title.suggest": map[string]any{
+					"number_of_fragments": 0,
+				},
+				"summary.suggest": map[string]any{
+					"fragment_size":       suggestFragmentSize,
+					"number_of_fragments": 1,
+				},
+			},
+		},
+		"aggs": map[string]any{
+			"all_keeps": map[string]any{
+				"global": map[string]any{},
+				"aggs": map[string]any{
+					"filtered": map[string]any{
+						"filter": tagFilter,
+						"aggs": map[string]any{
+							"tags": map[string]any{
+								"terms": map[string]any{
+									"field":   "tags",
+									"include": tagPrefixPattern(prefix),
+									"size":    size,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// tagPrefixPattern builds the terms include regular expression matching tags
+// that start with prefix, ignoring the case of ASCII letters since tags are
+// stored as typed.
+func tagPrefixPattern(prefix string) string {
+	var b strings.Builder
+	for _, r := range strings.TrimSpace(prefix) {
+		lower, upper := unicode.ToLower(r), unicode.ToUpper(r)
+		switch {
+		case r < unicode.MaxASCII && lower != upper:
+			fmt.Fprintf(&b, "[%c%c]", lower, upper)
+		case strings.ContainsRune(`.?+*|{}[]()"\#@&<>~`, r):
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteString(".*")
+	return b.String()
+}
+
+var markTags = regexp.MustCompile(`</?mark>`)
+
+// parseSuggestResult splits the hits into title and phrase completions, each
+// capped at the requested size, and reads the tag buckets.
+func parseSuggestResult(r SearchResult, size int) SuggestResult {
+	result := SuggestResult{Titles: []Suggestion{}, Phrases: []Suggestion{}, Tags: []TagSuggestion{}}
+	for _, h := range r.Hits.Hits {
+		if title := gjson.GetBytes(h.Highlight, `title\.suggest.0`); title.Exists() && len(result.Titles) < size {
+			result.Titles = append(result.Titles, Suggestion{
+				ID:        h.ID,
+				Text:      gjson.GetBytes(h.Source, "title").String(),
+				Highlight: title.String(),
+				Score:     h.Score,
+			})
+		}
+		if phrase := gjson.GetBytes(h.Highlight, `summary\.suggest.0`); phrase.Exists() && len(result.Phrases) < size {
+			result.Phrases = append(result.Phrases, Suggestion{
+				ID:        h.ID,
+				Text:      strings.TrimSpace(markTags.ReplaceAllString(phrase.String(), "")),
+				Highlight: phrase.String(),
+				Score:     h.Score,
+			})
+		}
+	}
+
+	gjson.GetBytes(r.Aggregations, "all_keeps.filtered.tags.buckets").ForEach(func(_, bucket gjson.Result) bool {
+		result.Tags = append(result.Tags, TagSuggestion{
+			Tag:   bucket.Get("key").String(),
+			Count: int(bucket.Get("doc_count").Int()),
+		})
+		return true
+	})
+	return result
+}
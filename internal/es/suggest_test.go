@@ -0,0 +1,92 @@
+package es
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/tidwall/gjson"
+)
+
+func TestTagPrefixPattern(t *testing.T) {
+	for _, tc := range []struct {
+		prefix string
+		match  []string
+		reject []string
+	}{
+		{"Go", []string{"go", "Golang", "GO"}, []string{"ago", "python"}},
+		{"机器", []string{"机器学习", "机器"}, []string{"学习机器"}},
+		{"c++", []string{"C++", "c++20"}, []string{"cpp"}},
+	} {
+		// Lucene 正则默认整词匹配，用 ^$ 模拟
+		re := regexp.MustCompile("^" + tagPrefixPattern(tc.prefix) + "$")
+		for _, tag := range tc.match {
+			if !re.MatchString(tag) {
+				t.Errorf("%q: expected %q to match %s", tc.prefix, tag, re)
+			}
+		}
+		for _, tag := range tc.reject {
+			if re.MatchString(tag) {
+				t.Errorf("%q: expected %q not to match %s", tc.prefix, tag, re)
+			}
+		}
+	}
+}
+
+func TestBuildSuggestBody(t *testing.T) {
+	body := buildSuggestBody("机器学", SuggestOptions{Size: 50, Filters: SearchFilters{OwnerID: "u1"}})
+	raw, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := gjson.GetBytes(raw, "aggs.all_keeps.aggs.filtered.aggs.tags.terms.size").Int(); got != MaxSuggestSize {
+		t.Errorf("expected the size to be capped at %d, got %d", MaxSuggestSize, got)
+	}
+	if got := gjson.GetBytes(raw, "query.bool.should.0.multi_match.type").String(); got != "bool_prefix" {
+		t.Errorf("expected a bool_prefix query, got %q", got)
+	}
+	if got := gjson.GetBytes(raw, "query.bool.should.0.multi_match.fields.2").String(); got != "title.suggest._3gram" {
+		t.Errorf("expected the shingle subfields to be queried, got %q", got)
+	}
+	// 标签建议同样受过滤条件限制
+	if got := gjson.GetBytes(raw, "aggs.all_keeps.aggs.filtered.filter.bool.filter.0.term.ownerId").String(); got != "u1" {
+		t.Errorf("expected the tag aggregation to be filtered, got %s", gjson.GetBytes(raw, "aggs.all_keeps.aggs.filtered.filter").Raw)
+	}
+	if _, ok := body["knn"]; ok {
+		t.Error("expected suggestions never to use a query vector")
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/app-keeps/_search" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		hits := ""
+		for i := range 8 {
+			if i > 0 {
+				hits += ","
+			}
+			hits += fmt.Sprintf(`{"_id":"k%d","_score":%d,"_source":{"title":"机器学习 %d"},
+				"highlight":{"title.suggest":["<mark>机</mark><mark>器</mark>学习 %d"],"summary.suggest":["关于<mark>机器</mark>学习的笔记"]}}`, i, 8-i, i, i)
+		}
+		fmt.Fprintf(w, `{"hits":{"hits":[%s]},"aggregations":{"all_keeps":{"filtered":{"tags":{"buckets":[{"key":"机器学习","doc_count":3}]}}}}}`, hits)
+	})
+
+	result, err := Suggest(t.Context(), client, "app-keeps", "机器", SuggestOptions{Size: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Titles) != 3 || result.Titles[0].ID != "k0" || result.Titles[0].Text != "机器学习 0" {
+		t.Errorf("expected the 3 best titles, got %+v", result.Titles)
+	}
+	if len(result.Phrases) != 3 || result.Phrases[0].Text != "关于机器学习的笔记" {
+		t.Errorf("expected phrases without marks, got %+v", result.Phrases)
+	}
+	if len(result.Tags) != 1 || result.Tags[0] != (TagSuggestion{Tag: "机器学习", Count: 3}) {
+		t.Errorf("expected one tag suggestion, got %+v", result.Tags)
+	}
+}
@@ -0,0 +1,95 @@
+package es
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+// DocumentChange is a single document write applied by ApplyChanges.
+type DocumentChange struct {
+	ID string
+	// Document is the source to index; nil deletes the document
+	Document map[string]any
+}
+
+// ApplyChanges indexes or deletes documents in the index behind alias with a
+// single bulk request. The returned map holds the error of every change that
+// failed, keyed by document ID; deleting a document that does not exist is
+// not a failure. A non-nil error means the request as a whole failed.
+func ApplyChanges(ctx context.Context, client *elasticsearch.Client, alias string, changes []DocumentChange) (map[string]error, error) {
+	failed := make(map[string]error)
+	if len(changes) == 0 {
+		return failed, nil
+	}
+
+	var buf bytes.Buffer
+	for _, change := range changes {
+		action := "index"
+		if change.Document == nil {
+			action = "delete"
+		}
+		meta := map[string]any{action: map[string]any{"_index": alias, "_id": change.ID}}
+		if err := json.NewEncoder(&buf).Encode(meta); err != nil {
+			return nil, fmt.Errorf("failed to encode bulk action for %s: %w", change.ID, err)
+		}
+		if change.Document != nil {
+			if err := json.NewEncoder(&buf).Encode(change.Document); err != nil {
+				return nil, fmt.Errorf("failed to encode document %s: %w", change.ID, err)
+			}
+		}
+	}
+
+	res, err := client.Bulk(&buf,
+		client.Bulk.WithContext(ctx),
+		// 让变更在下一次搜索中立即可见
+		client.Bulk.WithRefresh("wait_for"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("bulk request failed: %w", err)
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			indexerLogger.Error("error closing response body",
+				zap.Error(err),
+			)
+		}
+	}(res.Body)
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bulk response body: %w", err)
+	}
+	if res.IsError() {
+		return nil, fmt.Errorf("bulk request returned error: [%s] %s", res.Status(), string(body))
+	}
+
+	gjson.GetBytes(body, "items").ForEach(func(_, item gjson.Result) bool {
+		item.ForEach(func(action, result gjson.Result) bool {
+			if !result.Get("error").Exists() {
+				return false
+			}
+			if action.String() == "delete" && result.Get("status").Int() == http.StatusNotFound {
+				return false
+			}
+			failed[result.Get("_id").String()] = fmt.Errorf("%s %s: [%d] %s: %s",
+				action.String(),
+				result.Get("_id").String(),
+				result.Get("status").Int(),
+				result.Get("error.type").String(),
+				result.Get("error.reason").String(),
+			)
+			return false
+		})
+		return true
+	})
+	return failed, nil
+}
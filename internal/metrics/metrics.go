@@ -214,6 +214,23 @@ var (
 		},
 		[]string{"type", "percentile"},
 	)
+
+	// 索引一致性检查：kind 为 missing、orphaned 或 stale
+	indexInconsistentDocuments = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "index_inconsistent_documents",
+			Help: "Documents differing between the database and the index in the last consistency check",
+		},
+		[]string{"index", "kind"},
+	)
+
+	indexRepairedDocumentsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "index_repaired_documents_total",
+			Help: "Total number of documents re-upserted or deleted by consistency repairs",
+		},
+		[]string{"index"},
+	)
 )
 
 // NewMiddleware creates a Fiber middleware for collecting HTTP metrics
@@ -305,6 +322,14 @@ func RecordSearchLatencyPercentile(searchType string, percentile string, latency
 	searchLatencyPercentile.WithLabelValues(searchType, percentile).Set(latency)
 }
 
+// RecordIndexConsistency records the differences found by an index consistency check
+func RecordIndexConsistency(indexName string, missing, orphaned, stale, repaired int) {
+	indexInconsistentDocuments.WithLabelValues(indexName, "missing").Set(float64(missing))
+	indexInconsistentDocuments.WithLabelValues(indexName, "orphaned").Set(float64(orphaned))
+	indexInconsistentDocuments.WithLabelValues(indexName, "stale").Set(float64(stale))
+	indexRepairedDocumentsTotal.WithLabelValues(indexName).Add(float64(repaired))
+}
+
 // Collector MetricsCollector provides methods to collect various application metrics
 type Collector struct {
 	logger       *logger.Logger
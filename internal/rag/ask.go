@@ -0,0 +1,227 @@
+// Package rag answers questions over the user's notes: it retrieves keeps
+// and moments through the hybrid search, quotes their most relevant passages
+// and streams the answer of the LLM, with citations, back to the caller.
+package rag
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"api.us4ever/internal/dify"
+	"api.us4ever/internal/es"
+	"api.us4ever/internal/logger"
+	"api.us4ever/internal/search"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+var ragLogger *logger.Logger
+
+func init() {
+	var err error
+	ragLogger, err = logger.New("rag")
+	if err != nil {
+		panic("failed to initialize rag logger: " + err.Error())
+	}
+}
+
+const (
+	// DefaultLimit is the number of keeps and of moments retrieved by default
+	DefaultLimit = 5
+	// MaxLimit bounds the number of keeps and of moments retrieved
+	MaxLimit = 10
+)
+
+// Names of the events emitted by Answer, in order: citations once, answer
+// for every piece of text, then done or error.
+const (
+	EventCitations = "citations"
+	EventAnswer    = "answer"
+	EventDone      = "done"
+	EventError     = "error"
+)
+
+// StatusNoContext is the done status when no note matched the question; the
+// LLM is not called then.
+const StatusNoContext = "no_context"
+
+// Event is one step of a streamed answer.
+type Event struct {
+	Name string
+	Data any
+}
+
+// Done is the data of the done event.
+type Done struct {
+	Status string `json:"status"`
+	// Cited are the refs of the citations the answer actually uses
+	Cited []int `json:"cited"`
+	// Degraded is set when retrieval fell back to keyword-only search
+	Degraded bool `json:"degraded,omitempty"`
+}
+
+// Options controls retrieval.
+type Options struct {
+	// Limit is the number of keeps and of moments retrieved
+	Limit   int
+	Filters es.SearchFilters
+}
+
+// Retrieval is the context gathered for a question.
+type Retrieval struct {
+	Question string
+	Sources  []Source
+	Degraded bool
+}
+
+// Retrieve searches keeps and moments for question with the search backend
+// and selects the passages quoted to the LLM. A failing search is logged and
+// skipped; Retrieve only fails if both do.
+func Retrieve(ctx context.Context, backend search.SearchBackend, question string, opts Options) (Retrieval, error) {
+	retrieval := Retrieval{Question: question}
+	if opts.Limit <= 0 {
+		opts.Limit = DefaultLimit
+	}
+	searchOpts := es.SearchOptions{Size: min(opts.Limit, MaxLimit), Filters: opts.Filters}
+
+	keeps, keepsErr := backend.Search(ctx, es.KeepIndex, question, searchOpts)
+	if keepsErr != nil {
+		ragLogger.Warn("failed to retrieve keeps", zap.Error(keepsErr))
+	}
+	moments, momentsErr := backend.Search(ctx, es.MomentIndex, question, searchOpts)
+	if momentsErr != nil {
+		ragLogger.Warn("failed to retrieve moments", zap.Error(momentsErr))
+	}
+	if keepsErr != nil && momentsErr != nil {
+		return retrieval, fmt.Errorf("failed to retrieve notes: %w", keepsErr)
+	}
+
+	docs := interleave(
+		documents(es.TypeKeep, keeps, keepDocument),
+		documents(es.TypeMoment, moments, momentDocument),
+	)
+	retrieval.Sources = selectSources(question, docs)
+	retrieval.Degraded = keeps.Degraded || moments.Degraded
+
+	ragLogger.Info("retrieved context",
+		zap.Int("keeps", len(keeps.Hits.Hits)),
+		zap.Int("moments", len(moments.Hits.Hits)),
+		zap.Int("sources", len(retrieval.Sources)),
+		zap.Bool("degraded", retrieval.Degraded),
+	)
+	return retrieval, nil
+}
+
+// documents converts search hits into documents with toDocument.
+func documents(entityType string, result es.SearchResult, toDocument func(source json.RawMessage) (string, string)) []Document {
+	docs := make([]Document, 0, len(result.Hits.Hits))
+	for _, h := range result.Hits.Hits {
+		title, text := toDocument(h.Source)
+		docs = append(docs, Document{Type: entityType, ID: h.ID, Title: title, Text: text})
+	}
+	return docs
+}
+
+func keepDocument(source json.RawMessage) (string, string) {
+	var parts []string
+	for _, field := range []string{"summary", "content"} {
+		if v := strings.TrimSpace(gjson.GetBytes(source, field).String()); v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return gjson.GetBytes(source, "title").String(), strings.Join(parts, "\n\n")
+}
+
+func momentDocument(source json.RawMessage) (string, string) {
+	parts := []string{strings.TrimSpace(gjson.GetBytes(source, "content").String())}
+	gjson.GetBytes(source, "images.#.description").ForEach(func(_, d gjson.Result) bool {
+		if v := strings.TrimSpace(d.String()); v != "" {
+			parts = append(parts, "图片："+v)
+		}
+		return true
+	})
+	return "", strings.Join(parts, "\n\n")
+}
+
+// interleave merges two rankings by alternating them, which is what
+// reciprocal rank fusion amounts to for two lists without shared hits.
+func interleave(a, b []Document) []Document {
+	out := make([]Document, 0, len(a)+len(b))
+	for i := 0; i < max(len(a), len(b)); i++ {
+		if i < len(a) {
+			out = append(out, a[i])
+		}
+		if i < len(b) {
+			out = append(out, b[i])
+		}
+	}
+	return out
+}
+
+// Answer streams the answer to a retrieval through emit: the citations
+// first, then the text as the LLM produces it through the Dify workflow, then
+// a Done naming the citations used. An error of emit, e.g. a disconnected
+// client, stops the stream. Other failures are emitted as an error event and
+// returned.
+func Answer(ctx context.Context, retrieval Retrieval, emit func(Event) error) error {
+	if err := emit(Event{Name: EventCitations, Data: citations(retrieval.Sources)}); err != nil {
+		return err
+	}
+	if len(retrieval.Sources) == 0 {
+		return emit(Event{Name: EventDone, Data: Done{Status: StatusNoContext, Cited: []int{}, Degraded: retrieval.Degraded}})
+	}
+
+	req := &dify.WorkflowRequest{
+		Inputs: dify.WorkflowInput{
+			Action:  dify.ActionAnswer,
+			Content: buildPrompt(retrieval.Question, retrieval.Sources),
+		},
+	}
+	var emitErr error
+	result, err := dify.StreamWorkflow(ctx, req, func(text string) error {
+		emitErr = emit(Event{Name: EventAnswer, Data: map[string]string{"text": text}})
+		return emitErr
+	})
+	if emitErr != nil {
+		return emitErr
+	}
+	if err != nil {
+		ragLogger.Error("failed to generate answer", zap.Error(err))
+		_ = emit(Event{Name: EventError, Data: map[string]string{"message": "Failed to generate an answer"}})
+		return err
+	}
+
+	done := Done{
+		Status:   result.Status,
+		Cited:    citedRefs(result.Message, len(retrieval.Sources)),
+		Degraded: retrieval.Degraded,
+	}
+	ragLogger.Info("answer completed",
+		zap.String("status", done.Status),
+		zap.Int("sources", len(retrieval.Sources)),
+		zap.Ints("cited", done.Cited),
+	)
+	return emit(Event{Name: EventDone, Data: done})
+}
+
+var citationRef = regexp.MustCompile(`\[(\d+)\]`)
+
+// citedRefs returns the distinct [n] references in answer that point at one
+// of the sources, in ascending order.
+func citedRefs(answer string, sources int) []int {
+	refs := []int{}
+	for _, m := range citationRef.FindAllStringSubmatch(answer, -1) {
+		ref, err := strconv.Atoi(m[1])
+		if err != nil || ref < 1 || ref > sources || slices.Contains(refs, ref) {
+			continue
+		}
+		refs = append(refs, ref)
+	}
+	slices.Sort(refs)
+	return refs
+}
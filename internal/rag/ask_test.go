@@ -0,0 +1,45 @@
+package rag
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCitedRefs(t *testing.T) {
+	got := citedRefs("番茄要先炒[2]，鸡蛋另炒[1][2]。见 [7] 和 [0]。", 3)
+	if want := []int{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("citedRefs = %v, want %v", got, want)
+	}
+	if got := citedRefs("没有引用", 3); got == nil || len(got) != 0 {
+		t.Errorf("citedRefs without refs = %#v, want empty slice", got)
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	a := []Document{{ID: "k1"}, {ID: "k2"}, {ID: "k3"}}
+	b := []Document{{ID: "m1"}}
+	var ids []string
+	for _, d := range interleave(a, b) {
+		ids = append(ids, d.ID)
+	}
+	if want := []string{"k1", "m1", "k2", "k3"}; !slices.Equal(ids, want) {
+		t.Errorf("interleave = %v, want %v", ids, want)
+	}
+}
+
+func TestAnswer_NoContextSkipsLLM(t *testing.T) {
+	var events []Event
+	err := Answer(t.Context(), Retrieval{Question: "问题"}, func(e Event) error {
+		events = append(events, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Answer: %v", err)
+	}
+	if len(events) != 2 || events[0].Name != EventCitations || events[1].Name != EventDone {
+		t.Fatalf("unexpected events: %+v", events)
+	}
+	if done := events[1].Data.(Done); done.Status != StatusNoContext {
+		t.Errorf("done status = %q, want %q", done.Status, StatusNoContext)
+	}
+}
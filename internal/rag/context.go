@@ -0,0 +1,215 @@
+package rag
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"api.us4ever/internal/chunk"
+)
+
+const (
+	// maxContextRunes bounds the note text sent to the LLM
+	maxContextRunes = 6000
+	// maxPassagesPerDocument keeps one long note from filling the whole context
+	maxPassagesPerDocument = 2
+	// snippetRunes is the length of the passage excerpt returned with a citation
+	snippetRunes = 120
+)
+
+// passageOptions cut notes into passages small enough that several notes fit
+// in the context window.
+var passageOptions = chunk.Options{MaxRunes: 400, OverlapRunes: 60}
+
+// Document is a note retrieved for a question, in retrieval order.
+type Document struct {
+	Type  string
+	ID    string
+	Title string
+	Text  string
+}
+
+// Source is a document quoted in the context window. Ref is the number the
+// answer cites it with, e.g. [1].
+type Source struct {
+	Ref      int
+	Document Document
+	Passages []string
+}
+
+// Citation is a source as returned to the client.
+type Citation struct {
+	Ref     int    `json:"ref"`
+	Type    string `json:"type"`
+	ID      string `json:"id"`
+	Title   string `json:"title,omitempty"`
+	Snippet string `json:"snippet"`
+}
+
+// candidate is one passage of a retrieved document with its relevance.
+type candidate struct {
+	doc     int // index in retrieval order
+	passage int // index in the document
+	text    string
+	score   float64
+}
+
+// selectSources picks the passages of docs most relevant to question until
+// the context window is full. Every document contributes at most
+// maxPassagesPerDocument passages; its first passage stands in when none
+// shares a term with the question, since the search ranked it anyway.
+// Sources are numbered by their best passage, most relevant first.
+func selectSources(question string, docs []Document) []Source {
+	terms := questionTerms(question)
+
+	var candidates []candidate
+	for i, doc := range docs {
+		passages := chunk.Split(doc.Text, passageOptions)
+		if len(passages) == 0 && doc.Title != "" {
+			passages = []chunk.Passage{{Text: doc.Title}}
+		}
+		var scored []candidate
+		for j, p := range passages {
+			scored = append(scored, candidate{doc: i, passage: j, text: p.Text, score: overlap(terms, p.Text)})
+		}
+		slices.SortStableFunc(scored, func(a, b candidate) int {
+			return cmp.Compare(b.score, a.score)
+		})
+		candidates = append(candidates, scored[:min(len(scored), maxPassagesPerDocument)]...)
+	}
+
+	// 检索排名靠前的文档在相关度相同时优先
+	rankWeight := func(c candidate) float64 { return c.score + 1/float64(c.doc+2) }
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		return cmp.Compare(rankWeight(b), rankWeight(a))
+	})
+
+	var (
+		sources []Source
+		byDoc   = make(map[int]int)
+		budget  = maxContextRunes
+	)
+	for _, c := range candidates {
+		n := utf8.RuneCountInString(c.text)
+		if n > budget {
+			continue
+		}
+		budget -= n
+		i, ok := byDoc[c.doc]
+		if !ok {
+			i = len(sources)
+			byDoc[c.doc] = i
+			sources = append(sources, Source{Ref: i + 1, Document: docs[c.doc]})
+		}
+		sources[i].Passages = append(sources[i].Passages, c.text)
+	}
+	return sources
+}
+
+// questionTerms returns the terms of a question: lower-cased Latin words and
+// digits, and bigrams of Chinese characters, the unit a CJK analyzer matches on.
+func questionTerms(text string) map[string]bool {
+	terms := make(map[string]bool)
+	for _, t := range tokenize(text) {
+		terms[t] = true
+	}
+	return terms
+}
+
+// overlap returns the share of terms found in text.
+func overlap(terms map[string]bool, text string) float64 {
+	if len(terms) == 0 {
+		return 0
+	}
+	found := make(map[string]bool)
+	for _, t := range tokenize(text) {
+		if terms[t] {
+			found[t] = true
+		}
+	}
+	return float64(len(found)) / float64(len(terms))
+}
+
+func tokenize(text string) []string {
+	var (
+		tokens []string
+		word   []rune
+		han    []rune
+	)
+	flushWord := func() {
+		if len(word) > 1 {
+			tokens = append(tokens, string(word))
+		}
+		word = word[:0]
+	}
+	flushHan := func() {
+		if len(han) == 1 {
+			tokens = append(tokens, string(han))
+		}
+		for i := 0; i+1 < len(han); i++ {
+			tokens = append(tokens, string(han[i:i+2]))
+		}
+		han = han[:0]
+	}
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flushWord()
+			han = append(han, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushHan()
+			word = append(word, unicode.ToLower(r))
+		default:
+			flushWord()
+			flushHan()
+		}
+	}
+	flushWord()
+	flushHan()
+	return tokens
+}
+
+// buildPrompt lays out the numbered sources and the question for the answer
+// action of the Dify workflow.
+func buildPrompt(question string, sources []Source) string {
+	var b strings.Builder
+	b.WriteString("以下是用户笔记中与问题相关的片段，每条以 [编号] 开头。\n")
+	b.WriteString("只根据这些片段回答问题，在用到的内容后用 [编号] 标注来源；片段不足以回答时直接说明。\n\n")
+	for _, s := range sources {
+		fmt.Fprintf(&b, "[%d] %s", s.Ref, s.Document.Type)
+		if s.Document.Title != "" {
+			fmt.Fprintf(&b, "《%s》", s.Document.Title)
+		}
+		b.WriteByte('\n')
+		for _, p := range s.Passages {
+			b.WriteString(p)
+			b.WriteString("\n")
+		}
+		b.WriteByte('\n')
+	}
+	b.WriteString("问题：")
+	b.WriteString(question)
+	return b.String()
+}
+
+// citations converts sources into the citations returned to the client.
+func citations(sources []Source) []Citation {
+	out := make([]Citation, 0, len(sources))
+	for _, s := range sources {
+		snippet := []rune(s.Passages[0])
+		if len(snippet) > snippetRunes {
+			snippet = append(snippet[:snippetRunes], '…')
+		}
+		out = append(out, Citation{
+			Ref:     s.Ref,
+			Type:    s.Document.Type,
+			ID:      s.Document.ID,
+			Title:   s.Document.Title,
+			Snippet: string(snippet),
+		})
+	}
+	return out
+}
@@ -0,0 +1,84 @@
+package rag
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTokenize(t *testing.T) {
+	got := tokenize("Go 语言的 channel 用法")
+	want := []string{"go", "语言", "言的", "channel", "用法"}
+	if !slices.Equal(got, want) {
+		t.Errorf("tokenize = %q, want %q", got, want)
+	}
+	if got := tokenize("猫"); !slices.Equal(got, []string{"猫"}) {
+		t.Errorf("single Han character: got %q", got)
+	}
+}
+
+func TestSelectSources_PrefersRelevantPassages(t *testing.T) {
+	long := strings.Repeat("今天天气很好，出去散步了。", 40) + "\n\n" +
+		"番茄炒蛋的做法：先炒鸡蛋，再放番茄。"
+	docs := []Document{
+		{Type: "keep", ID: "k1", Title: "日记", Text: long},
+		{Type: "moment", ID: "m1", Text: "周末买了番茄。"},
+		{Type: "keep", ID: "k2", Text: "完全无关的内容。"},
+	}
+
+	sources := selectSources("番茄炒蛋怎么做", docs)
+	if len(sources) != 3 {
+		t.Fatalf("got %d sources, want 3", len(sources))
+	}
+	if sources[0].Document.ID != "k1" || sources[0].Ref != 1 {
+		t.Errorf("first source = %s (ref %d), want k1 (ref 1)", sources[0].Document.ID, sources[0].Ref)
+	}
+	if !strings.Contains(sources[0].Passages[0], "番茄炒蛋") {
+		t.Errorf("best passage of k1 does not hold the recipe: %q", sources[0].Passages[0])
+	}
+	if len(sources[0].Passages) > maxPassagesPerDocument {
+		t.Errorf("k1 contributed %d passages", len(sources[0].Passages))
+	}
+	// 无关文档仍按检索排名保留，排在最后
+	if sources[2].Document.ID != "k2" {
+		t.Errorf("last source = %s, want k2", sources[2].Document.ID)
+	}
+}
+
+func TestSelectSources_RespectsBudget(t *testing.T) {
+	var docs []Document
+	for range 40 {
+		docs = append(docs, Document{Type: "keep", ID: "k", Text: strings.Repeat("笔记内容。", 80)})
+	}
+	total := 0
+	for _, s := range selectSources("笔记", docs) {
+		for _, p := range s.Passages {
+			total += utf8.RuneCountInString(p)
+		}
+	}
+	if total == 0 || total > maxContextRunes {
+		t.Errorf("context holds %d runes, want 1..%d", total, maxContextRunes)
+	}
+}
+
+func TestBuildPromptAndCitations(t *testing.T) {
+	sources := []Source{
+		{Ref: 1, Document: Document{Type: "keep", ID: "k1", Title: "菜谱"}, Passages: []string{strings.Repeat("番", 200)}},
+		{Ref: 2, Document: Document{Type: "moment", ID: "m1"}, Passages: []string{"买了番茄"}},
+	}
+	prompt := buildPrompt("番茄怎么做", sources)
+	for _, want := range []string{"[1] keep《菜谱》", "[2] moment\n买了番茄", "问题：番茄怎么做"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("prompt does not contain %q:\n%s", want, prompt)
+		}
+	}
+
+	cs := citations(sources)
+	if len(cs) != 2 || cs[0].ID != "k1" || cs[1].Type != "moment" {
+		t.Fatalf("unexpected citations: %+v", cs)
+	}
+	if n := utf8.RuneCountInString(cs[0].Snippet); n != snippetRunes+1 {
+		t.Errorf("snippet has %d runes, want %d", n, snippetRunes+1)
+	}
+}
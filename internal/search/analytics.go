@@ -0,0 +1,313 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/metrics"
+	"go.uber.org/zap"
+)
+
+// Search types recorded besides the index names
+const (
+	SearchTypeAll     = "all"
+	SearchTypeSuggest = "suggest"
+)
+
+const (
+	// latencyWindowSize is the number of recent searches per type the latency percentiles are computed over
+	latencyWindowSize = 1000
+	// searchLogTimeout bounds the background write of a search log
+	searchLogTimeout = 5 * time.Second
+
+	// DefaultReportDays is the period covered by a report by default
+	DefaultReportDays = 7
+	// DefaultReportLimit is the number of queries listed by a report by default
+	DefaultReportLimit = 20
+	// MaxReportLimit bounds the number of queries listed by a report
+	MaxReportLimit = 200
+)
+
+// latencyPercentiles are exported as search_latency_percentile
+var latencyPercentiles = []struct {
+	label string
+	p     float64
+}{
+	{"p50", 0.50},
+	{"p95", 0.95},
+	{"p99", 0.99},
+}
+
+// SearchEvent is one search handled by the search routes.
+type SearchEvent struct {
+	Query string
+	// Type is the entity type searched, SearchTypeAll or SearchTypeSuggest
+	Type      string
+	Backend   string
+	RequestID string
+	// Status is the HTTP status the search was answered with
+	Status      int
+	ResultCount int
+	Latency     time.Duration
+	Degraded    bool
+}
+
+// Click is a hit opened from the results of the search with RequestID.
+type Click struct {
+	RequestID  string
+	EntityType string
+	EntityID   string
+	// Position is the 0-based rank of the hit, when known
+	Position *int
+}
+
+// Analytics records searches in the search_log table and the Prometheus
+// search metrics, and reports on them.
+type Analytics struct {
+	db database.Service
+
+	mu        sync.Mutex
+	latencies map[string]*latencyWindow
+}
+
+// NewAnalytics returns the analytics stored through db.
+func NewAnalytics(db database.Service) *Analytics {
+	return &Analytics{db: db, latencies: make(map[string]*latencyWindow)}
+}
+
+// RecordSearch updates the search metrics and, for successful searches
+// other than suggestions, writes a search log in the background so the
+// database never slows a search down. Suggestions run on every keystroke and
+// would drown the real queries, so they only count in the metrics.
+func (a *Analytics) RecordSearch(e SearchEvent) {
+	metrics.RecordSearchRequest(e.Type, strconv.Itoa(e.Status), e.ResultCount)
+	for label, latency := range a.observe(e.Type, e.Latency) {
+		metrics.RecordSearchLatencyPercentile(e.Type, label, latency)
+	}
+
+	if e.Status >= 300 || e.Type == SearchTypeSuggest || a.db == nil {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), searchLogTimeout)
+		defer cancel()
+		err := a.db.Client().SearchLog.Create().
+			SetQuery(e.Query).
+			SetType(e.Type).
+			SetBackend(e.Backend).
+			SetResultCount(e.ResultCount).
+			SetLatencyMs(int(e.Latency.Milliseconds())).
+			SetDegraded(e.Degraded).
+			SetRequestId(e.RequestID).
+			Exec(ctx)
+		if err != nil {
+			searchLogger.Warn("failed to record search log",
+				zap.String("type", e.Type),
+				zap.Error(err),
+			)
+		}
+	}()
+}
+
+// RecordClick stores a click on a search hit.
+func (a *Analytics) RecordClick(ctx context.Context, click Click) error {
+	err := a.db.Client().SearchClick.Create().
+		SetRequestId(click.RequestID).
+		SetEntityType(click.EntityType).
+		SetEntityId(click.EntityID).
+		SetNillablePosition(click.Position).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record search click: %w", err)
+	}
+	return nil
+}
+
+// observe adds a latency to the window of searchType and returns the
+// percentiles of the window, in seconds.
+func (a *Analytics) observe(searchType string, latency time.Duration) map[string]float64 {
+	a.mu.Lock()
+	w, ok := a.latencies[searchType]
+	if !ok {
+		w = &latencyWindow{}
+		a.latencies[searchType] = w
+	}
+	w.add(latency.Seconds())
+	values := slices.Clone(w.values)
+	a.mu.Unlock()
+
+	slices.Sort(values)
+	out := make(map[string]float64, len(latencyPercentiles))
+	for _, p := range latencyPercentiles {
+		out[p.label] = percentile(values, p.p)
+	}
+	return out
+}
+
+// latencyWindow keeps the last latencyWindowSize latencies.
+type latencyWindow struct {
+	values []float64
+	next   int
+}
+
+func (w *latencyWindow) add(v float64) {
+	if len(w.values) < latencyWindowSize {
+		w.values = append(w.values, v)
+		return
+	}
+	w.values[w.next] = v
+	w.next = (w.next + 1) % latencyWindowSize
+}
+
+// percentile returns the nearest-rank percentile p of sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(p*float64(len(sorted))+0.999999) - 1
+	return sorted[min(max(rank, 0), len(sorted)-1)]
+}
+
+// ReportOptions selects the searches a report covers.
+type ReportOptions struct {
+	Since time.Time
+	// Type restricts the report to one search type; empty covers all of them
+	Type  string
+	Limit int
+}
+
+// QueryStats aggregates the searches of one query, compared ignoring case
+// and surrounding spaces.
+type QueryStats struct {
+	Query       string  `json:"query"`
+	Searches    int     `json:"searches"`
+	ZeroResults int     `json:"zeroResults"`
+	AvgResults  float64 `json:"avgResults"`
+	// Clicked is the number of searches at least one hit was opened from
+	Clicked          int       `json:"clicked"`
+	ClickThroughRate float64   `json:"clickThroughRate"`
+	LastSearchedAt   time.Time `json:"lastSearchedAt"`
+}
+
+// Report lists queries together with the totals of the period.
+type Report struct {
+	Since            time.Time    `json:"since"`
+	Searches         int          `json:"searches"`
+	ZeroResults      int          `json:"zeroResults"`
+	ZeroResultRate   float64      `json:"zeroResultRate"`
+	Clicked          int          `json:"clicked"`
+	ClickThroughRate float64      `json:"clickThroughRate"`
+	Queries          []QueryStats `json:"queries"`
+}
+
+// TopQueries reports the most frequent queries.
+func (a *Analytics) TopQueries(ctx context.Context, opts ReportOptions) (Report, error) {
+	return a.report(ctx, opts, false)
+}
+
+// ZeroResultQueries reports the queries that most often found nothing.
+func (a *Analytics) ZeroResultQueries(ctx context.Context, opts ReportOptions) (Report, error) {
+	return a.report(ctx, opts, true)
+}
+
+func (a *Analytics) report(ctx context.Context, opts ReportOptions, zeroOnly bool) (Report, error) {
+	report := Report{Since: opts.Since, Queries: []QueryStats{}}
+	client := a.db.Client()
+
+	query, args := totalsQuery(opts)
+	rows, err := client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return report, fmt.Errorf("failed to load search totals: %w", err)
+	}
+	if rows.Next() {
+		if err := rows.Scan(&report.Searches, &report.ZeroResults, &report.Clicked); err != nil {
+			_ = rows.Close()
+			return report, fmt.Errorf("failed to read search totals: %w", err)
+		}
+	}
+	if err := closeRows(rows); err != nil {
+		return report, fmt.Errorf("failed to read search totals: %w", err)
+	}
+	report.ZeroResultRate = ratio(report.ZeroResults, report.Searches)
+	report.ClickThroughRate = ratio(report.Clicked, report.Searches)
+
+	query, args = queryStatsQuery(opts, zeroOnly)
+	rows, err = client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return report, fmt.Errorf("failed to load query stats: %w", err)
+	}
+	for rows.Next() {
+		var s QueryStats
+		if err := rows.Scan(&s.Query, &s.Searches, &s.ZeroResults, &s.AvgResults, &s.Clicked, &s.LastSearchedAt); err != nil {
+			_ = rows.Close()
+			return report, fmt.Errorf("failed to read query stats: %w", err)
+		}
+		s.ClickThroughRate = ratio(s.Clicked, s.Searches)
+		report.Queries = append(report.Queries, s)
+	}
+	if err := closeRows(rows); err != nil {
+		return report, fmt.Errorf("failed to read query stats: %w", err)
+	}
+	return report, nil
+}
+
+// clickedSearches joins every search log with whether one of its hits was opened.
+const clickedSearches = `FROM "search_log" l
+LEFT JOIN (SELECT DISTINCT "requestId" FROM "search_click") c
+  ON c."requestId" = l."requestId" AND l."requestId" <> ''`
+
+// reportWhere restricts a report to its period and type.
+func reportWhere(opts ReportOptions, args *sqlArgs) string {
+	where := `l."createdAt" >= ` + args.add(opts.Since)
+	if opts.Type != "" {
+		where += ` AND l."type" = ` + args.add(opts.Type)
+	}
+	return where
+}
+
+func totalsQuery(opts ReportOptions) (string, []any) {
+	var args sqlArgs
+	return fmt.Sprintf(`SELECT count(*), count(*) FILTER (WHERE l."resultCount" = 0), count(c."requestId") %s WHERE %s`,
+		clickedSearches, reportWhere(opts, &args)), args
+}
+
+func queryStatsQuery(opts ReportOptions, zeroOnly bool) (string, []any) {
+	var args sqlArgs
+	where := reportWhere(opts, &args)
+	having, order := "", `searches DESC, last DESC`
+	if zeroOnly {
+		having, order = `HAVING count(*) FILTER (WHERE l."resultCount" = 0) > 0`, `zero DESC, last DESC`
+	}
+	return fmt.Sprintf(`SELECT lower(btrim(l."query")) AS q, count(*) AS searches,
+  count(*) FILTER (WHERE l."resultCount" = 0) AS zero,
+  avg(l."resultCount")::float8, count(c."requestId"), max(l."createdAt") AS last
+%s
+WHERE %s
+GROUP BY q %s
+ORDER BY %s
+LIMIT %s`, clickedSearches, where, having, order, args.add(opts.Limit)), args
+}
+
+func ratio(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total)
+}
+
+// closeRows closes rows, returning the error that ended the iteration if any.
+func closeRows(rows interface {
+	Err() error
+	Close() error
+}) error {
+	if err := rows.Err(); err != nil {
+		_ = rows.Close()
+		return err
+	}
+	return rows.Close()
+}
@@ -0,0 +1,78 @@
+package search
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPercentile(t *testing.T) {
+	values := make([]float64, 100)
+	for i := range values {
+		values[i] = float64(i + 1)
+	}
+	for _, tc := range []struct {
+		p    float64
+		want float64
+	}{
+		{0.50, 50},
+		{0.95, 95},
+		{0.99, 99},
+		{1, 100},
+	} {
+		if got := percentile(values, tc.p); got != tc.want {
+			t.Errorf("percentile(%v) = %v, want %v", tc.p, got, tc.want)
+		}
+	}
+	if got := percentile([]float64{0.2}, 0.99); got != 0.2 {
+		t.Errorf("expected the single value, got %v", got)
+	}
+	if got := percentile(nil, 0.5); got != 0 {
+		t.Errorf("expected 0 without values, got %v", got)
+	}
+}
+
+func TestObserveKeepsRecentLatencies(t *testing.T) {
+	a := NewAnalytics(nil)
+	for range latencyWindowSize {
+		a.observe("keep", 10*time.Second)
+	}
+	// the slow searches above leave the window once enough fast ones arrive
+	var got map[string]float64
+	for range latencyWindowSize {
+		got = a.observe("keep", 100*time.Millisecond)
+	}
+	if got["p99"] != 0.1 {
+		t.Errorf("expected p99 of the recent searches only, got %v", got)
+	}
+	if got := a.observe("moment", time.Second); got["p50"] != 1 {
+		t.Errorf("expected a window per type, got %v", got)
+	}
+}
+
+func TestQueryStatsQuery(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	query, args := queryStatsQuery(ReportOptions{Since: since, Type: "keep", Limit: 20}, false)
+	for _, want := range []string{
+		`lower(btrim(l."query"))`,
+		`l."createdAt" >= $1`,
+		`l."type" = $2`,
+		`LIMIT $3`,
+		`ORDER BY searches DESC`,
+	} {
+		if !strings.Contains(query, want) {
+			t.Errorf("expected %q in\n%s", want, query)
+		}
+	}
+	if len(args) != 3 || args[1] != "keep" || args[2] != 20 {
+		t.Errorf("unexpected args %v", args)
+	}
+
+	query, args = queryStatsQuery(ReportOptions{Since: since, Limit: 5}, true)
+	if !strings.Contains(query, "HAVING") || !strings.Contains(query, "ORDER BY zero DESC") {
+		t.Errorf("expected zero-result queries only, got\n%s", query)
+	}
+	if strings.Contains(query, `l."type"`) || len(args) != 2 {
+		t.Errorf("expected no type filter, got %v\n%s", args, query)
+	}
+}
@@ -0,0 +1,58 @@
+// Package search puts the search engines behind one SearchBackend, so the
+// routes keep working whichever engine a deployment runs: Elasticsearch when
+// it is configured, Postgres otherwise, or an embedded Bleve index.
+package search
+
+import (
+	"context"
+	"errors"
+
+	"api.us4ever/internal/es"
+	"api.us4ever/internal/logger"
+)
+
+var searchLogger *logger.Logger
+
+func init() {
+	var err error
+	searchLogger, err = logger.New("search-backend")
+	if err != nil {
+		panic("failed to initialize search backend logger: " + err.Error())
+	}
+}
+
+// Names of the backends, as selected through config.SearchConfig.Backend
+const (
+	BackendElasticsearch = "elasticsearch"
+	BackendPostgres      = "postgres"
+	BackendBleve         = "bleve"
+)
+
+// ErrUnsupportedIndex is returned when a backend cannot search an index definition.
+var ErrUnsupportedIndex = errors.New("index is not supported by this search backend")
+
+// SearchBackend runs the searches behind the search routes. Every backend
+// returns the documents of es.IndexDefinition in the same shape, so callers
+// do not care which one answered. Degraded results mean the semantic part of
+// a search could not run.
+type SearchBackend interface {
+	// Name is the name of the backend, e.g. "elasticsearch"
+	Name() string
+	// Search runs a hybrid search over the documents of def.
+	Search(ctx context.Context, def *es.IndexDefinition, query string, opts es.SearchOptions) (es.SearchResult, error)
+	// SearchAll searches several definitions and merges their rankings.
+	SearchAll(ctx context.Context, defs []*es.IndexDefinition, query string, opts es.SearchOptions) (es.UnifiedSearchResult, error)
+	// Suggest completes a prefix from keep titles, summaries and tags.
+	Suggest(ctx context.Context, prefix string, opts es.SuggestOptions) (es.SuggestResult, error)
+	// Related finds the documents of def closest to document id, returning
+	// es.ErrDocumentNotFound when it does not exist.
+	Related(ctx context.Context, def *es.IndexDefinition, id string, opts es.RelatedOptions) (es.SearchResult, error)
+}
+
+// DocumentStore is implemented by the backends keeping their own copy of the
+// documents. The search outbox sync applies every change to it as well.
+type DocumentStore interface {
+	// ApplyChanges indexes or deletes documents of def like es.ApplyChanges,
+	// returning the error of every change that failed keyed by document ID.
+	ApplyChanges(ctx context.Context, def *es.IndexDefinition, changes []es.DocumentChange) (map[string]error, error)
+}
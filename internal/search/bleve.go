@@ -0,0 +1,638 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/es"
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/highlight/highlighter/html"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultBlevePath is where the Bleve indices are kept when no path is configured
+	DefaultBlevePath = "data/search"
+
+	// sourceField stores the document as JSON, returned as the _source of hits
+	sourceField = "source"
+	// rebuildPageSize is how many documents are loaded per page while rebuilding
+	rebuildPageSize = 500
+	// tagFacetSize is the number of tags counted before keeping those starting with the prefix
+	tagFacetSize = 200
+	// relatedTextRunes bounds the text of a document used to find related ones
+	relatedTextRunes = 500
+)
+
+// Bleve searches embedded Bleve indices, one per index definition, with text
+// analyzed by the CJK bigram analyzer. It needs no external service, which
+// suits single node deployments and local development. It has no vectors:
+// results of the types with vector fields are Degraded, and related documents
+// are found by their text. The indices are filled by Rebuild and kept up to
+// date by the search outbox sync through ApplyChanges.
+type Bleve struct {
+	path string
+
+	mu      sync.RWMutex
+	indices map[string]bleve.Index
+	// rebuilding holds the indices being rebuilt, which receive the changes
+	// applied meanwhile as well
+	rebuilding map[string]bleve.Index
+}
+
+// OpenBleve opens the indices of every registered definition under path,
+// creating the missing ones empty. An empty path keeps them in memory.
+func OpenBleve(path string) (*Bleve, error) {
+	b := &Bleve{path: path, indices: make(map[string]bleve.Index), rebuilding: make(map[string]bleve.Index)}
+	for _, def := range es.IndexDefinitions() {
+		idx, err := b.open(def)
+		if err != nil {
+			b.Close()
+			return nil, err
+		}
+		b.indices[def.Name] = idx
+	}
+	return b, nil
+}
+
+func (b *Bleve) Name() string { return BackendBleve }
+
+// Path is the directory holding the indices, "" when they are in memory.
+func (b *Bleve) Path() string { return b.path }
+
+// Close closes every index.
+func (b *Bleve) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var errs []error
+	for name, idx := range b.indices {
+		if err := idx.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close bleve index %s: %w", name, err))
+		}
+	}
+	b.indices = map[string]bleve.Index{}
+	return errors.Join(errs...)
+}
+
+func (b *Bleve) indexPath(def *es.IndexDefinition) string {
+	return filepath.Join(b.path, def.Name+".bleve")
+}
+
+func (b *Bleve) open(def *es.IndexDefinition) (bleve.Index, error) {
+	if b.path == "" {
+		return bleve.NewMemOnly(bleveMapping(def))
+	}
+	path := b.indexPath(def)
+	idx, err := bleve.Open(path)
+	if errors.Is(err, bleve.ErrorIndexPathDoesNotExist) {
+		idx, err = bleve.New(path, bleveMapping(def))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bleve index %s: %w", path, err)
+	}
+	return idx, nil
+}
+
+// index returns the index of def. The read lock must be held.
+func (b *Bleve) index(def *es.IndexDefinition) (bleve.Index, error) {
+	idx, ok := b.indices[def.Name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedIndex, def.Name)
+	}
+	return idx, nil
+}
+
+// bleveMapping maps the documents of def: the text fields analyzed with the
+// CJK analyzer, the SearchFilters fields as keywords, booleans and dates, and
+// the whole document stored as JSON. Everything else is ignored.
+func bleveMapping(def *es.IndexDefinition) mapping.IndexMapping {
+	doc := bleve.NewDocumentStaticMapping()
+	for _, field := range def.TextFields {
+		text := bleve.NewTextFieldMapping()
+		text.Analyzer = cjk.AnalyzerName
+		// 高亮需要存储原文和词向量
+		text.Store = true
+		text.IncludeTermVectors = true
+		doc.AddFieldMappingsAt(field, text)
+	}
+	for _, field := range []string{"tags", "category", "ownerId"} {
+		doc.AddFieldMappingsAt(field, bleve.NewKeywordFieldMapping())
+	}
+	doc.AddFieldMappingsAt("isPublic", bleve.NewBooleanFieldMapping())
+	doc.AddFieldMappingsAt("createdAt", bleve.NewDateTimeFieldMapping())
+
+	source := bleve.NewTextFieldMapping()
+	source.Index = false
+	source.Store = true
+	source.IncludeInAll = false
+	source.IncludeTermVectors = false
+	source.DocValues = false
+	doc.AddFieldMappingsAt(sourceField, source)
+
+	m := bleve.NewIndexMapping()
+	m.DefaultAnalyzer = cjk.AnalyzerName
+	m.DefaultMapping = doc
+	return m
+}
+
+// bleveDocument converts the source of a document into what is indexed: the
+// source without vectors and nested arrays, plus the same stored as JSON.
+func bleveDocument(def *es.IndexDefinition, source map[string]any) (map[string]any, error) {
+	raw, err := json.Marshal(searchSource(def, source))
+	if err != nil {
+		return nil, err
+	}
+	// 经过 JSON 往返，索引的值和返回的 _source 完全一致
+	var doc map[string]any
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	doc[sourceField] = string(raw)
+	return doc, nil
+}
+
+// ApplyChanges indexes or deletes documents of def in one batch.
+func (b *Bleve) ApplyChanges(ctx context.Context, def *es.IndexDefinition, changes []es.DocumentChange) (map[string]error, error) {
+	failed := make(map[string]error)
+	if len(changes) == 0 {
+		return failed, nil
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	idx, err := b.index(def)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make(map[string]map[string]any, len(changes))
+	for _, change := range changes {
+		if change.Document == nil {
+			continue
+		}
+		doc, err := bleveDocument(def, change.Document)
+		if err != nil {
+			failed[change.ID] = fmt.Errorf("index %s: %w", change.ID, err)
+			continue
+		}
+		docs[change.ID] = doc
+	}
+
+	targets := []bleve.Index{idx}
+	if rebuilding, ok := b.rebuilding[def.Name]; ok {
+		targets = append(targets, rebuilding)
+	}
+	for _, target := range targets {
+		batch := target.NewBatch()
+		for _, change := range changes {
+			if change.Document == nil {
+				batch.Delete(change.ID)
+			} else if doc, ok := docs[change.ID]; ok {
+				if err := batch.Index(change.ID, doc); err != nil {
+					failed[change.ID] = fmt.Errorf("index %s: %w", change.ID, err)
+				}
+			}
+		}
+		if err := target.Batch(batch); err != nil {
+			return nil, fmt.Errorf("bleve batch on %s failed: %w", def.Name, err)
+		}
+	}
+	return failed, nil
+}
+
+// Rebuild loads every document of def from the database into a new index,
+// then swaps it in place of the current one, so searches keep being answered
+// while it runs. Changes applied meanwhile go to both indices.
+func (b *Bleve) Rebuild(ctx context.Context, db database.Service, def *es.IndexDefinition) (int, error) {
+	var (
+		idx  bleve.Index
+		path string
+		err  error
+	)
+	if b.path == "" {
+		idx, err = bleve.NewMemOnly(bleveMapping(def))
+	} else {
+		path = b.indexPath(def) + ".rebuild"
+		if err := os.RemoveAll(path); err != nil {
+			return 0, fmt.Errorf("failed to remove stale rebuild of %s: %w", def.Name, err)
+		}
+		idx, err = bleve.New(path, bleveMapping(def))
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to create bleve index for %s: %w", def.Name, err)
+	}
+	b.mu.Lock()
+	if _, ok := b.rebuilding[def.Name]; ok {
+		b.mu.Unlock()
+		_ = idx.Close()
+		return 0, fmt.Errorf("%s is already being rebuilt", def.Name)
+	}
+	b.rebuilding[def.Name] = idx
+	b.mu.Unlock()
+
+	count := 0
+	err = def.Load(ctx, db, rebuildPageSize, func(docs []es.Document, _ database.Progress) error {
+		batch := idx.NewBatch()
+		for _, d := range docs {
+			doc, err := bleveDocument(def, d.Source)
+			if err == nil {
+				err = batch.Index(d.ID, doc)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to index %s %s: %w", def.Type, d.ID, err)
+			}
+		}
+		count += len(docs)
+		return idx.Batch(batch)
+	})
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.rebuilding, def.Name)
+	if err != nil {
+		_ = idx.Close()
+		if path != "" {
+			_ = os.RemoveAll(path)
+		}
+		return 0, fmt.Errorf("failed to rebuild %s: %w", def.Name, err)
+	}
+
+	if old, ok := b.indices[def.Name]; ok {
+		if err := old.Close(); err != nil {
+			searchLogger.Warn("failed to close replaced bleve index", zap.String("index", def.Name), zap.Error(err))
+		}
+	}
+	if path != "" {
+		// 关闭后才能在磁盘上替换目录
+		if err := idx.Close(); err != nil {
+			return 0, fmt.Errorf("failed to close rebuilt index of %s: %w", def.Name, err)
+		}
+		if err := os.RemoveAll(b.indexPath(def)); err != nil {
+			return 0, fmt.Errorf("failed to remove old index of %s: %w", def.Name, err)
+		}
+		if err := os.Rename(path, b.indexPath(def)); err != nil {
+			return 0, fmt.Errorf("failed to move rebuilt index of %s: %w", def.Name, err)
+		}
+		if idx, err = bleve.Open(b.indexPath(def)); err != nil {
+			delete(b.indices, def.Name)
+			return 0, fmt.Errorf("failed to open rebuilt index of %s: %w", def.Name, err)
+		}
+	}
+	b.indices[def.Name] = idx
+
+	searchLogger.Info("bleve index rebuilt", zap.String("index", def.Name), zap.Int("documents", count))
+	return count, nil
+}
+
+// RebuildEmpty rebuilds the indices that hold no document yet, e.g. after
+// the first start.
+func (b *Bleve) RebuildEmpty(ctx context.Context, db database.Service) error {
+	var errs []error
+	for _, def := range es.IndexDefinitions() {
+		b.mu.RLock()
+		idx, err := b.index(def)
+		var count uint64
+		if err == nil {
+			count, err = idx.DocCount()
+		}
+		b.mu.RUnlock()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if count > 0 {
+			continue
+		}
+		if _, err := b.Rebuild(ctx, db, def); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (b *Bleve) Search(ctx context.Context, def *es.IndexDefinition, q string, opts es.SearchOptions) (es.SearchResult, error) {
+	opts = normalize(opts)
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	idx, err := b.index(def)
+	if err != nil {
+		return es.SearchResult{}, err
+	}
+
+	req := bleve.NewSearchRequestOptions(bleveQuery(def, q, opts.Filters), opts.Size, opts.From, false)
+	req.Fields = []string{sourceField}
+	req.Highlight = bleve.NewHighlightWithStyle(html.Name)
+	req.Highlight.Fields = def.TextFields
+	res, err := idx.SearchInContext(ctx, req)
+	if err != nil {
+		return es.SearchResult{}, fmt.Errorf("bleve search on %s failed: %w", def.Name, err)
+	}
+
+	var result es.SearchResult
+	result.Hits.Hits = make([]es.ResultHit, 0, len(res.Hits))
+	for _, h := range res.Hits {
+		hit := es.ResultHit{Index: def.Name, ID: h.ID, Score: h.Score, Source: storedSource(h.Fields)}
+		if hit.Highlight, err = highlight(h.Fragments); err != nil {
+			return es.SearchResult{}, fmt.Errorf("failed to encode highlight of %s: %w", h.ID, err)
+		}
+		result.Hits.Hits = append(result.Hits.Hits, hit)
+	}
+	result.Hits.Total.Value = int(res.Total)
+	result.Hits.Total.Relation = "eq"
+	// 没有向量，语义部分无法执行
+	result.Degraded = len(def.VectorFields) > 0
+	return result, nil
+}
+
+func (b *Bleve) SearchAll(ctx context.Context, defs []*es.IndexDefinition, q string, opts es.SearchOptions) (es.UnifiedSearchResult, error) {
+	opts = normalize(opts)
+	window := es.SearchOptions{Size: opts.From + opts.Size, Filters: opts.Filters}
+
+	result := es.UnifiedSearchResult{Totals: make(map[string]int, len(defs))}
+	rankings := make([][]es.SearchHit, 0, len(defs))
+	for _, def := range defs {
+		r, err := b.Search(ctx, def, q, window)
+		if err != nil {
+			return es.UnifiedSearchResult{}, err
+		}
+		ranking := make([]es.SearchHit, 0, len(r.Hits.Hits))
+		for _, h := range r.Hits.Hits {
+			ranking = append(ranking, es.SearchHit{Type: def.Type, ID: h.ID, Score: h.Score, Highlight: h.Highlight, Source: h.Source})
+		}
+		rankings = append(rankings, ranking)
+		result.Totals[def.Type] = r.Hits.Total.Value
+		result.Total += r.Hits.Total.Value
+		result.Degraded = result.Degraded || r.Degraded
+	}
+
+	result.Hits = pageOf(es.FuseRankings(rankings), opts)
+	if result.Hits == nil {
+		result.Hits = []es.SearchHit{}
+	}
+	return result, nil
+}
+
+func (b *Bleve) Suggest(ctx context.Context, prefix string, opts es.SuggestOptions) (es.SuggestResult, error) {
+	result := es.SuggestResult{Titles: []es.Suggestion{}, Phrases: []es.Suggestion{}, Tags: []es.TagSuggestion{}}
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return result, nil
+	}
+	if opts.Size <= 0 {
+		opts.Size = es.DefaultSuggestSize
+	}
+	opts.Size = min(opts.Size, es.MaxSuggestSize)
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	idx, err := b.index(es.KeepIndex)
+	if err != nil {
+		return result, err
+	}
+
+	// 完整的词用 match，最后一个词按前缀匹配；结果再按词首前缀过滤
+	lower := strings.ToLower(prefix)
+	var should []query.Query
+	for _, field := range []string{"title", "summary"} {
+		match := bleve.NewMatchQuery(prefix)
+		match.SetField(field)
+		match.SetOperator(query.MatchQueryOperatorAnd)
+		pq := bleve.NewPrefixQuery(lower)
+		pq.SetField(field)
+		should = append(should, match, pq)
+	}
+	q := filteredQuery(bleve.NewDisjunctionQuery(should...), opts.Filters)
+	req := bleve.NewSearchRequestOptions(q, opts.Size*4, 0, false)
+	req.Fields = []string{"title", "summary"}
+	res, err := idx.SearchInContext(ctx, req)
+	if err != nil {
+		return result, fmt.Errorf("bleve suggest failed: %w", err)
+	}
+	for _, h := range res.Hits {
+		title, _ := h.Fields["title"].(string)
+		if hl := markPrefix(title, prefix, 0); hl != "" && len(result.Titles) < opts.Size {
+			result.Titles = append(result.Titles, es.Suggestion{ID: h.ID, Text: title, Highlight: hl, Score: h.Score})
+		}
+		summary, _ := h.Fields["summary"].(string)
+		if hl := markPrefix(summary, prefix, suggestFragmentRunes); hl != "" && len(result.Phrases) < opts.Size {
+			result.Phrases = append(result.Phrases, es.Suggestion{
+				ID:        h.ID,
+				Text:      strings.NewReplacer("<mark>", "", "</mark>", "").Replace(hl),
+				Highlight: hl,
+				Score:     h.Score,
+			})
+		}
+	}
+
+	// 标签按原样存储，前缀匹配时兼顾首字母大小写
+	var tagQueries []query.Query
+	for _, p := range uniqueStrings(prefix, lower, upperFirst(lower)) {
+		tq := bleve.NewPrefixQuery(p)
+		tq.SetField("tags")
+		tagQueries = append(tagQueries, tq)
+	}
+	tagReq := bleve.NewSearchRequestOptions(filteredQuery(bleve.NewDisjunctionQuery(tagQueries...), opts.Filters), 0, 0, false)
+	tagReq.AddFacet("tags", bleve.NewFacetRequest("tags", tagFacetSize))
+	tagRes, err := idx.SearchInContext(ctx, tagReq)
+	if err != nil {
+		return result, fmt.Errorf("bleve tag suggest failed: %w", err)
+	}
+	if facet, ok := tagRes.Facets["tags"]; ok && facet.Terms != nil {
+		for _, t := range facet.Terms.Terms() {
+			if len(result.Tags) >= opts.Size {
+				break
+			}
+			if strings.HasPrefix(strings.ToLower(t.Term), lower) {
+				result.Tags = append(result.Tags, es.TagSuggestion{Tag: t.Term, Count: t.Count})
+			}
+		}
+	}
+	return result, nil
+}
+
+func (b *Bleve) Related(ctx context.Context, def *es.IndexDefinition, id string, opts es.RelatedOptions) (es.SearchResult, error) {
+	if opts.Size <= 0 {
+		opts.Size = es.DefaultRelatedSize
+	}
+	opts.Size = min(opts.Size, es.MaxRelatedSize)
+
+	b.mu.RLock()
+	idx, err := b.index(def)
+	if err != nil {
+		b.mu.RUnlock()
+		return es.SearchResult{}, err
+	}
+	req := bleve.NewSearchRequest(bleve.NewDocIDQuery([]string{id}))
+	req.Fields = []string{sourceField}
+	res, err := idx.SearchInContext(ctx, req)
+	b.mu.RUnlock()
+	if err != nil {
+		return es.SearchResult{}, fmt.Errorf("failed to load %s %s: %w", def.Type, id, err)
+	}
+	if len(res.Hits) == 0 {
+		return es.SearchResult{}, fmt.Errorf("%w: %s", es.ErrDocumentNotFound, id)
+	}
+
+	// 用文档自身的文本代替向量
+	source := storedSource(res.Hits[0].Fields)
+	var text []string
+	for _, field := range def.TextFields {
+		if v := strings.TrimSpace(gjson.GetBytes(source, field).String()); v != "" {
+			text = append(text, v)
+		}
+	}
+	result := es.SearchResult{}
+	result.Hits.Hits = []es.ResultHit{}
+	if len(text) > 0 {
+		var err error
+		result, err = b.Search(ctx, def, truncateRunes(strings.Join(text, " "), relatedTextRunes), es.SearchOptions{
+			Size:    opts.Size + 1,
+			Filters: opts.Filters,
+		})
+		if err != nil {
+			return es.SearchResult{}, err
+		}
+		hits := result.Hits.Hits[:0]
+		for _, h := range result.Hits.Hits {
+			if h.ID != id && len(hits) < opts.Size {
+				h.Highlight = nil
+				hits = append(hits, h)
+			}
+		}
+		result.Hits.Hits = hits
+		result.Hits.Total.Value = max(result.Hits.Total.Value-1, 0)
+	}
+	result.Hits.Total.Relation = "eq"
+	result.Degraded = true
+	return result, nil
+}
+
+// bleveQuery matches q on the text fields of def, the first fields weighing
+// more, within the filters.
+func bleveQuery(def *es.IndexDefinition, q string, filters es.SearchFilters) query.Query {
+	var should []query.Query
+	for i, field := range def.TextFields {
+		match := bleve.NewMatchQuery(q)
+		match.SetField(field)
+		match.SetBoost(float64(len(def.TextFields) - i))
+		should = append(should, match)
+	}
+	return filteredQuery(bleve.NewDisjunctionQuery(should...), filters)
+}
+
+// filteredQuery restricts q to the documents matching filters, without
+// changing the scores.
+func filteredQuery(q query.Query, f es.SearchFilters) query.Query {
+	var filters []query.Query
+	if len(f.Tags) > 0 {
+		tags := make([]query.Query, 0, len(f.Tags))
+		for _, tag := range f.Tags {
+			tags = append(tags, termQuery("tags", tag))
+		}
+		filters = append(filters, bleve.NewDisjunctionQuery(tags...))
+	}
+	if f.Category != "" {
+		filters = append(filters, termQuery("category", f.Category))
+	}
+	if f.IsPublic != nil {
+		bq := bleve.NewBoolFieldQuery(*f.IsPublic)
+		bq.SetField("isPublic")
+		filters = append(filters, bq)
+	}
+	if f.OwnerID != "" {
+		filters = append(filters, termQuery("ownerId", f.OwnerID))
+	}
+	if f.CreatedFrom != nil || f.CreatedTo != nil {
+		inclusive := true
+		dq := &query.DateRangeQuery{InclusiveStart: &inclusive, InclusiveEnd: &inclusive}
+		if f.CreatedFrom != nil {
+			dq.Start = query.BleveQueryTime{Time: *f.CreatedFrom}
+		}
+		if f.CreatedTo != nil {
+			dq.End = query.BleveQueryTime{Time: *f.CreatedTo}
+		}
+		dq.SetField("createdAt")
+		filters = append(filters, dq)
+	}
+	if len(filters) == 0 {
+		return q
+	}
+	bq := bleve.NewBooleanQuery()
+	bq.AddMust(q)
+	bq.AddFilter(bleve.NewConjunctionQuery(filters...))
+	return bq
+}
+
+func termQuery(field, term string) query.Query {
+	tq := bleve.NewTermQuery(term)
+	tq.SetField(field)
+	return tq
+}
+
+// highlight encodes the fragments containing a match like an Elasticsearch
+// highlight, keeping the <mark> tags unescaped.
+func highlight(fragments map[string][]string) (json.RawMessage, error) {
+	marked := make(map[string][]string, len(fragments))
+	for field, frags := range fragments {
+		for _, f := range frags {
+			if strings.Contains(f, "<mark>") {
+				marked[field] = append(marked[field], f)
+			}
+		}
+	}
+	if len(marked) == 0 {
+		return nil, nil
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(marked); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSpace(buf.Bytes()), nil
+}
+
+// storedSource returns the document stored with a hit.
+func storedSource(fields map[string]any) json.RawMessage {
+	if s, ok := fields[sourceField].(string); ok {
+		return json.RawMessage(s)
+	}
+	return json.RawMessage("{}")
+}
+
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+func upperFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+func uniqueStrings(values ...string) []string {
+	var out []string
+	for _, v := range values {
+		if v != "" && !slices.Contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,132 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"api.us4ever/internal/es"
+	"github.com/tidwall/gjson"
+)
+
+func openTestBleve(t *testing.T) *Bleve {
+	t.Helper()
+	b, err := OpenBleve("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = b.Close() })
+
+	created := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	keep := func(title, summary, content, owner string, tags ...string) map[string]any {
+		return map[string]any{
+			"title": title, "summary": summary, "content": content,
+			"tags": tags, "category": "note", "isPublic": owner == "u1", "ownerId": owner, "createdAt": created,
+			"content_vector": []float32{0.1, 0.2},
+		}
+	}
+	failed, err := b.ApplyChanges(context.Background(), es.KeepIndex, []es.DocumentChange{
+		{ID: "k1", Document: keep("Go 并发模式", "关于 goroutine 和 channel 的总结", "使用 channel 在 goroutine 之间通信", "u1", "Go", "golang")},
+		{ID: "k2", Document: keep("Rust 所有权", "所有权和借用", "借用检查器保证内存安全", "u2", "rust")},
+		{ID: "k3", Document: keep("并发安全的 map", "sync.Map 的用法", "并发读写时使用 sync.Map", "u2", "go")},
+	})
+	if err != nil || len(failed) > 0 {
+		t.Fatalf("failed to index keeps: %v %v", err, failed)
+	}
+	return b
+}
+
+func TestBleveSearch(t *testing.T) {
+	b := openTestBleve(t)
+	ctx := context.Background()
+
+	result, err := b.Search(ctx, es.KeepIndex, "并发", es.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Hits.Total.Value != 2 || !result.Degraded {
+		t.Fatalf("expected 2 degraded hits, got %d (degraded %v)", result.Hits.Total.Value, result.Degraded)
+	}
+	hit := result.Hits.Hits[0]
+	if gjson.GetBytes(hit.Source, "content_vector").Exists() {
+		t.Error("expected vectors to be left out of the source")
+	}
+	if !strings.Contains(string(hit.Highlight), "<mark>") {
+		t.Errorf("expected a highlight, got %s", hit.Highlight)
+	}
+
+	result, err = b.Search(ctx, es.KeepIndex, "并发", es.SearchOptions{Filters: es.SearchFilters{OwnerID: "u2", Tags: []string{"go"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Hits.Hits) != 1 || result.Hits.Hits[0].ID != "k3" {
+		t.Errorf("expected the filters to keep k3 only, got %+v", result.Hits.Hits)
+	}
+}
+
+func TestBleveApplyChangesDelete(t *testing.T) {
+	b := openTestBleve(t)
+	ctx := context.Background()
+	if _, err := b.ApplyChanges(ctx, es.KeepIndex, []es.DocumentChange{{ID: "k2"}}); err != nil {
+		t.Fatal(err)
+	}
+	result, err := b.Search(ctx, es.KeepIndex, "所有权", es.SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Hits.Hits) != 0 {
+		t.Errorf("expected the deleted keep to be gone, got %+v", result.Hits.Hits)
+	}
+}
+
+func TestBleveSuggest(t *testing.T) {
+	b := openTestBleve(t)
+	result, err := b.Suggest(context.Background(), "go", es.SuggestOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Titles) != 1 || result.Titles[0].Highlight != "<mark>Go</mark> 并发模式" {
+		t.Errorf("unexpected title suggestions %+v", result.Titles)
+	}
+	var tags []string
+	for _, tag := range result.Tags {
+		tags = append(tags, tag.Tag)
+	}
+	if strings.Join(tags, ",") != "Go,go,golang" && strings.Join(tags, ",") != "go,Go,golang" {
+		t.Errorf("unexpected tag suggestions %v", tags)
+	}
+}
+
+func TestBleveRelated(t *testing.T) {
+	b := openTestBleve(t)
+	ctx := context.Background()
+	result, err := b.Related(ctx, es.KeepIndex, "k3", es.RelatedOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Degraded || len(result.Hits.Hits) == 0 || result.Hits.Hits[0].ID != "k1" {
+		t.Errorf("expected k1 first in degraded results, got %+v", result.Hits.Hits)
+	}
+	for _, h := range result.Hits.Hits {
+		if h.ID == "k3" {
+			t.Error("expected the document itself to be excluded")
+		}
+	}
+
+	if _, err := b.Related(ctx, es.KeepIndex, "missing", es.RelatedOptions{}); !errors.Is(err, es.ErrDocumentNotFound) {
+		t.Errorf("expected ErrDocumentNotFound, got %v", err)
+	}
+}
+
+func TestBleveSearchAll(t *testing.T) {
+	b := openTestBleve(t)
+	result, err := b.SearchAll(context.Background(), []*es.IndexDefinition{es.KeepIndex, es.MomentIndex}, "并发", es.SearchOptions{Size: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Total != 2 || result.Totals["keep"] != 2 || len(result.Hits) != 1 || result.Hits[0].Type != "keep" {
+		t.Errorf("unexpected unified result %+v", result)
+	}
+}
@@ -0,0 +1,41 @@
+package search
+
+import (
+	"context"
+
+	"api.us4ever/internal/es"
+	"github.com/elastic/go-elasticsearch/v8"
+)
+
+// Elasticsearch searches the aliases of the index definitions.
+type Elasticsearch struct {
+	client  *elasticsearch.Client
+	aliases es.IndexAliases
+}
+
+// NewElasticsearch returns the backend searching through client.
+func NewElasticsearch(client *elasticsearch.Client, aliases es.IndexAliases) *Elasticsearch {
+	return &Elasticsearch{client: client, aliases: aliases}
+}
+
+func (b *Elasticsearch) Name() string { return BackendElasticsearch }
+
+func (b *Elasticsearch) Search(ctx context.Context, def *es.IndexDefinition, query string, opts es.SearchOptions) (es.SearchResult, error) {
+	return es.Search(ctx, b.client, def, b.aliases.Of(def), query, opts)
+}
+
+func (b *Elasticsearch) SearchAll(ctx context.Context, defs []*es.IndexDefinition, query string, opts es.SearchOptions) (es.UnifiedSearchResult, error) {
+	targets := make([]es.SearchTarget, 0, len(defs))
+	for _, def := range defs {
+		targets = append(targets, es.SearchTarget{Type: def.Type, Alias: b.aliases.Of(def)})
+	}
+	return es.SearchAll(ctx, b.client, targets, query, opts)
+}
+
+func (b *Elasticsearch) Suggest(ctx context.Context, prefix string, opts es.SuggestOptions) (es.SuggestResult, error) {
+	return es.Suggest(ctx, b.client, b.aliases.Of(es.KeepIndex), prefix, opts)
+}
+
+func (b *Elasticsearch) Related(ctx context.Context, def *es.IndexDefinition, id string, opts es.RelatedOptions) (es.SearchResult, error) {
+	return es.Related(ctx, b.client, def, b.aliases.Of(def), id, opts)
+}
@@ -0,0 +1,96 @@
+package search
+
+import (
+	"io"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// Holder hands out the current SearchBackend and lets the server replace it
+// when the configuration changes. A replaced backend implementing io.Closer
+// is closed once the last caller that acquired it has released it, so
+// searches in flight during the swap still complete.
+type Holder struct {
+	mu      sync.Mutex
+	current *lease
+}
+
+// lease tracks the callers using one backend.
+type lease struct {
+	backend SearchBackend
+	users   int
+	retired bool
+}
+
+// NewHolder creates a holder serving backend, which may be nil when no
+// backend is available.
+func NewHolder(backend SearchBackend) *Holder {
+	return &Holder{current: &lease{backend: backend}}
+}
+
+// Acquire returns the current backend, nil when none is available, and the
+// function releasing it. The backend stays open until release is called;
+// calling release more than once has no further effect.
+func (h *Holder) Acquire() (SearchBackend, func()) {
+	h.mu.Lock()
+	l := h.current
+	l.users++
+	h.mu.Unlock()
+
+	var once sync.Once
+	return l.backend, func() {
+		once.Do(func() { h.release(l) })
+	}
+}
+
+// Current returns the current backend without acquiring it. It is only meant
+// to inspect the backend, e.g. to decide whether a new one is needed.
+func (h *Holder) Current() SearchBackend {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.current.backend
+}
+
+// Swap makes backend the current one. The previous backend is closed as soon
+// as nobody uses it any more, unless backend is the same instance.
+func (h *Holder) Swap(backend SearchBackend) {
+	h.mu.Lock()
+	previous := h.current
+	if previous.backend == backend {
+		h.mu.Unlock()
+		return
+	}
+	h.current = &lease{backend: backend}
+	previous.retired = true
+	idle := previous.users == 0
+	h.mu.Unlock()
+
+	if idle {
+		closeBackend(previous.backend)
+	}
+}
+
+func (h *Holder) release(l *lease) {
+	h.mu.Lock()
+	l.users--
+	idle := l.retired && l.users == 0
+	h.mu.Unlock()
+
+	if idle {
+		closeBackend(l.backend)
+	}
+}
+
+func closeBackend(backend SearchBackend) {
+	closer, ok := backend.(io.Closer)
+	if !ok {
+		return
+	}
+	if err := closer.Close(); err != nil {
+		searchLogger.Warn("error closing previous search backend",
+			zap.String("backend", backend.Name()),
+			zap.Error(err),
+		)
+	}
+}
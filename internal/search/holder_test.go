@@ -0,0 +1,71 @@
+package search
+
+import "testing"
+
+// closingBackend counts how often it is closed; the search methods are not used.
+type closingBackend struct {
+	SearchBackend
+	closed int
+}
+
+func (b *closingBackend) Name() string { return "closing" }
+
+func (b *closingBackend) Close() error {
+	b.closed++
+	return nil
+}
+
+func TestHolder_ClosesReplacedBackendAfterRelease(t *testing.T) {
+	old, next := &closingBackend{}, &closingBackend{}
+	h := NewHolder(old)
+
+	backend, release := h.Acquire()
+	if backend != old {
+		t.Fatalf("expected the initial backend, got %v", backend)
+	}
+	_, releaseOther := h.Acquire()
+
+	h.Swap(next)
+	got, releaseNext := h.Acquire()
+	if got != next {
+		t.Errorf("expected the new backend after the swap, got %v", got)
+	}
+	releaseNext()
+	if old.closed != 0 {
+		t.Fatal("expected the old backend to stay open while it is in use")
+	}
+
+	// 重复释放同一租约不算作最后一次释放
+	release()
+	release()
+	if old.closed != 0 {
+		t.Fatal("expected the old backend to stay open until its last release")
+	}
+	releaseOther()
+	if old.closed != 1 {
+		t.Errorf("expected the old backend closed once after its last release, got %d", old.closed)
+	}
+	if next.closed != 0 {
+		t.Error("expected the current backend to stay open")
+	}
+}
+
+func TestHolder_SwapSameBackend(t *testing.T) {
+	b := &closingBackend{}
+	h := NewHolder(b)
+	h.Swap(b)
+	if b.closed != 0 || h.Current() != b {
+		t.Errorf("expected the reused backend to stay current and open, closed %d times", b.closed)
+	}
+
+	// 没有请求在用时立即关闭
+	h.Swap(nil)
+	if b.closed != 1 {
+		t.Errorf("expected an idle replaced backend to be closed, got %d", b.closed)
+	}
+	if backend, release := h.Acquire(); backend != nil {
+		t.Errorf("expected no backend, got %v", backend)
+	} else {
+		release()
+	}
+}
@@ -0,0 +1,162 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"api.us4ever/internal/ent"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// vectorColumn is a JSON vector column to migrate to pgvector.
+type vectorColumn struct {
+	table, column string
+}
+
+// vectorColumns returns the vector columns of the searched tables plus the
+// keep chunk vectors, in a stable order.
+func vectorColumns() []vectorColumn {
+	var out []vectorColumn
+	for _, t := range pgTables {
+		for _, col := range t.vectors {
+			out = append(out, vectorColumn{t.table, col})
+		}
+	}
+	out = append(out, vectorColumn{"keep_chunk", "vector"})
+	slices.SortFunc(out, func(a, b vectorColumn) int {
+		return strings.Compare(a.table+"."+a.column, b.table+"."+b.column)
+	})
+	return out
+}
+
+// MigratePostgres prepares the database for the Postgres search backend: it
+// converts the JSON vector columns to pgvector vector(dims) columns with an
+// HNSW cosine index, and adds a generated tsvector column with a GIN index to
+// every searched table. Vectors of another dimension are reset to NULL, so the
+// embedding tasks compute them again. It can be run again safely.
+func MigratePostgres(ctx context.Context, client *ent.Client, dims int, textSearchConfig string) error {
+	if dims <= 0 {
+		return fmt.Errorf("invalid vector dimensions: %d", dims)
+	}
+	if textSearchConfig == "" {
+		textSearchConfig = DefaultTextSearchConfig
+	}
+
+	if _, err := client.ExecContext(ctx, `CREATE EXTENSION IF NOT EXISTS vector`); err != nil {
+		return fmt.Errorf("failed to create the vector extension: %w", err)
+	}
+
+	for _, vc := range vectorColumns() {
+		udt, err := columnType(ctx, client, vc.table, vc.column)
+		if err != nil {
+			return err
+		}
+		if udt == "" {
+			searchLogger.Warn("vector column not found, skipping",
+				zap.String("table", vc.table),
+				zap.String("column", vc.column),
+			)
+			continue
+		}
+		statements := vectorIndexStatements(vc)
+		if udt != "vector" {
+			statements = append(vectorColumnStatements(vc, dims), statements...)
+		}
+		if err := execAll(ctx, client, statements); err != nil {
+			return fmt.Errorf("failed to migrate %s.%s: %w", vc.table, vc.column, err)
+		}
+		searchLogger.Info("vector column migrated",
+			zap.String("table", vc.table),
+			zap.String("column", vc.column),
+			zap.Int("dims", dims),
+		)
+	}
+
+	names := make([]string, 0, len(pgTables))
+	for name := range pgTables {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		t := pgTables[name]
+		if err := execAll(ctx, client, textSearchStatements(t, textSearchConfig)); err != nil {
+			return fmt.Errorf("failed to add the text search column to %s: %w", t.table, err)
+		}
+	}
+	return nil
+}
+
+// columnType returns the udt_name of a column, or "" when it does not exist.
+func columnType(ctx context.Context, client *ent.Client, table, column string) (string, error) {
+	rows, err := client.QueryContext(ctx, `
+		SELECT udt_name FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1 AND column_name = $2`,
+		table, column)
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect %s.%s: %w", table, column, err)
+	}
+	defer rows.Close()
+	var udt string
+	if rows.Next() {
+		if err := rows.Scan(&udt); err != nil {
+			return "", fmt.Errorf("failed to inspect %s.%s: %w", table, column, err)
+		}
+	}
+	return udt, rows.Err()
+}
+
+// execAll runs statements in one transaction.
+func execAll(ctx context.Context, client *ent.Client, statements []string) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range statements {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+			}
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// vectorColumnStatements convert a JSON vector column to vector(dims),
+// dropping the vectors that do not fit.
+func vectorColumnStatements(vc vectorColumn, dims int) []string {
+	table, col := quote(vc.table), quote(vc.column)
+	return []string{
+		fmt.Sprintf(`UPDATE %s SET %s = NULL WHERE %[2]s IS NOT NULL AND (jsonb_typeof(%[2]s::text::jsonb) <> 'array' OR jsonb_array_length(%[2]s::text::jsonb) <> %d)`,
+			table, col, dims),
+		fmt.Sprintf(`ALTER TABLE %s ALTER COLUMN %s TYPE vector(%d) USING %[2]s::text::vector`, table, col, dims),
+	}
+}
+
+// vectorIndexStatements create the HNSW index the cosine distance ordering uses.
+func vectorIndexStatements(vc vectorColumn) []string {
+	return []string{
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s USING hnsw (%s vector_cosine_ops)`,
+			quote(vc.table+"_"+vc.column+"_hnsw_idx"), quote(vc.table), quote(vc.column)),
+	}
+}
+
+// textSearchStatements add the generated tsvector column of t, weighting the
+// text columns in order, and its GIN index.
+func textSearchStatements(t pgTable, textSearchConfig string) []string {
+	weights := []string{"A", "B", "C", "D"}
+	parts := make([]string, 0, len(t.text))
+	for i, col := range t.text {
+		parts = append(parts, fmt.Sprintf("setweight(to_tsvector(%s::regconfig, coalesce(%s, '')), '%s')",
+			pq.QuoteLiteral(textSearchConfig), quote(col), weights[min(i, len(weights)-1)]))
+	}
+	return []string{
+		fmt.Sprintf(`ALTER TABLE %s ADD COLUMN IF NOT EXISTS %s tsvector GENERATED ALWAYS AS (%s) STORED`,
+			quote(t.table), quote(tsvColumn), strings.Join(parts, " || ")),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s USING gin (%s)`,
+			quote(t.table+"_"+tsvColumn+"_idx"), quote(t.table), quote(tsvColumn)),
+	}
+}
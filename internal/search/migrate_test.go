@@ -0,0 +1,44 @@
+package search
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVectorColumnStatements(t *testing.T) {
+	statements := vectorColumnStatements(vectorColumn{"keeps", "content_vector"}, 1024)
+	if !strings.Contains(statements[0], `jsonb_array_length("content_vector"::text::jsonb) <> 1024`) {
+		t.Errorf("expected vectors of another size to be dropped, got %s", statements[0])
+	}
+	want := `ALTER TABLE "keeps" ALTER COLUMN "content_vector" TYPE vector(1024) USING "content_vector"::text::vector`
+	if statements[1] != want {
+		t.Errorf("unexpected alter statement\n got: %s\nwant: %s", statements[1], want)
+	}
+}
+
+func TestTextSearchStatements(t *testing.T) {
+	statements := textSearchStatements(pgTables["keeps"], "simple")
+	for _, want := range []string{
+		`setweight(to_tsvector('simple'::regconfig, coalesce("title", '')), 'A')`,
+		`setweight(to_tsvector('simple'::regconfig, coalesce("content", '')), 'C')`,
+		`GENERATED ALWAYS AS`,
+	} {
+		if !strings.Contains(statements[0], want) {
+			t.Errorf("expected %q in %s", want, statements[0])
+		}
+	}
+	if !strings.Contains(statements[1], `USING gin ("search_tsv")`) {
+		t.Errorf("expected a GIN index, got %s", statements[1])
+	}
+}
+
+func TestVectorColumns(t *testing.T) {
+	var got []string
+	for _, vc := range vectorColumns() {
+		got = append(got, vc.table+"."+vc.column)
+	}
+	want := "images.description_vector keep_chunk.vector keeps.content_vector keeps.summary_vector keeps.title_vector moments.content_vector"
+	if strings.Join(got, " ") != want {
+		t.Errorf("unexpected vector columns %v", got)
+	}
+}
@@ -0,0 +1,687 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"api.us4ever/internal/config"
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/es"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultTextSearchConfig is the text search configuration used when none
+	// is configured; it does not stem, which suits mixed Chinese and English
+	DefaultTextSearchConfig = "simple"
+
+	// tsvColumn is the generated tsvector column added by MigratePostgres
+	tsvColumn = "search_tsv"
+	// minCandidates is the minimum number of candidates of every ranking fused
+	minCandidates = 50
+	// relatedVectorColumn is the stored vector related documents are found by
+	relatedVectorColumn = "content_vector"
+	// suggestFragmentRunes is the length of a phrase completion taken from a summary
+	suggestFragmentRunes = 40
+	// capabilitiesTTL is how long the detected schema is trusted before it is
+	// looked up again, so a migration is picked up without a restart
+	capabilitiesTTL = 5 * time.Minute
+)
+
+// pgTable is how an index definition is searched in Postgres.
+type pgTable struct {
+	table string
+	// text are the columns matched by the keyword search, most important first
+	text []string
+	// vectors are the vector columns, matching the VectorFields of the definition
+	vectors []string
+	// owner is the column filtered by SearchFilters.OwnerID
+	owner string
+	// tags is set when the table has a JSON tags column
+	tags bool
+}
+
+// pgTables maps the definition names to their tables.
+var pgTables = map[string]pgTable{
+	"keeps":    {table: "keeps", text: []string{"title", "summary", "content"}, vectors: []string{"title_vector", "summary_vector", "content_vector"}, owner: "ownerId", tags: true},
+	"moments":  {table: "moments", text: []string{"content"}, vectors: []string{"content_vector"}, owner: "ownerId", tags: true},
+	"mindmaps": {table: "mindmaps", text: []string{"title", "summary"}, owner: "ownerId", tags: true},
+	"todos":    {table: "todos", text: []string{"title", "content"}, owner: "ownerId"},
+	"images":   {table: "images", text: []string{"name", "description"}, vectors: []string{"description_vector"}, owner: "uploadedBy", tags: true},
+	"videos":   {table: "videos", text: []string{"name"}, owner: "uploadedBy"},
+}
+
+// capabilities is the part of the schema the queries depend on.
+type capabilities struct {
+	// vectors holds the "table.column" vector columns already migrated to pgvector
+	vectors map[string]bool
+	// tsv holds the tables with the generated tsvector column
+	tsv map[string]bool
+}
+
+// Postgres searches the database directly, with a tsvector and substring
+// keyword search fused with pgvector cosine rankings. Until MigratePostgres
+// has run it falls back to substring matching only, and results are Degraded.
+type Postgres struct {
+	client           *ent.Client
+	textSearchConfig string
+
+	mu         sync.Mutex
+	caps       capabilities
+	detectedAt time.Time
+}
+
+// NewPostgres returns the backend searching through client.
+func NewPostgres(client *ent.Client, cfg config.SearchConfig) *Postgres {
+	tsc := cfg.TextSearchConfig
+	if tsc == "" {
+		tsc = DefaultTextSearchConfig
+	}
+	return &Postgres{client: client, textSearchConfig: tsc}
+}
+
+func (b *Postgres) Name() string { return BackendPostgres }
+
+// capabilities returns the detected schema, looking it up again when it is
+// older than capabilitiesTTL. A failed lookup keeps the previous one.
+func (b *Postgres) capabilities(ctx context.Context) capabilities {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.detectedAt.IsZero() && time.Since(b.detectedAt) < capabilitiesTTL {
+		return b.caps
+	}
+
+	tables := make([]string, 0, len(pgTables))
+	for _, t := range pgTables {
+		tables = append(tables, t.table)
+	}
+	rows, err := b.client.QueryContext(ctx, `
+		SELECT table_name, column_name, udt_name
+		FROM information_schema.columns
+		WHERE table_schema = current_schema()
+		  AND table_name = ANY($1)
+		  AND (udt_name = 'vector' OR column_name = $2)`,
+		pq.Array(tables), tsvColumn)
+	if err != nil {
+		searchLogger.Warn("failed to detect postgres search capabilities", zap.Error(err))
+		return b.caps
+	}
+	defer rows.Close()
+
+	caps := capabilities{vectors: make(map[string]bool), tsv: make(map[string]bool)}
+	for rows.Next() {
+		var table, column, udt string
+		if err := rows.Scan(&table, &column, &udt); err != nil {
+			searchLogger.Warn("failed to detect postgres search capabilities", zap.Error(err))
+			return b.caps
+		}
+		if column == tsvColumn {
+			caps.tsv[table] = true
+		} else {
+			caps.vectors[table+"."+column] = true
+		}
+	}
+	if err := rows.Err(); err != nil {
+		searchLogger.Warn("failed to detect postgres search capabilities", zap.Error(err))
+		return b.caps
+	}
+	b.caps, b.detectedAt = caps, time.Now()
+	return caps
+}
+
+// semantic reports whether every vector column of t has been migrated.
+func (c capabilities) semantic(t pgTable) bool {
+	for _, col := range t.vectors {
+		if !c.vectors[t.table+"."+col] {
+			return false
+		}
+	}
+	return len(t.vectors) > 0
+}
+
+func (b *Postgres) Search(ctx context.Context, def *es.IndexDefinition, query string, opts es.SearchOptions) (es.SearchResult, error) {
+	opts = normalize(opts)
+	caps := b.capabilities(ctx)
+
+	var vector []float32
+	if caps.semantic(pgTables[def.Name]) {
+		vector = embedQuery(ctx, query)
+	}
+	ranked, err := b.rank(ctx, caps, def, query, vector, opts.Filters, opts.From+opts.Size)
+	if err != nil {
+		return es.SearchResult{}, err
+	}
+
+	page := pageOf(ranked.hits, opts)
+	hits, err := b.hits(ctx, page)
+	if err != nil {
+		return es.SearchResult{}, err
+	}
+	var result es.SearchResult
+	result.Hits.Hits = hits
+	result.Hits.Total.Value = ranked.total
+	result.Hits.Total.Relation = "eq"
+	if ranked.lowerBound {
+		result.Hits.Total.Relation = "gte"
+	}
+	result.Degraded = ranked.degraded
+
+	searchLogger.Info("postgres search completed",
+		zap.String("index", def.Name),
+		zap.Int("hits_count", len(hits)),
+		zap.Int("total", ranked.total),
+		zap.Bool("degraded", ranked.degraded),
+	)
+	return result, nil
+}
+
+func (b *Postgres) SearchAll(ctx context.Context, defs []*es.IndexDefinition, query string, opts es.SearchOptions) (es.UnifiedSearchResult, error) {
+	opts = normalize(opts)
+	caps := b.capabilities(ctx)
+
+	var vector []float32
+	for _, def := range defs {
+		if caps.semantic(pgTables[def.Name]) {
+			vector = embedQuery(ctx, query)
+			break
+		}
+	}
+
+	result := es.UnifiedSearchResult{Totals: make(map[string]int, len(defs))}
+	rankings := make([][]es.SearchHit, 0, len(defs))
+	for _, def := range defs {
+		ranked, err := b.rank(ctx, caps, def, query, vector, opts.Filters, opts.From+opts.Size)
+		if err != nil {
+			return es.UnifiedSearchResult{}, err
+		}
+		rankings = append(rankings, ranked.hits)
+		result.Totals[def.Type] = ranked.total
+		result.Total += ranked.total
+		result.Degraded = result.Degraded || ranked.degraded
+	}
+
+	page := pageOf(es.FuseRankings(rankings), opts)
+	hits, err := b.hits(ctx, page)
+	if err != nil {
+		return es.UnifiedSearchResult{}, err
+	}
+	result.Hits = make([]es.SearchHit, 0, len(hits))
+	for i, h := range hits {
+		result.Hits = append(result.Hits, es.SearchHit{
+			Type:   page[i].Type,
+			ID:     h.ID,
+			Score:  h.Score,
+			Source: h.Source,
+		})
+	}
+	return result, nil
+}
+
+// ranking is the outcome of rank.
+type ranking struct {
+	// hits are the best documents, at most limit per ranking fused
+	hits []es.SearchHit
+	// total counts the keyword matches, or the fused hits when there are more
+	total int
+	// lowerBound is set when semantic hits beyond the keyword matches make
+	// total only a lower bound of the documents matching
+	lowerBound bool
+	// degraded reports that the semantic part could not run
+	degraded bool
+}
+
+// rank returns up to limit documents of def matching query, best first. The
+// keyword ranking is fused with one ranking per vector column when vector is
+// set.
+func (b *Postgres) rank(ctx context.Context, caps capabilities, def *es.IndexDefinition, query string, vector []float32, filters es.SearchFilters, limit int) (ranking, error) {
+	t, ok := pgTables[def.Name]
+	if !ok {
+		return ranking{}, fmt.Errorf("%w: %s", ErrUnsupportedIndex, def.Name)
+	}
+	limit = max(limit, minCandidates)
+
+	tsv := caps.tsv[t.table]
+	sqlText, args := keywordQuery(t, tsv, b.textSearchConfig, query, filters, limit)
+	keyword, err := b.ids(ctx, sqlText, args...)
+	if err != nil {
+		return ranking{}, fmt.Errorf("postgres keyword search on %s failed: %w", t.table, err)
+	}
+	// 关键词结果被 limit 截断时另行计数，总数不随分页变化
+	matched := len(keyword)
+	if matched == limit {
+		sqlText, args := keywordCountQuery(t, tsv, b.textSearchConfig, query, filters)
+		if matched, err = b.count(ctx, sqlText, args...); err != nil {
+			return ranking{}, fmt.Errorf("postgres keyword count on %s failed: %w", t.table, err)
+		}
+	}
+	rankings := [][]es.SearchHit{toHits(def.Type, keyword)}
+
+	semantic := caps.semantic(t)
+	if semantic && vector != nil {
+		for _, col := range t.vectors {
+			sqlText, args := vectorQuery(t, col, vector, filters, limit)
+			ids, err := b.ids(ctx, sqlText, args...)
+			if err != nil {
+				return ranking{}, fmt.Errorf("postgres vector search on %s.%s failed: %w", t.table, col, err)
+			}
+			rankings = append(rankings, toHits(def.Type, ids))
+		}
+	}
+	hits := es.FuseRankings(rankings)
+	return ranking{
+		hits:       hits,
+		total:      max(matched, len(hits)),
+		lowerBound: len(hits) > matched,
+		degraded:   len(def.VectorFields) > 0 && (!semantic || vector == nil),
+	}, nil
+}
+
+// hits loads the documents of a ranked page through the Fetch of their
+// definitions, so they look exactly like the documents of the search indices.
+func (b *Postgres) hits(ctx context.Context, page []es.SearchHit) ([]es.ResultHit, error) {
+	byType := make(map[string][]string)
+	for _, h := range page {
+		byType[h.Type] = append(byType[h.Type], h.ID)
+	}
+	sources := make(map[string]json.RawMessage, len(page))
+	for entityType, ids := range byType {
+		def, ok := es.LookupIndexByType(entityType)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedIndex, entityType)
+		}
+		docs, err := def.Fetch(ctx, b.client, ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s: %w", def.Name, err)
+		}
+		for _, doc := range docs {
+			source, err := json.Marshal(searchSource(def, doc.Source))
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode %s %s: %w", def.Type, doc.ID, err)
+			}
+			sources[entityType+"/"+doc.ID] = source
+		}
+	}
+
+	hits := make([]es.ResultHit, 0, len(page))
+	for _, h := range page {
+		source, ok := sources[h.Type+"/"+h.ID]
+		if !ok {
+			// 排名之后被删除
+			continue
+		}
+		def, _ := es.LookupIndexByType(h.Type)
+		hits = append(hits, es.ResultHit{Index: pgTables[def.Name].table, ID: h.ID, Score: h.Score, Source: source})
+	}
+	return hits, nil
+}
+
+// ids runs a query selecting one ID column.
+func (b *Postgres) ids(ctx context.Context, query string, args ...any) ([]string, error) {
+	rows, err := b.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// count runs a query selecting one count.
+func (b *Postgres) count(ctx context.Context, query string, args ...any) (int, error) {
+	rows, err := b.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	var n int
+	if rows.Next() {
+		if err := rows.Scan(&n); err != nil {
+			return 0, err
+		}
+	}
+	return n, rows.Err()
+}
+
+// searchSource drops the vectors and nested arrays of a document, like the
+// _source excludes of the index searches.
+func searchSource(def *es.IndexDefinition, source map[string]any) map[string]any {
+	out := make(map[string]any, len(source))
+	for k, v := range source {
+		out[k] = v
+	}
+	for _, f := range def.VectorFields {
+		delete(out, f)
+	}
+	for name := range def.Nested {
+		delete(out, name)
+	}
+	return out
+}
+
+// sqlArgs numbers the parameters of a query as they are added.
+type sqlArgs []any
+
+func (a *sqlArgs) add(v any) string {
+	*a = append(*a, v)
+	return "$" + strconv.Itoa(len(*a))
+}
+
+func quote(column string) string { return pq.QuoteIdentifier(column) }
+
+// keywordQuery selects the IDs of t matching query, ranked by the tsvector
+// rank when the column exists and by which text columns contain the query.
+func keywordQuery(t pgTable, tsv bool, textSearchConfig, query string, filters es.SearchFilters, limit int) (string, []any) {
+	var args sqlArgs
+	where, rank := keywordMatch(t, tsv, textSearchConfig, query, filters, &args)
+	return fmt.Sprintf(`SELECT "id" FROM %s WHERE %s ORDER BY %s DESC, "updatedAt" DESC LIMIT %s`,
+		quote(t.table), where, rank, args.add(limit)), args
+}
+
+// keywordCountQuery counts the rows of t keywordQuery matches, without its limit.
+func keywordCountQuery(t pgTable, tsv bool, textSearchConfig, query string, filters es.SearchFilters) (string, []any) {
+	var args sqlArgs
+	where, _ := keywordMatch(t, tsv, textSearchConfig, query, filters, &args)
+	return fmt.Sprintf(`SELECT count(*) FROM %s WHERE %s`, quote(t.table), where), args
+}
+
+// keywordMatch returns the condition selecting the rows of t matching query
+// and the expression ranking them.
+func keywordMatch(t pgTable, tsv bool, textSearchConfig, query string, filters es.SearchFilters, args *sqlArgs) (where, rank string) {
+	pattern := args.add("%" + escapeLike(query) + "%")
+
+	var match, ranks []string
+	for i, col := range t.text {
+		c := fmt.Sprintf("coalesce(%s, '') ILIKE %s", quote(col), pattern)
+		match = append(match, c)
+		// 越靠前的列权重越高
+		ranks = append(ranks, fmt.Sprintf("(CASE WHEN %s THEN %d ELSE 0 END)", c, len(t.text)-i))
+	}
+	if tsv {
+		tsquery := fmt.Sprintf("plainto_tsquery(%s::regconfig, %s)", args.add(textSearchConfig), args.add(query))
+		match = append(match, fmt.Sprintf("%s @@ %s", quote(tsvColumn), tsquery))
+		ranks = append(ranks, fmt.Sprintf("ts_rank_cd(%s, %s) * 10", quote(tsvColumn), tsquery))
+	}
+
+	clauses := append([]string{"(" + strings.Join(match, " OR ") + ")"}, filterClauses(t, filters, args)...)
+	return strings.Join(clauses, " AND "), strings.Join(ranks, " + ")
+}
+
+// vectorQuery selects the IDs of t nearest to vector in column, by cosine
+// distance, which the HNSW index created by MigratePostgres serves.
+func vectorQuery(t pgTable, column string, vector []float32, filters es.SearchFilters, limit int) (string, []any) {
+	var args sqlArgs
+	v := args.add(vectorLiteral(vector))
+	where := append([]string{quote(column) + " IS NOT NULL"}, filterClauses(t, filters, &args)...)
+	return fmt.Sprintf(`SELECT "id" FROM %s WHERE %s ORDER BY %s <=> %s::vector LIMIT %s`,
+		quote(t.table), strings.Join(where, " AND "), quote(column), v, args.add(limit)), args
+}
+
+// filterClauses converts the search filters into conditions on t.
+func filterClauses(t pgTable, f es.SearchFilters, args *sqlArgs) []string {
+	var out []string
+	if len(f.Tags) > 0 {
+		if !t.tags {
+			// 没有标签的实体不可能匹配标签过滤，和 ES 中的行为一致
+			out = append(out, "FALSE")
+		} else {
+			out = append(out, fmt.Sprintf(`"tags"::jsonb ?| %s`, args.add(pq.Array(f.Tags))))
+		}
+	}
+	if f.Category != "" {
+		out = append(out, `"category" = `+args.add(f.Category))
+	}
+	if f.IsPublic != nil {
+		out = append(out, `"isPublic" = `+args.add(*f.IsPublic))
+	}
+	if f.OwnerID != "" {
+		out = append(out, quote(t.owner)+" = "+args.add(f.OwnerID))
+	}
+	if f.CreatedFrom != nil {
+		out = append(out, `"createdAt" >= `+args.add(*f.CreatedFrom))
+	}
+	if f.CreatedTo != nil {
+		out = append(out, `"createdAt" <= `+args.add(*f.CreatedTo))
+	}
+	return out
+}
+
+// vectorLiteral formats a vector as pgvector text input, e.g. [0.1,0.2].
+func vectorLiteral(vector []float32) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, v := range vector {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatFloat(float64(v), 'g', -1, 32))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// escapeLike escapes the LIKE wildcards of s.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func toHits(entityType string, ids []string) []es.SearchHit {
+	hits := make([]es.SearchHit, 0, len(ids))
+	for _, id := range ids {
+		hits = append(hits, es.SearchHit{Type: entityType, ID: id})
+	}
+	return hits
+}
+
+// pageOf cuts the requested page out of a ranking.
+func pageOf(ranked []es.SearchHit, opts es.SearchOptions) []es.SearchHit {
+	if opts.From >= len(ranked) {
+		return nil
+	}
+	return ranked[opts.From:min(opts.From+opts.Size, len(ranked))]
+}
+
+// normalize fills in the default page size, like the Elasticsearch searches.
+func normalize(opts es.SearchOptions) es.SearchOptions {
+	if opts.Size <= 0 {
+		opts.Size = es.DefaultSearchSize
+	}
+	opts.From = max(opts.From, 0)
+	return opts
+}
+
+// embedQuery embeds the query, returning nil when that fails so the search
+// falls back to the keyword ranking alone.
+func embedQuery(ctx context.Context, query string) []float32 {
+	vector, err := es.EmbedQuery(ctx, query)
+	if err != nil {
+		searchLogger.Warn("query embedding failed, falling back to keyword-only search",
+			zap.Error(err),
+		)
+		return nil
+	}
+	return vector
+}
+
+func (b *Postgres) Suggest(ctx context.Context, prefix string, opts es.SuggestOptions) (es.SuggestResult, error) {
+	result := es.SuggestResult{Titles: []es.Suggestion{}, Phrases: []es.Suggestion{}, Tags: []es.TagSuggestion{}}
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return result, nil
+	}
+	if opts.Size <= 0 {
+		opts.Size = es.DefaultSuggestSize
+	}
+	opts.Size = min(opts.Size, es.MaxSuggestSize)
+	t := pgTables[es.KeepIndex.Name]
+
+	// 标题：以前缀开头，或某个词以前缀开头
+	var args sqlArgs
+	where := append([]string{fmt.Sprintf(`("title" ILIKE %s OR "title" ILIKE %s)`,
+		args.add(escapeLike(prefix)+"%"), args.add("% "+escapeLike(prefix)+"%"))}, filterClauses(t, opts.Filters, &args)...)
+	titles, err := b.pairs(ctx, fmt.Sprintf(`SELECT "id", "title" FROM "keeps" WHERE %s ORDER BY length("title"), "updatedAt" DESC LIMIT %s`,
+		strings.Join(where, " AND "), args.add(opts.Size)), args...)
+	if err != nil {
+		return result, fmt.Errorf("postgres title suggestions failed: %w", err)
+	}
+	for i, p := range titles {
+		result.Titles = append(result.Titles, es.Suggestion{
+			ID:        p[0],
+			Text:      p[1],
+			Highlight: markPrefix(p[1], prefix, 0),
+			Score:     float64(len(titles) - i),
+		})
+	}
+
+	args = nil
+	where = append([]string{`"summary" ILIKE ` + args.add("%"+escapeLike(prefix)+"%")}, filterClauses(t, opts.Filters, &args)...)
+	phrases, err := b.pairs(ctx, fmt.Sprintf(`SELECT "id", "summary" FROM "keeps" WHERE %s ORDER BY "updatedAt" DESC LIMIT %s`,
+		strings.Join(where, " AND "), args.add(opts.Size)), args...)
+	if err != nil {
+		return result, fmt.Errorf("postgres phrase suggestions failed: %w", err)
+	}
+	for i, p := range phrases {
+		highlight := markPrefix(p[1], prefix, suggestFragmentRunes)
+		if highlight == "" {
+			continue
+		}
+		result.Phrases = append(result.Phrases, es.Suggestion{
+			ID:        p[0],
+			Text:      strings.NewReplacer("<mark>", "", "</mark>", "").Replace(highlight),
+			Highlight: highlight,
+			Score:     float64(len(phrases) - i),
+		})
+	}
+
+	args = nil
+	where = append([]string{"tag ILIKE " + args.add(escapeLike(prefix)+"%")}, filterClauses(t, opts.Filters, &args)...)
+	tags, err := b.pairs(ctx, fmt.Sprintf(`SELECT tag, count(*)::text FROM "keeps", jsonb_array_elements_text("tags"::jsonb) AS tag WHERE %s GROUP BY tag ORDER BY count(*) DESC, tag LIMIT %s`,
+		strings.Join(where, " AND "), args.add(opts.Size)), args...)
+	if err != nil {
+		return result, fmt.Errorf("postgres tag suggestions failed: %w", err)
+	}
+	for _, p := range tags {
+		count, _ := strconv.Atoi(p[1])
+		result.Tags = append(result.Tags, es.TagSuggestion{Tag: p[0], Count: count})
+	}
+	return result, nil
+}
+
+func (b *Postgres) Related(ctx context.Context, def *es.IndexDefinition, id string, opts es.RelatedOptions) (es.SearchResult, error) {
+	var result es.SearchResult
+	t, ok := pgTables[def.Name]
+	if !ok {
+		return result, fmt.Errorf("%w: %s", ErrUnsupportedIndex, def.Name)
+	}
+	if !slices.Contains(t.vectors, relatedVectorColumn) {
+		return result, fmt.Errorf("%w: %s", es.ErrRelatedUnsupported, def.Name)
+	}
+	if opts.Size <= 0 {
+		opts.Size = es.DefaultRelatedSize
+	}
+	opts.Size = min(opts.Size, es.MaxRelatedSize)
+
+	found, err := b.pairs(ctx, fmt.Sprintf(`SELECT "id", (%s IS NOT NULL)::text FROM %s WHERE "id" = $1`,
+		quote(relatedVectorColumn), quote(t.table)), id)
+	if err != nil {
+		return result, fmt.Errorf("failed to load %s %s: %w", def.Type, id, err)
+	}
+	if len(found) == 0 {
+		return result, fmt.Errorf("%w: %s", es.ErrDocumentNotFound, id)
+	}
+	result.Hits.Hits = []es.ResultHit{}
+	if found[0][1] != "true" || !b.capabilities(ctx).vectors[t.table+"."+relatedVectorColumn] {
+		// 没有向量时无法计算相似度，返回空的降级结果
+		result.Hits.Total.Relation = "eq"
+		result.Degraded = true
+		return result, nil
+	}
+
+	args := sqlArgs{id}
+	col := quote(relatedVectorColumn)
+	where := append([]string{`"id" <> $1`, col + " IS NOT NULL"}, filterClauses(t, opts.Filters, &args)...)
+	related, err := b.pairs(ctx, fmt.Sprintf(`SELECT "id", (1 - (%[1]s <=> src.v))::text FROM %[2]s, (SELECT %[1]s AS v FROM %[2]s WHERE "id" = $1) AS src WHERE %[3]s ORDER BY %[1]s <=> src.v LIMIT %[4]s`,
+		col, quote(t.table), strings.Join(where, " AND "), args.add(opts.Size)), args...)
+	if err != nil {
+		return result, fmt.Errorf("postgres related search on %s failed: %w", t.table, err)
+	}
+	page := make([]es.SearchHit, 0, len(related))
+	for _, p := range related {
+		score, _ := strconv.ParseFloat(p[1], 64)
+		page = append(page, es.SearchHit{Type: def.Type, ID: p[0], Score: score})
+	}
+	if result.Hits.Hits, err = b.hits(ctx, page); err != nil {
+		return es.SearchResult{}, err
+	}
+	result.Hits.Total.Value = len(result.Hits.Hits)
+	result.Hits.Total.Relation = "eq"
+	return result, nil
+}
+
+// pairs runs a query selecting two text columns.
+func (b *Postgres) pairs(ctx context.Context, query string, args ...any) ([][2]string, error) {
+	rows, err := b.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out [][2]string
+	for rows.Next() {
+		var p [2]string
+		if err := rows.Scan(&p[0], &p[1]); err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	return out, rows.Err()
+}
+
+// prefixAt returns the rune offset of the first word of text beginning with
+// prefix, ignoring case, or -1.
+func prefixAt(text, prefix string) int {
+	runes, n := []rune(text), len([]rune(prefix))
+	for i := 0; i+n <= len(runes); i++ {
+		if i > 0 && !unicode.IsSpace(runes[i-1]) && !isCJK(runes[i]) {
+			continue
+		}
+		if strings.EqualFold(string(runes[i:i+n]), prefix) {
+			return i
+		}
+	}
+	return -1
+}
+
+// markPrefix wraps the first word of text beginning with prefix in <mark>,
+// keeping at most size runes from it when size > 0, in which case the text
+// before it is dropped like in a summary fragment. It returns "" when no word
+// of text begins with prefix.
+func markPrefix(text, prefix string, size int) string {
+	i := prefixAt(text, prefix)
+	if i < 0 {
+		return ""
+	}
+	runes, n := []rune(text), len([]rune(prefix))
+	head, end := string(runes[:i]), len(runes)
+	if size > 0 {
+		head, end = "", min(i+max(size, n), len(runes))
+	}
+	return head + "<mark>" + string(runes[i:i+n]) + "</mark>" + strings.TrimRightFunc(string(runes[i+n:end]), unicode.IsSpace)
+}
+
+// isCJK reports whether r is a Han character, which starts a word anywhere
+// since Chinese text is not separated by spaces.
+func isCJK(r rune) bool { return unicode.Is(unicode.Han, r) }
@@ -0,0 +1,121 @@
+package search
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"api.us4ever/internal/es"
+	"github.com/lib/pq"
+)
+
+func TestKeywordQuery(t *testing.T) {
+	keeps := pgTables["keeps"]
+	isPublic := true
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	filters := es.SearchFilters{Tags: []string{"go"}, IsPublic: &isPublic, OwnerID: "u1", CreatedFrom: &from}
+
+	query, args := keywordQuery(keeps, true, "simple", "50%_off", filters, 20)
+	for _, want := range []string{
+		`coalesce("title", '') ILIKE $1`,
+		`"search_tsv" @@ plainto_tsquery($2::regconfig, $3)`,
+		`ts_rank_cd("search_tsv"`,
+		`"tags"::jsonb ?| $4`,
+		`"isPublic" = $5`,
+		`"ownerId" = $6`,
+		`"createdAt" >= $7`,
+		`LIMIT $8`,
+	} {
+		if !strings.Contains(query, want) {
+			t.Errorf("expected %q in\n%s", want, query)
+		}
+	}
+	if len(args) != 8 || args[0] != `%50\%\_off%` || args[7] != 20 {
+		t.Errorf("unexpected args %v", args)
+	}
+
+	query, _ = keywordQuery(keeps, false, "simple", "go", es.SearchFilters{}, 20)
+	if strings.Contains(query, "search_tsv") {
+		t.Errorf("expected substring matching only before the migration, got\n%s", query)
+	}
+}
+
+func TestKeywordCountQuery(t *testing.T) {
+	keeps := pgTables["keeps"]
+	filters := es.SearchFilters{OwnerID: "u1"}
+	query, args := keywordCountQuery(keeps, true, "simple", "go", filters)
+	ranked, rankedArgs := keywordQuery(keeps, true, "simple", "go", filters, 20)
+
+	if !strings.HasPrefix(query, `SELECT count(*) FROM "keeps" WHERE `) || strings.Contains(query, "LIMIT") {
+		t.Errorf("unexpected count query\n%s", query)
+	}
+	// 计数与排序使用同一个匹配条件
+	where := strings.TrimPrefix(query, `SELECT count(*) FROM "keeps" WHERE `)
+	if !strings.Contains(ranked, " WHERE "+where+" ORDER BY ") {
+		t.Errorf("expected the count to match like\n%s\ngot\n%s", ranked, query)
+	}
+	if !slices.Equal(args, rankedArgs[:len(rankedArgs)-1]) {
+		t.Errorf("expected the ranking args without the limit, got %v", args)
+	}
+}
+
+func TestFilterClauses(t *testing.T) {
+	var args sqlArgs
+	got := filterClauses(pgTables["images"], es.SearchFilters{OwnerID: "u1", Category: "photo"}, &args)
+	if !slices.Equal(got, []string{`"category" = $1`, `"uploadedBy" = $2`}) {
+		t.Errorf("unexpected clauses %v", got)
+	}
+
+	// 没有标签列的表不会匹配标签过滤
+	args = nil
+	got = filterClauses(pgTables["todos"], es.SearchFilters{Tags: []string{"go"}}, &args)
+	if !slices.Equal(got, []string{"FALSE"}) || len(args) != 0 {
+		t.Errorf("expected tag filters to exclude todos, got %v %v", got, args)
+	}
+}
+
+func TestVectorQuery(t *testing.T) {
+	query, args := vectorQuery(pgTables["moments"], "content_vector", []float32{0.5, -1, 0.25}, es.SearchFilters{Tags: []string{"a"}}, 10)
+	want := `SELECT "id" FROM "moments" WHERE "content_vector" IS NOT NULL AND "tags"::jsonb ?| $2 ORDER BY "content_vector" <=> $1::vector LIMIT $3`
+	if query != want {
+		t.Errorf("unexpected query\n got: %s\nwant: %s", query, want)
+	}
+	if args[0] != "[0.5,-1,0.25]" {
+		t.Errorf("unexpected vector literal %v", args[0])
+	}
+	if _, ok := args[1].(*pq.StringArray); !ok {
+		t.Errorf("expected the tags as an array parameter, got %T", args[1])
+	}
+}
+
+func TestMarkPrefix(t *testing.T) {
+	for _, tc := range []struct {
+		text, prefix string
+		size         int
+		want         string
+	}{
+		{"Go 并发模式", "go", 0, "<mark>Go</mark> 并发模式"},
+		{"学习 golang 笔记", "gol", 0, "学习 <mark>gol</mark>ang 笔记"},
+		{"ago", "go", 0, ""},
+		{"这是一段关于并发的总结", "并发", 4, "<mark>并发</mark>的总"},
+	} {
+		if got := markPrefix(tc.text, tc.prefix, tc.size); got != tc.want {
+			t.Errorf("markPrefix(%q, %q, %d) = %q, want %q", tc.text, tc.prefix, tc.size, got, tc.want)
+		}
+	}
+}
+
+func TestCapabilitiesSemantic(t *testing.T) {
+	caps := capabilities{vectors: map[string]bool{"keeps.title_vector": true, "keeps.summary_vector": true}}
+	if caps.semantic(pgTables["keeps"]) {
+		t.Error("expected keeps to need every vector column migrated")
+	}
+	caps.vectors["keeps.content_vector"] = true
+	if !caps.semantic(pgTables["keeps"]) {
+		t.Error("expected keeps to be searched semantically")
+	}
+	if caps.semantic(pgTables["todos"]) {
+		t.Error("expected no semantic search without vector columns")
+	}
+}
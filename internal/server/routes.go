@@ -1,6 +1,7 @@
 package server
 
 import (
+	"strings"
 	"time"
 
 	"api.us4ever/internal/metrics"
@@ -12,6 +13,13 @@ import (
 	"github.com/gofiber/fiber/v3/middleware/requestid"
 )
 
+const (
+	// suggestPath is rate limited on its own, see RegisterFiberRoutes
+	suggestPath = "/internal/search/suggest"
+	// suggestRateLimit is the number of suggest requests per IP in 30 seconds
+	suggestRateLimit = 300
+)
+
 func (s *FiberServer) RegisterFiberRoutes() {
 	// 1. Recovery: 捕获后续所有中间件或处理器中的 panic，并将其转换为 500 错误。
 	// 必须放在指标中间件之后，这样指标中间件才能捕获到它设置的 500 状态码。
@@ -41,7 +49,11 @@ func (s *FiberServer) RegisterFiberRoutes() {
 
 	// 7. Limiter: 在请求到达核心业务逻辑之前进行速率限制，保护应用。
 	// 即使请求被限流（返回 429），指标中间件也因为在其之前注册而能记录到这次请求。
+	// 输入提示随键入触发，请求频率远高于其他接口，使用单独的更宽松的限额。
 	s.App.Use(limiter.New(limiter.Config{
+		Next: func(c fiber.Ctx) bool {
+			return strings.EqualFold(strings.TrimSuffix(c.Path(), "/"), suggestPath)
+		},
 		Max:               30,
 		Expiration:        30 * time.Second,
 		LimiterMiddleware: limiter.SlidingWindow{},
@@ -49,6 +61,14 @@ func (s *FiberServer) RegisterFiberRoutes() {
 			return middleware.GetRealIP(c)
 		},
 	}))
+	s.App.Use(suggestPath, limiter.New(limiter.Config{
+		Max:               suggestRateLimit,
+		Expiration:        30 * time.Second,
+		LimiterMiddleware: limiter.SlidingWindow{},
+		KeyGenerator: func(c fiber.Ctx) string {
+			return middleware.GetRealIP(c)
+		},
+	}))
 
 	// 注册基础路由
 	routes.RegisterBaseRoutes(s.App)
@@ -58,10 +78,34 @@ func (s *FiberServer) RegisterFiberRoutes() {
 	internalRoutes.Register()
 
 	// 注册搜索路由
-	searchRoutes := routes.NewSearchRoutes(s.App, s.EsClient, s.DbClient, s.KeepEsIndexAlias, s.MomentEsIndexAlias)
+	searchRoutes := routes.NewSearchRoutes(s.App, s.Search, s.DbClient)
 	searchRoutes.Register()
 
 	// 注册重索引路由
-	reindexRoutes := routes.NewReindexRoutes(s.App, s.EsClient, s.DbClient, s.KeepEsIndexAlias, s.MomentEsIndexAlias)
+	reindexRoutes := routes.NewReindexRoutes(s.App, s.EsClient, s.DbClient, s.ReindexJobs, s.ConsistencyReports, s.EsIndexAliases)
 	reindexRoutes.Register()
+
+	// 注册 keep 增删改查路由
+	keepRoutes := routes.NewKeepRoutes(s.App, s.DbClient, s.Enrichment)
+	keepRoutes.Register()
+
+	// 注册 moment 增删改查路由
+	momentRoutes := routes.NewMomentRoutes(s.App, s.DbClient, s.Enrichment)
+	momentRoutes.Register()
+
+	// 注册 todo 增删改查、批量操作和日期视图路由
+	todoRoutes := routes.NewTodoRoutes(s.App, s.DbClient)
+	todoRoutes.Register()
+
+	// 注册重复检测路由
+	duplicateRoutes := routes.NewDuplicateRoutes(s.App, s.DbClient, s.Duplicates)
+	duplicateRoutes.Register()
+
+	// 注册标签建议路由
+	tagRoutes := routes.NewTagRoutes(s.App, s.DbClient)
+	tagRoutes.Register()
+
+	// 注册问答路由
+	askRoutes := routes.NewAskRoutes(s.App, s.Search)
+	askRoutes.Register()
 }
@@ -0,0 +1,178 @@
+package routes
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"api.us4ever/internal/es"
+	"api.us4ever/internal/logger"
+	"api.us4ever/internal/middleware"
+	"api.us4ever/internal/rag"
+	"api.us4ever/internal/search"
+	"github.com/gofiber/fiber/v3"
+	"go.uber.org/zap"
+)
+
+var askLogger *logger.Logger
+
+func init() {
+	var err error
+	askLogger, err = logger.New("ask")
+	if err != nil {
+		panic("failed to initialize ask logger: " + err.Error())
+	}
+}
+
+const (
+	// maxQuestionLength bounds the question, in characters
+	maxQuestionLength = 1000
+	// askTimeout bounds the generation of an answer, which outlives the handler
+	askTimeout = 2 * time.Minute
+)
+
+type AskRoutes struct {
+	app      *fiber.App
+	backends *search.Holder
+}
+
+func NewAskRoutes(app *fiber.App, backends *search.Holder) *AskRoutes {
+	return &AskRoutes{
+		app:      app,
+		backends: backends,
+	}
+}
+
+func (r *AskRoutes) Register() {
+	internal := r.app.Group("/internal")
+
+	// 基于笔记的问答，以 SSE 流式返回答案和引用
+	internal.Post("/ask", r.askHandler)
+}
+
+// askRequest is the body of an ask request. Limit is the number of keeps and
+// of moments retrieved; the other fields filter them like the search filters.
+type askRequest struct {
+	Question string   `json:"question"`
+	Limit    int      `json:"limit"`
+	Tags     []string `json:"tags"`
+	Category string   `json:"category"`
+	OwnerID  string   `json:"ownerId"`
+	IsPublic *bool    `json:"isPublic"`
+}
+
+// askHandler answers a question from the keeps and moments it retrieves.
+// Retrieval errors are returned as JSON; the answer is then streamed as
+// server-sent events: citations, answer (repeated) and done or error.
+func (r *AskRoutes) askHandler(c fiber.Ctx) error {
+	req, err := parseAskRequest(c.Body())
+	if err != nil {
+		askLogger.Warn("invalid ask request",
+			zap.String("ip", middleware.GetRealIP(c)),
+			zap.Error(err),
+		)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "ValidationError",
+				"message": err.Error(),
+				"code":    400,
+			},
+		})
+	}
+
+	// Check if a search backend is available
+	backend, release := r.backends.Acquire()
+	defer release()
+	if backend == nil {
+		askLogger.Warn("no search backend is available for ask")
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "ServiceError",
+				"message": "Search service is temporarily unavailable",
+				"code":    503,
+			},
+		})
+	}
+
+	retrieval, err := rag.Retrieve(c.Context(), backend, req.Question, rag.Options{
+		Limit: req.Limit,
+		Filters: es.SearchFilters{
+			Tags:     req.Tags,
+			Category: req.Category,
+			OwnerID:  req.OwnerID,
+			IsPublic: req.IsPublic,
+		},
+	})
+	if err != nil {
+		askLogger.Error("error retrieving context for question", zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "SearchError",
+				"message": "Failed to retrieve notes",
+				"code":    500,
+			},
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	// 流在处理函数返回后才写出，不能使用请求的 context
+	return c.SendStreamWriter(func(w *bufio.Writer) {
+		ctx, cancel := context.WithTimeout(context.Background(), askTimeout)
+		defer cancel()
+
+		err := rag.Answer(ctx, retrieval, func(e rag.Event) error {
+			return writeEvent(w, e)
+		})
+		if err != nil {
+			askLogger.Warn("answer stream ended early", zap.Error(err))
+		}
+	})
+}
+
+// parseAskRequest decodes and validates the body of an ask request.
+func parseAskRequest(body []byte) (askRequest, error) {
+	var req askRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return req, fmt.Errorf("invalid request body: %w", err)
+	}
+	req.Question = strings.TrimSpace(req.Question)
+	if req.Question == "" {
+		return req, fmt.Errorf("missing question")
+	}
+	if utf8.RuneCountInString(req.Question) > maxQuestionLength {
+		return req, fmt.Errorf("question too long, maximum length is %d", maxQuestionLength)
+	}
+	if req.Limit < 0 || req.Limit > rag.MaxLimit {
+		return req, fmt.Errorf("invalid limit: must be between 1 and %d", rag.MaxLimit)
+	}
+	var tags []string
+	for _, tag := range req.Tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	req.Tags = tags
+	req.Category = strings.TrimSpace(req.Category)
+	req.OwnerID = strings.TrimSpace(req.OwnerID)
+	return req, nil
+}
+
+// writeEvent writes e as a server-sent event and flushes it to the client.
+func writeEvent(w *bufio.Writer, e rag.Event) error {
+	data, err := json.Marshal(e.Data)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", e.Name, err)
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Name, data); err != nil {
+		return err
+	}
+	return w.Flush()
+}
@@ -0,0 +1,195 @@
+package routes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/dedup"
+	"api.us4ever/internal/logger"
+	"api.us4ever/internal/middleware"
+	"github.com/gofiber/fiber/v3"
+	"go.uber.org/zap"
+)
+
+var duplicatesLogger *logger.Logger
+
+func init() {
+	var err error
+	duplicatesLogger, err = logger.New("duplicates")
+	if err != nil {
+		panic("failed to initialize duplicates logger: " + err.Error())
+	}
+}
+
+type DuplicateRoutes struct {
+	app      *fiber.App
+	dbClient database.Service
+	reports  *dedup.Reports
+}
+
+func NewDuplicateRoutes(app *fiber.App, dbClient database.Service, reports *dedup.Reports) *DuplicateRoutes {
+	return &DuplicateRoutes{
+		app:      app,
+		dbClient: dbClient,
+		reports:  reports,
+	}
+}
+
+func (r *DuplicateRoutes) Register() {
+	internal := r.app.Group("/internal")
+
+	// 重复候选分组、立即重新扫描与合并
+	for _, kind := range dedup.Kinds() {
+		internal.Get("/duplicates/"+kind, r.listHandler(kind))
+		internal.Post("/duplicates/"+kind+"/scan", r.scanHandler(kind))
+		internal.Post("/duplicates/"+kind+"/merge", r.mergeHandler(kind))
+	}
+}
+
+// listHandler builds the handler listing the candidate duplicate groups of
+// kind found by the latest scan. The first request after a restart scans.
+func (r *DuplicateRoutes) listHandler(kind string) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if report, ok := r.reports.Latest(kind); ok {
+			return c.JSON(report)
+		}
+		return r.scan(c, kind)
+	}
+}
+
+// scanHandler builds the handler scanning kind for duplicates right away.
+func (r *DuplicateRoutes) scanHandler(kind string) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		return r.scan(c, kind)
+	}
+}
+
+func (r *DuplicateRoutes) scan(c fiber.Ctx, kind string) error {
+	if r.dbClient == nil {
+		return duplicatesUnavailable(c)
+	}
+	report, err := dedup.Scan(c.Context(), r.dbClient, kind, dedup.Options{})
+	if err != nil {
+		duplicatesLogger.Error("failed to scan for duplicates",
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "DatabaseError",
+				"message": "Failed to scan " + kind + " for duplicates",
+				"code":    500,
+			},
+		})
+	}
+	r.reports.Record(report)
+	return c.JSON(report)
+}
+
+// mergeRequest is the body of a merge: the record to keep and the
+// duplicates folded into it and deleted.
+type mergeRequest struct {
+	CanonicalID  string   `json:"canonicalId"`
+	DuplicateIDs []string `json:"duplicateIds"`
+}
+
+// mergeHandler builds the handler merging duplicates of kind into a
+// canonical record.
+func (r *DuplicateRoutes) mergeHandler(kind string) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		req, err := parseMergeRequest(c.Body())
+		if err != nil {
+			duplicatesLogger.Warn("invalid merge request",
+				zap.String("ip", middleware.GetRealIP(c)),
+				zap.Error(err),
+			)
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": fiber.Map{
+					"type":    "ValidationError",
+					"message": err.Error(),
+					"code":    400,
+				},
+			})
+		}
+		if r.dbClient == nil {
+			return duplicatesUnavailable(c)
+		}
+
+		result, err := dedup.Merge(c.Context(), r.dbClient.Client(), kind, req.CanonicalID, req.DuplicateIDs)
+		switch {
+		case errors.Is(err, dedup.ErrNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": fiber.Map{
+					"type":    "NotFoundError",
+					"message": err.Error(),
+					"code":    404,
+				},
+			})
+		case errors.Is(err, dedup.ErrOwnerMismatch):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error": fiber.Map{
+					"type":    "ConflictError",
+					"message": err.Error(),
+					"code":    409,
+				},
+			})
+		case err != nil:
+			duplicatesLogger.Error("failed to merge duplicates",
+				zap.String("kind", kind),
+				zap.String("canonicalId", req.CanonicalID),
+				zap.Error(err),
+			)
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": fiber.Map{
+					"type":    "DatabaseError",
+					"message": "Failed to merge " + kind,
+					"code":    500,
+				},
+			})
+		}
+
+		r.reports.Forget(kind, result.Merged)
+		return c.JSON(result)
+	}
+}
+
+// parseMergeRequest decodes and validates the body of a merge.
+func parseMergeRequest(body []byte) (mergeRequest, error) {
+	var req mergeRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return req, fmt.Errorf("invalid request body: %w", err)
+	}
+	req.CanonicalID = strings.TrimSpace(req.CanonicalID)
+	if req.CanonicalID == "" {
+		return req, fmt.Errorf("missing canonicalId")
+	}
+	var ids []string
+	for _, id := range req.DuplicateIDs {
+		if id = strings.TrimSpace(id); id == "" {
+			continue
+		}
+		if id == req.CanonicalID {
+			return req, fmt.Errorf("canonicalId %s is also listed in duplicateIds", id)
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return req, fmt.Errorf("missing duplicateIds")
+	}
+	req.DuplicateIDs = ids
+	return req, nil
+}
+
+func duplicatesUnavailable(c fiber.Ctx) error {
+	duplicatesLogger.Warn("no database is available for duplicate detection")
+	return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "ServiceError",
+			"message": "Database service is temporarily unavailable",
+			"code":    503,
+		},
+	})
+}
@@ -0,0 +1,585 @@
+package routes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/enrich"
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/keep"
+	"api.us4ever/internal/ent/keepchunk"
+	"api.us4ever/internal/ent/user"
+	"api.us4ever/internal/logger"
+	"api.us4ever/internal/middleware"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var keepsLogger *logger.Logger
+
+func init() {
+	var err error
+	keepsLogger, err = logger.New("keeps")
+	if err != nil {
+		panic("failed to initialize keeps logger: " + err.Error())
+	}
+}
+
+const (
+	// defaultPageLimit is the number of records listed by default
+	defaultPageLimit = 20
+	// maxPageLimit bounds the number of records listed at once
+	maxPageLimit = 100
+
+	maxTitleRunes    = 200
+	maxSummaryRunes  = 2000
+	maxCategoryRunes = 50
+	maxContentRunes  = 100000
+	maxTags          = 20
+	maxTagRunes      = 50
+
+	// defaultCategory is the category of records created without one
+	defaultCategory = "default"
+)
+
+// keepFields are the columns returned by the keep routes; the vectors are
+// left out.
+var keepFields = []string{
+	keep.FieldID,
+	keep.FieldTitle,
+	keep.FieldSummary,
+	keep.FieldContent,
+	keep.FieldCategory,
+	keep.FieldTags,
+	keep.FieldIsPublic,
+	keep.FieldOwnerId,
+	keep.FieldViews,
+	keep.FieldLikes,
+	keep.FieldExtraData,
+	keep.FieldCreatedAt,
+	keep.FieldUpdatedAt,
+}
+
+type KeepRoutes struct {
+	app        *fiber.App
+	dbClient   database.Service
+	enrichment *enrich.Queue
+}
+
+func NewKeepRoutes(app *fiber.App, dbClient database.Service, enrichment *enrich.Queue) *KeepRoutes {
+	return &KeepRoutes{
+		app:        app,
+		dbClient:   dbClient,
+		enrichment: enrichment,
+	}
+}
+
+func (r *KeepRoutes) Register() {
+	keeps := r.app.Group("/api/keeps")
+
+	keeps.Get("/", r.listHandler)
+	keeps.Post("/", r.createHandler)
+	keeps.Get("/:id", r.getHandler)
+	keeps.Patch("/:id", r.updateHandler)
+	keeps.Delete("/:id", r.deleteHandler)
+}
+
+// keepResponse is a keep as returned by the API.
+type keepResponse struct {
+	ID        string          `json:"id"`
+	Title     string          `json:"title"`
+	Summary   string          `json:"summary"`
+	Content   string          `json:"content"`
+	Category  string          `json:"category"`
+	Tags      []string        `json:"tags"`
+	IsPublic  bool            `json:"isPublic"`
+	OwnerID   string          `json:"ownerId,omitempty"`
+	Views     int32           `json:"views"`
+	Likes     int32           `json:"likes"`
+	ExtraData json.RawMessage `json:"extraData"`
+	CreatedAt time.Time       `json:"createdAt"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+}
+
+func newKeepResponse(k *ent.Keep) keepResponse {
+	return keepResponse{
+		ID:        k.ID,
+		Title:     k.Title,
+		Summary:   k.Summary,
+		Content:   k.Content,
+		Category:  k.Category,
+		Tags:      storedTags(k.Tags),
+		IsPublic:  k.IsPublic,
+		OwnerID:   k.OwnerId,
+		Views:     k.Views,
+		Likes:     k.Likes,
+		ExtraData: storedObject(k.ExtraData),
+		CreatedAt: k.CreatedAt,
+		UpdatedAt: k.UpdatedAt,
+	}
+}
+
+// keepRequest is the body of a create or update. Fields left out are not
+// changed by an update; an empty title or summary is generated again.
+type keepRequest struct {
+	Title     *string         `json:"title"`
+	Summary   *string         `json:"summary"`
+	Content   *string         `json:"content"`
+	Category  *string         `json:"category"`
+	Tags      *[]string       `json:"tags"`
+	IsPublic  *bool           `json:"isPublic"`
+	OwnerID   *string         `json:"ownerId"`
+	ExtraData json.RawMessage `json:"extraData"`
+}
+
+// listHandler lists keeps, most recently updated first. It accepts ownerId,
+// category, tag, isPublic, limit (up to maxPageLimit) and offset, and
+// returns the total number of matches with the page.
+func (r *KeepRoutes) listHandler(c fiber.Ctx) error {
+	limit, offset, err := parsePage(c)
+	if err != nil {
+		return keepsValidationError(c, err)
+	}
+	isPublic, err := queryOptionalBool(c, "isPublic")
+	if err != nil {
+		return keepsValidationError(c, err)
+	}
+	if r.dbClient == nil {
+		return keepsUnavailable(c)
+	}
+
+	query := r.dbClient.Client().Keep.Query()
+	if ownerID := strings.TrimSpace(c.Query("ownerId")); ownerID != "" {
+		query = query.Where(keep.OwnerId(ownerID))
+	}
+	if category := strings.TrimSpace(c.Query("category")); category != "" {
+		query = query.Where(keep.Category(category))
+	}
+	if tag := strings.TrimSpace(c.Query("tag")); tag != "" {
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(keep.FieldTags, tag))
+		})
+	}
+	if isPublic != nil {
+		query = query.Where(keep.IsPublic(*isPublic))
+	}
+
+	total, err := query.Clone().Count(c.Context())
+	if err != nil {
+		return keepsDatabaseError(c, "list", err)
+	}
+	keeps, err := query.
+		Order(ent.Desc(keep.FieldUpdatedAt), ent.Desc(keep.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		Select(keepFields...).
+		All(c.Context())
+	if err != nil {
+		return keepsDatabaseError(c, "list", err)
+	}
+
+	items := make([]keepResponse, 0, len(keeps))
+	for _, k := range keeps {
+		items = append(items, newKeepResponse(k))
+	}
+	return c.JSON(fiber.Map{
+		"total": total,
+		"keeps": items,
+	})
+}
+
+func (r *KeepRoutes) getHandler(c fiber.Ctx) error {
+	if r.dbClient == nil {
+		return keepsUnavailable(c)
+	}
+	k, err := r.load(c, c.Params("id"))
+	if ent.IsNotFound(err) {
+		return keepNotFound(c)
+	}
+	if err != nil {
+		return keepsDatabaseError(c, "load", err)
+	}
+	return c.JSON(newKeepResponse(k))
+}
+
+// createHandler creates a keep from its content. A missing title or summary
+// is generated, and the vectors computed, in the background.
+func (r *KeepRoutes) createHandler(c fiber.Ctx) error {
+	req, err := parseKeepRequest(c.Body(), true)
+	if err != nil {
+		return keepsValidationError(c, err)
+	}
+	if r.dbClient == nil {
+		return keepsUnavailable(c)
+	}
+	client := r.dbClient.Client()
+
+	if req.OwnerID != nil {
+		exists, err := client.User.Query().Where(user.ID(*req.OwnerID)).Exist(c.Context())
+		if err != nil {
+			return keepsDatabaseError(c, "create", err)
+		}
+		if !exists {
+			return keepsValidationError(c, fmt.Errorf("unknown ownerId %q", *req.OwnerID))
+		}
+	}
+
+	now := time.Now()
+	create := client.Keep.Create().
+		SetID(uuid.NewString()).
+		SetContent(*req.Content).
+		SetTitle(valueOr(req.Title, "")).
+		SetSummary(valueOr(req.Summary, "")).
+		SetCategory(valueOr(req.Category, defaultCategory)).
+		SetTags(encodeTags(valueOr(req.Tags, nil))).
+		SetIsPublic(valueOr(req.IsPublic, false)).
+		SetExtraData(objectOr(req.ExtraData)).
+		SetViews(0).
+		SetLikes(0).
+		SetCreatedAt(now).
+		SetUpdatedAt(now)
+	if req.OwnerID != nil {
+		create = create.SetOwnerId(*req.OwnerID)
+	}
+	k, err := create.Save(c.Context())
+	if err != nil {
+		return keepsDatabaseError(c, "create", err)
+	}
+
+	r.enrich(client, k.ID)
+	return c.Status(fiber.StatusCreated).JSON(newKeepResponse(k))
+}
+
+// updateHandler changes the fields present in the body. The vectors of
+// changed text are cleared and computed again in the background.
+func (r *KeepRoutes) updateHandler(c fiber.Ctx) error {
+	req, err := parseKeepRequest(c.Body(), false)
+	if err != nil {
+		return keepsValidationError(c, err)
+	}
+	if r.dbClient == nil {
+		return keepsUnavailable(c)
+	}
+	client := r.dbClient.Client()
+
+	current, err := r.load(c, c.Params("id"))
+	if ent.IsNotFound(err) {
+		return keepNotFound(c)
+	}
+	if err != nil {
+		return keepsDatabaseError(c, "load", err)
+	}
+
+	update := client.Keep.UpdateOneID(current.ID).SetUpdatedAt(time.Now())
+	if req.Title != nil && *req.Title != current.Title {
+		update = update.SetTitle(*req.Title).ClearTitleVector()
+	}
+	if req.Summary != nil && *req.Summary != current.Summary {
+		update = update.SetSummary(*req.Summary).ClearSummaryVector()
+	}
+	if req.Content != nil && *req.Content != current.Content {
+		update = update.SetContent(*req.Content).ClearContentVector()
+	}
+	if req.Category != nil {
+		update = update.SetCategory(*req.Category)
+	}
+	if req.Tags != nil {
+		update = update.SetTags(encodeTags(*req.Tags))
+	}
+	if req.IsPublic != nil {
+		update = update.SetIsPublic(*req.IsPublic)
+	}
+	if req.ExtraData != nil {
+		update = update.SetExtraData(req.ExtraData)
+	}
+	k, err := update.Save(c.Context())
+	if ent.IsNotFound(err) {
+		return keepNotFound(c)
+	}
+	if err != nil {
+		return keepsDatabaseError(c, "update", err)
+	}
+
+	r.enrich(client, k.ID)
+	return c.JSON(newKeepResponse(k))
+}
+
+// deleteHandler deletes a keep and its search chunks.
+func (r *KeepRoutes) deleteHandler(c fiber.Ctx) error {
+	if r.dbClient == nil {
+		return keepsUnavailable(c)
+	}
+	id := c.Params("id")
+
+	tx, err := r.dbClient.Client().Tx(c.Context())
+	if err != nil {
+		return keepsDatabaseError(c, "delete", err)
+	}
+	if _, err := tx.KeepChunk.Delete().Where(keepchunk.KeepId(id)).Exec(c.Context()); err != nil {
+		_ = tx.Rollback()
+		return keepsDatabaseError(c, "delete", err)
+	}
+	err = tx.Keep.DeleteOneID(id).Exec(c.Context())
+	if ent.IsNotFound(err) {
+		_ = tx.Rollback()
+		return keepNotFound(c)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return keepsDatabaseError(c, "delete", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return keepsDatabaseError(c, "delete", err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (r *KeepRoutes) load(c fiber.Ctx, id string) (*ent.Keep, error) {
+	return r.dbClient.Client().Keep.Query().
+		Where(keep.ID(id)).
+		Select(keepFields...).
+		Only(c.Context())
+}
+
+// enrich queues the generation of what keep id is missing; whatever the
+// queue cannot take is left to the scheduled tasks.
+func (r *KeepRoutes) enrich(client *ent.Client, id string) {
+	if r.enrichment != nil {
+		r.enrichment.Enqueue(client, enrich.KindKeep, id)
+	}
+}
+
+// parseKeepRequest decodes and validates the body of a create, which needs
+// content, or of an update, which needs at least one field.
+func parseKeepRequest(body []byte, create bool) (keepRequest, error) {
+	var req keepRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return req, fmt.Errorf("invalid request body: %w", err)
+	}
+
+	if create && req.Content == nil {
+		return req, fmt.Errorf("missing content")
+	}
+	if !create && req.Title == nil && req.Summary == nil && req.Content == nil && req.Category == nil &&
+		req.Tags == nil && req.IsPublic == nil && req.OwnerID == nil && req.ExtraData == nil {
+		return req, fmt.Errorf("nothing to update")
+	}
+	if !create && req.OwnerID != nil {
+		return req, fmt.Errorf("ownerId cannot be changed")
+	}
+
+	if req.Content != nil {
+		if strings.TrimSpace(*req.Content) == "" {
+			return req, fmt.Errorf("content must not be empty")
+		}
+		if err := checkLength("content", *req.Content, maxContentRunes); err != nil {
+			return req, err
+		}
+	}
+	if err := trimField("title", req.Title, maxTitleRunes); err != nil {
+		return req, err
+	}
+	if err := trimField("summary", req.Summary, maxSummaryRunes); err != nil {
+		return req, err
+	}
+	if err := trimField("category", req.Category, maxCategoryRunes); err != nil {
+		return req, err
+	}
+	if req.Category != nil && *req.Category == "" {
+		return req, fmt.Errorf("category must not be empty")
+	}
+	if req.OwnerID != nil {
+		if *req.OwnerID = strings.TrimSpace(*req.OwnerID); *req.OwnerID == "" {
+			req.OwnerID = nil
+		}
+	}
+	if req.Tags != nil {
+		tags, err := normalizeTags(*req.Tags)
+		if err != nil {
+			return req, err
+		}
+		req.Tags = &tags
+	}
+	if req.ExtraData != nil {
+		if err := checkObject("extraData", req.ExtraData); err != nil {
+			return req, err
+		}
+	}
+	return req, nil
+}
+
+// parsePage reads the limit and offset of a list.
+func parsePage(c fiber.Ctx) (limit, offset int, err error) {
+	if limit, err = queryInt(c, "limit", defaultPageLimit); err != nil {
+		return 0, 0, err
+	}
+	if limit < 1 || limit > maxPageLimit {
+		return 0, 0, fmt.Errorf("invalid limit: must be between 1 and %d", maxPageLimit)
+	}
+	if offset, err = queryInt(c, "offset", 0); err != nil {
+		return 0, 0, err
+	}
+	if offset < 0 {
+		return 0, 0, fmt.Errorf("invalid offset: must not be negative")
+	}
+	return limit, offset, nil
+}
+
+// queryOptionalBool parses a boolean query parameter, nil when absent.
+func queryOptionalBool(c fiber.Ctx, key string) (*bool, error) {
+	v := c.Query(key)
+	if v == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %q", key, v)
+	}
+	return &b, nil
+}
+
+// trimField trims *value in place and checks its length; a nil value is
+// left alone.
+func trimField(name string, value *string, maxRunes int) error {
+	if value == nil {
+		return nil
+	}
+	*value = strings.TrimSpace(*value)
+	return checkLength(name, *value, maxRunes)
+}
+
+func checkLength(name, value string, maxRunes int) error {
+	if utf8.RuneCountInString(value) > maxRunes {
+		return fmt.Errorf("%s is too long: at most %d characters", name, maxRunes)
+	}
+	return nil
+}
+
+// checkObject requires raw to be a JSON object.
+func checkObject(name string, raw json.RawMessage) error {
+	var object map[string]any
+	if err := json.Unmarshal(raw, &object); err != nil || object == nil {
+		return fmt.Errorf("%s must be a JSON object", name)
+	}
+	return nil
+}
+
+// normalizeTags trims the tags and drops empty ones and repeats, compared
+// case-insensitively.
+func normalizeTags(tags []string) ([]string, error) {
+	out := []string{}
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[strings.ToLower(tag)] {
+			continue
+		}
+		if err := checkLength("tag", tag, maxTagRunes); err != nil {
+			return nil, err
+		}
+		seen[strings.ToLower(tag)] = true
+		out = append(out, tag)
+	}
+	if len(out) > maxTags {
+		return nil, fmt.Errorf("too many tags: at most %d", maxTags)
+	}
+	return out, nil
+}
+
+// encodeTags is the stored form of tags; nil is stored as an empty array.
+func encodeTags(tags []string) json.RawMessage {
+	if tags == nil {
+		tags = []string{}
+	}
+	raw, _ := json.Marshal(tags)
+	return raw
+}
+
+// storedTags reads a JSON tags column; anything but an array of strings is
+// returned as no tags.
+func storedTags(raw json.RawMessage) []string {
+	tags := []string{}
+	if len(raw) > 0 && json.Unmarshal(raw, &tags) != nil {
+		return []string{}
+	}
+	return tags
+}
+
+// storedObject returns a JSON object column, or an empty object if unset.
+func storedObject(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 || string(raw) == "null" {
+		return json.RawMessage("{}")
+	}
+	return raw
+}
+
+func objectOr(raw json.RawMessage) json.RawMessage {
+	if raw == nil {
+		return json.RawMessage("{}")
+	}
+	return raw
+}
+
+func valueOr[T any](value *T, def T) T {
+	if value == nil {
+		return def
+	}
+	return *value
+}
+
+func keepsValidationError(c fiber.Ctx, err error) error {
+	keepsLogger.Warn("invalid keep request",
+		zap.String("ip", middleware.GetRealIP(c)),
+		zap.Error(err),
+	)
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "ValidationError",
+			"message": err.Error(),
+			"code":    400,
+		},
+	})
+}
+
+func keepNotFound(c fiber.Ctx) error {
+	return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "NotFoundError",
+			"message": fmt.Sprintf("keep %s not found", c.Params("id")),
+			"code":    404,
+		},
+	})
+}
+
+func keepsDatabaseError(c fiber.Ctx, action string, err error) error {
+	keepsLogger.Error("error in keep "+action,
+		zap.String("id", c.Params("id")),
+		zap.Error(err),
+	)
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "DatabaseError",
+			"message": "Failed to " + action + " keep",
+			"code":    500,
+		},
+	})
+}
+
+func keepsUnavailable(c fiber.Ctx) error {
+	keepsLogger.Warn("no database is available for keeps")
+	return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "ServiceError",
+			"message": "Database service is temporarily unavailable",
+			"code":    503,
+		},
+	})
+}
@@ -0,0 +1,770 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/enrich"
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/bucket"
+	"api.us4ever/internal/ent/file"
+	"api.us4ever/internal/ent/image"
+	"api.us4ever/internal/ent/moment"
+	"api.us4ever/internal/ent/momentimage"
+	"api.us4ever/internal/ent/momentvideo"
+	"api.us4ever/internal/ent/user"
+	"api.us4ever/internal/ent/video"
+	"api.us4ever/internal/logger"
+	"api.us4ever/internal/middleware"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var momentsLogger *logger.Logger
+
+func init() {
+	var err error
+	momentsLogger, err = logger.New("moments")
+	if err != nil {
+		panic("failed to initialize moments logger: " + err.Error())
+	}
+}
+
+// maxAttachments bounds the images, and the videos, of one moment
+const maxAttachments = 50
+
+var (
+	// errUnknownAttachment is returned when an image or video to attach does not exist.
+	errUnknownAttachment = errors.New("unknown attachment")
+	// errUnknownOwner is returned when the owner of a new moment does not exist.
+	errUnknownOwner = errors.New("unknown owner")
+	// errEmptyMoment is returned when a moment would have neither content nor attachments.
+	errEmptyMoment = errors.New("a moment needs content, images or videos")
+)
+
+// momentFields are the columns returned by the moment routes; the vector is
+// left out.
+var momentFields = []string{
+	moment.FieldID,
+	moment.FieldContent,
+	moment.FieldCategory,
+	moment.FieldTags,
+	moment.FieldIsPublic,
+	moment.FieldOwnerId,
+	moment.FieldViews,
+	moment.FieldLikes,
+	moment.FieldExtraData,
+	moment.FieldCreatedAt,
+	moment.FieldUpdatedAt,
+}
+
+type MomentRoutes struct {
+	app        *fiber.App
+	dbClient   database.Service
+	enrichment *enrich.Queue
+}
+
+func NewMomentRoutes(app *fiber.App, dbClient database.Service, enrichment *enrich.Queue) *MomentRoutes {
+	return &MomentRoutes{
+		app:        app,
+		dbClient:   dbClient,
+		enrichment: enrichment,
+	}
+}
+
+func (r *MomentRoutes) Register() {
+	moments := r.app.Group("/api/moments")
+
+	moments.Get("/", r.listHandler)
+	moments.Post("/", r.createHandler)
+	moments.Get("/:id", r.getHandler)
+	moments.Patch("/:id", r.updateHandler)
+	moments.Delete("/:id", r.deleteHandler)
+}
+
+// momentResponse is a moment as returned by the API, with its attachments
+// in order.
+type momentResponse struct {
+	ID        string          `json:"id"`
+	Content   string          `json:"content"`
+	Category  string          `json:"category"`
+	Tags      []string        `json:"tags"`
+	IsPublic  bool            `json:"isPublic"`
+	OwnerID   string          `json:"ownerId,omitempty"`
+	Views     int32           `json:"views"`
+	Likes     int32           `json:"likes"`
+	ExtraData json.RawMessage `json:"extraData"`
+	Images    []momentImage   `json:"images"`
+	Videos    []momentVideo   `json:"videos"`
+	CreatedAt time.Time       `json:"createdAt"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+}
+
+type momentImage struct {
+	ID          string    `json:"id"`
+	Sort        int32     `json:"sort"`
+	Name        string    `json:"name"`
+	Width       int32     `json:"width"`
+	Height      int32     `json:"height"`
+	Description string    `json:"description"`
+	URLs        imageURLs `json:"urls"`
+}
+
+// imageURLs are the public URLs of the files of an image; a file that is
+// missing or in a bucket without a public URL is left out.
+type imageURLs struct {
+	Thumbnail320x string `json:"thumbnail320x,omitempty"`
+	Thumbnail768x string `json:"thumbnail768x,omitempty"`
+	Compressed    string `json:"compressed,omitempty"`
+	Original      string `json:"original,omitempty"`
+}
+
+type momentVideo struct {
+	ID        string `json:"id"`
+	Sort      int32  `json:"sort"`
+	Name      string `json:"name"`
+	Duration  int32  `json:"duration"`
+	URL       string `json:"url,omitempty"`
+	PosterURL string `json:"posterUrl,omitempty"`
+}
+
+func newMomentResponse(m *ent.Moment) momentResponse {
+	resp := momentResponse{
+		ID:        m.ID,
+		Content:   m.Content,
+		Category:  m.Category,
+		Tags:      storedTags(m.Tags),
+		IsPublic:  m.IsPublic,
+		OwnerID:   m.OwnerId,
+		Views:     m.Views,
+		Likes:     m.Likes,
+		ExtraData: storedObject(m.ExtraData),
+		Images:    []momentImage{},
+		Videos:    []momentVideo{},
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+	for _, mi := range m.Edges.MomentImages {
+		img := mi.Edges.Image
+		if img == nil {
+			continue
+		}
+		resp.Images = append(resp.Images, momentImage{
+			ID:          img.ID,
+			Sort:        mi.Sort,
+			Name:        img.Name,
+			Width:       img.Width,
+			Height:      img.Height,
+			Description: img.Description,
+			URLs: imageURLs{
+				Thumbnail320x: fileURL(img.Edges.Thumbnail320x),
+				Thumbnail768x: fileURL(img.Edges.Thumbnail768x),
+				Compressed:    fileURL(img.Edges.Compressed),
+				Original:      fileURL(img.Edges.Original),
+			},
+		})
+	}
+	for _, mv := range m.Edges.MomentVideos {
+		v := mv.Edges.Video
+		if v == nil {
+			continue
+		}
+		resp.Videos = append(resp.Videos, momentVideo{
+			ID:        v.ID,
+			Sort:      mv.Sort,
+			Name:      v.Name,
+			Duration:  v.Duration,
+			URL:       fileURL(v.Edges.File),
+			PosterURL: fileURL(v.Edges.Poster),
+		})
+	}
+	return resp
+}
+
+// fileURL is the public URL of f: the public URL of its bucket joined with
+// its path. It is empty when either is unknown.
+func fileURL(f *ent.File) string {
+	if f == nil || f.Edges.Bucket == nil || f.Edges.Bucket.PublicUrl == "" || f.Path == "" {
+		return ""
+	}
+	return strings.TrimRight(f.Edges.Bucket.PublicUrl, "/") + "/" + strings.TrimLeft(f.Path, "/")
+}
+
+// momentRequest is the body of a create or update. Fields left out are not
+// changed by an update; imageIds and videoIds replace the attachments, in
+// the order given.
+type momentRequest struct {
+	Content   *string         `json:"content"`
+	Category  *string         `json:"category"`
+	Tags      *[]string       `json:"tags"`
+	IsPublic  *bool           `json:"isPublic"`
+	OwnerID   *string         `json:"ownerId"`
+	ExtraData json.RawMessage `json:"extraData"`
+	ImageIDs  *[]string       `json:"imageIds"`
+	VideoIDs  *[]string       `json:"videoIds"`
+}
+
+// listHandler lists moments, newest first, with their attachments. It
+// accepts ownerId, category, tag, isPublic, limit (up to maxPageLimit) and
+// offset, and returns the total number of matches with the page.
+func (r *MomentRoutes) listHandler(c fiber.Ctx) error {
+	limit, offset, err := parsePage(c)
+	if err != nil {
+		return momentsValidationError(c, err)
+	}
+	isPublic, err := queryOptionalBool(c, "isPublic")
+	if err != nil {
+		return momentsValidationError(c, err)
+	}
+	if r.dbClient == nil {
+		return momentsUnavailable(c)
+	}
+
+	query := r.dbClient.Client().Moment.Query()
+	if ownerID := strings.TrimSpace(c.Query("ownerId")); ownerID != "" {
+		query = query.Where(moment.OwnerId(ownerID))
+	}
+	if category := strings.TrimSpace(c.Query("category")); category != "" {
+		query = query.Where(moment.Category(category))
+	}
+	if tag := strings.TrimSpace(c.Query("tag")); tag != "" {
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(moment.FieldTags, tag))
+		})
+	}
+	if isPublic != nil {
+		query = query.Where(moment.IsPublic(*isPublic))
+	}
+
+	total, err := query.Clone().Count(c.Context())
+	if err != nil {
+		return r.writeError(c, "list", err)
+	}
+	moments, err := withAttachments(query).
+		Order(ent.Desc(moment.FieldCreatedAt), ent.Desc(moment.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		Select(momentFields...).
+		All(c.Context())
+	if err != nil {
+		return r.writeError(c, "list", err)
+	}
+
+	items := make([]momentResponse, 0, len(moments))
+	for _, m := range moments {
+		items = append(items, newMomentResponse(m))
+	}
+	return c.JSON(fiber.Map{
+		"total":   total,
+		"moments": items,
+	})
+}
+
+func (r *MomentRoutes) getHandler(c fiber.Ctx) error {
+	if r.dbClient == nil {
+		return momentsUnavailable(c)
+	}
+	return r.respond(c, fiber.StatusOK, c.Params("id"))
+}
+
+// createHandler creates a moment with its attachments in one transaction.
+// Its content is embedded in the background.
+func (r *MomentRoutes) createHandler(c fiber.Ctx) error {
+	req, err := parseMomentRequest(c.Body(), true)
+	if err != nil {
+		return momentsValidationError(c, err)
+	}
+	if r.dbClient == nil {
+		return momentsUnavailable(c)
+	}
+	client := r.dbClient.Client()
+
+	id := uuid.NewString()
+	err = withTx(c.Context(), client, func(tx *ent.Tx) error {
+		if req.OwnerID != nil {
+			exists, err := tx.User.Query().Where(user.ID(*req.OwnerID)).Exist(c.Context())
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return fmt.Errorf("ownerId %s: %w", *req.OwnerID, errUnknownOwner)
+			}
+		}
+
+		now := time.Now()
+		create := tx.Moment.Create().
+			SetID(id).
+			SetContent(valueOr(req.Content, "")).
+			SetCategory(valueOr(req.Category, defaultCategory)).
+			SetTags(encodeTags(valueOr(req.Tags, nil))).
+			SetIsPublic(valueOr(req.IsPublic, false)).
+			SetExtraData(objectOr(req.ExtraData)).
+			SetViews(0).
+			SetLikes(0).
+			SetCreatedAt(now).
+			SetUpdatedAt(now)
+		if req.OwnerID != nil {
+			create = create.SetOwnerId(*req.OwnerID)
+		}
+		if err := create.Exec(c.Context()); err != nil {
+			return err
+		}
+		if err := syncMomentImages(c.Context(), tx, id, valueOr(req.ImageIDs, nil), now); err != nil {
+			return err
+		}
+		if err := syncMomentVideos(c.Context(), tx, id, valueOr(req.VideoIDs, nil), now); err != nil {
+			return err
+		}
+		return checkNotEmpty(c.Context(), tx, id)
+	})
+	if err != nil {
+		return r.writeError(c, "create", err)
+	}
+
+	r.enrich(client, id)
+	return r.respond(c, fiber.StatusCreated, id)
+}
+
+// updateHandler changes the fields present in the body, and replaces the
+// attachments when imageIds or videoIds are given, in one transaction.
+func (r *MomentRoutes) updateHandler(c fiber.Ctx) error {
+	req, err := parseMomentRequest(c.Body(), false)
+	if err != nil {
+		return momentsValidationError(c, err)
+	}
+	if r.dbClient == nil {
+		return momentsUnavailable(c)
+	}
+	client := r.dbClient.Client()
+
+	var id string
+	err = withTx(c.Context(), client, func(tx *ent.Tx) error {
+		current, err := tx.Moment.Query().
+			Where(moment.ID(c.Params("id"))).
+			Select(moment.FieldID, moment.FieldContent).
+			Only(c.Context())
+		if err != nil {
+			return err
+		}
+		id = current.ID
+
+		now := time.Now()
+		update := tx.Moment.UpdateOneID(id).SetUpdatedAt(now)
+		if req.Content != nil && *req.Content != current.Content {
+			update = update.SetContent(*req.Content).ClearContentVector()
+		}
+		if req.Category != nil {
+			update = update.SetCategory(*req.Category)
+		}
+		if req.Tags != nil {
+			update = update.SetTags(encodeTags(*req.Tags))
+		}
+		if req.IsPublic != nil {
+			update = update.SetIsPublic(*req.IsPublic)
+		}
+		if req.ExtraData != nil {
+			update = update.SetExtraData(req.ExtraData)
+		}
+		if err := update.Exec(c.Context()); err != nil {
+			return err
+		}
+		if req.ImageIDs != nil {
+			if err := syncMomentImages(c.Context(), tx, id, *req.ImageIDs, now); err != nil {
+				return err
+			}
+		}
+		if req.VideoIDs != nil {
+			if err := syncMomentVideos(c.Context(), tx, id, *req.VideoIDs, now); err != nil {
+				return err
+			}
+		}
+		return checkNotEmpty(c.Context(), tx, id)
+	})
+	if err != nil {
+		return r.writeError(c, "update", err)
+	}
+
+	r.enrich(client, id)
+	return r.respond(c, fiber.StatusOK, id)
+}
+
+// deleteHandler deletes a moment and its attachment rows; the images and
+// videos themselves are kept.
+func (r *MomentRoutes) deleteHandler(c fiber.Ctx) error {
+	if r.dbClient == nil {
+		return momentsUnavailable(c)
+	}
+	id := c.Params("id")
+
+	err := withTx(c.Context(), r.dbClient.Client(), func(tx *ent.Tx) error {
+		if _, err := tx.MomentImage.Delete().Where(momentimage.MomentId(id)).Exec(c.Context()); err != nil {
+			return err
+		}
+		if _, err := tx.MomentVideo.Delete().Where(momentvideo.MomentId(id)).Exec(c.Context()); err != nil {
+			return err
+		}
+		return tx.Moment.DeleteOneID(id).Exec(c.Context())
+	})
+	if err != nil {
+		return r.writeError(c, "delete", err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// respond writes moment id with its attachments.
+func (r *MomentRoutes) respond(c fiber.Ctx, status int, id string) error {
+	m, err := withAttachments(r.dbClient.Client().Moment.Query().Where(moment.ID(id))).
+		Select(momentFields...).
+		Only(c.Context())
+	if err != nil {
+		return r.writeError(c, "load", err)
+	}
+	return c.Status(status).JSON(newMomentResponse(m))
+}
+
+// writeError maps the errors of the moment routes to responses.
+func (r *MomentRoutes) writeError(c fiber.Ctx, action string, err error) error {
+	switch {
+	case ent.IsNotFound(err):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "NotFoundError",
+				"message": fmt.Sprintf("moment %s not found", c.Params("id")),
+				"code":    404,
+			},
+		})
+	case errors.Is(err, errUnknownAttachment), errors.Is(err, errUnknownOwner), errors.Is(err, errEmptyMoment):
+		return momentsValidationError(c, err)
+	}
+	momentsLogger.Error("error in moment "+action,
+		zap.String("id", c.Params("id")),
+		zap.Error(err),
+	)
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "DatabaseError",
+			"message": "Failed to " + action + " moment",
+			"code":    500,
+		},
+	})
+}
+
+// enrich queues the embedding of moment id; whatever the queue cannot take
+// is left to the scheduled tasks.
+func (r *MomentRoutes) enrich(client *ent.Client, id string) {
+	if r.enrichment != nil {
+		r.enrichment.Enqueue(client, enrich.KindMoment, id)
+	}
+}
+
+// withAttachments eager loads the images and videos of the moments of
+// query in order, with the files and buckets their URLs are built from.
+func withAttachments(query *ent.MomentQuery) *ent.MomentQuery {
+	withFile := func(q *ent.FileQuery) {
+		q.Select(file.FieldPath).WithBucket(func(q *ent.BucketQuery) {
+			q.Select(bucket.FieldPublicUrl)
+		})
+	}
+	return query.
+		WithMomentImages(func(q *ent.MomentImageQuery) {
+			q.Order(ent.Asc(momentimage.FieldSort), ent.Asc(momentimage.FieldID)).
+				WithImage(func(q *ent.ImageQuery) {
+					q.Select(image.FieldName, image.FieldWidth, image.FieldHeight, image.FieldDescription).
+						WithThumbnail320x(withFile).
+						WithThumbnail768x(withFile).
+						WithCompressed(withFile).
+						WithOriginal(withFile)
+				})
+		}).
+		WithMomentVideos(func(q *ent.MomentVideoQuery) {
+			q.Order(ent.Asc(momentvideo.FieldSort), ent.Asc(momentvideo.FieldID)).
+				WithVideo(func(q *ent.VideoQuery) {
+					q.Select(video.FieldName, video.FieldDuration).
+						WithFile(withFile).
+						WithPoster(withFile)
+				})
+		})
+}
+
+// attachedRow is a join row between a moment and an image or video.
+type attachedRow struct {
+	id     uint
+	target string
+	sort   int32
+}
+
+// planAttachments compares the join rows of a moment with the ordered
+// targets it should have. It returns the rows to delete, the rows whose sort
+// changes and the targets to attach; rows for a target listed again keep
+// their ID and creation time.
+func planAttachments(existing []attachedRow, wanted []string) (remove []uint, resort, add []attachedRow) {
+	position := make(map[string]int32, len(wanted))
+	for i, target := range wanted {
+		position[target] = int32(i)
+	}
+	kept := make(map[string]bool, len(existing))
+	for _, row := range existing {
+		sort, ok := position[row.target]
+		if !ok || kept[row.target] {
+			remove = append(remove, row.id)
+			continue
+		}
+		kept[row.target] = true
+		if row.sort != sort {
+			resort = append(resort, attachedRow{id: row.id, target: row.target, sort: sort})
+		}
+	}
+	for i, target := range wanted {
+		if !kept[target] {
+			add = append(add, attachedRow{target: target, sort: int32(i)})
+		}
+	}
+	return remove, resort, add
+}
+
+// syncMomentImages makes imageIDs, in order, the images of moment id.
+func syncMomentImages(ctx context.Context, tx *ent.Tx, id string, imageIDs []string, now time.Time) error {
+	found, err := tx.Image.Query().Where(image.IDIn(imageIDs...)).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkFound("imageIds", imageIDs, found); err != nil {
+		return err
+	}
+	rows, err := tx.MomentImage.Query().Where(momentimage.MomentId(id)).All(ctx)
+	if err != nil {
+		return err
+	}
+	existing := make([]attachedRow, 0, len(rows))
+	for _, row := range rows {
+		existing = append(existing, attachedRow{id: row.ID, target: row.ImageId, sort: row.Sort})
+	}
+
+	remove, resort, add := planAttachments(existing, imageIDs)
+	if len(remove) > 0 {
+		if _, err := tx.MomentImage.Delete().Where(momentimage.IDIn(remove...)).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	for _, row := range resort {
+		if err := tx.MomentImage.UpdateOneID(row.id).SetSort(row.sort).SetUpdatedAt(now).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	for _, row := range add {
+		err := tx.MomentImage.Create().
+			SetMomentId(id).
+			SetImageId(row.target).
+			SetSort(row.sort).
+			SetCreatedAt(now).
+			SetUpdatedAt(now).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncMomentVideos makes videoIDs, in order, the videos of moment id.
+func syncMomentVideos(ctx context.Context, tx *ent.Tx, id string, videoIDs []string, now time.Time) error {
+	found, err := tx.Video.Query().Where(video.IDIn(videoIDs...)).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkFound("videoIds", videoIDs, found); err != nil {
+		return err
+	}
+	rows, err := tx.MomentVideo.Query().Where(momentvideo.MomentId(id)).All(ctx)
+	if err != nil {
+		return err
+	}
+	existing := make([]attachedRow, 0, len(rows))
+	for _, row := range rows {
+		existing = append(existing, attachedRow{id: row.ID, target: row.VideoId, sort: row.Sort})
+	}
+
+	remove, resort, add := planAttachments(existing, videoIDs)
+	if len(remove) > 0 {
+		if _, err := tx.MomentVideo.Delete().Where(momentvideo.IDIn(remove...)).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	for _, row := range resort {
+		if err := tx.MomentVideo.UpdateOneID(row.id).SetSort(row.sort).SetUpdatedAt(now).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	for _, row := range add {
+		err := tx.MomentVideo.Create().
+			SetMomentId(id).
+			SetVideoId(row.target).
+			SetSort(row.sort).
+			SetCreatedAt(now).
+			SetUpdatedAt(now).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkFound fails with errUnknownAttachment when some of ids were not found.
+func checkFound(name string, ids, found []string) error {
+	var missing []string
+	for _, id := range ids {
+		if !slices.Contains(found, id) {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s %s: %w", name, strings.Join(missing, ", "), errUnknownAttachment)
+	}
+	return nil
+}
+
+// checkNotEmpty fails with errEmptyMoment when moment id has no content and
+// nothing attached.
+func checkNotEmpty(ctx context.Context, tx *ent.Tx, id string) error {
+	hasContent, err := tx.Moment.Query().Where(moment.ID(id), moment.ContentNEQ("")).Exist(ctx)
+	if err != nil || hasContent {
+		return err
+	}
+	hasImages, err := tx.MomentImage.Query().Where(momentimage.MomentId(id)).Exist(ctx)
+	if err != nil || hasImages {
+		return err
+	}
+	hasVideos, err := tx.MomentVideo.Query().Where(momentvideo.MomentId(id)).Exist(ctx)
+	if err != nil || hasVideos {
+		return err
+	}
+	return errEmptyMoment
+}
+
+// withTx runs fn in a transaction, committed when fn succeeds.
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// parseMomentRequest decodes and validates the body of a create or of an
+// update, which needs at least one field.
+func parseMomentRequest(body []byte, create bool) (momentRequest, error) {
+	var req momentRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return req, fmt.Errorf("invalid request body: %w", err)
+	}
+
+	if !create && req.Content == nil && req.Category == nil && req.Tags == nil && req.IsPublic == nil &&
+		req.OwnerID == nil && req.ExtraData == nil && req.ImageIDs == nil && req.VideoIDs == nil {
+		return req, fmt.Errorf("nothing to update")
+	}
+	if !create && req.OwnerID != nil {
+		return req, fmt.Errorf("ownerId cannot be changed")
+	}
+
+	if err := trimField("content", req.Content, maxContentRunes); err != nil {
+		return req, err
+	}
+	if err := trimField("category", req.Category, maxCategoryRunes); err != nil {
+		return req, err
+	}
+	if req.Category != nil && *req.Category == "" {
+		return req, fmt.Errorf("category must not be empty")
+	}
+	if req.OwnerID != nil {
+		if *req.OwnerID = strings.TrimSpace(*req.OwnerID); *req.OwnerID == "" {
+			req.OwnerID = nil
+		}
+	}
+	if req.Tags != nil {
+		tags, err := normalizeTags(*req.Tags)
+		if err != nil {
+			return req, err
+		}
+		req.Tags = &tags
+	}
+	if req.ExtraData != nil {
+		if err := checkObject("extraData", req.ExtraData); err != nil {
+			return req, err
+		}
+	}
+	for name, ids := range map[string]*[]string{"imageIds": req.ImageIDs, "videoIds": req.VideoIDs} {
+		if ids == nil {
+			continue
+		}
+		cleaned, err := normalizeAttachments(name, *ids)
+		if err != nil {
+			return req, err
+		}
+		*ids = cleaned
+	}
+	return req, nil
+}
+
+// normalizeAttachments trims the IDs of an ordered attachment list; an ID
+// listed twice is rejected since its position would be ambiguous.
+func normalizeAttachments(name string, ids []string) ([]string, error) {
+	if len(ids) > maxAttachments {
+		return nil, fmt.Errorf("too many %s: at most %d", name, maxAttachments)
+	}
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			return nil, fmt.Errorf("%s must not contain empty IDs", name)
+		}
+		if slices.Contains(out, id) {
+			return nil, fmt.Errorf("%s lists %s twice", name, id)
+		}
+		out = append(out, id)
+	}
+	return out, nil
+}
+
+func momentsValidationError(c fiber.Ctx, err error) error {
+	momentsLogger.Warn("invalid moment request",
+		zap.String("ip", middleware.GetRealIP(c)),
+		zap.Error(err),
+	)
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "ValidationError",
+			"message": err.Error(),
+			"code":    400,
+		},
+	})
+}
+
+func momentsUnavailable(c fiber.Ctx) error {
+	momentsLogger.Warn("no database is available for moments")
+	return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "ServiceError",
+			"message": "Database service is temporarily unavailable",
+			"code":    503,
+		},
+	})
+}
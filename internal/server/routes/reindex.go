@@ -2,6 +2,8 @@ package routes
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 
 	"api.us4ever/internal/database"
@@ -23,101 +25,304 @@ func init() {
 }
 
 type ReindexRoutes struct {
-	app                *fiber.App
-	esClient           *elasticsearch.Client
-	dbClient           database.Service
-	keepEsIndexAlias   string
-	momentEsIndexAlias string
+	app      *fiber.App
+	esClient *elasticsearch.Client
+	dbClient database.Service
+	jobs     *es.ReindexJobs
+	reports  *es.ConsistencyReports
+	aliases  es.IndexAliases
 }
 
-func NewReindexRoutes(app *fiber.App, esClient *elasticsearch.Client, dbClient database.Service, keepEsIndexAlias string, momentEsIndexAlias string) *ReindexRoutes {
+func NewReindexRoutes(app *fiber.App, esClient *elasticsearch.Client, dbClient database.Service, jobs *es.ReindexJobs, reports *es.ConsistencyReports, aliases es.IndexAliases) *ReindexRoutes {
 	return &ReindexRoutes{
-		app:                app,
-		esClient:           esClient,
-		dbClient:           dbClient,
-		keepEsIndexAlias:   keepEsIndexAlias,
-		momentEsIndexAlias: momentEsIndexAlias,
+		app:      app,
+		esClient: esClient,
+		dbClient: dbClient,
+		jobs:     jobs,
+		reports:  reports,
+		aliases:  aliases,
 	}
 }
 
 func (r *ReindexRoutes) Register() {
 	internal := r.app.Group("/internal")
 
-	// 重索引端点
-	internal.Get("/reindex/keeps", r.reindexKeepsHandler)
-	internal.Get("/reindex/moments", r.reindexMomentsHandler)
+	// 每个注册的索引一个重索引端点，返回任务 ID
+	for _, def := range es.IndexDefinitions() {
+		internal.Post("/reindex/"+def.Name, r.reindexHandler(def))
+	}
+	// Deprecated: 保留 GET 以兼容旧的调用方
+	internal.Get("/reindex/keeps", r.reindexHandler(es.KeepIndex))
+	internal.Get("/reindex/moments", r.reindexHandler(es.MomentIndex))
+
+	// 任务状态与取消
+	internal.Get("/reindex/jobs/:id", r.getJobHandler)
+	internal.Delete("/reindex/jobs/:id", r.cancelJobHandler)
+
+	// 别名回滚到上一代索引
+	internal.Post("/index/:alias/rollback", r.rollbackHandler)
+
+	// 数据库与索引的一致性检查
+	internal.Get("/index/consistency", r.consistencyHandler)
+	internal.Post("/index/:alias/verify", r.verifyHandler)
+}
+
+// reindexHandler builds the handler starting a background re-indexing job for
+// def. force=true switches the alias even if the new index fails the document
+// count check.
+func (r *ReindexRoutes) reindexHandler(def *es.IndexDefinition) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		alias := r.aliases.Of(def)
+		opts := es.ReindexOptions{Force: fiber.Query[bool](c, "force")}
+		return r.startJob(c, def.Name, alias, func(ctx context.Context, job *es.ReindexJob) error {
+			return es.Reindex(ctx, r.esClient, r.dbClient, def, alias, job, opts)
+		})
+	}
 }
 
-// reindexKeepsHandler triggers the re-indexing process for keeps.
-func (r *ReindexRoutes) reindexKeepsHandler(c fiber.Ctx) error {
-	reindexLogger.Info("received request to re-index keeps")
+// startJob registers and starts a reindex job, rejecting it while another job
+// for the same alias is running.
+func (r *ReindexRoutes) startJob(c fiber.Ctx, indexType, alias string, run func(ctx context.Context, job *es.ReindexJob) error) error {
+	reindexLogger.Info("received request to re-index",
+		zap.String("index_type", indexType),
+	)
 	if r.esClient == nil {
 		reindexLogger.Warn("Elasticsearch client is not available for re-indexing")
 		return c.Status(http.StatusServiceUnavailable).JSON(fiber.Map{
-			"error": "Elasticsearch service is not available to perform re-indexing",
+			"error": fiber.Map{
+				"type":    "ServiceError",
+				"message": "Elasticsearch service is not available to perform re-indexing",
+				"code":    503,
+			},
 		})
 	}
 
-	// Run indexing in a goroutine to avoid blocking the request
-	go func() {
-		// Use a background context detached from the HTTP request
-		ctx := context.Background()
-		reindexLogger.Info("starting background re-indexing process",
-			zap.String("index_type", "keeps"),
-			zap.String("index_alias", r.keepEsIndexAlias),
+	job, err := r.jobs.Start(alias, run)
+	if errors.Is(err, es.ErrReindexRunning) {
+		reindexLogger.Warn("rejected re-indexing request, a job is already running",
+			zap.String("index_type", indexType),
+			zap.String("job_id", job.ID()),
 		)
-		if err := es.IndexKeeps(ctx, r.esClient, r.dbClient, r.keepEsIndexAlias); err != nil {
-			reindexLogger.Error("background re-indexing failed",
-				zap.Error(err),
-				zap.String("index_type", "keeps"),
-			)
-			// Consider adding monitoring/alerting here
-		} else {
-			reindexLogger.Info("background re-indexing completed successfully",
-				zap.String("index_type", "keeps"),
-			)
-		}
-	}()
+		return c.Status(http.StatusConflict).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "ConflictError",
+				"message": fmt.Sprintf("Re-indexing of %s is already running as job %s", indexType, job.ID()),
+				"code":    409,
+			},
+			"job": job.Status(),
+		})
+	}
 
-	// Immediately return success, indicating the process has started
+	reindexLogger.Info("started background re-indexing job",
+		zap.String("index_type", indexType),
+		zap.String("index_alias", alias),
+		zap.String("job_id", job.ID()),
+	)
+	c.Location("/internal/reindex/jobs/" + job.ID())
 	return c.Status(http.StatusAccepted).JSON(fiber.Map{
-		"message": "Re-indexing process started in the background.",
+		"jobId": job.ID(),
+		"job":   job.Status(),
 	})
 }
 
-// reindexMomentsHandler handles requests to re-index moments in Elasticsearch
-func (r *ReindexRoutes) reindexMomentsHandler(c fiber.Ctx) error {
-	reindexLogger.Info("received request to re-index moments")
+// getJobHandler reports the phase, progress and errors of a reindex job.
+func (r *ReindexRoutes) getJobHandler(c fiber.Ctx) error {
+	job, ok := r.jobs.Get(c.Params("id"))
+	if !ok {
+		return jobNotFound(c)
+	}
+	return c.JSON(job.Status())
+}
+
+// cancelJobHandler cancels a running reindex job. The new index is deleted and
+// the alias keeps pointing at the old one.
+func (r *ReindexRoutes) cancelJobHandler(c fiber.Ctx) error {
+	job, ok := r.jobs.Get(c.Params("id"))
+	if !ok {
+		return jobNotFound(c)
+	}
+	if status := job.Status(); status.FinishedAt != nil {
+		return c.Status(http.StatusConflict).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "ConflictError",
+				"message": fmt.Sprintf("Re-indexing job already %s", status.Phase),
+				"code":    409,
+			},
+			"job": status,
+		})
+	}
+
+	r.jobs.Cancel(job.ID())
+	reindexLogger.Info("cancelling re-indexing job",
+		zap.String("job_id", job.ID()),
+	)
+	return c.Status(http.StatusAccepted).JSON(job.Status())
+}
+
+// rollbackHandler points an alias back at its previous index generation. The
+// alias may be given in full or by index name, e.g. "keeps". That generation
+// lacks the changes synced since it was built, so it is repaired from the
+// database right away; the response holds the repair report, or a warning
+// when the repair failed.
+func (r *ReindexRoutes) rollbackHandler(c fiber.Ctx) error {
+	def, alias, ok := r.lookupAlias(c.Params("alias"))
+	if !ok {
+		return aliasNotFound(c)
+	}
+	if r.esClient == nil {
+		return c.Status(http.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "ServiceError",
+				"message": "Elasticsearch service is not available to perform a rollback",
+				"code":    503,
+			},
+		})
+	}
+	// 重索引结束时会切换别名，回滚会被覆盖
+	if r.jobs.Running(alias) {
+		return c.Status(http.StatusConflict).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "ConflictError",
+				"message": fmt.Sprintf("Re-indexing of %s is running, cancel it before rolling back", alias),
+				"code":    409,
+			},
+		})
+	}
+
+	result, err := es.Rollback(c.Context(), r.esClient, alias)
+	if errors.Is(err, es.ErrNoPreviousGeneration) {
+		return c.Status(http.StatusConflict).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "ConflictError",
+				"message": err.Error(),
+				"code":    409,
+			},
+		})
+	}
+	if err != nil {
+		reindexLogger.Error("failed to roll back index alias",
+			zap.String("alias", alias),
+			zap.Error(err),
+		)
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "IndexError",
+				"message": "Failed to roll back the index alias",
+				"code":    500,
+			},
+		})
+	}
+
+	reindexLogger.Info("rolled back index alias",
+		zap.String("alias", alias),
+		zap.String("from", result.From),
+		zap.String("to", result.To),
+	)
+
+	// 旧一代索引缺少它建成之后同步的变更，回滚后立即按数据库修复
+	response := fiber.Map{
+		"alias": result.Alias,
+		"from":  result.From,
+		"to":    result.To,
+	}
+	opts := es.ConsistencyOptions{Repair: true, IncludeRecent: true}
+	report, err := es.CheckConsistency(c.Context(), r.esClient, r.dbClient.Client(), def, alias, opts)
+	if err != nil {
+		reindexLogger.Error("failed to repair index after rollback",
+			zap.String("alias", alias),
+			zap.Error(err),
+		)
+		response["warning"] = fmt.Sprintf("%s lacks the changes made since it was built and could not be repaired: "+
+			"run POST /internal/index/%s/verify?repair=true or reindex", result.To, alias)
+		return c.JSON(response)
+	}
+	r.reports.Record(report)
+	response["repair"] = report
+	return c.JSON(response)
+}
+
+// verifyHandler compares an index with the database and reports missing,
+// orphaned and stale documents. repair=true re-upserts or deletes just the
+// differing documents. The alias may be given in full or by index name.
+func (r *ReindexRoutes) verifyHandler(c fiber.Ctx) error {
+	def, alias, ok := r.lookupAlias(c.Params("alias"))
+	if !ok {
+		return aliasNotFound(c)
+	}
 	if r.esClient == nil {
-		reindexLogger.Warn("Elasticsearch client is not available for re-indexing")
 		return c.Status(http.StatusServiceUnavailable).JSON(fiber.Map{
-			"error": "Elasticsearch service is not available to perform re-indexing",
+			"error": fiber.Map{
+				"type":    "ServiceError",
+				"message": "Elasticsearch service is not available to verify the index",
+				"code":    503,
+			},
+		})
+	}
+	// 重索引期间新索引尚未写完，比较结果没有意义
+	if r.jobs.Running(alias) {
+		return c.Status(http.StatusConflict).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "ConflictError",
+				"message": fmt.Sprintf("Re-indexing of %s is running, verify it once the job is done", alias),
+				"code":    409,
+			},
 		})
 	}
 
-	// Run indexing in a goroutine to avoid blocking the request
-	go func() {
-		// Use a background context detached from the HTTP request
-		ctx := context.Background()
-		reindexLogger.Info("starting background re-indexing process",
-			zap.String("index_type", "moments"),
-			zap.String("index_alias", r.momentEsIndexAlias),
+	opts := es.ConsistencyOptions{Repair: fiber.Query[bool](c, "repair")}
+	report, err := es.CheckConsistency(c.Context(), r.esClient, r.dbClient.Client(), def, alias, opts)
+	if err != nil {
+		reindexLogger.Error("failed to verify index consistency",
+			zap.String("alias", alias),
+			zap.Error(err),
 		)
-		if err := es.IndexMoments(ctx, r.esClient, r.dbClient, r.momentEsIndexAlias); err != nil {
-			reindexLogger.Error("background re-indexing failed",
-				zap.Error(err),
-				zap.String("index_type", "moments"),
-			)
-			// Consider adding monitoring/alerting here
-		} else {
-			reindexLogger.Info("background re-indexing completed successfully",
-				zap.String("index_type", "moments"),
-			)
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "IndexError",
+				"message": "Failed to verify the index",
+				"code":    500,
+			},
+		})
+	}
+	r.reports.Record(report)
+	return c.JSON(report)
+}
+
+// consistencyHandler returns the latest consistency report of every index,
+// whether it came from the scheduled check or from verifyHandler.
+func (r *ReindexRoutes) consistencyHandler(c fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"reports": r.reports.Latest(),
+	})
+}
+
+// lookupAlias resolves a full alias or an index name, e.g. "keeps", to the
+// definition and alias of the index.
+func (r *ReindexRoutes) lookupAlias(param string) (*es.IndexDefinition, string, bool) {
+	for _, def := range es.IndexDefinitions() {
+		if alias := r.aliases.Of(def); param == alias || param == def.Name {
+			return def, alias, true
 		}
-	}()
+	}
+	return nil, "", false
+}
 
-	// Immediately return success, indicating the process has started
-	return c.Status(http.StatusAccepted).JSON(fiber.Map{
-		"message": "Re-indexing process for moments started in the background.",
+func aliasNotFound(c fiber.Ctx) error {
+	return c.Status(http.StatusNotFound).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "NotFoundError",
+			"message": fmt.Sprintf("Unknown index alias %q", c.Params("alias")),
+			"code":    404,
+		},
+	})
+}
+
+func jobNotFound(c fiber.Ctx) error {
+	return c.Status(http.StatusNotFound).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "NotFoundError",
+			"message": "Re-indexing job not found",
+			"code":    404,
+		},
 	})
 }
@@ -1,11 +1,18 @@
 package routes
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"api.us4ever/internal/database"
 	"api.us4ever/internal/es"
 	"api.us4ever/internal/logger"
 	"api.us4ever/internal/middleware"
-	"github.com/elastic/go-elasticsearch/v8"
+	"api.us4ever/internal/search"
+	"api.us4ever/internal/validator"
 	"github.com/gofiber/fiber/v3"
 	"go.uber.org/zap"
 )
@@ -21,20 +28,18 @@ func init() {
 }
 
 type SearchRoutes struct {
-	app                *fiber.App
-	esClient           *elasticsearch.Client
-	dbClient           database.Service
-	keepEsIndexAlias   string
-	momentEsIndexAlias string
+	app       *fiber.App
+	backends  *search.Holder
+	dbClient  database.Service
+	analytics *search.Analytics
 }
 
-func NewSearchRoutes(app *fiber.App, esClient *elasticsearch.Client, dbClient database.Service, keepEsIndexAlias string, momentEsIndexAlias string) *SearchRoutes {
+func NewSearchRoutes(app *fiber.App, backends *search.Holder, dbClient database.Service) *SearchRoutes {
 	return &SearchRoutes{
-		app:                app,
-		esClient:           esClient,
-		dbClient:           dbClient,
-		keepEsIndexAlias:   keepEsIndexAlias,
-		momentEsIndexAlias: momentEsIndexAlias,
+		app:       app,
+		backends:  backends,
+		dbClient:  dbClient,
+		analytics: search.NewAnalytics(dbClient),
 	}
 }
 
@@ -42,37 +47,130 @@ func (r *SearchRoutes) Register() {
 	internal := r.app.Group("/internal")
 	searchGroup := internal.Group("/search")
 
-	// 新的搜索路由
-	searchGroup.Get("/keeps", r.searchKeepsHandler)
-	searchGroup.Get("/moments", r.searchMomentsHandler)
+	// 跨实体统一搜索
+	internal.Get("/search", r.searchAllHandler)
+
+	// 输入提示，不调用向量模型
+	searchGroup.Get("/suggest", r.suggestHandler)
+
+	// 基于已存向量的相关内容，不调用向量模型
+	for _, def := range []*es.IndexDefinition{es.KeepIndex, es.MomentIndex} {
+		internal.Get("/"+def.Name+"/:id/related", r.relatedHandler(def))
+	}
+
+	// 每个注册的索引一个搜索端点
+	for _, def := range es.IndexDefinitions() {
+		searchGroup.Get("/"+def.Name, r.searchHandler(def))
+	}
+
+	// 搜索点击和搜索分析报表
+	searchGroup.Post("/click", r.clickHandler)
+	searchGroup.Get("/analytics/top-queries", r.topQueriesHandler)
+	searchGroup.Get("/analytics/zero-results", r.zeroResultsHandler)
 }
 
-// searchKeepsHandler handles requests to search keeps in Elasticsearch
-func (r *SearchRoutes) searchKeepsHandler(c fiber.Ctx) error {
+// searchHandler builds the handler searching the documents of def.
+func (r *SearchRoutes) searchHandler(def *es.IndexDefinition) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		// Parse and validate the query, pagination and filters
+		req, opts, err := parseSearchRequest(c)
+		if err != nil {
+			esLogger.Warn("invalid search request",
+				zap.String("ip", middleware.GetRealIP(c)),
+				zap.Error(err),
+			)
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": fiber.Map{
+					"type":    "ValidationError",
+					"message": err.Error(),
+					"code":    400,
+				},
+			})
+		}
+		query := req.Query
+
+		// Check if a search backend is available
+		backend, release := r.backends.Acquire()
+		defer release()
+		if backend == nil {
+			esLogger.Warn("no search backend is available for search",
+				zap.String("index", def.Name),
+			)
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+				"error": fiber.Map{
+					"type":    "ServiceError",
+					"message": "Search service is temporarily unavailable",
+					"code":    503,
+				},
+			})
+		}
+
+		start := time.Now()
+		result, err := backend.Search(c.Context(), def, query, opts)
+		if err != nil {
+			r.recordSearch(c, backend, def.Type, query, start, fiber.StatusInternalServerError, 0, false)
+			esLogger.Error("error searching",
+				zap.String("backend", backend.Name()),
+				zap.String("index", def.Name),
+				zap.Error(err),
+				zap.String("query", query),
+			)
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": fiber.Map{
+					"type":    "SearchError",
+					"message": "Failed to search " + def.Name,
+					"code":    500,
+				},
+			})
+		}
 
-	// Get the search query from the query parameter 'q'
-	query := c.Query("q")
+		// Log successful search
+		esLogger.Info("search "+def.Name+" completed",
+			zap.String("backend", backend.Name()),
+			zap.String("query", query),
+			zap.Int("from", opts.From),
+			zap.Int("size", opts.Size),
+			zap.Int("results", len(result.Hits.Hits)),
+			zap.Int("total", result.Hits.Total.Value),
+		)
+		r.recordSearch(c, backend, def.Type, query, start, fiber.StatusOK, result.Hits.Total.Value, result.Degraded)
 
-	// Basic input validation
-	if query == "" {
-		esLogger.Warn("search request with empty query",
+		return c.JSON(result)
+	}
+}
+
+// searchAllHandler searches every indexed entity type in one request and merges the rankings
+func (r *SearchRoutes) searchAllHandler(c fiber.Ctx) error {
+
+	// Parse and validate the query, pagination, filters and entity types
+	req, opts, err := parseSearchRequest(c)
+	var targets []*es.IndexDefinition
+	if err == nil {
+		targets, err = searchTargets(c.Query("types"))
+	}
+	if err != nil {
+		esLogger.Warn("invalid search request",
 			zap.String("ip", middleware.GetRealIP(c)),
+			zap.Error(err),
 		)
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error": fiber.Map{
 				"type":    "ValidationError",
-				"message": "Missing search query parameter 'q'",
+				"message": err.Error(),
 				"code":    400,
 			},
 		})
 	}
+	query := req.Query
 
-	// Check if the ES client is available
-	if r.esClient == nil {
-		esLogger.Warn("Elasticsearch client is not available for search",
-			zap.String("handler", "searchKeeps"),
+	// Check if a search backend is available
+	backend, release := r.backends.Acquire()
+	defer release()
+	if backend == nil {
+		esLogger.Warn("no search backend is available for search",
+			zap.String("handler", "searchAll"),
 		)
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
 			"error": fiber.Map{
 				"type":    "ServiceError",
 				"message": "Search service is temporarily unavailable",
@@ -81,58 +179,65 @@ func (r *SearchRoutes) searchKeepsHandler(c fiber.Ctx) error {
 		})
 	}
 
-	// Perform the search using the es package, passing the client and alias
-	keeps, err := es.SearchKeeps(c.Context(), r.esClient, r.keepEsIndexAlias, query)
+	// Query all targets and fuse the rankings
+	start := time.Now()
+	result, err := backend.SearchAll(c.Context(), targets, query, opts)
 	if err != nil {
-		esLogger.Error("error searching keeps in Elasticsearch",
+		r.recordSearch(c, backend, search.SearchTypeAll, query, start, fiber.StatusInternalServerError, 0, false)
+		esLogger.Error("error running unified search",
+			zap.String("backend", backend.Name()),
 			zap.Error(err),
 			zap.String("query", query),
 		)
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": fiber.Map{
 				"type":    "SearchError",
-				"message": "Failed to search keeps",
+				"message": "Failed to search",
 				"code":    500,
 			},
 		})
 	}
 
 	// Log successful search
-	esLogger.Info("search keeps completed",
+	esLogger.Info("unified search completed",
+		zap.String("backend", backend.Name()),
 		zap.String("query", query),
-		zap.Int("results", len(keeps.Hits.Hits)),
-		zap.Int("total", keeps.Hits.Total.Value),
+		zap.Int("targets", len(targets)),
+		zap.Int("from", opts.From),
+		zap.Int("size", opts.Size),
+		zap.Int("results", len(result.Hits)),
+		zap.Int("total", result.Total),
 	)
+	r.recordSearch(c, backend, search.SearchTypeAll, query, start, fiber.StatusOK, result.Total, result.Degraded)
 
-	return c.JSON(keeps)
+	return c.JSON(result)
 }
 
-// searchMomentsHandler handles requests to search moments in Elasticsearch
-func (r *SearchRoutes) searchMomentsHandler(c fiber.Ctx) error {
-
-	// Get the search query from the query parameter 'q'
-	query := c.Query("q")
-
-	// Basic input validation
-	if query == "" {
-		esLogger.Warn("search request with empty query",
+// suggestHandler completes the prefix being typed with keep titles, summary
+// phrases and tags. It accepts q, size (up to es.MaxSuggestSize) and the
+// search filters.
+func (r *SearchRoutes) suggestHandler(c fiber.Ctx) error {
+	prefix, opts, err := parseSuggestRequest(c)
+	if err != nil {
+		esLogger.Warn("invalid suggest request",
 			zap.String("ip", middleware.GetRealIP(c)),
+			zap.Error(err),
 		)
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error": fiber.Map{
 				"type":    "ValidationError",
-				"message": "Missing search query parameter 'q'",
+				"message": err.Error(),
 				"code":    400,
 			},
 		})
 	}
 
-	// Check if the ES client is available
-	if r.esClient == nil {
-		esLogger.Warn("Elasticsearch client is not available for search",
-			zap.String("handler", "searchMoments"),
-		)
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+	// Check if a search backend is available
+	backend, release := r.backends.Acquire()
+	defer release()
+	if backend == nil {
+		esLogger.Warn("no search backend is available for suggestions")
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
 			"error": fiber.Map{
 				"type":    "ServiceError",
 				"message": "Search service is temporarily unavailable",
@@ -141,28 +246,285 @@ func (r *SearchRoutes) searchMomentsHandler(c fiber.Ctx) error {
 		})
 	}
 
-	// Perform the search using the es package, passing the client and alias
-	moments, err := es.SearchMoments(c.Context(), r.esClient, r.momentEsIndexAlias, query)
+	start := time.Now()
+	result, err := backend.Suggest(c.Context(), prefix, opts)
 	if err != nil {
-		esLogger.Error("error searching moments in Elasticsearch",
+		r.recordSearch(c, backend, search.SearchTypeSuggest, prefix, start, fiber.StatusInternalServerError, 0, false)
+		esLogger.Error("error getting suggestions",
+			zap.String("backend", backend.Name()),
 			zap.Error(err),
-			zap.String("query", query),
+			zap.String("query", prefix),
 		)
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": fiber.Map{
 				"type":    "SearchError",
-				"message": "Failed to search moments",
+				"message": "Failed to get suggestions",
 				"code":    500,
 			},
 		})
 	}
+	count := len(result.Titles) + len(result.Phrases) + len(result.Tags)
+	r.recordSearch(c, backend, search.SearchTypeSuggest, prefix, start, fiber.StatusOK, count, false)
+	return c.JSON(result)
+}
 
-	// Log successful search
-	esLogger.Info("search moments completed",
-		zap.String("query", query),
-		zap.Int("results", len(moments.Hits.Hits)),
-		zap.Int("total", moments.Hits.Total.Value),
+// parseSuggestRequest reads the prefix, size and filters of a suggest request.
+func parseSuggestRequest(c fiber.Ctx) (string, es.SuggestOptions, error) {
+	var opts es.SuggestOptions
+	prefix := validator.SanitizeQuery(c.Query("q"))
+	if prefix == "" {
+		return "", opts, fmt.Errorf("missing search query parameter 'q'")
+	}
+
+	size, err := queryInt(c, "size", es.DefaultSuggestSize)
+	if err != nil {
+		return "", opts, err
+	}
+	if size < 1 || size > es.MaxSuggestSize {
+		return "", opts, fmt.Errorf("invalid size: must be between 1 and %d", es.MaxSuggestSize)
+	}
+	opts.Size = size
+
+	if opts.Filters, err = parseSearchFilters(c); err != nil {
+		return "", opts, err
+	}
+	return prefix, opts, nil
+}
+
+// relatedHandler builds the handler returning the documents of def closest to
+// the one in the path. It accepts size (up to es.MaxRelatedSize), mlt=true to
+// mix in a more_like_this clause, and the search filters.
+func (r *SearchRoutes) relatedHandler(def *es.IndexDefinition) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		id := c.Params("id")
+		opts, err := parseRelatedRequest(c)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": fiber.Map{
+					"type":    "ValidationError",
+					"message": err.Error(),
+					"code":    400,
+				},
+			})
+		}
+
+		// Check if a search backend is available
+		backend, release := r.backends.Acquire()
+		defer release()
+		if backend == nil {
+			esLogger.Warn("no search backend is available for related search",
+				zap.String("index", def.Name),
+			)
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+				"error": fiber.Map{
+					"type":    "ServiceError",
+					"message": "Search service is temporarily unavailable",
+					"code":    503,
+				},
+			})
+		}
+
+		result, err := backend.Related(c.Context(), def, id, opts)
+		if errors.Is(err, es.ErrDocumentNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": fiber.Map{
+					"type":    "NotFoundError",
+					"message": fmt.Sprintf("No indexed %s with ID %q", def.Type, id),
+					"code":    404,
+				},
+			})
+		}
+		if err != nil {
+			esLogger.Error("error finding related documents",
+				zap.String("backend", backend.Name()),
+				zap.String("index", def.Name),
+				zap.String("id", id),
+				zap.Error(err),
+			)
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": fiber.Map{
+					"type":    "SearchError",
+					"message": "Failed to find related " + def.Name,
+					"code":    500,
+				},
+			})
+		}
+		return c.JSON(result)
+	}
+}
+
+// parseRelatedRequest reads the size, mlt flag and filters of a related request.
+func parseRelatedRequest(c fiber.Ctx) (es.RelatedOptions, error) {
+	var opts es.RelatedOptions
+	size, err := queryInt(c, "size", es.DefaultRelatedSize)
+	if err != nil {
+		return opts, err
+	}
+	if size < 1 || size > es.MaxRelatedSize {
+		return opts, fmt.Errorf("invalid size: must be between 1 and %d", es.MaxRelatedSize)
+	}
+	opts.Size = size
+	if v := c.Query("mlt"); v != "" {
+		if opts.MoreLikeThis, err = strconv.ParseBool(v); err != nil {
+			return opts, fmt.Errorf("invalid mlt: %q", v)
+		}
+	}
+	if opts.Filters, err = parseSearchFilters(c); err != nil {
+		return opts, err
+	}
+	return opts, nil
+}
+
+// searchTargets resolves the comma separated types parameter into the index
+// definitions to search. An empty value selects every indexed entity type.
+func searchTargets(types string) ([]*es.IndexDefinition, error) {
+	all := es.IndexDefinitions()
+	if strings.TrimSpace(types) == "" {
+		return all, nil
+	}
+
+	var targets []*es.IndexDefinition
+	seen := make(map[string]bool)
+	for _, t := range strings.Split(types, ",") {
+		t = strings.TrimSpace(t)
+		if t == "" || seen[t] {
+			continue
+		}
+		if !es.IsSearchableType(t) {
+			return nil, fmt.Errorf("invalid types: unsupported type %q", t)
+		}
+		seen[t] = true
+		for _, target := range all {
+			if target.Type == t {
+				targets = append(targets, target)
+			}
+		}
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("invalid types: no type selected")
+	}
+	return targets, nil
+}
+
+// parseSearchRequest reads the query string of a search request.
+//
+// Pagination is accepted either as page/size (1-based page) or as limit/offset,
+// and is enforced through validator.SearchRequest. Filters are read by
+// parseSearchFilters.
+func parseSearchRequest(c fiber.Ctx) (validator.SearchRequest, es.SearchOptions, error) {
+	req := validator.SearchRequest{Query: validator.SanitizeQuery(c.Query("q"))}
+	var opts es.SearchOptions
+
+	if req.Query == "" {
+		return req, opts, fmt.Errorf("missing search query parameter 'q'")
+	}
+
+	size, err := queryInt(c, "size", 0)
+	if err != nil {
+		return req, opts, err
+	}
+	if size == 0 {
+		if size, err = queryInt(c, "limit", 0); err != nil {
+			return req, opts, err
+		}
+	}
+	if size == 0 {
+		size = es.DefaultSearchSize
+	}
+	req.Limit = size
+
+	if c.Query("page") != "" {
+		page, err := queryInt(c, "page", 1)
+		if err != nil {
+			return req, opts, err
+		}
+		if page < 1 {
+			return req, opts, fmt.Errorf("invalid page: must be at least 1")
+		}
+		req.Offset = (page - 1) * size
+	} else if req.Offset, err = queryInt(c, "offset", 0); err != nil {
+		return req, opts, err
+	}
+
+	if err := validator.ValidateSearchRequest(&req); err != nil {
+		return req, opts, err
+	}
+	opts.From = req.Offset
+	opts.Size = req.Limit
+
+	if opts.Filters, err = parseSearchFilters(c); err != nil {
+		return req, opts, err
+	}
+
+	return req, opts, nil
+}
+
+// parseSearchFilters reads the filters shared by the search endpoints: tags
+// (comma separated), category, isPublic, ownerId, createdFrom and createdTo
+// (RFC3339 or YYYY-MM-DD).
+func parseSearchFilters(c fiber.Ctx) (es.SearchFilters, error) {
+	var (
+		f   es.SearchFilters
+		err error
 	)
+	if tags := c.Query("tags"); tags != "" {
+		for _, tag := range strings.Split(tags, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				f.Tags = append(f.Tags, tag)
+			}
+		}
+	}
+	f.Category = strings.TrimSpace(c.Query("category"))
+	f.OwnerID = strings.TrimSpace(c.Query("ownerId"))
+	if v := c.Query("isPublic"); v != "" {
+		isPublic, err := strconv.ParseBool(v)
+		if err != nil {
+			return f, fmt.Errorf("invalid isPublic: %q", v)
+		}
+		f.IsPublic = &isPublic
+	}
+	if f.CreatedFrom, err = queryTime(c, "createdFrom", false); err != nil {
+		return f, err
+	}
+	if f.CreatedTo, err = queryTime(c, "createdTo", true); err != nil {
+		return f, err
+	}
+	if from, to := f.CreatedFrom, f.CreatedTo; from != nil && to != nil && from.After(*to) {
+		return f, fmt.Errorf("invalid date range: createdFrom is after createdTo")
+	}
+
+	return f, nil
+}
+
+// queryInt parses an integer query parameter, returning def when it is absent.
+func queryInt(c fiber.Ctx, key string, def int) (int, error) {
+	v := c.Query(key)
+	if v == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %q is not a number", key, v)
+	}
+	return n, nil
+}
 
-	return c.JSON(moments)
+// queryTime parses a RFC3339 or YYYY-MM-DD query parameter. A bare date used
+// as an upper bound covers the whole day.
+func queryTime(c fiber.Ctx, key string, endOfDay bool) (*time.Time, error) {
+	v := c.Query(key)
+	if v == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse(time.DateOnly, v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: expected RFC3339 or YYYY-MM-DD, got %q", key, v)
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+	return &t, nil
 }
@@ -0,0 +1,194 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"api.us4ever/internal/es"
+	"api.us4ever/internal/middleware"
+	"api.us4ever/internal/search"
+	"github.com/gofiber/fiber/v3"
+	"go.uber.org/zap"
+)
+
+// maxReportDays bounds the period covered by a search analytics report
+const maxReportDays = 365
+
+// recordSearch records a search answered by backend with status in the
+// search log and metrics. The request ID lets clicks on its hits be tied back to it.
+func (r *SearchRoutes) recordSearch(c fiber.Ctx, backend search.SearchBackend, searchType, query string, start time.Time, status, count int, degraded bool) {
+	r.analytics.RecordSearch(search.SearchEvent{
+		Query:       query,
+		Type:        searchType,
+		Backend:     backend.Name(),
+		RequestID:   middleware.GetRequestID(c),
+		Status:      status,
+		ResultCount: count,
+		Latency:     time.Since(start),
+		Degraded:    degraded,
+	})
+}
+
+// clickRequest is the body of a click on a search hit. RequestID is the
+// X-Request-ID of the search response the hit was listed in.
+type clickRequest struct {
+	RequestID string `json:"requestId"`
+	Type      string `json:"type"`
+	ID        string `json:"id"`
+	Position  *int   `json:"position"`
+}
+
+// clickHandler records that a search hit was opened.
+func (r *SearchRoutes) clickHandler(c fiber.Ctx) error {
+	click, err := parseClickRequest(c.Body())
+	if err != nil {
+		esLogger.Warn("invalid search click",
+			zap.String("ip", middleware.GetRealIP(c)),
+			zap.Error(err),
+		)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "ValidationError",
+				"message": err.Error(),
+				"code":    400,
+			},
+		})
+	}
+
+	if r.dbClient == nil {
+		return analyticsUnavailable(c)
+	}
+
+	if err := r.analytics.RecordClick(c.Context(), click); err != nil {
+		esLogger.Error("error recording search click",
+			zap.String("requestId", click.RequestID),
+			zap.Error(err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "DatabaseError",
+				"message": "Failed to record click",
+				"code":    500,
+			},
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// parseClickRequest decodes and validates the body of a click.
+func parseClickRequest(body []byte) (search.Click, error) {
+	var req clickRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return search.Click{}, fmt.Errorf("invalid request body: %w", err)
+	}
+	req.RequestID = strings.TrimSpace(req.RequestID)
+	req.ID = strings.TrimSpace(req.ID)
+	if req.RequestID == "" {
+		return search.Click{}, fmt.Errorf("missing requestId")
+	}
+	if req.ID == "" {
+		return search.Click{}, fmt.Errorf("missing id")
+	}
+	if !es.IsSearchableType(req.Type) {
+		return search.Click{}, fmt.Errorf("unknown type %q", req.Type)
+	}
+	if req.Position != nil && *req.Position < 0 {
+		return search.Click{}, fmt.Errorf("invalid position: must not be negative")
+	}
+	return search.Click{
+		RequestID:  req.RequestID,
+		EntityType: req.Type,
+		EntityID:   req.ID,
+		Position:   req.Position,
+	}, nil
+}
+
+// topQueriesHandler lists the most frequent queries. It accepts days (up to
+// maxReportDays), type and limit (up to search.MaxReportLimit).
+func (r *SearchRoutes) topQueriesHandler(c fiber.Ctx) error {
+	return r.reportHandler(c, "top queries", r.analytics.TopQueries)
+}
+
+// zeroResultsHandler lists the queries that most often found nothing, with
+// the same parameters as topQueriesHandler.
+func (r *SearchRoutes) zeroResultsHandler(c fiber.Ctx) error {
+	return r.reportHandler(c, "zero-result queries", r.analytics.ZeroResultQueries)
+}
+
+func (r *SearchRoutes) reportHandler(c fiber.Ctx, name string, run func(ctx context.Context, opts search.ReportOptions) (search.Report, error)) error {
+	opts, err := parseReportRequest(c)
+	if err != nil {
+		esLogger.Warn("invalid search analytics request",
+			zap.String("ip", middleware.GetRealIP(c)),
+			zap.Error(err),
+		)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "ValidationError",
+				"message": err.Error(),
+				"code":    400,
+			},
+		})
+	}
+
+	if r.dbClient == nil {
+		return analyticsUnavailable(c)
+	}
+
+	report, err := run(c.Context(), opts)
+	if err != nil {
+		esLogger.Error("error building search report",
+			zap.String("report", name),
+			zap.Error(err),
+		)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "DatabaseError",
+				"message": "Failed to load " + name,
+				"code":    500,
+			},
+		})
+	}
+	return c.JSON(report)
+}
+
+// parseReportRequest reads the period, type and limit of a report.
+func parseReportRequest(c fiber.Ctx) (search.ReportOptions, error) {
+	var opts search.ReportOptions
+	days, err := queryInt(c, "days", search.DefaultReportDays)
+	if err != nil {
+		return opts, err
+	}
+	if days < 1 || days > maxReportDays {
+		return opts, fmt.Errorf("invalid days: must be between 1 and %d", maxReportDays)
+	}
+	opts.Since = time.Now().AddDate(0, 0, -days)
+
+	opts.Limit, err = queryInt(c, "limit", search.DefaultReportLimit)
+	if err != nil {
+		return opts, err
+	}
+	if opts.Limit < 1 || opts.Limit > search.MaxReportLimit {
+		return opts, fmt.Errorf("invalid limit: must be between 1 and %d", search.MaxReportLimit)
+	}
+
+	opts.Type = strings.TrimSpace(c.Query("type"))
+	if opts.Type != "" && opts.Type != search.SearchTypeAll && !es.IsSearchableType(opts.Type) {
+		return opts, fmt.Errorf("unknown type %q", opts.Type)
+	}
+	return opts, nil
+}
+
+func analyticsUnavailable(c fiber.Ctx) error {
+	esLogger.Warn("no database is available for search analytics")
+	return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "ServiceError",
+			"message": "Database service is temporarily unavailable",
+			"code":    503,
+		},
+	})
+}
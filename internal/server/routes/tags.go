@@ -0,0 +1,188 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/tagsuggestion"
+	"api.us4ever/internal/logger"
+	"api.us4ever/internal/middleware"
+	"api.us4ever/internal/tagging"
+	"github.com/gofiber/fiber/v3"
+	"go.uber.org/zap"
+)
+
+var tagsLogger *logger.Logger
+
+func init() {
+	var err error
+	tagsLogger, err = logger.New("tags")
+	if err != nil {
+		panic("failed to initialize tags logger: " + err.Error())
+	}
+}
+
+type TagRoutes struct {
+	app      *fiber.App
+	dbClient database.Service
+}
+
+func NewTagRoutes(app *fiber.App, dbClient database.Service) *TagRoutes {
+	return &TagRoutes{
+		app:      app,
+		dbClient: dbClient,
+	}
+}
+
+func (r *TagRoutes) Register() {
+	suggestions := r.app.Group("/internal/tags/suggestions")
+
+	// 自动生成的标签建议及其审核
+	suggestions.Get("/", r.listHandler)
+	suggestions.Post("/:id/accept", r.reviewHandler("accept", tagging.Accept))
+	suggestions.Post("/:id/reject", r.reviewHandler("reject", tagging.Reject))
+}
+
+// listHandler lists tag suggestions, newest first. It accepts status
+// (suggested by default, "all" for every status), type, ownerId, tag, limit
+// (up to tagging.MaxListLimit) and offset.
+func (r *TagRoutes) listHandler(c fiber.Ctx) error {
+	opts, err := parseSuggestionList(c)
+	if err != nil {
+		tagsLogger.Warn("invalid tag suggestion list request",
+			zap.String("ip", middleware.GetRealIP(c)),
+			zap.Error(err),
+		)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "ValidationError",
+				"message": err.Error(),
+				"code":    400,
+			},
+		})
+	}
+	if r.dbClient == nil {
+		return tagsUnavailable(c)
+	}
+
+	suggestions, total, err := tagging.List(c.Context(), r.dbClient.Client(), opts)
+	if err != nil {
+		tagsLogger.Error("error listing tag suggestions", zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "DatabaseError",
+				"message": "Failed to list tag suggestions",
+				"code":    500,
+			},
+		})
+	}
+	if suggestions == nil {
+		suggestions = []*ent.TagSuggestion{}
+	}
+	return c.JSON(fiber.Map{
+		"total":       total,
+		"suggestions": suggestions,
+	})
+}
+
+// parseSuggestionList reads the filters and page of a suggestion list.
+func parseSuggestionList(c fiber.Ctx) (tagging.ListOptions, error) {
+	opts := tagging.ListOptions{
+		Status:     strings.TrimSpace(c.Query("status", string(tagsuggestion.StatusSuggested))),
+		EntityType: strings.TrimSpace(c.Query("type")),
+		OwnerID:    strings.TrimSpace(c.Query("ownerId")),
+		Tag:        strings.TrimSpace(c.Query("tag")),
+	}
+	if opts.Status == "all" {
+		opts.Status = ""
+	} else if err := tagsuggestion.StatusValidator(tagsuggestion.Status(opts.Status)); err != nil {
+		return opts, fmt.Errorf("invalid status %q", opts.Status)
+	}
+	if opts.EntityType != "" && !tagging.IsEntityType(opts.EntityType) {
+		return opts, fmt.Errorf("unknown type %q", opts.EntityType)
+	}
+
+	var err error
+	if opts.Limit, err = queryInt(c, "limit", tagging.DefaultListLimit); err != nil {
+		return opts, err
+	}
+	if opts.Limit < 1 || opts.Limit > tagging.MaxListLimit {
+		return opts, fmt.Errorf("invalid limit: must be between 1 and %d", tagging.MaxListLimit)
+	}
+	if opts.Offset, err = queryInt(c, "offset", 0); err != nil {
+		return opts, err
+	}
+	if opts.Offset < 0 {
+		return opts, fmt.Errorf("invalid offset: must not be negative")
+	}
+	return opts, nil
+}
+
+// reviewHandler builds the handler accepting or rejecting one suggestion.
+func (r *TagRoutes) reviewHandler(action string, review func(context.Context, *ent.Client, int) (*ent.TagSuggestion, error)) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		id, err := strconv.Atoi(c.Params("id"))
+		if err != nil || id <= 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": fiber.Map{
+					"type":    "ValidationError",
+					"message": fmt.Sprintf("invalid suggestion id %q", c.Params("id")),
+					"code":    400,
+				},
+			})
+		}
+		if r.dbClient == nil {
+			return tagsUnavailable(c)
+		}
+
+		suggestion, err := review(c.Context(), r.dbClient.Client(), id)
+		switch {
+		case errors.Is(err, tagging.ErrNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": fiber.Map{
+					"type":    "NotFoundError",
+					"message": err.Error(),
+					"code":    404,
+				},
+			})
+		case errors.Is(err, tagging.ErrReviewed):
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error": fiber.Map{
+					"type":    "ConflictError",
+					"message": err.Error(),
+					"code":    409,
+				},
+			})
+		case err != nil:
+			tagsLogger.Error("error reviewing tag suggestion",
+				zap.String("action", action),
+				zap.Int("id", id),
+				zap.Error(err),
+			)
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": fiber.Map{
+					"type":    "DatabaseError",
+					"message": "Failed to " + action + " tag suggestion",
+					"code":    500,
+				},
+			})
+		}
+		return c.JSON(suggestion)
+	}
+}
+
+func tagsUnavailable(c fiber.Ctx) error {
+	tagsLogger.Warn("no database is available for tag suggestions")
+	return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "ServiceError",
+			"message": "Database service is temporarily unavailable",
+			"code":    503,
+		},
+	})
+}
@@ -0,0 +1,550 @@
+package routes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/todo"
+	"api.us4ever/internal/ent/user"
+	"api.us4ever/internal/logger"
+	"api.us4ever/internal/middleware"
+	"api.us4ever/internal/todos"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var todosLogger *logger.Logger
+
+func init() {
+	var err error
+	todosLogger, err = logger.New("todos")
+	if err != nil {
+		panic("failed to initialize todos logger: " + err.Error())
+	}
+}
+
+const (
+	// maxBulkIDs bounds the todos completed or reordered at once
+	maxBulkIDs = 200
+	// maxPriority bounds the priority of a todo; higher comes first
+	maxPriority = 100
+)
+
+type TodoRoutes struct {
+	app      *fiber.App
+	dbClient database.Service
+}
+
+func NewTodoRoutes(app *fiber.App, dbClient database.Service) *TodoRoutes {
+	return &TodoRoutes{
+		app:      app,
+		dbClient: dbClient,
+	}
+}
+
+func (r *TodoRoutes) Register() {
+	group := r.app.Group("/api/todos")
+
+	group.Get("/", r.listHandler)
+	group.Post("/", r.createHandler)
+
+	// 批量完成与手动排序
+	group.Post("/complete", r.completeHandler)
+	group.Post("/reorder", r.reorderHandler)
+
+	group.Get("/:id", r.getHandler)
+	group.Patch("/:id", r.updateHandler)
+	group.Delete("/:id", r.deleteHandler)
+}
+
+// todoResponse is a todo as returned by the API. Status is true once the
+// todo is done.
+type todoResponse struct {
+	ID        string          `json:"id"`
+	Title     string          `json:"title"`
+	Content   string          `json:"content"`
+	Status    bool            `json:"status"`
+	Priority  int32           `json:"priority"`
+	DueDate   *time.Time      `json:"dueDate"`
+	Pinned    bool            `json:"pinned"`
+	IsPublic  bool            `json:"isPublic"`
+	OwnerID   string          `json:"ownerId,omitempty"`
+	Category  string          `json:"category"`
+	ExtraData json.RawMessage `json:"extraData"`
+	CreatedAt time.Time       `json:"createdAt"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+}
+
+func newTodoResponse(t *ent.Todo) todoResponse {
+	resp := todoResponse{
+		ID:        t.ID,
+		Title:     t.Title,
+		Content:   t.Content,
+		Status:    t.Status,
+		Priority:  t.Priority,
+		Pinned:    t.Pinned,
+		IsPublic:  t.IsPublic,
+		OwnerID:   t.OwnerId,
+		Category:  t.Category,
+		ExtraData: storedObject(t.ExtraData),
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+	// 没有截止日期时返回 null 而不是零值时间
+	if !t.DueDate.IsZero() {
+		resp.DueDate = &t.DueDate
+	}
+	return resp
+}
+
+// todoRequest is the body of a create or update. Fields left out are not
+// changed by an update; a null dueDate removes it. dueDate is RFC3339 or
+// YYYY-MM-DD, a bare date being midnight in the time zone of the request.
+type todoRequest struct {
+	Title     *string         `json:"title"`
+	Content   *string         `json:"content"`
+	Status    *bool           `json:"status"`
+	Priority  *int32          `json:"priority"`
+	DueDate   json.RawMessage `json:"dueDate"`
+	Pinned    *bool           `json:"pinned"`
+	IsPublic  *bool           `json:"isPublic"`
+	OwnerID   *string         `json:"ownerId"`
+	Category  *string         `json:"category"`
+	ExtraData json.RawMessage `json:"extraData"`
+
+	// dueDate is the parsed DueDate; clearDueDate is set by a null DueDate
+	dueDate      *time.Time
+	clearDueDate bool
+}
+
+// bulkRequest is the body of a bulk completion or reorder.
+type bulkRequest struct {
+	IDs []string `json:"ids"`
+	// Status is the status set by a bulk completion, done by default
+	Status *bool `json:"status"`
+}
+
+// listHandler lists todos. With view (overdue, today, upcoming or pinned)
+// only the todos of that view are listed, computed in the time zone tz
+// (an IANA name, UTC by default); upcoming covers the next days days. It
+// also accepts ownerId, category, status, isPublic, limit (up to
+// maxPageLimit) and offset, and returns the total number of matches with
+// the page.
+func (r *TodoRoutes) listHandler(c fiber.Ctx) error {
+	limit, offset, err := parsePage(c)
+	if err != nil {
+		return todosValidationError(c, err)
+	}
+	view := strings.TrimSpace(c.Query("view"))
+	if view != "" && !todos.IsView(view) {
+		return todosValidationError(c, fmt.Errorf("unknown view %q: expected one of %s", view, strings.Join(todos.Views(), ", ")))
+	}
+	loc, err := queryLocation(c)
+	if err != nil {
+		return todosValidationError(c, err)
+	}
+	days, err := queryInt(c, "days", todos.DefaultUpcomingDays)
+	if err != nil {
+		return todosValidationError(c, err)
+	}
+	if days < 1 || days > todos.MaxUpcomingDays {
+		return todosValidationError(c, fmt.Errorf("invalid days: must be between 1 and %d", todos.MaxUpcomingDays))
+	}
+	status, err := queryOptionalBool(c, "status")
+	if err != nil {
+		return todosValidationError(c, err)
+	}
+	isPublic, err := queryOptionalBool(c, "isPublic")
+	if err != nil {
+		return todosValidationError(c, err)
+	}
+	if r.dbClient == nil {
+		return todosUnavailable(c)
+	}
+
+	query := r.dbClient.Client().Todo.Query().Where(todos.Where(view, time.Now(), loc, days)...)
+	if ownerID := strings.TrimSpace(c.Query("ownerId")); ownerID != "" {
+		query = query.Where(todo.OwnerId(ownerID))
+	}
+	if category := strings.TrimSpace(c.Query("category")); category != "" {
+		query = query.Where(todo.Category(category))
+	}
+	if status != nil {
+		query = query.Where(todo.Status(*status))
+	}
+	if isPublic != nil {
+		query = query.Where(todo.IsPublic(*isPublic))
+	}
+
+	total, err := query.Clone().Count(c.Context())
+	if err != nil {
+		return todosDatabaseError(c, "list", err)
+	}
+	rows, err := query.
+		Order(todos.Order(view)...).
+		Limit(limit).
+		Offset(offset).
+		All(c.Context())
+	if err != nil {
+		return todosDatabaseError(c, "list", err)
+	}
+
+	items := make([]todoResponse, 0, len(rows))
+	for _, t := range rows {
+		items = append(items, newTodoResponse(t))
+	}
+	return c.JSON(fiber.Map{
+		"total": total,
+		"todos": items,
+	})
+}
+
+func (r *TodoRoutes) getHandler(c fiber.Ctx) error {
+	if r.dbClient == nil {
+		return todosUnavailable(c)
+	}
+	t, err := r.dbClient.Client().Todo.Get(c.Context(), c.Params("id"))
+	if ent.IsNotFound(err) {
+		return todoNotFound(c, c.Params("id"))
+	}
+	if err != nil {
+		return todosDatabaseError(c, "load", err)
+	}
+	return c.JSON(newTodoResponse(t))
+}
+
+func (r *TodoRoutes) createHandler(c fiber.Ctx) error {
+	loc, err := queryLocation(c)
+	if err != nil {
+		return todosValidationError(c, err)
+	}
+	req, err := parseTodoRequest(c.Body(), loc, true)
+	if err != nil {
+		return todosValidationError(c, err)
+	}
+	if r.dbClient == nil {
+		return todosUnavailable(c)
+	}
+	client := r.dbClient.Client()
+
+	if req.OwnerID != nil {
+		exists, err := client.User.Query().Where(user.ID(*req.OwnerID)).Exist(c.Context())
+		if err != nil {
+			return todosDatabaseError(c, "create", err)
+		}
+		if !exists {
+			return todosValidationError(c, fmt.Errorf("unknown ownerId %q", *req.OwnerID))
+		}
+	}
+
+	now := time.Now()
+	create := client.Todo.Create().
+		SetID(uuid.NewString()).
+		SetTitle(*req.Title).
+		SetContent(valueOr(req.Content, "")).
+		SetStatus(valueOr(req.Status, false)).
+		SetPriority(valueOr(req.Priority, 0)).
+		SetNillableDueDate(req.dueDate).
+		SetPinned(valueOr(req.Pinned, false)).
+		SetIsPublic(valueOr(req.IsPublic, false)).
+		SetCategory(valueOr(req.Category, defaultCategory)).
+		SetExtraData(objectOr(req.ExtraData)).
+		SetCreatedAt(now).
+		SetUpdatedAt(now)
+	if req.OwnerID != nil {
+		create = create.SetOwnerId(*req.OwnerID)
+	}
+	t, err := create.Save(c.Context())
+	if err != nil {
+		return todosDatabaseError(c, "create", err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(newTodoResponse(t))
+}
+
+func (r *TodoRoutes) updateHandler(c fiber.Ctx) error {
+	loc, err := queryLocation(c)
+	if err != nil {
+		return todosValidationError(c, err)
+	}
+	req, err := parseTodoRequest(c.Body(), loc, false)
+	if err != nil {
+		return todosValidationError(c, err)
+	}
+	if r.dbClient == nil {
+		return todosUnavailable(c)
+	}
+
+	update := r.dbClient.Client().Todo.UpdateOneID(c.Params("id")).SetUpdatedAt(time.Now())
+	if req.Title != nil {
+		update = update.SetTitle(*req.Title)
+	}
+	if req.Content != nil {
+		update = update.SetContent(*req.Content)
+	}
+	if req.Status != nil {
+		update = update.SetStatus(*req.Status)
+	}
+	if req.Priority != nil {
+		update = update.SetPriority(*req.Priority)
+	}
+	if req.dueDate != nil {
+		update = update.SetDueDate(*req.dueDate)
+	}
+	if req.clearDueDate {
+		update = update.ClearDueDate()
+	}
+	if req.Pinned != nil {
+		update = update.SetPinned(*req.Pinned)
+	}
+	if req.IsPublic != nil {
+		update = update.SetIsPublic(*req.IsPublic)
+	}
+	if req.Category != nil {
+		update = update.SetCategory(*req.Category)
+	}
+	if req.ExtraData != nil {
+		update = update.SetExtraData(req.ExtraData)
+	}
+	t, err := update.Save(c.Context())
+	if ent.IsNotFound(err) {
+		return todoNotFound(c, c.Params("id"))
+	}
+	if err != nil {
+		return todosDatabaseError(c, "update", err)
+	}
+	return c.JSON(newTodoResponse(t))
+}
+
+func (r *TodoRoutes) deleteHandler(c fiber.Ctx) error {
+	if r.dbClient == nil {
+		return todosUnavailable(c)
+	}
+	err := r.dbClient.Client().Todo.DeleteOneID(c.Params("id")).Exec(c.Context())
+	if ent.IsNotFound(err) {
+		return todoNotFound(c, c.Params("id"))
+	}
+	if err != nil {
+		return todosDatabaseError(c, "delete", err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// completeHandler sets the status of several todos at once, done unless
+// the body says otherwise. Either all of them change or none.
+func (r *TodoRoutes) completeHandler(c fiber.Ctx) error {
+	req, err := parseBulkRequest(c.Body())
+	if err != nil {
+		return todosValidationError(c, err)
+	}
+	if r.dbClient == nil {
+		return todosUnavailable(c)
+	}
+
+	updated, err := todos.Complete(c.Context(), r.dbClient.Client(), req.IDs, valueOr(req.Status, true))
+	if err != nil {
+		return r.bulkError(c, "complete", err)
+	}
+	return c.JSON(fiber.Map{
+		"updated": updated,
+	})
+}
+
+// reorderHandler stores the order of the todos listed, first to last.
+func (r *TodoRoutes) reorderHandler(c fiber.Ctx) error {
+	req, err := parseBulkRequest(c.Body())
+	if err != nil {
+		return todosValidationError(c, err)
+	}
+	if req.Status != nil {
+		return todosValidationError(c, fmt.Errorf("status is not accepted by reorder"))
+	}
+	if r.dbClient == nil {
+		return todosUnavailable(c)
+	}
+
+	if err := todos.Reorder(c.Context(), r.dbClient.Client(), req.IDs); err != nil {
+		return r.bulkError(c, "reorder", err)
+	}
+	return c.JSON(fiber.Map{
+		"reordered": len(req.IDs),
+	})
+}
+
+func (r *TodoRoutes) bulkError(c fiber.Ctx, action string, err error) error {
+	switch {
+	case errors.Is(err, todos.ErrNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": fiber.Map{
+				"type":    "NotFoundError",
+				"message": err.Error(),
+				"code":    404,
+			},
+		})
+	case errors.Is(err, todos.ErrMixedOwners):
+		return todosValidationError(c, err)
+	}
+	return todosDatabaseError(c, action, err)
+}
+
+// queryLocation reads the IANA time zone in tz, UTC by default.
+func queryLocation(c fiber.Ctx) (*time.Location, error) {
+	name := strings.TrimSpace(c.Query("tz"))
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tz %q: expected an IANA time zone such as Asia/Shanghai", name)
+	}
+	return loc, nil
+}
+
+// parseTodoRequest decodes and validates the body of a create, which needs
+// a title, or of an update, which needs at least one field.
+func parseTodoRequest(body []byte, loc *time.Location, create bool) (todoRequest, error) {
+	var req todoRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return req, fmt.Errorf("invalid request body: %w", err)
+	}
+
+	if create && req.Title == nil {
+		return req, fmt.Errorf("missing title")
+	}
+	if !create && req.Title == nil && req.Content == nil && req.Status == nil && req.Priority == nil &&
+		req.DueDate == nil && req.Pinned == nil && req.IsPublic == nil && req.OwnerID == nil &&
+		req.Category == nil && req.ExtraData == nil {
+		return req, fmt.Errorf("nothing to update")
+	}
+	if !create && req.OwnerID != nil {
+		return req, fmt.Errorf("ownerId cannot be changed")
+	}
+
+	if err := trimField("title", req.Title, maxTitleRunes); err != nil {
+		return req, err
+	}
+	if req.Title != nil && *req.Title == "" {
+		return req, fmt.Errorf("title must not be empty")
+	}
+	if err := trimField("content", req.Content, maxContentRunes); err != nil {
+		return req, err
+	}
+	if req.Priority != nil && (*req.Priority < 0 || *req.Priority > maxPriority) {
+		return req, fmt.Errorf("invalid priority: must be between 0 and %d", maxPriority)
+	}
+	if err := trimField("category", req.Category, maxCategoryRunes); err != nil {
+		return req, err
+	}
+	if req.Category != nil && *req.Category == "" {
+		return req, fmt.Errorf("category must not be empty")
+	}
+	if req.OwnerID != nil {
+		if *req.OwnerID = strings.TrimSpace(*req.OwnerID); *req.OwnerID == "" {
+			req.OwnerID = nil
+		}
+	}
+	if req.ExtraData != nil {
+		if err := checkObject("extraData", req.ExtraData); err != nil {
+			return req, err
+		}
+	}
+
+	if req.DueDate != nil {
+		var v *string
+		if err := json.Unmarshal(req.DueDate, &v); err != nil {
+			return req, fmt.Errorf("invalid dueDate: expected a string or null")
+		}
+		if v == nil || strings.TrimSpace(*v) == "" {
+			req.clearDueDate = !create
+		} else {
+			due, err := todos.ParseDueDate(strings.TrimSpace(*v), loc)
+			if err != nil {
+				return req, err
+			}
+			req.dueDate = &due
+		}
+	}
+	return req, nil
+}
+
+// parseBulkRequest decodes the body of a bulk operation and checks its IDs.
+func parseBulkRequest(body []byte) (bulkRequest, error) {
+	var req bulkRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return req, fmt.Errorf("invalid request body: %w", err)
+	}
+	if len(req.IDs) == 0 {
+		return req, fmt.Errorf("missing ids")
+	}
+	if len(req.IDs) > maxBulkIDs {
+		return req, fmt.Errorf("too many ids: at most %d", maxBulkIDs)
+	}
+	ids := make([]string, 0, len(req.IDs))
+	for _, id := range req.IDs {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			return req, fmt.Errorf("ids must not contain empty IDs")
+		}
+		if slices.Contains(ids, id) {
+			return req, fmt.Errorf("ids lists %s twice", id)
+		}
+		ids = append(ids, id)
+	}
+	req.IDs = ids
+	return req, nil
+}
+
+func todosValidationError(c fiber.Ctx, err error) error {
+	todosLogger.Warn("invalid todo request",
+		zap.String("ip", middleware.GetRealIP(c)),
+		zap.Error(err),
+	)
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "ValidationError",
+			"message": err.Error(),
+			"code":    400,
+		},
+	})
+}
+
+func todoNotFound(c fiber.Ctx, id string) error {
+	return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "NotFoundError",
+			"message": fmt.Sprintf("todo %s not found", id),
+			"code":    404,
+		},
+	})
+}
+
+func todosDatabaseError(c fiber.Ctx, action string, err error) error {
+	todosLogger.Error("error in todo "+action,
+		zap.String("id", c.Params("id")),
+		zap.Error(err),
+	)
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "DatabaseError",
+			"message": "Failed to " + action + " todos",
+			"code":    500,
+		},
+	})
+}
+
+func todosUnavailable(c fiber.Ctx) error {
+	todosLogger.Warn("no database is available for todos")
+	return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+		"error": fiber.Map{
+			"type":    "ServiceError",
+			"message": "Database service is temporarily unavailable",
+			"code":    503,
+		},
+	})
+}
@@ -4,27 +4,41 @@ import (
 	"context"
 	"fmt"
 	"reflect"
-	"strings"
 
+	"api.us4ever/internal/cache"
 	"api.us4ever/internal/config"
 	"api.us4ever/internal/database"
+	"api.us4ever/internal/dedup"
+	"api.us4ever/internal/enrich"
 	"api.us4ever/internal/es"
 	"api.us4ever/internal/logger"
 	"api.us4ever/internal/metrics"
+	"api.us4ever/internal/search"
 	"github.com/elastic/go-elasticsearch/v8"
 	"github.com/gofiber/fiber/v3"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 )
 
 type FiberServer struct {
 	*fiber.App
 
-	DbClient           database.Service
-	EsClient           *elasticsearch.Client
-	KeepEsIndexAlias   string
-	MomentEsIndexAlias string
-	cfg                *config.AppConfig
-	logger             *logger.Logger
+	DbClient       database.Service
+	EsClient       *elasticsearch.Client
+	RedisClient    *redis.Client
+	EsIndexAliases es.IndexAliases
+	// Search holds the backend answering the search routes, Elasticsearch,
+	// Postgres or Bleve; it is replaced when the configuration changes
+	Search      *search.Holder
+	ReindexJobs *es.ReindexJobs
+	// ConsistencyReports holds the latest index consistency check of every index
+	ConsistencyReports *es.ConsistencyReports
+	// Duplicates holds the latest near-duplicate scan of keeps and moments
+	Duplicates *dedup.Reports
+	// Enrichment generates what keeps and moments written through the API derive from their content
+	Enrichment *enrich.Queue
+	cfg        *config.AppConfig
+	logger     *logger.Logger
 }
 
 var (
@@ -76,13 +90,24 @@ func New() *FiberServer {
 			esClient = nil
 		}
 	} else {
-		esLogger.Info("Elasticsearch configuration not provided, search functionality will be unavailable")
+		esLogger.Info("Elasticsearch configuration not provided, searching Postgres instead")
 	}
 
-	// Create index aliases with sanitized app name
-	sanitizedAppName := strings.ToLower(strings.ReplaceAll(appConfig.AppName, " ", "-"))
-	keepIndexAlias := fmt.Sprintf("%s-keeps", sanitizedAppName)
-	momentIndexAlias := fmt.Sprintf("%s-moments", sanitizedAppName)
+	// Initialize the optional Redis client, used to share query embeddings between replicas
+	var redisClient *redis.Client
+	if appConfig.Redis.Host != "" {
+		redisClient, err = cache.NewRedisClient(appConfig.Redis)
+		if err != nil {
+			// Fall back to the in-process cache only
+			serverLogger.Error("failed to initialize Redis client",
+				zap.Error(err),
+			)
+			redisClient = nil
+		}
+	} else {
+		serverLogger.Info("Redis configuration not provided, query embeddings will only be cached in process")
+	}
+	es.UseRedisForQueryEmbeddings(redisClient)
 
 	server := &FiberServer{
 		App: fiber.New(fiber.Config{
@@ -90,12 +115,19 @@ func New() *FiberServer {
 			AppName:      appConfig.AppName,
 		}),
 
-		DbClient:           dbClient,
-		EsClient:           esClient,
-		KeepEsIndexAlias:   keepIndexAlias,
-		MomentEsIndexAlias: momentIndexAlias,
+		DbClient:    dbClient,
+		EsClient:    esClient,
+		RedisClient: redisClient,
+		// Create index aliases with sanitized app name
+		EsIndexAliases:     es.NewIndexAliases(appConfig.AppName),
+		ReindexJobs:        es.NewReindexJobs(),
+		ConsistencyReports: es.NewConsistencyReports(),
+		Duplicates:         dedup.NewReports(),
+		Enrichment:         enrich.NewQueue(enrich.DefaultQueueSize),
 		cfg:                appConfig,
 	}
+	server.Search = search.NewHolder(server.newSearchBackend())
+	server.fillSearchIndices()
 
 	// Register configuration change callback
 	config.RegisterChangeCallback(server.handleConfigChange)
@@ -119,54 +151,34 @@ func (s *FiberServer) triggerInitialIndexing() {
 		return
 	}
 
-	// 为 keeps 创建索引
-	go func() {
-		// check index already exist, ignore create if exists
-		ctx := context.Background()
-		_, err := s.EsClient.Indices.Exists([]string{s.KeepEsIndexAlias}, s.EsClient.Indices.Exists.WithContext(ctx))
-		if err != nil {
-			esLogger.Error("failed to check index existence",
-				zap.Error(err),
-			)
-		} else {
-			esLogger.Info(fmt.Sprintf("%s already exists, skipping initial indexing", s.KeepEsIndexAlias))
-			return
-		}
-
-		// Create a background context for the initial indexing
-		// Use context.Background() as this is not tied to a specific request
-		esLogger.Info("starting initial Elasticsearch indexing for keeps")
-		if err := es.IndexKeeps(ctx, s.EsClient, s.DbClient, s.KeepEsIndexAlias); err != nil {
-			esLogger.Error("initial Elasticsearch indexing for keeps failed",
-				zap.Error(err),
-			)
-		} else {
-			esLogger.Info("initial Elasticsearch indexing for keeps completed successfully")
-		}
-	}()
-
-	// 为moments创建索引
-	go func() {
-		ctx := context.Background()
-		_, err := s.EsClient.Indices.Exists([]string{s.MomentEsIndexAlias}, s.EsClient.Indices.Exists.WithContext(ctx))
-		if err != nil {
-			esLogger.Error("failed to check index existence",
-				zap.Error(err),
-			)
-		} else {
-			esLogger.Info(fmt.Sprintf("%s already exists, skipping initial indexing", s.MomentEsIndexAlias))
-			return
-		}
-
-		esLogger.Info("starting initial Elasticsearch indexing for moments")
-		if err := es.IndexMoments(ctx, s.EsClient, s.DbClient, s.MomentEsIndexAlias); err != nil {
-			esLogger.Error("initial Elasticsearch indexing for moments failed",
-				zap.Error(err),
-			)
-		} else {
-			esLogger.Info("initial Elasticsearch indexing for moments completed successfully")
-		}
-	}()
+	// 为每个注册的索引创建索引
+	for _, def := range es.IndexDefinitions() {
+		alias := s.EsIndexAliases.Of(def)
+		go func() {
+			// check index already exist, ignore create if exists
+			ctx := context.Background()
+			_, err := s.EsClient.Indices.Exists([]string{alias}, s.EsClient.Indices.Exists.WithContext(ctx))
+			if err != nil {
+				esLogger.Error("failed to check index existence",
+					zap.Error(err),
+				)
+			} else {
+				esLogger.Info(fmt.Sprintf("%s already exists, skipping initial indexing", alias))
+				return
+			}
+
+			// Create a background context for the initial indexing
+			// Use context.Background() as this is not tied to a specific request
+			esLogger.Info("starting initial Elasticsearch indexing for " + def.Name)
+			if err := es.Reindex(ctx, s.EsClient, s.DbClient, def, alias, nil, es.ReindexOptions{}); err != nil {
+				esLogger.Error("initial Elasticsearch indexing for "+def.Name+" failed",
+					zap.Error(err),
+				)
+			} else {
+				esLogger.Info("initial Elasticsearch indexing for " + def.Name + " completed successfully")
+			}
+		}()
+	}
 }
 
 // handleConfigChange handles configuration changes
@@ -181,10 +193,14 @@ func (s *FiberServer) handleConfigChange(newConfig *config.AppConfig) {
 
 	dbConfigChanged := false
 	esConfigChanged := false
+	redisConfigChanged := false
+	searchConfigChanged := false
 
 	if oldConfig != nil { // Ensure current config exists for comparison
 		dbConfigChanged = oldConfig.Database != newConfig.Database
 		esConfigChanged = !reflect.DeepEqual(oldConfig.ES, newConfig.ES)
+		redisConfigChanged = oldConfig.Redis != newConfig.Redis
+		searchConfigChanged = oldConfig.Search != newConfig.Search
 	}
 
 	// Only refresh the database connection if the DB config actually changed
@@ -214,6 +230,28 @@ func (s *FiberServer) handleConfigChange(newConfig *config.AppConfig) {
 	} else {
 		esLogger.Debug("Elasticsearch configuration unchanged, skipping ES client refresh")
 	}
+
+	// The search backend depends on both clients and its own config
+	if dbConfigChanged || esConfigChanged || searchConfigChanged {
+		// 旧后端在最后一个使用它的请求结束后才关闭
+		previous := s.Search.Current()
+		s.Search.Swap(s.newSearchBackend())
+		if s.Search.Current() != previous {
+			s.fillSearchIndices()
+		}
+	}
+
+	// Only refresh the Redis client if the Redis config actually changed
+	if redisConfigChanged {
+		configLogger.Info("Redis configuration changed, updating Redis client")
+		if err := s.refreshRedisClient(); err != nil {
+			configLogger.Error("failed to update Redis client",
+				zap.Error(err),
+			)
+		} else {
+			configLogger.Info("Redis client updated successfully")
+		}
+	}
 }
 
 // refreshDatabase 重新创建数据库连接
@@ -254,3 +292,111 @@ func (s *FiberServer) refreshESClient() error {
 	s.EsClient = newESClient
 	return nil
 }
+
+// newSearchBackend 根据配置选择搜索后端：显式配置优先（elasticsearch、postgres 或 bleve），
+// 否则有 ES 客户端时使用 ES，没有时回退到 Postgres
+func (s *FiberServer) newSearchBackend() search.SearchBackend {
+	backend := s.cfg.Search.Backend
+	if backend == "" {
+		backend = search.BackendPostgres
+		if s.EsClient != nil {
+			backend = search.BackendElasticsearch
+		}
+	}
+
+	switch backend {
+	case search.BackendElasticsearch:
+		if s.EsClient == nil {
+			serverLogger.Error("elasticsearch search backend configured without an Elasticsearch client, search will be unavailable")
+			return nil
+		}
+		serverLogger.Info("searching with elasticsearch")
+		return search.NewElasticsearch(s.EsClient, s.EsIndexAliases)
+	case search.BackendPostgres:
+		serverLogger.Info("searching with postgres")
+		return search.NewPostgres(s.DbClient.Client(), s.cfg.Search)
+	case search.BackendBleve:
+		path := s.cfg.Search.BlevePath
+		if path == "" {
+			path = search.DefaultBlevePath
+		}
+		// 同一目录的索引只能打开一次，配置变化时复用
+		if current, ok := s.currentSearchBackend().(*search.Bleve); ok && current.Path() == path {
+			return current
+		}
+		backend, err := search.OpenBleve(path)
+		if err != nil {
+			serverLogger.Error("failed to open bleve indices, search will be unavailable",
+				zap.String("path", path),
+				zap.Error(err),
+			)
+			return nil
+		}
+		serverLogger.Info("searching with bleve", zap.String("path", path))
+		return backend
+	default:
+		serverLogger.Error("unknown search backend, search will be unavailable",
+			zap.String("backend", backend),
+		)
+		return nil
+	}
+}
+
+// fillSearchIndices 在后台从数据库填充新启用的 Bleve 后端的空索引，之后由 search outbox 增量同步。
+// 填充期间持有该后端的租约，配置切换不会在填充途中关闭索引
+func (s *FiberServer) fillSearchIndices() {
+	backend, release := s.Search.Acquire()
+	bleveBackend, ok := backend.(*search.Bleve)
+	if !ok {
+		release()
+		return
+	}
+	go func() {
+		defer release()
+		if err := bleveBackend.RebuildEmpty(context.Background(), s.DbClient); err != nil {
+			serverLogger.Error("failed to fill bleve indices", zap.Error(err))
+		}
+	}()
+}
+
+// currentSearchBackend returns the backend in use, nil before the first one is chosen.
+func (s *FiberServer) currentSearchBackend() search.SearchBackend {
+	if s.Search == nil {
+		return nil
+	}
+	return s.Search.Current()
+}
+
+// refreshRedisClient 重新创建 Redis 客户端连接
+func (s *FiberServer) refreshRedisClient() error {
+	var newRedisClient *redis.Client
+	if s.cfg.Redis.Host != "" {
+		var err error
+		newRedisClient, err = cache.NewRedisClient(s.cfg.Redis)
+		if err != nil {
+			// Keep serving from the in-process cache only
+			es.UseRedisForQueryEmbeddings(nil)
+			s.closeRedisClient()
+			return fmt.Errorf("failed to create new Redis client: %w", err)
+		}
+	}
+
+	// Switch the cache over before closing the old connection
+	es.UseRedisForQueryEmbeddings(newRedisClient)
+	s.closeRedisClient()
+	s.RedisClient = newRedisClient
+	return nil
+}
+
+// closeRedisClient closes the current Redis client, if any
+func (s *FiberServer) closeRedisClient() {
+	if s.RedisClient == nil {
+		return
+	}
+	if err := s.RedisClient.Close(); err != nil {
+		serverLogger.Warn("error closing previous Redis connection",
+			zap.Error(err),
+		)
+	}
+	s.RedisClient = nil
+}
@@ -0,0 +1,140 @@
+package tagging
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// kMeansIterations bounds the refinement rounds; assignments of note
+// embeddings settle long before
+const kMeansIterations = 30
+
+// kMeans clusters unit vectors into k groups with spherical k-means: points
+// are assigned to the centroid with the highest cosine similarity and
+// centroids are the normalized means of their points. Seeding is k-means++
+// with a fixed seed, so the same vectors always give the same clusters. It
+// returns the cluster of every vector and its similarity to the centroid.
+func kMeans(vectors [][]float32, k int, seed uint64) ([]int, []float64) {
+	n := len(vectors)
+	assign := make([]int, n)
+	similarity := make([]float64, n)
+	if n == 0 || k <= 0 {
+		return assign, similarity
+	}
+	k = min(k, n)
+	dims := len(vectors[0])
+	rng := rand.New(rand.NewPCG(seed, seed))
+
+	// k-means++：按与已选中心的距离加权选取下一个中心
+	centroids := make([][]float64, 0, k)
+	centroids = append(centroids, toFloat64(vectors[rng.IntN(n)]))
+	distance := make([]float64, n)
+	for len(centroids) < k {
+		var total float64
+		for i, v := range vectors {
+			best := math.Inf(1)
+			for _, c := range centroids {
+				best = min(best, 1-dot(v, c))
+			}
+			distance[i] = max(best, 0)
+			total += distance[i]
+		}
+		if total == 0 {
+			break
+		}
+		target := rng.Float64() * total
+		next := n - 1
+		for i, d := range distance {
+			if target -= d; target <= 0 {
+				next = i
+				break
+			}
+		}
+		centroids = append(centroids, toFloat64(vectors[next]))
+	}
+
+	for iteration := range kMeansIterations {
+		changed := false
+		for i, v := range vectors {
+			best, bestSim := 0, math.Inf(-1)
+			for c, centroid := range centroids {
+				if s := dot(v, centroid); s > bestSim {
+					best, bestSim = c, s
+				}
+			}
+			if iteration == 0 || assign[i] != best {
+				changed = true
+			}
+			assign[i], similarity[i] = best, bestSim
+		}
+		if !changed {
+			break
+		}
+
+		sums := make([][]float64, len(centroids))
+		for c := range sums {
+			sums[c] = make([]float64, dims)
+		}
+		for i, v := range vectors {
+			for d, x := range v {
+				sums[assign[i]][d] += float64(x)
+			}
+		}
+		for c, sum := range sums {
+			// 空簇保留原中心
+			if normalize(sum) {
+				centroids[c] = sum
+			}
+		}
+	}
+	return assign, similarity
+}
+
+// unitVector returns v scaled to length 1, or nil for a zero vector.
+func unitVector(v []float32) []float32 {
+	var norm float64
+	for _, x := range v {
+		norm += float64(x) * float64(x)
+	}
+	if norm == 0 {
+		return nil
+	}
+	norm = math.Sqrt(norm)
+	out := make([]float32, len(v))
+	for i, x := range v {
+		out[i] = float32(float64(x) / norm)
+	}
+	return out
+}
+
+// normalize scales v to length 1 in place; it reports false for a zero vector.
+func normalize(v []float64) bool {
+	var norm float64
+	for _, x := range v {
+		norm += x * x
+	}
+	if norm == 0 {
+		return false
+	}
+	norm = math.Sqrt(norm)
+	for i := range v {
+		v[i] /= norm
+	}
+	return true
+}
+
+func dot(v []float32, c []float64) float64 {
+	var s float64
+	for i, x := range v {
+		s += float64(x) * c[i]
+	}
+	return s
+}
+
+func toFloat64(v []float32) []float64 {
+	out := make([]float64, len(v))
+	for i, x := range v {
+		out[i] = float64(x)
+	}
+	return out
+}
@@ -0,0 +1,223 @@
+package tagging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/embedding"
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/file"
+	"api.us4ever/internal/ent/keep"
+	"api.us4ever/internal/ent/mindmap"
+	"api.us4ever/internal/ent/moment"
+	"api.us4ever/internal/es"
+	"go.uber.org/zap"
+)
+
+const (
+	// loadPageSize is the number of records loaded per page
+	loadPageSize = 500
+	// embedBatchSize is the number of mindmap and file texts embedded per request
+	embedBatchSize = 32
+	// maxEmbedRunes bounds the text embedded for a mindmap or file
+	maxEmbedRunes = 2000
+)
+
+// LoadItems loads the records tags are suggested for. Keeps and moments use
+// their stored content vectors. Mindmaps and files have none, so their text
+// is embedded with embedder; with a nil embedder, or when the embedding
+// service fails, they are left out of this run.
+func LoadItems(ctx context.Context, db database.Service, embedder embedding.Embedder) ([]Item, error) {
+	var items []Item
+
+	err := db.KeepPages(ctx, loadPageSize, func(page []*ent.Keep, _ database.Progress) error {
+		for _, k := range page {
+			items = append(items, Item{
+				Type:    EntityKeep,
+				ID:      k.ID,
+				OwnerID: k.OwnerId,
+				Text:    firstNonEmpty(k.Title, k.Summary, k.Content),
+				Tags:    decodeTags(k.Tags),
+				Vector:  embedding.DecodeStored(k.ContentVector),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load keeps: %w", err)
+	}
+
+	err = db.MomentPages(ctx, loadPageSize, func(page []*ent.Moment, _ database.Progress) error {
+		for _, m := range page {
+			items = append(items, Item{
+				Type:    EntityMoment,
+				ID:      m.ID,
+				OwnerID: m.OwnerId,
+				Text:    m.Content,
+				Tags:    decodeTags(m.Tags),
+				Vector:  embedding.DecodeStored(m.ContentVector),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load moments: %w", err)
+	}
+
+	var pending []Item
+	var texts []string
+	err = db.MindmapPages(ctx, loadPageSize, func(page []*ent.Mindmap, _ database.Progress) error {
+		for _, m := range page {
+			text := joinText(m.Title, m.Summary, es.FlattenMindmap(m.Content))
+			if text == "" {
+				continue
+			}
+			pending = append(pending, Item{
+				Type:    EntityMindmap,
+				ID:      m.ID,
+				OwnerID: m.OwnerId,
+				Text:    firstNonEmpty(m.Title, m.Summary, text),
+				Tags:    decodeTags(m.Tags),
+			})
+			texts = append(texts, text)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load mindmaps: %w", err)
+	}
+
+	// 只有带描述的文件才有可供聚类的文本
+	files, err := db.Client().File.Query().
+		Where(file.DescriptionNEQ("")).
+		Order(ent.Asc(file.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load files: %w", err)
+	}
+	for _, f := range files {
+		pending = append(pending, Item{
+			Type:    EntityFile,
+			ID:      f.ID,
+			OwnerID: f.UploadedBy,
+			Text:    joinText(f.Name, f.Description),
+			Tags:    decodeTags(f.Tags),
+		})
+		texts = append(texts, joinText(f.Name, f.Description))
+	}
+
+	if embedder == nil || len(pending) == 0 {
+		return items, nil
+	}
+	for start := 0; start < len(pending); start += embedBatchSize {
+		end := min(start+embedBatchSize, len(pending))
+		vectors, err := embedder.Embed(ctx, texts[start:end])
+		if err != nil {
+			taggingLogger.Warn("failed to embed mindmaps and files, leaving them out of this run",
+				zap.Int("pending", len(pending)),
+				zap.Error(err),
+			)
+			return items, nil
+		}
+		for i, v := range vectors {
+			pending[start+i].Vector = v
+		}
+	}
+	return append(items, pending...), nil
+}
+
+// loadTags reads the tags of a record.
+func loadTags(ctx context.Context, client *ent.Client, entityType, id string) ([]string, error) {
+	var raw json.RawMessage
+	var err error
+	switch entityType {
+	case EntityKeep:
+		var k *ent.Keep
+		if k, err = client.Keep.Query().Where(keep.ID(id)).Select(keep.FieldTags).Only(ctx); err == nil {
+			raw = k.Tags
+		}
+	case EntityMoment:
+		var m *ent.Moment
+		if m, err = client.Moment.Query().Where(moment.ID(id)).Select(moment.FieldTags).Only(ctx); err == nil {
+			raw = m.Tags
+		}
+	case EntityMindmap:
+		var m *ent.Mindmap
+		if m, err = client.Mindmap.Query().Where(mindmap.ID(id)).Select(mindmap.FieldTags).Only(ctx); err == nil {
+			raw = m.Tags
+		}
+	case EntityFile:
+		var f *ent.File
+		if f, err = client.File.Query().Where(file.ID(id)).Select(file.FieldTags).Only(ctx); err == nil {
+			raw = f.Tags
+		}
+	default:
+		return nil, fmt.Errorf("unknown entity type %q", entityType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decodeTags(raw), nil
+}
+
+// saveTags replaces the tags of a record. The updatedAt of the record is left
+// alone: a suggested tag is not an edit by its owner.
+func saveTags(ctx context.Context, client *ent.Client, entityType, id string, tags []string) error {
+	if tags == nil {
+		tags = []string{}
+	}
+	raw, err := json.Marshal(tags)
+	if err != nil {
+		return err
+	}
+	switch entityType {
+	case EntityKeep:
+		return client.Keep.UpdateOneID(id).SetTags(raw).Exec(ctx)
+	case EntityMoment:
+		return client.Moment.UpdateOneID(id).SetTags(raw).Exec(ctx)
+	case EntityMindmap:
+		return client.Mindmap.UpdateOneID(id).SetTags(raw).Exec(ctx)
+	case EntityFile:
+		return client.File.UpdateOneID(id).SetTags(raw).Exec(ctx)
+	default:
+		return fmt.Errorf("unknown entity type %q", entityType)
+	}
+}
+
+// decodeTags reads a JSON tags column; anything but an array of strings is
+// treated as no tags.
+func decodeTags(raw json.RawMessage) []string {
+	tags := []string{}
+	if len(raw) > 0 && json.Unmarshal(raw, &tags) != nil {
+		return []string{}
+	}
+	return tags
+}
+
+// joinText joins the non-empty parts, one per line, bounded to maxEmbedRunes.
+func joinText(parts ...string) string {
+	var kept []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			kept = append(kept, p)
+		}
+	}
+	text := strings.Join(kept, "\n")
+	if utf8.RuneCountInString(text) > maxEmbedRunes {
+		text = string([]rune(text)[:maxEmbedRunes])
+	}
+	return text
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,136 @@
+package tagging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/tagsuggestion"
+)
+
+var (
+	// ErrNotFound is returned when a suggestion does not exist.
+	ErrNotFound = errors.New("tag suggestion not found")
+	// ErrReviewed is returned when a suggestion was already accepted or rejected.
+	ErrReviewed = errors.New("tag suggestion already reviewed")
+)
+
+const (
+	// DefaultListLimit is the number of suggestions listed by default
+	DefaultListLimit = 50
+	// MaxListLimit bounds the number of suggestions listed at once
+	MaxListLimit = 200
+)
+
+// ListOptions selects the suggestions to list. Empty fields match all.
+type ListOptions struct {
+	Status     string
+	EntityType string
+	OwnerID    string
+	Tag        string
+	Limit      int
+	Offset     int
+}
+
+// List returns the suggestions matching opts, newest first, with the total
+// number of matches.
+func List(ctx context.Context, client *ent.Client, opts ListOptions) ([]*ent.TagSuggestion, int, error) {
+	query := client.TagSuggestion.Query()
+	if opts.Status != "" {
+		query = query.Where(tagsuggestion.StatusEQ(tagsuggestion.Status(opts.Status)))
+	}
+	if opts.EntityType != "" {
+		query = query.Where(tagsuggestion.EntityType(opts.EntityType))
+	}
+	if opts.OwnerID != "" {
+		query = query.Where(tagsuggestion.OwnerId(opts.OwnerID))
+	}
+	if opts.Tag != "" {
+		query = query.Where(tagsuggestion.TagEqualFold(opts.Tag))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count tag suggestions: %w", err)
+	}
+	suggestions, err := query.
+		Order(ent.Desc(tagsuggestion.FieldCreatedAt), ent.Desc(tagsuggestion.FieldID)).
+		Limit(opts.Limit).
+		Offset(opts.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list tag suggestions: %w", err)
+	}
+	return suggestions, total, nil
+}
+
+// Accept keeps a suggested tag.
+func Accept(ctx context.Context, client *ent.Client, id int) (*ent.TagSuggestion, error) {
+	return review(ctx, client, id, tagsuggestion.StatusAccepted, nil)
+}
+
+// Reject removes a suggested tag from its record. The suggestion is kept as
+// rejected so the tag is not suggested again.
+func Reject(ctx context.Context, client *ent.Client, id int) (*ent.TagSuggestion, error) {
+	return review(ctx, client, id, tagsuggestion.StatusRejected, func(ctx context.Context, tx *ent.Tx, s *ent.TagSuggestion) error {
+		tags, err := loadTags(ctx, tx.Client(), s.EntityType, s.EntityId)
+		if ent.IsNotFound(err) {
+			// 记录已被删除，无需移除标签
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to load tags: %w", err)
+		}
+		kept := slices.DeleteFunc(slices.Clone(tags), func(t string) bool {
+			return strings.EqualFold(strings.TrimSpace(t), s.Tag)
+		})
+		if len(kept) == len(tags) {
+			return nil
+		}
+		if err := saveTags(ctx, tx.Client(), s.EntityType, s.EntityId, kept); err != nil {
+			return fmt.Errorf("failed to save tags: %w", err)
+		}
+		return nil
+	})
+}
+
+// review moves a pending suggestion to status, running apply in the same
+// transaction. The status is changed by a conditional update, so of two
+// concurrent reviews only the first one applies.
+func review(ctx context.Context, client *ent.Client, id int, status tagsuggestion.Status, apply func(context.Context, *ent.Tx, *ent.TagSuggestion) error) (*ent.TagSuggestion, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	n, err := tx.TagSuggestion.Update().
+		Where(tagsuggestion.ID(id), tagsuggestion.StatusEQ(tagsuggestion.StatusSuggested)).
+		SetStatus(status).
+		SetReviewedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to update suggestion %d: %w", id, err))
+	}
+	s, err := tx.TagSuggestion.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, rollback(tx, fmt.Errorf("suggestion %d: %w", id, ErrNotFound))
+	}
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to load suggestion %d: %w", id, err))
+	}
+	if n == 0 {
+		return nil, rollback(tx, fmt.Errorf("suggestion %d is %s: %w", id, s.Status, ErrReviewed))
+	}
+	if apply != nil {
+		if err := apply(ctx, tx, s); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit review: %w", err)
+	}
+	return s.Unwrap(), nil
+}
@@ -0,0 +1,157 @@
+package tagging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/tagsuggestion"
+	"go.uber.org/zap"
+)
+
+// Result counts what a tagging run did.
+type Result struct {
+	Items    int `json:"items"`
+	Clusters int `json:"clusters"`
+	// Named is the number of clusters named by the LLM rather than by a tag
+	// most of their records already carried
+	Named     int `json:"named"`
+	Suggested int `json:"suggested"`
+	Failed    int `json:"failed"`
+}
+
+// Suggest clusters items, names the clusters and writes the names into the
+// tags of the records close to each cluster centre. A tag is never suggested
+// twice for the same record, so a rejected suggestion stays rejected. A
+// cluster that cannot be named is skipped and retried on the next run.
+func Suggest(ctx context.Context, client *ent.Client, items []Item, namer Namer, opts Options) (Result, error) {
+	opts = opts.normalize()
+	result := Result{Items: len(items)}
+
+	previous, err := previousSuggestions(ctx, client)
+	if err != nil {
+		return result, err
+	}
+
+	clusters := Clusters(items, opts)
+	result.Clusters = len(clusters)
+	for _, cluster := range clusters {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		tag, ok := commonTag(items, cluster.Members)
+		if !ok {
+			samples := namingSamplesOf(items, cluster.Members)
+			if len(samples) == 0 {
+				continue
+			}
+			answer, err := namer(ctx, samples)
+			if err == nil {
+				tag, err = cleanTag(answer)
+			}
+			if err != nil {
+				taggingLogger.Warn("failed to name cluster",
+					zap.String("ownerId", cluster.OwnerID),
+					zap.Int("size", len(cluster.Members)),
+					zap.Error(err),
+				)
+				continue
+			}
+			result.Named++
+		}
+
+		for _, m := range cluster.Members {
+			item := items[m.Index]
+			if m.Similarity < opts.MinSimilarity || hasTag(item.Tags, tag) || previous[suggestionKey(item.Type, item.ID, tag)] {
+				continue
+			}
+			added, err := suggest(ctx, client, item, tag, m.Similarity)
+			if err != nil {
+				taggingLogger.Warn("failed to suggest tag",
+					zap.String("type", item.Type),
+					zap.String("id", item.ID),
+					zap.String("tag", tag),
+					zap.Error(err),
+				)
+				result.Failed++
+				continue
+			}
+			if added {
+				result.Suggested++
+			}
+		}
+	}
+
+	taggingLogger.Info("tag suggestion completed",
+		zap.Int("items", result.Items),
+		zap.Int("clusters", result.Clusters),
+		zap.Int("named", result.Named),
+		zap.Int("suggested", result.Suggested),
+		zap.Int("failed", result.Failed),
+	)
+	return result, nil
+}
+
+// suggest adds tag to the tags of item and records the suggestion, in one
+// transaction. The tags are read again so edits made since loading are kept;
+// it reports false when the owner has added the tag meanwhile.
+func suggest(ctx context.Context, client *ent.Client, item Item, tag string, similarity float64) (bool, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	tags, err := loadTags(ctx, tx.Client(), item.Type, item.ID)
+	if err != nil {
+		return false, rollback(tx, fmt.Errorf("failed to load tags: %w", err))
+	}
+	if hasTag(tags, tag) {
+		return false, rollback(tx, nil)
+	}
+	if err := saveTags(ctx, tx.Client(), item.Type, item.ID, append(tags, tag)); err != nil {
+		return false, rollback(tx, fmt.Errorf("failed to save tags: %w", err))
+	}
+	err = tx.TagSuggestion.Create().
+		SetEntityType(item.Type).
+		SetEntityId(item.ID).
+		SetOwnerId(item.OwnerID).
+		SetTag(tag).
+		SetSimilarity(similarity).
+		Exec(ctx)
+	if err != nil {
+		return false, rollback(tx, fmt.Errorf("failed to record suggestion: %w", err))
+	}
+	return true, tx.Commit()
+}
+
+// previousSuggestions returns the keys of every suggestion made so far,
+// whatever its status.
+func previousSuggestions(ctx context.Context, client *ent.Client) (map[string]bool, error) {
+	rows, err := client.TagSuggestion.Query().
+		Select(tagsuggestion.FieldEntityType, tagsuggestion.FieldEntityId, tagsuggestion.FieldTag).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load previous suggestions: %w", err)
+	}
+	keys := make(map[string]bool, len(rows))
+	for _, s := range rows {
+		keys[suggestionKey(s.EntityType, s.EntityId, s.Tag)] = true
+	}
+	return keys, nil
+}
+
+func suggestionKey(entityType, id, tag string) string {
+	return entityType + "\x00" + id + "\x00" + strings.ToLower(tag)
+}
+
+// rollback rolls tx back and returns err, with the rollback failure if any.
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		if err == nil {
+			return fmt.Errorf("rollback failed: %w", rerr)
+		}
+		return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+	}
+	return err
+}
@@ -0,0 +1,274 @@
+// Package tagging suggests tags from the topics of the stored content. The
+// content vectors of each owner's keeps, moments, mindmaps and files are
+// clustered with k-means, every cluster is named, by a tag most of its
+// records already carry or else by the LLM, and the name is written into the
+// tags of the records close to the cluster centre. Every tag written is
+// recorded as a TagSuggestion, which users accept or reject.
+package tagging
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"api.us4ever/internal/dify"
+	"api.us4ever/internal/logger"
+)
+
+var taggingLogger *logger.Logger
+
+func init() {
+	var err error
+	taggingLogger, err = logger.New("tagging")
+	if err != nil {
+		panic("failed to initialize tagging logger: " + err.Error())
+	}
+}
+
+// Types of records tags are suggested for
+const (
+	EntityKeep    = "keep"
+	EntityMoment  = "moment"
+	EntityMindmap = "mindmap"
+	EntityFile    = "file"
+)
+
+// EntityTypes lists the types of records tags are suggested for.
+func EntityTypes() []string {
+	return []string{EntityKeep, EntityMoment, EntityMindmap, EntityFile}
+}
+
+// IsEntityType reports whether tags are suggested for records of entityType.
+func IsEntityType(entityType string) bool {
+	return slices.Contains(EntityTypes(), entityType)
+}
+
+const (
+	// DefaultMinClusterSize is the number of records a topic needs to be named
+	DefaultMinClusterSize = 3
+	// DefaultMaxClusters bounds the number of topics per owner
+	DefaultMaxClusters = 40
+	// DefaultMinSimilarity is the similarity to the cluster centre a record
+	// needs to be tagged; records on the edge of a cluster are left alone
+	DefaultMinSimilarity = 0.6
+
+	// maxTagRunes bounds the length of a generated tag
+	maxTagRunes = 20
+	// namingSamples is the number of records shown to the LLM to name a cluster
+	namingSamples = 8
+	// sampleRunes bounds the text of each sample
+	sampleRunes = 200
+	// clusterSeed makes the clustering reproducible between runs
+	clusterSeed = 20240501
+)
+
+// Options tunes the clustering. Zero values use the defaults.
+type Options struct {
+	MinClusterSize int
+	MaxClusters    int
+	MinSimilarity  float64
+}
+
+func (o Options) normalize() Options {
+	if o.MinClusterSize < 2 {
+		o.MinClusterSize = DefaultMinClusterSize
+	}
+	if o.MaxClusters <= 0 {
+		o.MaxClusters = DefaultMaxClusters
+	}
+	if o.MinSimilarity <= 0 || o.MinSimilarity > 1 {
+		o.MinSimilarity = DefaultMinSimilarity
+	}
+	return o
+}
+
+// Item is a record considered for tagging.
+type Item struct {
+	Type    string
+	ID      string
+	OwnerID string
+	// Text is shown to the LLM when naming the cluster of the item
+	Text   string
+	Tags   []string
+	Vector []float32
+}
+
+// Member is an item of a cluster.
+type Member struct {
+	// Index is the position of the item in the clustered slice
+	Index      int
+	Similarity float64
+}
+
+// Cluster is a topic found among the items of one owner. Members are ordered
+// by similarity to the centre, closest first.
+type Cluster struct {
+	OwnerID string
+	Members []Member
+}
+
+// Clusters groups items by topic. Items are only clustered with items of the
+// same owner and vector size, as vectors of different models do not compare.
+// The number of topics grows with the square root of the number of items.
+// Clusters smaller than the minimum size are dropped.
+func Clusters(items []Item, opts Options) []Cluster {
+	opts = opts.normalize()
+
+	type groupKey struct {
+		owner string
+		dims  int
+	}
+	groups := make(map[groupKey][]int)
+	var keys []groupKey
+	for i, item := range items {
+		if len(item.Vector) == 0 {
+			continue
+		}
+		key := groupKey{item.OwnerID, len(item.Vector)}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], i)
+	}
+
+	var clusters []Cluster
+	for _, key := range keys {
+		idx := groups[key]
+		if len(idx) < 2*opts.MinClusterSize {
+			continue
+		}
+		vectors := make([][]float32, 0, len(idx))
+		var kept []int
+		for _, i := range idx {
+			if v := unitVector(items[i].Vector); v != nil {
+				vectors = append(vectors, v)
+				kept = append(kept, i)
+			}
+		}
+		k := clusterCount(len(vectors), opts)
+		assign, similarity := kMeans(vectors, k, clusterSeed)
+
+		byCluster := make([][]Member, k)
+		for j, c := range assign {
+			byCluster[c] = append(byCluster[c], Member{Index: kept[j], Similarity: similarity[j]})
+		}
+		for _, members := range byCluster {
+			if len(members) < opts.MinClusterSize {
+				continue
+			}
+			slices.SortFunc(members, func(a, b Member) int {
+				return cmp.Or(cmp.Compare(b.Similarity, a.Similarity), cmp.Compare(a.Index, b.Index))
+			})
+			clusters = append(clusters, Cluster{OwnerID: key.owner, Members: members})
+		}
+	}
+	return clusters
+}
+
+// clusterCount picks the number of clusters for n items: sqrt(n/2), the
+// usual rule of thumb, bounded by the options.
+func clusterCount(n int, opts Options) int {
+	k := int(math.Round(math.Sqrt(float64(n) / 2)))
+	return max(1, min(k, opts.MaxClusters, n/opts.MinClusterSize))
+}
+
+// Namer names the topic of a cluster from sample texts of its records.
+type Namer func(ctx context.Context, samples []string) (string, error)
+
+// DifyNamer names topics with the tag action of the Dify workflow.
+func DifyNamer(_ context.Context, samples []string) (string, error) {
+	req := &dify.WorkflowRequest{
+		Inputs: dify.WorkflowInput{
+			Action:  dify.ActionTag,
+			Content: strings.Join(samples, "\n---\n"),
+		},
+		ResponseMode: dify.ResponseModeBlocking,
+	}
+	result, err := dify.CallWorkflow(req)
+	if err != nil {
+		return "", err
+	}
+	return result.Message, nil
+}
+
+// commonTag returns the tag carried by at least half the members of a
+// cluster, the most frequent one if several are. Tags are compared ignoring
+// case; the spelling of the first record carrying it is returned.
+func commonTag(items []Item, members []Member) (string, bool) {
+	counts := make(map[string]int)
+	spelling := make(map[string]string)
+	var order []string
+	for _, m := range members {
+		seen := make(map[string]bool)
+		for _, tag := range items[m.Index].Tags {
+			key := strings.ToLower(strings.TrimSpace(tag))
+			if key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			if _, ok := spelling[key]; !ok {
+				spelling[key] = strings.TrimSpace(tag)
+				order = append(order, key)
+			}
+			counts[key]++
+		}
+	}
+	best := ""
+	for _, key := range order {
+		if counts[key] > counts[best] {
+			best = key
+		}
+	}
+	if best == "" || 2*counts[best] < len(members) {
+		return "", false
+	}
+	return spelling[best], true
+}
+
+// namingSamplesOf returns the texts shown to the LLM for a cluster: those of
+// the records closest to its centre.
+func namingSamplesOf(items []Item, members []Member) []string {
+	var samples []string
+	for _, m := range members {
+		text := strings.TrimSpace(items[m.Index].Text)
+		if text == "" {
+			continue
+		}
+		if utf8.RuneCountInString(text) > sampleRunes {
+			text = string([]rune(text)[:sampleRunes])
+		}
+		samples = append(samples, text)
+		if len(samples) == namingSamples {
+			break
+		}
+	}
+	return samples
+}
+
+// cleanTag turns the answer of the LLM into a tag: the first line, without
+// surrounding quotes, hashes and punctuation.
+func cleanTag(answer string) (string, error) {
+	tag, _, _ := strings.Cut(strings.TrimSpace(answer), "\n")
+	tag = strings.TrimFunc(tag, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r)
+	})
+	if tag == "" {
+		return "", fmt.Errorf("empty tag in answer %q", answer)
+	}
+	if utf8.RuneCountInString(tag) > maxTagRunes {
+		return "", fmt.Errorf("tag %q is longer than %d characters", tag, maxTagRunes)
+	}
+	return tag, nil
+}
+
+// hasTag reports whether tags contains tag, ignoring case.
+func hasTag(tags []string, tag string) bool {
+	return slices.ContainsFunc(tags, func(t string) bool {
+		return strings.EqualFold(strings.TrimSpace(t), tag)
+	})
+}
@@ -0,0 +1,113 @@
+package tagging
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+// topicVector returns a vector close to the axis of topic.
+func topicVector(rng *rand.Rand, topic, dims int) []float32 {
+	v := make([]float32, dims)
+	for i := range v {
+		v[i] = float32(rng.Float64() * 0.2)
+	}
+	v[topic] += 1
+	return v
+}
+
+func TestKMeansSeparatesTopics(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	var vectors [][]float32
+	for i := range 30 {
+		vectors = append(vectors, unitVector(topicVector(rng, i%3, 8)))
+	}
+	assign, similarity := kMeans(vectors, 3, clusterSeed)
+	for i := range vectors {
+		if assign[i] != assign[i%3] {
+			t.Fatalf("vector %d of topic %d landed in cluster %d, want %d", i, i%3, assign[i], assign[i%3])
+		}
+		if similarity[i] < 0.9 {
+			t.Errorf("expected vector %d close to its centre, got %v", i, similarity[i])
+		}
+	}
+	if assign[0] == assign[1] || assign[1] == assign[2] || assign[0] == assign[2] {
+		t.Errorf("expected three distinct clusters, got %v", assign[:3])
+	}
+}
+
+func TestClusters(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	var items []Item
+	for i := range 12 {
+		items = append(items, Item{ID: fmt.Sprintf("u1-%d", i), OwnerID: "u1", Vector: topicVector(rng, i%2, 8)})
+	}
+	// 其他用户的记录太少，不聚类
+	for i := range 3 {
+		items = append(items, Item{ID: fmt.Sprintf("u2-%d", i), OwnerID: "u2", Vector: topicVector(rng, 0, 8)})
+	}
+	// 没有向量的记录被忽略
+	items = append(items, Item{ID: "none", OwnerID: "u1"})
+
+	clusters := Clusters(items, Options{})
+	if len(clusters) != 2 {
+		t.Fatalf("expected 2 clusters, got %d", len(clusters))
+	}
+	for _, c := range clusters {
+		if c.OwnerID != "u1" || len(c.Members) != 6 {
+			t.Errorf("unexpected cluster of %s with %d members", c.OwnerID, len(c.Members))
+		}
+		for i := 1; i < len(c.Members); i++ {
+			if c.Members[i].Similarity > c.Members[i-1].Similarity {
+				t.Errorf("expected members ordered by similarity, got %+v", c.Members)
+			}
+		}
+	}
+}
+
+func TestClusterCount(t *testing.T) {
+	opts := Options{}.normalize()
+	for _, tc := range []struct{ n, want int }{
+		{6, 2},
+		{50, 5},
+		{200, 10},
+		{100000, DefaultMaxClusters},
+	} {
+		if got := clusterCount(tc.n, opts); got != tc.want {
+			t.Errorf("clusterCount(%d) = %d, want %d", tc.n, got, tc.want)
+		}
+	}
+}
+
+func TestCommonTag(t *testing.T) {
+	items := []Item{
+		{Tags: []string{"Go", "notes"}},
+		{Tags: []string{"go"}},
+		{Tags: []string{"rust", "notes"}},
+		{Tags: nil},
+	}
+	members := []Member{{Index: 0}, {Index: 1}, {Index: 2}, {Index: 3}}
+	if tag, ok := commonTag(items, members); !ok || tag != "Go" {
+		t.Errorf("expected the first spelling of the most common tag, got %q %v", tag, ok)
+	}
+	if tag, ok := commonTag(items, members[1:]); ok {
+		t.Errorf("expected no tag carried by half the members, got %q", tag)
+	}
+}
+
+func TestCleanTag(t *testing.T) {
+	for answer, want := range map[string]string{
+		"旅行": "旅行",
+		"  #读书笔记\n这些笔记都与读书有关": "读书笔记",
+		`"Go 并发"。`: "Go 并发",
+	} {
+		if got, err := cleanTag(answer); err != nil || got != want {
+			t.Errorf("cleanTag(%q) = %q, %v; want %q", answer, got, err, want)
+		}
+	}
+	for _, answer := range []string{"", "「」", "这是一个非常非常长的标签，显然不是一个合适的标签"} {
+		if tag, err := cleanTag(answer); err == nil {
+			t.Errorf("expected an error for %q, got %q", answer, tag)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package dedup
+
+import (
+	"context"
+	"time"
+
+	"api.us4ever/internal/dedup"
+	"api.us4ever/internal/logger"
+	"api.us4ever/internal/server"
+	"go.uber.org/zap"
+)
+
+var duplicatesLogger *logger.Logger
+
+func init() {
+	var err error
+	duplicatesLogger, err = logger.New("duplicates")
+	if err != nil {
+		panic("failed to initialize duplicates logger: " + err.Error())
+	}
+}
+
+// FindDuplicates scans keeps and moments for near-duplicates and records the
+// reports on the server, where /internal/duplicates lists them for review.
+// Nothing is merged automatically. It returns the number of duplicates found.
+func FindDuplicates(fiberServer *server.FiberServer) (int, error) {
+	if fiberServer.DbClient == nil {
+		return 0, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	found := 0
+	for _, kind := range dedup.Kinds() {
+		report, err := dedup.Scan(ctx, fiberServer.DbClient, kind, dedup.Options{})
+		if err != nil {
+			// 单个类型失败不影响其他类型
+			duplicatesLogger.Error("duplicate scan failed",
+				zap.String("kind", kind),
+				zap.Error(err),
+			)
+			continue
+		}
+		fiberServer.Duplicates.Record(report)
+		found += report.Duplicates
+	}
+	return found, nil
+}
@@ -7,7 +7,7 @@ import (
 
 	"api.us4ever/internal/server"
 
-	"api.us4ever/internal/dify"
+	"api.us4ever/internal/enrich"
 	"api.us4ever/internal/ent/keep"
 	"api.us4ever/internal/logger"
 	"go.uber.org/zap"
@@ -35,6 +35,7 @@ func GenerateTitleAndSummary(fiberServer *server.FiberServer) (int, error) {
 	// 查询缺少 title 或 summary 的记录
 	keeps, err := db.Client().Keep.Query().
 		Where(
+			keep.ContentNEQ(""),
 			keep.Or(
 				keep.TitleEQ(""),
 				keep.SummaryEQ(""),
@@ -55,94 +56,13 @@ func GenerateTitleAndSummary(fiberServer *server.FiberServer) (int, error) {
 
 	// 处理每条记录
 	for _, k := range keeps {
-		// 生成 title
-		if k.Title == "" {
-			title, err := generateTitle(k.Content)
-			if err != nil {
-				titleSummaryLogger.Error("error generating title",
-					zap.String("keep_id", k.ID),
-					zap.Error(err),
-				)
-				continue
-			}
-
-			// 更新 title
-			_, err = k.Update().
-				SetTitle(title).
-				SetUpdatedAt(time.Now()).
-				Save(ctx)
-
-			if err != nil {
-				titleSummaryLogger.Error("error updating title",
-					zap.String("keep_id", k.ID),
-					zap.Error(err),
-				)
-				continue
-			}
-		}
-
-		// 生成 summary
-		if k.Summary == "" {
-			summary, err := generateSummary(k.Content)
-			if err != nil {
-				titleSummaryLogger.Error("error generating summary",
-					zap.String("keep_id", k.ID),
-					zap.Error(err),
-				)
-				continue
-			}
-
-			// 更新 summary
-			_, err = k.Update().
-				SetSummary(summary).
-				SetUpdatedAt(time.Now()).
-				Save(ctx)
-
-			if err != nil {
-				titleSummaryLogger.Error("error updating summary",
-					zap.String("keep_id", k.ID),
-					zap.Error(err),
-				)
-				continue
-			}
+		if _, err := enrich.TitleAndSummary(ctx, db.Client(), k); err != nil {
+			titleSummaryLogger.Error("error generating title and summary",
+				zap.String("keep_id", k.ID),
+				zap.Error(err),
+			)
 		}
 	}
 
 	return len(keeps), nil
 }
-
-// generateTitle 使用 Dify 生成标题
-func generateTitle(content string) (string, error) {
-	req := &dify.WorkflowRequest{
-		Inputs: dify.WorkflowInput{
-			Action:  dify.ActionTitle,
-			Content: content,
-		},
-		ResponseMode: dify.ResponseModeBlocking,
-	}
-
-	result, err := dify.CallWorkflow(req)
-	if err != nil {
-		return "", err
-	}
-
-	return result.Message, nil
-}
-
-// generateSummary 使用 Dify 生成摘要
-func generateSummary(content string) (string, error) {
-	req := &dify.WorkflowRequest{
-		Inputs: dify.WorkflowInput{
-			Action:  dify.ActionContent,
-			Content: content,
-		},
-		ResponseMode: dify.ResponseModeBlocking,
-	}
-
-	result, err := dify.CallWorkflow(req)
-	if err != nil {
-		return "", err
-	}
-
-	return result.Message, nil
-}
@@ -0,0 +1,63 @@
+package search
+
+import (
+	"context"
+	"time"
+
+	"api.us4ever/internal/config"
+	"api.us4ever/internal/es"
+	"api.us4ever/internal/logger"
+	"api.us4ever/internal/server"
+	"go.uber.org/zap"
+)
+
+var consistencyLogger *logger.Logger
+
+func init() {
+	var err error
+	consistencyLogger, err = logger.New("search-consistency")
+	if err != nil {
+		panic("failed to initialize search-consistency logger: " + err.Error())
+	}
+}
+
+// VerifySearchIndices compares every index with the database and records the
+// reports on the server. Differences are repaired only when
+// es.repair_inconsistencies is set; otherwise they are reported through
+// /internal/index/consistency and the Prometheus gauges. It returns the
+// number of differing documents found.
+func VerifySearchIndices(fiberServer *server.FiberServer) (int, error) {
+	if fiberServer.EsClient == nil {
+		return 0, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	opts := es.ConsistencyOptions{}
+	if appConfig := config.GetAppConfig(); appConfig != nil {
+		opts.Repair = appConfig.ES.RepairInconsistencies
+	}
+
+	found := 0
+	for _, def := range es.IndexDefinitions() {
+		alias := fiberServer.EsIndexAliases.Of(def)
+		// 重索引期间跳过，下一轮再检查
+		if fiberServer.ReindexJobs.Running(alias) {
+			continue
+		}
+		report, err := es.CheckConsistency(ctx, fiberServer.EsClient, fiberServer.DbClient.Client(), def, alias, opts)
+		if err != nil {
+			// 单个索引失败不影响其他索引
+			consistencyLogger.Error("index consistency check failed",
+				zap.String("index", def.Name),
+				zap.String("alias", alias),
+				zap.Error(err),
+			)
+			continue
+		}
+		fiberServer.ConsistencyReports.Record(report)
+		found += report.Missing + report.Orphaned + report.Stale
+	}
+	return found, nil
+}
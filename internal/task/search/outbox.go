@@ -0,0 +1,247 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/searchoutbox"
+	"api.us4ever/internal/es"
+	"api.us4ever/internal/logger"
+	searchbackend "api.us4ever/internal/search"
+	"api.us4ever/internal/server"
+	"go.uber.org/zap"
+)
+
+var (
+	outboxLogger *logger.Logger
+)
+
+func init() {
+	var err error
+	outboxLogger, err = logger.New("search-outbox")
+	if err != nil {
+		panic("failed to initialize search-outbox logger: " + err.Error())
+	}
+}
+
+const (
+	outboxBatchSize = 200 // Rows drained per task run
+	// outboxMaxAttempts stops retrying a row; it stays in the table with its
+	// last error until a full reindex makes it moot
+	outboxMaxAttempts = 10
+	outboxBaseDelay   = 5 * time.Second
+	outboxMaxDelay    = 10 * time.Minute
+)
+
+// outboxEntry is the latest pending change of one entity, together with every
+// outbox row it replaces.
+type outboxEntry struct {
+	entityID string
+	op       searchoutbox.Op
+	attempts int
+	rowIDs   []int
+}
+
+// SyncSearchOutbox applies pending search outbox rows to the aliases of the
+// indexed entity types, and to the search backend when it keeps its own copy
+// of the documents. Rows that fail are retried later with exponential backoff.
+func SyncSearchOutbox(fiberServer *server.FiberServer) (int, error) {
+	backend, release := fiberServer.Search.Acquire()
+	defer release()
+	store, _ := backend.(searchbackend.DocumentStore)
+	if fiberServer.EsClient == nil && store == nil {
+		return 0, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Second)
+	defer cancel()
+
+	client := fiberServer.DbClient.Client()
+	rows, err := client.SearchOutbox.Query().
+		Where(
+			searchoutbox.AvailableAtLTE(time.Now()),
+			searchoutbox.AttemptsLT(outboxMaxAttempts),
+		).
+		Order(ent.Asc(searchoutbox.FieldID)).
+		Limit(outboxBatchSize).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query search outbox: %w", err)
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	handled := 0
+	for entityType, entries := range latestChanges(rows) {
+		var changes []es.DocumentChange
+		var alias string
+		def, ok := es.LookupIndexByType(entityType)
+		if ok {
+			alias = fiberServer.EsIndexAliases.Of(def)
+			changes, err = loadChanges(ctx, client, def, entries)
+		} else {
+			err = fmt.Errorf("unknown entity type %q", entityType)
+		}
+
+		failed := make(map[string]error)
+		if err == nil && fiberServer.EsClient != nil {
+			failed, err = applyToIndex(ctx, fiberServer, alias, changes)
+		}
+		if err == nil && store != nil {
+			var storeFailed map[string]error
+			if storeFailed, err = store.ApplyChanges(ctx, def, changes); err == nil {
+				// 两边都成功才算同步完成
+				maps.Copy(failed, storeFailed)
+			}
+		}
+
+		var done []int
+		for _, entry := range entries {
+			entryErr := err
+			if entryErr == nil {
+				entryErr = failed[entry.entityID]
+			}
+			if entryErr == nil {
+				done = append(done, entry.rowIDs...)
+				handled++
+				continue
+			}
+			markFailed(ctx, client, entityType, entry, entryErr)
+		}
+
+		if len(done) > 0 {
+			if _, err := client.SearchOutbox.Delete().Where(searchoutbox.IDIn(done...)).Exec(ctx); err != nil {
+				// 删除失败只会导致重复同步，文档写入是幂等的
+				outboxLogger.Error("failed to delete synced outbox rows",
+					zap.String("entity_type", entityType),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+
+	return handled, nil
+}
+
+// applyToIndex writes changes to alias and, while a reindex of alias runs, to
+// the generation it builds as well: that one is filled from the database
+// without the changes drained meanwhile and replaces the alias at the end.
+func applyToIndex(ctx context.Context, fiberServer *server.FiberServer, alias string, changes []es.DocumentChange) (map[string]error, error) {
+	failed, err := es.ApplyChanges(ctx, fiberServer.EsClient, alias, changes)
+	if err != nil {
+		return nil, err
+	}
+	target, ok := fiberServer.ReindexJobs.Target(alias)
+	if !ok {
+		return failed, nil
+	}
+	targetFailed, err := es.ApplyChanges(ctx, fiberServer.EsClient, target, changes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply changes to reindex target %s: %w", target, err)
+	}
+	for id, cause := range targetFailed {
+		if _, ok := failed[id]; !ok {
+			failed[id] = fmt.Errorf("reindex target %s: %w", target, cause)
+		}
+	}
+	return failed, nil
+}
+
+// latestChanges groups rows by entity type and collapses the rows of each
+// entity into its latest change. rows must be ordered by ID.
+func latestChanges(rows []*ent.SearchOutbox) map[string][]*outboxEntry {
+	grouped := make(map[string][]*outboxEntry)
+	index := make(map[string]*outboxEntry)
+	for _, row := range rows {
+		key := row.EntityType + "/" + row.EntityId
+		entry, ok := index[key]
+		if !ok {
+			entry = &outboxEntry{entityID: row.EntityId}
+			index[key] = entry
+			grouped[row.EntityType] = append(grouped[row.EntityType], entry)
+		}
+		entry.op = row.Op
+		entry.attempts = max(entry.attempts, row.Attempts)
+		entry.rowIDs = append(entry.rowIDs, row.ID)
+	}
+	return grouped
+}
+
+// retryDelay doubles the wait after every failed attempt, up to outboxMaxDelay.
+func retryDelay(attempts int) time.Duration {
+	delay := outboxBaseDelay
+	for i := 0; i < attempts && delay < outboxMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxMaxDelay)
+}
+
+func markFailed(ctx context.Context, client *ent.Client, entityType string, entry *outboxEntry, cause error) {
+	attempts := entry.attempts + 1
+	fields := []zap.Field{
+		zap.String("entity_type", entityType),
+		zap.String("entity_id", entry.entityID),
+		zap.Int("attempts", attempts),
+		zap.Error(cause),
+	}
+	if attempts >= outboxMaxAttempts {
+		outboxLogger.Error("search sync failed, giving up", fields...)
+	} else {
+		outboxLogger.Warn("search sync failed, will retry", fields...)
+	}
+
+	err := client.SearchOutbox.Update().
+		Where(searchoutbox.IDIn(entry.rowIDs...)).
+		SetAttempts(attempts).
+		SetLastError(cause.Error()).
+		SetAvailableAt(time.Now().Add(retryDelay(entry.attempts))).
+		Exec(ctx)
+	if err != nil {
+		outboxLogger.Error("failed to update outbox rows", append(fields, zap.NamedError("update_error", err))...)
+	}
+}
+
+// upsertIDs returns the entity IDs whose latest change is an upsert.
+func upsertIDs(entries []*outboxEntry) []string {
+	var ids []string
+	for _, entry := range entries {
+		if entry.op == searchoutbox.OpUpsert {
+			ids = append(ids, entry.entityID)
+		}
+	}
+	return ids
+}
+
+// documentChanges turns entries into document writes. Upserts of entities
+// that no longer exist become deletes.
+func documentChanges(entries []*outboxEntry, documents map[string]map[string]any) []es.DocumentChange {
+	changes := make([]es.DocumentChange, 0, len(entries))
+	for _, entry := range entries {
+		change := es.DocumentChange{ID: entry.entityID}
+		if entry.op == searchoutbox.OpUpsert {
+			change.Document = documents[entry.entityID]
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// loadChanges loads the upserted entities of def and turns entries into
+// document writes.
+func loadChanges(ctx context.Context, client *ent.Client, def *es.IndexDefinition, entries []*outboxEntry) ([]es.DocumentChange, error) {
+	documents := make(map[string]map[string]any)
+	if ids := upsertIDs(entries); len(ids) > 0 {
+		docs, err := def.Fetch(ctx, client, ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s: %w", def.Name, err)
+		}
+		for _, doc := range docs {
+			documents[doc.ID] = doc.Source
+		}
+	}
+	return documentChanges(entries, documents), nil
+}
@@ -0,0 +1,58 @@
+package search
+
+import (
+	"testing"
+
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/searchoutbox"
+)
+
+func TestLatestChanges(t *testing.T) {
+	rows := []*ent.SearchOutbox{
+		{ID: 1, EntityType: "keep", EntityId: "a", Op: searchoutbox.OpUpsert},
+		{ID: 2, EntityType: "moment", EntityId: "a", Op: searchoutbox.OpUpsert},
+		{ID: 3, EntityType: "keep", EntityId: "a", Op: searchoutbox.OpDelete, Attempts: 2},
+		{ID: 4, EntityType: "keep", EntityId: "b", Op: searchoutbox.OpUpsert},
+	}
+
+	grouped := latestChanges(rows)
+	keeps := grouped["keep"]
+	if len(keeps) != 2 || len(grouped["moment"]) != 1 {
+		t.Fatalf("unexpected grouping: %+v", grouped)
+	}
+	if keeps[0].entityID != "a" || keeps[0].op != searchoutbox.OpDelete {
+		t.Errorf("expected the latest op of keep a to win, got %+v", keeps[0])
+	}
+	if keeps[0].attempts != 2 || len(keeps[0].rowIDs) != 2 {
+		t.Errorf("expected both rows of keep a to be collapsed, got %+v", keeps[0])
+	}
+}
+
+func TestDocumentChanges_MissingEntityIsDeleted(t *testing.T) {
+	entries := []*outboxEntry{
+		{entityID: "a", op: searchoutbox.OpUpsert},
+		{entityID: "b", op: searchoutbox.OpUpsert},
+		{entityID: "c", op: searchoutbox.OpDelete},
+	}
+	documents := map[string]map[string]any{"a": {"title": "a"}}
+
+	changes := documentChanges(entries, documents)
+	if changes[0].Document == nil {
+		t.Error("expected a to be indexed")
+	}
+	if changes[1].Document != nil || changes[2].Document != nil {
+		t.Error("expected b and c to be deleted")
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	if got := retryDelay(0); got != outboxBaseDelay {
+		t.Errorf("expected %v, got %v", outboxBaseDelay, got)
+	}
+	if got := retryDelay(2); got != 4*outboxBaseDelay {
+		t.Errorf("expected %v, got %v", 4*outboxBaseDelay, got)
+	}
+	if got := retryDelay(100); got != outboxMaxDelay {
+		t.Errorf("expected the delay to be capped at %v, got %v", outboxMaxDelay, got)
+	}
+}
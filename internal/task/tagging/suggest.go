@@ -0,0 +1,51 @@
+package tagging
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"api.us4ever/internal/embedding"
+	"api.us4ever/internal/logger"
+	"api.us4ever/internal/server"
+	"api.us4ever/internal/tagging"
+	"go.uber.org/zap"
+)
+
+var suggestLogger *logger.Logger
+
+func init() {
+	var err error
+	suggestLogger, err = logger.New("tag-suggestion")
+	if err != nil {
+		panic("failed to initialize tag-suggestion logger: " + err.Error())
+	}
+}
+
+// SuggestTags clusters the content of every owner by topic and writes the
+// topic names into the tags of the records, marked as suggestions to review
+// through /internal/tags/suggestions. It returns the number of tags suggested.
+func SuggestTags(fiberServer *server.FiberServer) (int, error) {
+	if fiberServer.DbClient == nil {
+		return 0, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	// 没有向量服务时只对已存向量的 keep 和 moment 聚类
+	embedder, err := embedding.Default()
+	if err != nil {
+		suggestLogger.Warn("embedding service unavailable, mindmaps and files are skipped", zap.Error(err))
+	}
+
+	items, err := tagging.LoadItems(ctx, fiberServer.DbClient, embedder)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load records: %w", err)
+	}
+	result, err := tagging.Suggest(ctx, fiberServer.DbClient.Client(), items, tagging.DifyNamer, tagging.Options{})
+	if err != nil {
+		return result.Suggested, fmt.Errorf("failed to suggest tags: %w", err)
+	}
+	return result.Suggested, nil
+}
@@ -2,9 +2,13 @@ package task
 
 import (
 	"api.us4ever/internal/server"
+	"api.us4ever/internal/task/dedup"
 	"api.us4ever/internal/task/image"
 	"api.us4ever/internal/task/keep"
+	"api.us4ever/internal/task/search"
+	"api.us4ever/internal/task/tagging"
 	"api.us4ever/internal/task/telegram"
+	"api.us4ever/internal/task/vector"
 )
 
 // RegisterTasks 注册所有定时任务
@@ -28,17 +32,47 @@ func RegisterTasks(scheduler *Scheduler, fiberServer *server.FiberServer) error
 		return err
 	}
 
+	// 每 5s 将 outbox 中的变更同步到 ES
+	err = scheduler.AddTaskWithServer("sync_search_outbox", "*/5 * * * * *", search.SyncSearchOutbox, fiberServer)
+	if err != nil {
+		return err
+	}
+
+	// 每小时比对一次数据库与 ES 索引
+	err = scheduler.AddTaskWithServer("verify_search_indices", "0 17 * * * *", search.VerifySearchIndices, fiberServer)
+	if err != nil {
+		return err
+	}
+
+	// 每小时查找一次重复的 keep 和 moment，只报告不合并
+	err = scheduler.AddTaskWithServer("find_duplicates", "0 43 * * * *", dedup.FindDuplicates, fiberServer)
+	if err != nil {
+		return err
+	}
+
+	// 每天凌晨按主题聚类并写入建议标签，等待用户审核
+	err = scheduler.AddTaskWithServer("suggest_tags", "0 20 4 * * *", tagging.SuggestTags, fiberServer)
+	if err != nil {
+		return err
+	}
+
 	// the embedding moment task (runs every 60 seconds)
-	//err = scheduler.AddTaskWithServer("embedding_moments", "0 * * * * *", vector.EmbeddingMoments, fiberServer)
-	//if err != nil {
-	//	return err
-	//}
+	err = scheduler.AddTaskWithServer("embedding_moments", "0 * * * * *", vector.EmbeddingMoments, fiberServer)
+	if err != nil {
+		return err
+	}
 
 	// the embedding keep task (runs every 60 seconds)
-	//err = scheduler.AddTaskWithServer("embedding_keeps", "0 * * * * *", vector.EmbeddingKeeps, fiberServer)
-	//if err != nil {
-	//	return err
-	//}
+	err = scheduler.AddTaskWithServer("embedding_keeps", "0 * * * * *", vector.EmbeddingKeeps, fiberServer)
+	if err != nil {
+		return err
+	}
+
+	// the keep chunk task: re-chunks changed keeps and embeds their passages (runs every 60 seconds)
+	err = scheduler.AddTaskWithServer("embedding_keep_chunks", "30 * * * * *", vector.EmbeddingKeepChunks, fiberServer)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -2,12 +2,13 @@ package vector
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"time"
 
+	"api.us4ever/internal/embedding"
+	"api.us4ever/internal/enrich"
 	"api.us4ever/internal/ent/keep"
 	"api.us4ever/internal/ent/moment"
-	"api.us4ever/internal/es"
 	"api.us4ever/internal/logger"
 	"api.us4ever/internal/server"
 	"go.uber.org/zap"
@@ -25,82 +26,64 @@ func init() {
 	}
 }
 
+// EmbeddingMoments embeds the content of the moments that have none. It
+// catches up on what the enrichment queue missed: moments written elsewhere,
+// dropped from a full queue, failed, or still waiting at shutdown.
 func EmbeddingMoments(fiberServer *server.FiberServer) (int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Hour)
 	defer cancel()
 
-	db := fiberServer.DbClient
-	records, err := db.Client().Moment.Query().
+	client := fiberServer.DbClient.Client()
+	ids, err := client.Moment.Query().
 		Where(
 			moment.ContentNEQ(""),
 			moment.ContentVectorIsNil(),
 		).
-		All(ctx)
+		IDs(ctx)
 	if err != nil {
 		return 0, err
 	}
 
-	if len(records) > 0 {
+	if len(ids) > 0 {
 		embeddingLogger.Info("found moments to process for embedding",
-			zap.Int("count", len(records)),
+			zap.Int("count", len(ids)),
 		)
 	}
 
 	handledCount := 0
-
-	for _, record := range records {
-		vector, err := es.Embed(ctx, record.Content)
-		if err != nil {
-			// Handle error
-			embeddingLogger.Error("error embedding content for moment record",
-				zap.String("record_id", record.ID),
-				zap.Error(err),
-			)
-			continue
-		}
-		// Convert vector to JSON format
-		contentVector, err := json.Marshal(vector)
-		if err != nil {
-			// Handle error
-			embeddingLogger.Error("error marshalling vector for moment record",
-				zap.String("record_id", record.ID),
-				zap.Error(err),
-			)
-			continue
-		}
-		_, err = record.Update().SetContentVector(contentVector).Save(ctx)
-		if err != nil {
-			// Handle error
-			embeddingLogger.Error("error updating content vector for moment record",
-				zap.String("record_id", record.ID),
+	for _, id := range ids {
+		if err := enrich.Moment(ctx, client, id); err != nil {
+			embeddingLogger.Error("error embedding moment record",
+				zap.String("record_id", id),
 				zap.Error(err),
 			)
+			if errors.Is(err, embedding.ErrUnavailable) {
+				// 服务不可用时不再逐条重试，等下一轮
+				break
+			}
 			continue
 		}
 		handledCount++
 	}
 
-	// 重建 es 索引
-	if handledCount > 0 {
-		err = es.IndexMoments(ctx, fiberServer.EsClient, fiberServer.DbClient, fiberServer.MomentEsIndexAlias)
-	}
-	if err != nil {
-		return 0, err
-	}
+	// 向量更新会通过 search outbox 增量同步到 es
 	return handledCount, nil
 }
 
+// EmbeddingKeeps embeds the title, summary and content of the keeps that have
+// text but no vector for it, catching up on what the enrichment queue missed.
 func EmbeddingKeeps(fiberServer *server.FiberServer) (int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Hour)
 	defer cancel()
 
-	db := fiberServer.DbClient
-	records, err := db.Client().Keep.Query().
+	client := fiberServer.DbClient.Client()
+	records, err := client.Keep.Query().
 		Where(
+			// 没有文本的字段不会有向量，不能作为待处理的条件
 			keep.Or(
-				keep.TitleVectorIsNil(),
-				keep.SummaryVectorIsNil(),
-				keep.ContentVectorIsNil(),
+				keep.And(keep.TitleVectorIsNil(), keep.TitleNEQ("")),
+				keep.And(keep.SummaryVectorIsNil(), keep.SummaryNEQ("")),
+				keep.And(keep.ContentVectorIsNil(), keep.ContentNEQ("")),
 			),
 		).
 		All(ctx)
@@ -115,97 +98,21 @@ func EmbeddingKeeps(fiberServer *server.FiberServer) (int, error) {
 	}
 
 	handledCount := 0
-
 	for _, record := range records {
-		if record.TitleVector == nil && record.Title != "" {
-			vector, err := es.Embed(ctx, record.Title)
-			if err != nil {
-				embeddingLogger.Error("error embedding title for keep record",
-					zap.String("record_id", record.ID),
-					zap.Error(err),
-				)
-				continue
-			}
-			titleVector, err := json.Marshal(vector)
-			if err != nil {
-				embeddingLogger.Error("error marshalling title vector for keep record",
-					zap.String("record_id", record.ID),
-					zap.Error(err),
-				)
-				continue
-			}
-			_, err = record.Update().SetTitleVector(titleVector).Save(ctx)
-			if err != nil {
-				embeddingLogger.Error("error updating title vector for keep record",
-					zap.String("record_id", record.ID),
-					zap.Error(err),
-				)
-				continue
-			}
-		}
-		if record.SummaryVector == nil && record.Summary != "" {
-			vector, err := es.Embed(ctx, record.Summary)
-			if err != nil {
-				embeddingLogger.Error("error embedding summary for keep record",
-					zap.String("record_id", record.ID),
-					zap.Error(err),
-				)
-				continue
-			}
-			summaryVector, err := json.Marshal(vector)
-			if err != nil {
-				embeddingLogger.Error("error marshalling summary vector for keep record",
-					zap.String("record_id", record.ID),
-					zap.Error(err),
-				)
-				continue
-			}
-			_, err = record.Update().SetSummaryVector(summaryVector).Save(ctx)
-			if err != nil {
-				embeddingLogger.Error("error updating summary vector for keep record",
-					zap.String("record_id", record.ID),
-					zap.Error(err),
-				)
-				continue
-			}
-		}
-		if record.ContentVector == nil && record.Content != "" {
-			vector, err := es.Embed(ctx, record.Content)
-			if err != nil {
-				embeddingLogger.Error("error embedding content for keep record",
-					zap.String("record_id", record.ID),
-					zap.Error(err),
-				)
-				continue
-			}
-			contentVector, err := json.Marshal(vector)
-			if err != nil {
-				embeddingLogger.Error("error marshalling content vector for keep record",
-					zap.String("record_id", record.ID),
-					zap.Error(err),
-				)
-				continue
-			}
-			_, err = record.Update().SetContentVector(contentVector).Save(ctx)
-			if err != nil {
-				embeddingLogger.Error("error updating content vector for keep record",
-					zap.String("record_id", record.ID),
-					zap.Error(err),
-				)
-				continue
+		if err := enrich.Vectors(ctx, client, record); err != nil {
+			embeddingLogger.Error("error embedding keep record",
+				zap.String("record_id", record.ID),
+				zap.Error(err),
+			)
+			if errors.Is(err, embedding.ErrUnavailable) {
+				// 服务不可用时不再逐条重试，等下一轮
+				break
 			}
+			continue
 		}
 		handledCount++
 	}
 
-	// 重建 es 索引
-	if handledCount > 0 {
-		err = es.IndexKeeps(ctx, fiberServer.EsClient, fiberServer.DbClient, fiberServer.KeepEsIndexAlias)
-	}
-
-	if err != nil {
-		return 0, err
-	}
-
+	// 向量更新会通过 search outbox 增量同步到 es
 	return handledCount, nil
 }
@@ -0,0 +1,223 @@
+package vector
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"api.us4ever/internal/chunk"
+	"api.us4ever/internal/embedding"
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/keep"
+	"api.us4ever/internal/ent/keepchunk"
+	"api.us4ever/internal/es"
+	"api.us4ever/internal/server"
+	"go.uber.org/zap"
+)
+
+// keepChunkOptions keep a chunk well inside the context of the embedding model.
+var keepChunkOptions = chunk.Options{
+	MaxRunes:     chunk.DefaultMaxRunes,
+	OverlapRunes: chunk.DefaultOverlapRunes,
+}
+
+// EmbeddingKeepChunks cuts the content of every new or changed keep into
+// passages at Markdown headings and sentence boundaries, stores them as keep
+// chunks and embeds the chunks that have no vector yet. A passage whose text
+// did not change keeps its vector. It returns the number of chunks embedded.
+func EmbeddingKeepChunks(fiberServer *server.FiberServer) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Hour)
+	defer cancel()
+
+	client := fiberServer.DbClient.Client()
+	if err := rechunkKeeps(ctx, client); err != nil {
+		return 0, err
+	}
+	// 分块变化会通过 search outbox 增量同步到 es
+	return embedKeepChunks(ctx, client)
+}
+
+// rechunkKeeps rebuilds the chunks of the keeps changed since they were
+// chunked and drops the chunks of deleted or emptied keeps.
+func rechunkKeeps(ctx context.Context, client *ent.Client) error {
+	keeps, err := client.Keep.Query().
+		Where(keep.ContentNEQ("")).
+		Select(keep.FieldID, keep.FieldUpdatedAt).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	chunked, err := client.KeepChunk.Query().
+		Select(keepchunk.FieldKeepId, keepchunk.FieldKeepUpdatedAt).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	chunkedAt := make(map[string]time.Time, len(chunked))
+	for _, c := range chunked {
+		chunkedAt[c.KeepId] = c.KeepUpdatedAt
+	}
+
+	var stale []string
+	for _, k := range keeps {
+		at, ok := chunkedAt[k.ID]
+		if !ok || !at.Equal(k.UpdatedAt) {
+			stale = append(stale, k.ID)
+		}
+		delete(chunkedAt, k.ID)
+	}
+	// 剩下的是已删除或内容被清空的 keep
+	if len(chunkedAt) > 0 {
+		orphans := make([]string, 0, len(chunkedAt))
+		for id := range chunkedAt {
+			orphans = append(orphans, id)
+		}
+		if _, err := client.KeepChunk.Delete().Where(keepchunk.KeepIdIn(orphans...)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete chunks of removed keeps: %w", err)
+		}
+	}
+
+	if len(stale) > 0 {
+		embeddingLogger.Info("found keeps to chunk",
+			zap.Int("count", len(stale)),
+			zap.Int("orphaned", len(chunkedAt)),
+		)
+	}
+	for _, id := range stale {
+		record, err := client.Keep.Get(ctx, id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if err := rechunkKeep(ctx, client, record); err != nil {
+			embeddingLogger.Error("error chunking keep record",
+				zap.String("record_id", id),
+				zap.Error(err),
+			)
+		}
+	}
+	return nil
+}
+
+// rechunkKeep replaces the chunks of one keep in a transaction, carrying the
+// vectors of unchanged passages over.
+func rechunkKeep(ctx context.Context, client *ent.Client, record *ent.Keep) error {
+	previous, err := client.KeepChunk.Query().
+		Where(keepchunk.KeepId(record.ID), keepchunk.VectorNotNil()).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	vectors := make(map[string]json.RawMessage, len(previous))
+	for _, c := range previous {
+		vectors[c.ContentHash] = c.Vector
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.KeepChunk.Delete().Where(keepchunk.KeepId(record.ID)).Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	passages := chunk.SplitMarkdown(record.Content, keepChunkOptions)
+	if len(passages) == 0 {
+		// 只有标题的笔记按原文切分，标题本身就是内容
+		passages = chunk.Split(record.Content, keepChunkOptions)
+	}
+	builders := make([]*ent.KeepChunkCreate, 0, len(passages))
+	for i, p := range passages {
+		hash := contentHash(p.EmbeddingText())
+		b := tx.KeepChunk.Create().
+			SetKeepId(record.ID).
+			SetOrdinal(i).
+			SetHeading(p.Heading).
+			SetContent(p.Text).
+			SetStart(p.Start).
+			SetEnd(p.End).
+			SetContentHash(hash).
+			SetKeepUpdatedAt(record.UpdatedAt)
+		if v, ok := vectors[hash]; ok {
+			b.SetVector(v)
+		}
+		builders = append(builders, b)
+	}
+	if err := tx.KeepChunk.CreateBulk(builders...).Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+	}
+	return err
+}
+
+// embedKeepChunks embeds the chunks without a vector.
+func embedKeepChunks(ctx context.Context, client *ent.Client) (int, error) {
+	records, err := client.KeepChunk.Query().
+		Where(keepchunk.VectorIsNil()).
+		Order(ent.Asc(keepchunk.FieldKeepId), ent.Asc(keepchunk.FieldOrdinal)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(records) > 0 {
+		embeddingLogger.Info("found keep chunks to process for embedding",
+			zap.Int("count", len(records)),
+		)
+	}
+
+	handledCount := 0
+	for _, record := range records {
+		passage := chunk.Passage{Text: record.Content, Heading: record.Heading}
+		vector, err := es.Embed(ctx, passage.EmbeddingText())
+		if err != nil {
+			embeddingLogger.Error("error embedding keep chunk",
+				zap.String("keep_id", record.KeepId),
+				zap.Int("ordinal", record.Ordinal),
+				zap.Error(err),
+			)
+			if errors.Is(err, embedding.ErrUnavailable) {
+				// 服务不可用时不再逐条重试，等下一轮
+				break
+			}
+			continue
+		}
+		chunkVector, err := json.Marshal(vector)
+		if err != nil {
+			embeddingLogger.Error("error marshalling vector for keep chunk",
+				zap.String("keep_id", record.KeepId),
+				zap.Int("ordinal", record.Ordinal),
+				zap.Error(err),
+			)
+			continue
+		}
+		if _, err := record.Update().SetVector(chunkVector).Save(ctx); err != nil {
+			embeddingLogger.Error("error updating vector for keep chunk",
+				zap.String("keep_id", record.KeepId),
+				zap.Int("ordinal", record.Ordinal),
+				zap.Error(err),
+			)
+			continue
+		}
+		handledCount++
+	}
+	return handledCount, nil
+}
+
+// contentHash identifies the text a chunk vector was computed from.
+func contentHash(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
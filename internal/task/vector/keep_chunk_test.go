@@ -0,0 +1,140 @@
+package vector
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/enttest"
+	"api.us4ever/internal/ent/keepchunk"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func openTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func createKeep(t *testing.T, client *ent.Client, id, content string, updatedAt time.Time) *ent.Keep {
+	t.Helper()
+	k, err := client.Keep.Create().
+		SetID(id).
+		SetTitle("").
+		SetContent(content).
+		SetIsPublic(false).
+		SetTags(json.RawMessage(`[]`)).
+		SetCreatedAt(updatedAt).
+		SetUpdatedAt(updatedAt).
+		SetCategory("default").
+		SetViews(0).
+		SetLikes(0).
+		SetSummary("").
+		SetExtraData(json.RawMessage(`{}`)).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func keepChunks(t *testing.T, client *ent.Client, keepID string) []*ent.KeepChunk {
+	t.Helper()
+	chunks, err := client.KeepChunk.Query().
+		Where(keepchunk.KeepId(keepID)).
+		Order(ent.Asc(keepchunk.FieldOrdinal)).
+		All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return chunks
+}
+
+func TestRechunkKeep(t *testing.T) {
+	ctx := context.Background()
+	client := openTestClient(t)
+	updatedAt := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	// 每节都足够长，不会与相邻的节合并成一段
+	install := "# 安装\n\n" + strings.Repeat("下载安装包并解压。", 30)
+	k := createKeep(t, client, "k1", install+"\n\n# 使用\n\n"+strings.Repeat("运行命令即可。", 40), updatedAt)
+
+	if err := rechunkKeep(ctx, client, k); err != nil {
+		t.Fatal(err)
+	}
+	chunks := keepChunks(t, client, "k1")
+	if len(chunks) != 2 {
+		t.Fatalf("expected one chunk per section, got %d", len(chunks))
+	}
+	for i, c := range chunks {
+		if c.Ordinal != i || !c.KeepUpdatedAt.Equal(updatedAt) || c.Vector != nil || c.ContentHash == "" {
+			t.Errorf("unexpected chunk %d: %+v", i, c)
+		}
+	}
+	if chunks[0].Heading != "安装" || !strings.Contains(chunks[0].Content, "下载安装包") {
+		t.Errorf("unexpected first chunk %q: %q", chunks[0].Heading, chunks[0].Content)
+	}
+	if chunks[1].Heading != "使用" || !strings.Contains(chunks[1].Content, "运行命令") {
+		t.Errorf("unexpected second chunk %q: %q", chunks[1].Heading, chunks[1].Content)
+	}
+
+	// 未改动段落的向量在重新切分后保留
+	vector := json.RawMessage(`[0.1,0.2]`)
+	if err := client.KeepChunk.UpdateOne(chunks[0]).SetVector(vector).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	k, err := k.Update().
+		SetContent(install + "\n\n# 使用\n\n" + strings.Repeat("运行新的命令。", 40)).
+		SetUpdatedAt(updatedAt.Add(time.Hour)).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rechunkKeep(ctx, client, k); err != nil {
+		t.Fatal(err)
+	}
+	chunks = keepChunks(t, client, "k1")
+	if len(chunks) != 2 {
+		t.Fatalf("expected 2 chunks after the edit, got %d", len(chunks))
+	}
+	if string(chunks[0].Vector) != string(vector) {
+		t.Errorf("expected the unchanged section to keep its vector, got %s", chunks[0].Vector)
+	}
+	if chunks[1].Vector != nil || !strings.Contains(chunks[1].Content, "新的命令") {
+		t.Errorf("expected the edited section rechunked without a vector, got %+v", chunks[1])
+	}
+	if !chunks[1].KeepUpdatedAt.Equal(updatedAt.Add(time.Hour)) {
+		t.Errorf("expected the chunks stamped with the new updatedAt, got %v", chunks[1].KeepUpdatedAt)
+	}
+}
+
+func TestRechunkKeeps(t *testing.T) {
+	ctx := context.Background()
+	client := openTestClient(t)
+	updatedAt := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	createKeep(t, client, "k1", "第一条笔记。", updatedAt)
+	gone := createKeep(t, client, "k2", "会被删除的笔记。", updatedAt)
+
+	if err := rechunkKeeps(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	if len(keepChunks(t, client, "k1")) != 1 || len(keepChunks(t, client, "k2")) != 1 {
+		t.Fatal("expected every keep with content to be chunked")
+	}
+
+	if err := client.Keep.DeleteOne(gone).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := rechunkKeeps(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(keepChunks(t, client, "k2")); n != 0 {
+		t.Errorf("expected the chunks of the deleted keep dropped, got %d", n)
+	}
+	if n := len(keepChunks(t, client, "k1")); n != 1 {
+		t.Errorf("expected the other keep to keep its chunk, got %d", n)
+	}
+}
@@ -0,0 +1,133 @@
+package todos
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/todo"
+)
+
+// SortKey is the key of the manual position in the extraData of a todo.
+const SortKey = "sort"
+
+var (
+	// ErrNotFound is returned when some of the todos do not exist.
+	ErrNotFound = errors.New("todo not found")
+	// ErrMixedOwners is returned when todos of several owners are reordered together.
+	ErrMixedOwners = errors.New("todos belong to different owners")
+)
+
+// Complete sets the status of the todos ids, all or none, and returns the
+// number of todos changed. Todos already in that status are left alone.
+func Complete(ctx context.Context, client *ent.Client, ids []string, done bool) (int, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	if err := checkFound(ctx, tx, ids); err != nil {
+		return 0, rollback(tx, err)
+	}
+	n, err := tx.Todo.Update().
+		Where(todo.IDIn(ids...), todo.StatusNEQ(done)).
+		SetStatus(done).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return 0, rollback(tx, fmt.Errorf("failed to update todos: %w", err))
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit: %w", err)
+	}
+	return n, nil
+}
+
+// Reorder stores the position of each of ids in its extraData, in the order
+// given. Todos left out keep their position. The todos must share an owner.
+func Reorder(ctx context.Context, client *ent.Client, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	rows, err := tx.Todo.Query().
+		Where(todo.IDIn(ids...)).
+		Select(todo.FieldID, todo.FieldOwnerId, todo.FieldExtraData).
+		All(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to load todos: %w", err))
+	}
+	if err := missing(ids, rows); err != nil {
+		return rollback(tx, err)
+	}
+	for _, t := range rows[1:] {
+		if t.OwnerId != rows[0].OwnerId {
+			return rollback(tx, ErrMixedOwners)
+		}
+	}
+
+	for _, t := range rows {
+		extra, err := withSort(t.ExtraData, slices.Index(ids, t.ID))
+		if err != nil {
+			return rollback(tx, fmt.Errorf("todo %s: %w", t.ID, err))
+		}
+		// 调整顺序不算编辑，不更新 updatedAt
+		if err := tx.Todo.UpdateOneID(t.ID).SetExtraData(extra).Exec(ctx); err != nil {
+			return rollback(tx, fmt.Errorf("failed to update todo %s: %w", t.ID, err))
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+	return nil
+}
+
+// withSort returns extraData with its SortKey set to position, keeping the
+// other keys.
+func withSort(extraData json.RawMessage, position int) (json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if len(extraData) > 0 && string(extraData) != "null" {
+		if err := json.Unmarshal(extraData, &fields); err != nil {
+			return nil, fmt.Errorf("extraData is not a JSON object: %w", err)
+		}
+	}
+	fields[SortKey] = json.RawMessage(fmt.Sprint(position))
+	return json.Marshal(fields)
+}
+
+func checkFound(ctx context.Context, tx *ent.Tx, ids []string) error {
+	rows, err := tx.Todo.Query().Where(todo.IDIn(ids...)).Select(todo.FieldID).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load todos: %w", err)
+	}
+	return missing(ids, rows)
+}
+
+// missing fails with ErrNotFound, naming them, when some of ids are not in rows.
+func missing(ids []string, rows []*ent.Todo) error {
+	var absent []string
+	for _, id := range ids {
+		if !slices.ContainsFunc(rows, func(t *ent.Todo) bool { return t.ID == id }) {
+			absent = append(absent, id)
+		}
+	}
+	if len(absent) > 0 {
+		return fmt.Errorf("%s: %w", strings.Join(absent, ", "), ErrNotFound)
+	}
+	return nil
+}
+
+// rollback rolls tx back and returns err, with the rollback failure if any.
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+	}
+	return err
+}
@@ -0,0 +1,156 @@
+package todos
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"api.us4ever/internal/ent/todo"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+func TestRange(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skip("time zone database unavailable:", err)
+	}
+	// 2026-03-01 23:30 UTC 已是上海的 3 月 2 日
+	now := time.Date(2026, 3, 1, 23, 30, 0, 0, time.UTC)
+	today := time.Date(2026, 3, 2, 0, 0, 0, 0, shanghai)
+	tomorrow := time.Date(2026, 3, 3, 0, 0, 0, 0, shanghai)
+
+	for _, tc := range []struct {
+		view     string
+		from, to time.Time
+	}{
+		{ViewOverdue, time.Time{}, today},
+		{ViewToday, today, tomorrow},
+		{ViewUpcoming, tomorrow, time.Date(2026, 3, 10, 0, 0, 0, 0, shanghai)},
+		{ViewPinned, time.Time{}, time.Time{}},
+	} {
+		from, to := Range(tc.view, now, shanghai, DefaultUpcomingDays)
+		if !from.Equal(tc.from) || !to.Equal(tc.to) {
+			t.Errorf("Range(%s) = %v, %v; want %v, %v", tc.view, from, to, tc.from, tc.to)
+		}
+	}
+
+	// 同一时刻在 UTC 仍是 3 月 1 日
+	from, _ := Range(ViewToday, now, time.UTC, DefaultUpcomingDays)
+	if want := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC); !from.Equal(want) {
+		t.Errorf("expected today to start at %v in UTC, got %v", want, from)
+	}
+}
+
+func TestRangeAcrossDaylightSaving(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database unavailable:", err)
+	}
+	// 2026-03-08 是纽约切换夏令时的日子，这一天只有 23 小时
+	now := time.Date(2026, 3, 8, 12, 0, 0, 0, newYork)
+	from, to := Range(ViewToday, now, newYork, DefaultUpcomingDays)
+	if got := to.Sub(from); got != 23*time.Hour {
+		t.Errorf("expected a 23 hour day, got %v", got)
+	}
+	if to.Hour() != 0 || to.Day() != 9 {
+		t.Errorf("expected tomorrow to start at local midnight, got %v", to)
+	}
+}
+
+func TestWhereInUTC(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skip("time zone database unavailable:", err)
+	}
+	now := time.Date(2026, 3, 1, 23, 30, 0, 0, time.UTC)
+
+	selector := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table(todo.Table))
+	for _, p := range Where(ViewToday, now, shanghai, DefaultUpcomingDays) {
+		p(selector)
+	}
+	_, args := selector.Query()
+
+	// 上海的 3 月 2 日从 UTC 3 月 1 日 16:00 开始
+	want := []time.Time{
+		time.Date(2026, 3, 1, 16, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 2, 16, 0, 0, 0, time.UTC),
+	}
+	var bounds []time.Time
+	for _, arg := range args {
+		if bound, ok := arg.(time.Time); ok {
+			bounds = append(bounds, bound)
+		}
+	}
+	if len(bounds) != len(want) {
+		t.Fatalf("expected %d time bounds, got %v", len(want), args)
+	}
+	for i, bound := range bounds {
+		if bound.Location() != time.UTC || !bound.Equal(want[i]) {
+			t.Errorf("bound %d = %v, want %v in UTC", i, bound, want[i])
+		}
+	}
+}
+
+func TestParseDueDate(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skip("time zone database unavailable:", err)
+	}
+	for v, want := range map[string]time.Time{
+		// 日期按 tz 的零点解释
+		"2026-03-02":                time.Date(2026, 3, 1, 16, 0, 0, 0, time.UTC),
+		"2026-03-02T09:00:00+08:00": time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC),
+		"2026-03-02T09:00:00Z":      time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+	} {
+		got, err := ParseDueDate(v, shanghai)
+		if err != nil {
+			t.Errorf("ParseDueDate(%q): %v", v, err)
+			continue
+		}
+		if got.Location() != time.UTC || !got.Equal(want) {
+			t.Errorf("ParseDueDate(%q) = %v, want %v in UTC", v, got, want)
+		}
+	}
+	if _, err := ParseDueDate("tomorrow", shanghai); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
+
+func TestIsView(t *testing.T) {
+	for _, view := range Views() {
+		if !IsView(view) {
+			t.Errorf("expected %s to be a view", view)
+		}
+	}
+	if IsView("") || IsView("later") {
+		t.Error("expected unknown views to be rejected")
+	}
+	if Where("", time.Now(), time.UTC, DefaultUpcomingDays) != nil {
+		t.Error("expected no predicates without a view")
+	}
+}
+
+func TestWithSort(t *testing.T) {
+	extra, err := withSort(json.RawMessage(`{"color":"red","sort":9}`), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(extra, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if fields["color"] != "red" || fields[SortKey] != float64(2) {
+		t.Errorf("expected other keys kept and the position replaced, got %s", extra)
+	}
+
+	for _, raw := range []string{"", "null", "{}"} {
+		extra, err := withSort(json.RawMessage(raw), 0)
+		if err != nil || string(extra) != `{"sort":0}` {
+			t.Errorf("withSort(%q) = %s, %v", raw, extra, err)
+		}
+	}
+	if _, err := withSort(json.RawMessage(`[1]`), 0); err == nil {
+		t.Error("expected an error for extraData that is not an object")
+	}
+}
@@ -0,0 +1,134 @@
+// Package todos holds what the todo API computes beyond plain CRUD: the
+// date-based list views, bulk completion and manual ordering.
+//
+// Views are computed from dueDate in the time zone of the user, so "today"
+// starts at their local midnight. Manual positions are stored in the
+// extraData of each todo under SortKey, since the todos table has no column
+// for them.
+package todos
+
+import (
+	"fmt"
+	"time"
+
+	"api.us4ever/internal/ent/predicate"
+	"api.us4ever/internal/ent/todo"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// ViewOverdue lists open todos due before today
+	ViewOverdue = "overdue"
+	// ViewToday lists open todos due today
+	ViewToday = "today"
+	// ViewUpcoming lists open todos due in the next days, from tomorrow on
+	ViewUpcoming = "upcoming"
+	// ViewPinned lists pinned todos, open ones first
+	ViewPinned = "pinned"
+
+	// DefaultUpcomingDays is the number of days ViewUpcoming covers by default
+	DefaultUpcomingDays = 7
+	// MaxUpcomingDays bounds the days ViewUpcoming covers
+	MaxUpcomingDays = 365
+)
+
+// Views returns every list view.
+func Views() []string {
+	return []string{ViewOverdue, ViewToday, ViewUpcoming, ViewPinned}
+}
+
+// IsView reports whether view is a known list view.
+func IsView(view string) bool {
+	switch view {
+	case ViewOverdue, ViewToday, ViewUpcoming, ViewPinned:
+		return true
+	}
+	return false
+}
+
+// Range returns the due dates covered by a dated view at now in loc, from
+// included to excluded. A zero bound is open; both are zero for views not
+// based on the due date.
+func Range(view string, now time.Time, loc *time.Location, upcomingDays int) (from, to time.Time) {
+	local := now.In(loc)
+	// 用 time.Date 计算日界，夏令时切换当天也是当地的零点
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	tomorrow := time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, loc)
+
+	switch view {
+	case ViewOverdue:
+		return time.Time{}, today
+	case ViewToday:
+		return today, tomorrow
+	case ViewUpcoming:
+		return tomorrow, time.Date(local.Year(), local.Month(), local.Day()+1+upcomingDays, 0, 0, 0, 0, loc)
+	}
+	return time.Time{}, time.Time{}
+}
+
+// Where returns the predicates selecting the todos of view. The dated views
+// only list open todos with a due date.
+func Where(view string, now time.Time, loc *time.Location, upcomingDays int) []predicate.Todo {
+	switch view {
+	case ViewOverdue, ViewToday, ViewUpcoming:
+		ps := []predicate.Todo{todo.Status(false), todo.DueDateNotNil()}
+		from, to := Range(view, now, loc, upcomingDays)
+		// dueDate 列不带时区，存的是 UTC；带偏移的时间传给 Postgres 时偏移会被丢弃
+		if !from.IsZero() {
+			ps = append(ps, todo.DueDateGTE(from.UTC()))
+		}
+		if !to.IsZero() {
+			ps = append(ps, todo.DueDateLT(to.UTC()))
+		}
+		return ps
+	case ViewPinned:
+		return []predicate.Todo{todo.Pinned(true)}
+	}
+	return nil
+}
+
+// ParseDueDate reads a RFC3339 time, or a YYYY-MM-DD date at midnight in loc,
+// and returns it in UTC, the way the dueDate column stores it.
+func ParseDueDate(v string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t.UTC(), nil
+	}
+	t, err := time.ParseInLocation(time.DateOnly, v, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid dueDate: expected RFC3339 or YYYY-MM-DD, got %q", v)
+	}
+	return t.UTC(), nil
+}
+
+// Order returns the order of the todos of view. The dated views are ordered
+// by due date; otherwise pinned and open todos come first, then the manual
+// order, then priority, due date and creation.
+func Order(view string) []todo.OrderOption {
+	switch view {
+	case ViewOverdue, ViewToday, ViewUpcoming:
+		return []todo.OrderOption{
+			todo.ByDueDate(),
+			todo.ByPriority(sql.OrderDesc()),
+			todo.ByID(),
+		}
+	}
+	return []todo.OrderOption{
+		todo.ByPinned(sql.OrderDesc()),
+		todo.ByStatus(),
+		bySort,
+		todo.ByPriority(sql.OrderDesc()),
+		todo.ByDueDate(sql.OrderNullsLast()),
+		todo.ByCreatedAt(sql.OrderDesc()),
+		todo.ByID(),
+	}
+}
+
+// bySort orders by the manual position, todos never reordered last. A
+// position that is not a number is ignored rather than failing the query.
+func bySort(s *sql.Selector) {
+	s.OrderExprFunc(func(b *sql.Builder) {
+		column := s.C(todo.FieldExtraData)
+		b.WriteString("(CASE WHEN jsonb_typeof(" + column + "::jsonb -> '" + SortKey + "') = 'number' THEN (" +
+			column + "::jsonb ->> '" + SortKey + "')::numeric END) ASC NULLS LAST")
+	})
+}
@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/ent/migrate"
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect/sql/schema"
+)
+
+// serviceTables are the tables owned by this service. Everything else belongs
+// to the Prisma schema and is only ever imported, never migrated from here.
+var serviceTables = []*schema.Table{
+	migrate.SearchOutboxTable,
+	migrate.IndexDeadLetterTable,
+	migrate.KeepChunkTable,
+	migrate.SearchLogTable,
+	migrate.SearchClickTable,
+	migrate.TagSuggestionTable,
+}
+
+// serviceTableNames returns the names of the tables owned by this service.
+func serviceTableNames() []string {
+	names := make([]string, 0, len(serviceTables))
+	for _, t := range serviceTables {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+// MigrateServiceTables creates or updates the tables owned by this service.
+// Tables managed by Prisma are left alone.
+func MigrateServiceTables() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	// Initialize database service
+	db, err := database.New()
+	if err != nil {
+		return fmt.Errorf("failed to initialize database: %w", err)
+	}
+	defer func() {
+		if closeErr := db.Close(); closeErr != nil {
+			toolsLogger.Warnw("failed to close database connection", "error", closeErr)
+		}
+	}()
+
+	if err := migrate.Create(ctx, db.Client().Schema, serviceTables, schema.WithDiffHook(keepVectorColumns)); err != nil {
+		return fmt.Errorf("failed to migrate service tables: %w", err)
+	}
+
+	toolsLogger.Infow("service tables migrated", "tables", serviceTableNames())
+	return nil
+}
+
+// keepVectorColumns drops the changes that would turn the columns converted by
+// MigratePgvector back into the jsonb type declared in the ent schema.
+func keepVectorColumns(next schema.Differ) schema.Differ {
+	return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range changes {
+			if m, ok := c.(*atlas.ModifyTable); ok {
+				m.Changes = slices.DeleteFunc(m.Changes, isVectorColumnChange)
+			}
+		}
+		return changes, nil
+	})
+}
+
+// isVectorColumnChange reports whether c modifies a pgvector column, which
+// Atlas inspects as a user-defined type.
+func isVectorColumnChange(c atlas.Change) bool {
+	m, ok := c.(*atlas.ModifyColumn)
+	return ok && m.From != nil && m.From.Type != nil && m.From.Type.Raw == "USER-DEFINED"
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"time"
 
 	"api.us4ever/internal/database"
+	"api.us4ever/internal/embedding"
 	"api.us4ever/internal/es"
 	"api.us4ever/internal/logger"
 	"github.com/google/uuid"
@@ -91,6 +93,7 @@ func ImportMomentsFromCSV(filePath string) error {
 
 	// Process CSV records
 	var processedCount int
+	embeddingDown := false
 	for {
 		record, err := reader.Read()
 		if err != nil {
@@ -115,15 +118,22 @@ func ImportMomentsFromCSV(filePath string) error {
 			continue
 		}
 
-		// Generate content vector
-		contentVector, err := es.Embed(ctx, content)
-		if err != nil {
-			toolsLogger.Warnw("failed to generate embedding for content",
-				"error", err,
-				"content", content[:min(50, len(content))],
-			)
-			// Continue without vector if embedding fails
-			contentVector = nil
+		// Generate content vector; rows left without one are picked up by the embedding task later
+		var contentVector []float32
+		if !embeddingDown {
+			contentVector, err = es.Embed(ctx, content)
+			if err != nil {
+				toolsLogger.Warnw("failed to generate embedding for content",
+					"error", err,
+					"content", content[:min(50, len(content))],
+				)
+				// Continue without vector if embedding fails
+				contentVector = nil
+				if errors.Is(err, embedding.ErrUnavailable) {
+					toolsLogger.Warn("embedding service unavailable, importing remaining rows without vectors")
+					embeddingDown = true
+				}
+			}
 		}
 
 		var vectorJSON []byte
@@ -0,0 +1,45 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"api.us4ever/internal/config"
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/embedding"
+	"api.us4ever/internal/search"
+)
+
+// MigratePgvector converts the JSON vector columns to pgvector columns sized
+// for the configured embedding model and adds the text search columns, so the
+// Postgres search backend can rank by both. The Prisma schema has to declare
+// the converted columns as Unsupported("vector(N)"), otherwise a Prisma
+// migration turns them back into Json.
+func MigratePgvector() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	// Initialize database service
+	db, err := database.New()
+	if err != nil {
+		return fmt.Errorf("failed to initialize database: %w", err)
+	}
+	defer func() {
+		if closeErr := db.Close(); closeErr != nil {
+			toolsLogger.Warnw("failed to close database connection", "error", closeErr)
+		}
+	}()
+
+	var textSearchConfig string
+	if appConfig := config.GetAppConfig(); appConfig != nil {
+		textSearchConfig = appConfig.Search.TextSearchConfig
+	}
+	dims := embedding.Dimensions()
+	if err := search.MigratePostgres(ctx, db.Client(), dims, textSearchConfig); err != nil {
+		return err
+	}
+
+	toolsLogger.Infow("vector columns migrated to pgvector", "dims", dims)
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
 
 	"api.us4ever/internal/config"
 	"api.us4ever/internal/logger"
@@ -26,15 +28,15 @@ func init() {
 func SyncSchema() error {
 	// 确保目录存在
 	schemaDir := "internal/ent/schema"
-	// 先清空目录
-	if err := os.RemoveAll(schemaDir); err != nil {
-		return fmt.Errorf("failed to remove existing schema directory: %v", err)
-	}
-
 	if err := os.MkdirAll(schemaDir, 0755); err != nil {
 		return fmt.Errorf("failed to create schema directory: %v", err)
 	}
 
+	// 先清空 entimport 生成的文件，保留手写的 schema（本服务自有的表）
+	if err := removeGeneratedSchemas(schemaDir); err != nil {
+		return err
+	}
+
 	// 从 config 包获取配置
 	dbConfig, err := config.LoadDatabaseConfig()
 	if err != nil {
@@ -51,7 +53,7 @@ func SyncSchema() error {
 		"-mod=mod", "github.com/powerfulyang/entimport/cmd/entimport",
 		"-dsn", dsn,
 		"-schema-path", "./internal/ent/schema",
-		"--exclude-tables", "_prisma_migrations",
+		"--exclude-tables", strings.Join(append([]string{"_prisma_migrations"}, serviceTableNames()...), ","),
 	)
 	cmd.Dir = "."
 	cmd.Stdout = os.Stdout
@@ -64,3 +66,28 @@ func SyncSchema() error {
 	syncLogger.Info("ENT schema generated successfully")
 	return nil
 }
+
+// generatedHeader marks schema files written by entimport
+const generatedHeader = "// Code generated by entimport, DO NOT EDIT."
+
+// removeGeneratedSchemas deletes the schema files written by entimport.
+func removeGeneratedSchemas(schemaDir string) error {
+	files, err := filepath.Glob(filepath.Join(schemaDir, "*.go"))
+	if err != nil {
+		return fmt.Errorf("failed to list schema files: %v", err)
+	}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read schema file %s: %v", file, err)
+		}
+		if !strings.HasPrefix(string(content), generatedHeader) {
+			syncLogger.Infow("keeping hand-written schema", "file", file)
+			continue
+		}
+		if err := os.Remove(file); err != nil {
+			return fmt.Errorf("failed to remove schema file %s: %v", file, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"api.us4ever/internal/database"
+	"api.us4ever/internal/embedding"
+	"api.us4ever/internal/ent"
+	"api.us4ever/internal/ent/keep"
+	"api.us4ever/internal/ent/moment"
+	"api.us4ever/internal/ent/predicate"
+	"api.us4ever/internal/es"
+	"entgo.io/ent/dialect/sql"
+)
+
+// repairPageSize is how many rows are loaded per query while repairing vectors
+const repairPageSize = 100
+
+// VectorRepairReport counts what RepairPlaceholderVectors found and did.
+type VectorRepairReport struct {
+	// Found is the number of vector columns holding the placeholder
+	Found int
+	// Repaired is the number of columns re-embedded successfully
+	Repaired int
+	// Cleared is the number of columns reset to NULL, either because the text is
+	// empty or the service rejected it; the embedding task fills them in later
+	Cleared int
+}
+
+// RepairPlaceholderVectors finds keep and moment vectors that still hold the
+// placeholder stored while the embedding service was down, and re-embeds them.
+// With dryRun set it only counts them. The search indices are not touched; run
+// a reindex afterwards.
+func RepairPlaceholderVectors(dryRun bool) (VectorRepairReport, error) {
+	var report VectorRepairReport
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Hour)
+	defer cancel()
+
+	// Initialize database service
+	db, err := database.New()
+	if err != nil {
+		return report, fmt.Errorf("failed to initialize database: %w", err)
+	}
+	defer func() {
+		if closeErr := db.Close(); closeErr != nil {
+			toolsLogger.Warnw("failed to close database connection", "error", closeErr)
+		}
+	}()
+
+	if err := repairKeepVectors(ctx, db.Client(), dryRun, &report); err != nil {
+		return report, err
+	}
+	if err := repairMomentVectors(ctx, db.Client(), dryRun, &report); err != nil {
+		return report, err
+	}
+	return report, nil
+}
+
+func repairKeepVectors(ctx context.Context, client *ent.Client, dryRun bool, report *VectorRepairReport) error {
+	lastID := ""
+	for {
+		records, err := client.Keep.Query().
+			Where(
+				keep.IDGT(lastID),
+				keep.Or(
+					placeholderCandidate[predicate.Keep](keep.FieldTitleVector),
+					placeholderCandidate[predicate.Keep](keep.FieldSummaryVector),
+					placeholderCandidate[predicate.Keep](keep.FieldContentVector),
+				),
+			).
+			Order(ent.Asc(keep.FieldID)).
+			Limit(repairPageSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query keeps: %w", err)
+		}
+		if len(records) == 0 {
+			return nil
+		}
+
+		for _, record := range records {
+			lastID = record.ID
+			update := client.Keep.UpdateOneID(record.ID)
+			changed := false
+
+			columns := []struct {
+				name  string
+				value json.RawMessage
+				text  string
+				set   func(json.RawMessage) *ent.KeepUpdateOne
+				clear func() *ent.KeepUpdateOne
+			}{
+				{keep.FieldTitleVector, record.TitleVector, record.Title, update.SetTitleVector, update.ClearTitleVector},
+				{keep.FieldSummaryVector, record.SummaryVector, record.Summary, update.SetSummaryVector, update.ClearSummaryVector},
+				{keep.FieldContentVector, record.ContentVector, record.Content, update.SetContentVector, update.ClearContentVector},
+			}
+			for _, col := range columns {
+				if !isPlaceholderJSON(col.value) {
+					continue
+				}
+				report.Found++
+				if dryRun {
+					toolsLogger.Infow("placeholder vector found", "entity", "keep", "id", record.ID, "column", col.name)
+					continue
+				}
+				vector, err := reembed(ctx, col.text)
+				if err != nil {
+					return fmt.Errorf("keep %s %s: %w", record.ID, col.name, err)
+				}
+				if vector == nil {
+					col.clear()
+					report.Cleared++
+				} else {
+					col.set(vector)
+					report.Repaired++
+				}
+				changed = true
+			}
+
+			if changed {
+				if err := update.Exec(ctx); err != nil {
+					return fmt.Errorf("failed to update keep %s: %w", record.ID, err)
+				}
+			}
+		}
+	}
+}
+
+func repairMomentVectors(ctx context.Context, client *ent.Client, dryRun bool, report *VectorRepairReport) error {
+	lastID := ""
+	for {
+		records, err := client.Moment.Query().
+			Where(
+				moment.IDGT(lastID),
+				placeholderCandidate[predicate.Moment](moment.FieldContentVector),
+			).
+			Order(ent.Asc(moment.FieldID)).
+			Limit(repairPageSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query moments: %w", err)
+		}
+		if len(records) == 0 {
+			return nil
+		}
+
+		for _, record := range records {
+			lastID = record.ID
+			if !isPlaceholderJSON(record.ContentVector) {
+				continue
+			}
+			report.Found++
+			if dryRun {
+				toolsLogger.Infow("placeholder vector found", "entity", "moment", "id", record.ID, "column", moment.FieldContentVector)
+				continue
+			}
+			vector, err := reembed(ctx, record.Content)
+			if err != nil {
+				return fmt.Errorf("moment %s %s: %w", record.ID, moment.FieldContentVector, err)
+			}
+			update := client.Moment.UpdateOneID(record.ID)
+			if vector == nil {
+				update.ClearContentVector()
+				report.Cleared++
+			} else {
+				update.SetContentVector(vector)
+				report.Repaired++
+			}
+			if err := update.Exec(ctx); err != nil {
+				return fmt.Errorf("failed to update moment %s: %w", record.ID, err)
+			}
+		}
+	}
+}
+
+// placeholderCandidate narrows the scan in SQL to vectors whose first
+// component is 0.1; isPlaceholderJSON then checks the rest in Go. The column
+// goes through text so it works before and after migrate-pgvector.
+func placeholderCandidate[P ~func(*sql.Selector)](column string) P {
+	return func(s *sql.Selector) {
+		s.Where(sql.ExprP(fmt.Sprintf("(%s::text::jsonb->>0) = '0.1'", s.C(column))))
+	}
+}
+
+func isPlaceholderJSON(raw json.RawMessage) bool {
+	if len(raw) == 0 {
+		return false
+	}
+	var vector []float32
+	if err := json.Unmarshal(raw, &vector); err != nil {
+		return false
+	}
+	return embedding.IsLegacyPlaceholder(vector)
+}
+
+// reembed returns the new vector as JSON, or nil when the column should be
+// cleared. An unavailable embedding service aborts the repair.
+func reembed(ctx context.Context, text string) (json.RawMessage, error) {
+	if text == "" {
+		return nil, nil
+	}
+	vector, err := es.Embed(ctx, text)
+	if err != nil {
+		if errors.Is(err, embedding.ErrUnavailable) {
+			return nil, err
+		}
+		toolsLogger.Warnw("embedding rejected, clearing vector", "error", err)
+		return nil, nil
+	}
+	return json.Marshal(vector)
+}
@@ -5,6 +5,8 @@ import (
 	"regexp"
 	"strings"
 	"unicode/utf8"
+
+	"api.us4ever/internal/es"
 )
 
 // SearchRequest represents a search request with validation
@@ -46,9 +48,20 @@ func (v *Validator) ValidateSearchRequest(req *SearchRequest) error {
 		return fmt.Errorf("invalid offset: %w", err)
 	}
 	
+	// Elasticsearch refuses pages ending past its result window
+	if window := v.resultWindow(); req.Offset+req.Limit > window {
+		return fmt.Errorf("invalid offset: offset plus limit cannot exceed %d", window)
+	}
+	
 	return nil
 }
 
+// resultWindow returns the deepest hit a page may end at: the configured
+// limits, bounded by the result window of Elasticsearch
+func (v *Validator) resultWindow() int {
+	return min(v.maxOffset+v.maxLimit, es.MaxResultWindow)
+}
+
 // validateQuery validates the search query
 func (v *Validator) validateQuery(query string) error {
 	// Trim whitespace
@@ -142,13 +155,13 @@ func (v *Validator) SanitizeQuery(query string) string {
 	// Trim whitespace
 	query = strings.TrimSpace(query)
 	
-	// Remove control characters
-	query = regexp.MustCompile(`[\x00-\x1f\x7f]`).ReplaceAllString(query, "")
-	
-	// Normalize whitespace
+	// Normalize whitespace first so tabs and newlines become spaces
 	query = regexp.MustCompile(`\s+`).ReplaceAllString(query, " ")
 	
-	return query
+	// Remove remaining control characters
+	query = regexp.MustCompile(`[\x00-\x1f\x7f]`).ReplaceAllString(query, "")
+	
+	return strings.TrimSpace(query)
 }
 
 // ValidateAndSanitizeQuery validates and sanitizes a search query
@@ -66,6 +66,24 @@ func TestValidateSearchRequest(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "last page of the result window",
+			request: SearchRequest{
+				Query:  "test",
+				Limit:  100,
+				Offset: 9900,
+			},
+			wantErr: false,
+		},
+		{
+			name: "page past the result window",
+			request: SearchRequest{
+				Query:  "test",
+				Limit:  100,
+				Offset: 10000,
+			},
+			wantErr: true,
+		},
 		{
 			name: "dangerous script tag",
 			request: SearchRequest{
@@ -124,6 +142,21 @@ func TestSanitizeQuery(t *testing.T) {
 			input:    "hello\t\nworld",
 			expected: "hello world",
 		},
+		{
+			name:     "query with carriage return",
+			input:    "hello\r\nworld",
+			expected: "hello world",
+		},
+		{
+			name:     "control characters next to whitespace",
+			input:    "hello\x00\tworld",
+			expected: "hello world",
+		},
+		{
+			name:     "control characters at the edges",
+			input:    "\x00 hello world \x7f",
+			expected: "hello world",
+		},
 	}
 	
 	for _, tt := range tests {
@@ -208,6 +241,24 @@ func TestSetLimits(t *testing.T) {
 	if err2 == nil {
 		t.Error("ValidateSearchRequest() should have failed with custom limits")
 	}
+
+	// Pages end within the configured limits
+	validator.SetLimits(0, 0, 20, 100)
+	req3 := &SearchRequest{Query: "test", Limit: 20, Offset: 100}
+	if err := validator.ValidateSearchRequest(req3); err != nil {
+		t.Errorf("ValidateSearchRequest() of the last configured page failed: %v", err)
+	}
+
+	// Configured limits cannot reach past the Elasticsearch result window
+	validator.SetLimits(0, 0, 100, 20000)
+	req4 := &SearchRequest{Query: "test", Limit: 100, Offset: 9900}
+	if err := validator.ValidateSearchRequest(req4); err != nil {
+		t.Errorf("ValidateSearchRequest() of the last page of the window failed: %v", err)
+	}
+	req4.Offset = 15000
+	if err := validator.ValidateSearchRequest(req4); err == nil {
+		t.Error("ValidateSearchRequest() should have failed past the result window")
+	}
 }
 
 func BenchmarkValidateSearchRequest(b *testing.B) {
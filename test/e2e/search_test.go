@@ -47,7 +47,7 @@ func TestSearchKeeps(t *testing.T) {
 		"index_alias", indexAlias,
 		"search_query", searchQuery,
 	)
-	results, err := es.SearchKeeps(ctx, client, indexAlias, searchQuery)
+	results, err := es.SearchKeeps(ctx, client, indexAlias, searchQuery, es.SearchOptions{})
 
 	total := results.Hits.Total.Value
 